@@ -0,0 +1,52 @@
+package cosmos
+
+import (
+	anteinterfaces "github.com/cosmos/evm/ante/interfaces"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// SignerNonceDecorator records the sequence of the transaction signers before it's
+// incremented, so that the contract creations executed on behalf of a signer (e.g.
+// MsgDeployContract) take the nonce of the transaction like an Ethereum transaction,
+// instead of incrementing the signer sequence a second time.
+// CONTRACT: it must be called before the IncrementSequenceDecorator.
+type SignerNonceDecorator struct {
+	accountKeeper anteinterfaces.AccountKeeper
+	evmKeeper     anteinterfaces.EVMKeeper
+}
+
+// NewSignerNonceDecorator creates a new SignerNonceDecorator instance used only for
+// Cosmos transactions.
+func NewSignerNonceDecorator(ak anteinterfaces.AccountKeeper, ek anteinterfaces.EVMKeeper) SignerNonceDecorator {
+	return SignerNonceDecorator{accountKeeper: ak, evmKeeper: ek}
+}
+
+func (snd SignerNonceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return ctx, errorsmod.Wrapf(errortypes.ErrInvalidType, "invalid transaction type %T, expected authsigning.SigVerifiableTx", tx)
+	}
+
+	signers, err := sigTx.GetSigners()
+	if err != nil {
+		return ctx, err
+	}
+
+	// the transient store is only reset at the end of the block, so the signers of
+	// the previous txs of the block must be removed
+	snd.evmKeeper.ResetSignerNoncesTransient(ctx)
+	for _, signer := range signers {
+		sequence, err := snd.accountKeeper.GetSequence(ctx, signer)
+		if err != nil {
+			return ctx, err
+		}
+		snd.evmKeeper.SetSignerNonceTransient(ctx, signer, sequence)
+	}
+
+	return next(ctx, tx, simulate)
+}
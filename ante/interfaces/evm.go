@@ -26,6 +26,8 @@ type EVMKeeper interface {
 	GetBalance(ctx sdk.Context, addr common.Address) *big.Int
 	ResetTransientGasUsed(ctx sdk.Context)
 	GetTxIndexTransient(ctx sdk.Context) uint64
+	SetSignerNonceTransient(ctx sdk.Context, signer sdk.AccAddress, nonce uint64)
+	ResetSignerNoncesTransient(ctx sdk.Context)
 	GetParams(ctx sdk.Context) evmtypes.Params
 	// GetBaseFee returns the BaseFee param from the fee market module
 	// adapted according to the evm denom decimals
@@ -19,10 +19,14 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_ConvertERC20_FullMethodName     = "/cosmos.evm.erc20.v1.Msg/ConvertERC20"
-	Msg_UpdateParams_FullMethodName     = "/cosmos.evm.erc20.v1.Msg/UpdateParams"
-	Msg_RegisterERC20_FullMethodName    = "/cosmos.evm.erc20.v1.Msg/RegisterERC20"
-	Msg_ToggleConversion_FullMethodName = "/cosmos.evm.erc20.v1.Msg/ToggleConversion"
+	Msg_ConvertERC20_FullMethodName               = "/cosmos.evm.erc20.v1.Msg/ConvertERC20"
+	Msg_UpdateParams_FullMethodName               = "/cosmos.evm.erc20.v1.Msg/UpdateParams"
+	Msg_RegisterERC20_FullMethodName              = "/cosmos.evm.erc20.v1.Msg/RegisterERC20"
+	Msg_ToggleConversion_FullMethodName           = "/cosmos.evm.erc20.v1.Msg/ToggleConversion"
+	Msg_RegisterERC20WithDeposit_FullMethodName   = "/cosmos.evm.erc20.v1.Msg/RegisterERC20WithDeposit"
+	Msg_DelistERC20_FullMethodName                = "/cosmos.evm.erc20.v1.Msg/DelistERC20"
+	Msg_RedeemCoin_FullMethodName                 = "/cosmos.evm.erc20.v1.Msg/RedeemCoin"
+	Msg_ReleaseRegistrationDeposit_FullMethodName = "/cosmos.evm.erc20.v1.Msg/ReleaseRegistrationDeposit"
 )
 
 // MsgClient is the client API for Msg service.
@@ -44,6 +48,21 @@ type MsgClient interface {
 	// token pair conversion. The authority is hard-coded to the Cosmos SDK x/gov
 	// module account
 	ToggleConversion(ctx context.Context, in *MsgToggleConversion, opts ...grpc.CallOption) (*MsgToggleConversionResponse, error)
+	// RegisterERC20WithDeposit registers a token pair for the specified erc20
+	// contract without governance, by locking the registration deposit from the
+	// sender. It requires the permissionless registration to be enabled.
+	RegisterERC20WithDeposit(ctx context.Context, in *MsgRegisterERC20WithDeposit, opts ...grpc.CallOption) (*MsgRegisterERC20WithDepositResponse, error)
+	// DelistERC20 defines a governance operation for removing the token pair of
+	// an erc20 contract and refunding or burning its registration deposit. The
+	// authority is hard-coded to the Cosmos SDK x/gov module account
+	DelistERC20(ctx context.Context, in *MsgDelistERC20, opts ...grpc.CallOption) (*MsgDelistERC20Response, error)
+	// RedeemCoin converts the Cosmos coins of a delisted token pair back to
+	// their ERC20 tokens
+	RedeemCoin(ctx context.Context, in *MsgRedeemCoin, opts ...grpc.CallOption) (*MsgRedeemCoinResponse, error)
+	// ReleaseRegistrationDeposit defines a governance operation for refunding
+	// the registration deposit of a token pair to its depositor. The authority
+	// is hard-coded to the Cosmos SDK x/gov module account
+	ReleaseRegistrationDeposit(ctx context.Context, in *MsgReleaseRegistrationDeposit, opts ...grpc.CallOption) (*MsgReleaseRegistrationDepositResponse, error)
 }
 
 type msgClient struct {
@@ -90,6 +109,42 @@ func (c *msgClient) ToggleConversion(ctx context.Context, in *MsgToggleConversio
 	return out, nil
 }
 
+func (c *msgClient) RegisterERC20WithDeposit(ctx context.Context, in *MsgRegisterERC20WithDeposit, opts ...grpc.CallOption) (*MsgRegisterERC20WithDepositResponse, error) {
+	out := new(MsgRegisterERC20WithDepositResponse)
+	err := c.cc.Invoke(ctx, Msg_RegisterERC20WithDeposit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DelistERC20(ctx context.Context, in *MsgDelistERC20, opts ...grpc.CallOption) (*MsgDelistERC20Response, error) {
+	out := new(MsgDelistERC20Response)
+	err := c.cc.Invoke(ctx, Msg_DelistERC20_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RedeemCoin(ctx context.Context, in *MsgRedeemCoin, opts ...grpc.CallOption) (*MsgRedeemCoinResponse, error) {
+	out := new(MsgRedeemCoinResponse)
+	err := c.cc.Invoke(ctx, Msg_RedeemCoin_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReleaseRegistrationDeposit(ctx context.Context, in *MsgReleaseRegistrationDeposit, opts ...grpc.CallOption) (*MsgReleaseRegistrationDepositResponse, error) {
+	out := new(MsgReleaseRegistrationDepositResponse)
+	err := c.cc.Invoke(ctx, Msg_ReleaseRegistrationDeposit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -109,6 +164,21 @@ type MsgServer interface {
 	// token pair conversion. The authority is hard-coded to the Cosmos SDK x/gov
 	// module account
 	ToggleConversion(context.Context, *MsgToggleConversion) (*MsgToggleConversionResponse, error)
+	// RegisterERC20WithDeposit registers a token pair for the specified erc20
+	// contract without governance, by locking the registration deposit from the
+	// sender. It requires the permissionless registration to be enabled.
+	RegisterERC20WithDeposit(context.Context, *MsgRegisterERC20WithDeposit) (*MsgRegisterERC20WithDepositResponse, error)
+	// DelistERC20 defines a governance operation for removing the token pair of
+	// an erc20 contract and refunding or burning its registration deposit. The
+	// authority is hard-coded to the Cosmos SDK x/gov module account
+	DelistERC20(context.Context, *MsgDelistERC20) (*MsgDelistERC20Response, error)
+	// RedeemCoin converts the Cosmos coins of a delisted token pair back to
+	// their ERC20 tokens
+	RedeemCoin(context.Context, *MsgRedeemCoin) (*MsgRedeemCoinResponse, error)
+	// ReleaseRegistrationDeposit defines a governance operation for refunding
+	// the registration deposit of a token pair to its depositor. The authority
+	// is hard-coded to the Cosmos SDK x/gov module account
+	ReleaseRegistrationDeposit(context.Context, *MsgReleaseRegistrationDeposit) (*MsgReleaseRegistrationDepositResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -128,6 +198,18 @@ func (UnimplementedMsgServer) RegisterERC20(context.Context, *MsgRegisterERC20)
 func (UnimplementedMsgServer) ToggleConversion(context.Context, *MsgToggleConversion) (*MsgToggleConversionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ToggleConversion not implemented")
 }
+func (UnimplementedMsgServer) RegisterERC20WithDeposit(context.Context, *MsgRegisterERC20WithDeposit) (*MsgRegisterERC20WithDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterERC20WithDeposit not implemented")
+}
+func (UnimplementedMsgServer) DelistERC20(context.Context, *MsgDelistERC20) (*MsgDelistERC20Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelistERC20 not implemented")
+}
+func (UnimplementedMsgServer) RedeemCoin(context.Context, *MsgRedeemCoin) (*MsgRedeemCoinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemCoin not implemented")
+}
+func (UnimplementedMsgServer) ReleaseRegistrationDeposit(context.Context, *MsgReleaseRegistrationDeposit) (*MsgReleaseRegistrationDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseRegistrationDeposit not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -213,6 +295,78 @@ func _Msg_ToggleConversion_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RegisterERC20WithDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterERC20WithDeposit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterERC20WithDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RegisterERC20WithDeposit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterERC20WithDeposit(ctx, req.(*MsgRegisterERC20WithDeposit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DelistERC20_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelistERC20)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelistERC20(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DelistERC20_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelistERC20(ctx, req.(*MsgDelistERC20))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RedeemCoin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRedeemCoin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RedeemCoin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RedeemCoin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RedeemCoin(ctx, req.(*MsgRedeemCoin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReleaseRegistrationDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReleaseRegistrationDeposit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReleaseRegistrationDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ReleaseRegistrationDeposit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReleaseRegistrationDeposit(ctx, req.(*MsgReleaseRegistrationDeposit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -236,6 +390,22 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ToggleConversion",
 			Handler:    _Msg_ToggleConversion_Handler,
 		},
+		{
+			MethodName: "RegisterERC20WithDeposit",
+			Handler:    _Msg_RegisterERC20WithDeposit_Handler,
+		},
+		{
+			MethodName: "DelistERC20",
+			Handler:    _Msg_DelistERC20_Handler,
+		},
+		{
+			MethodName: "RedeemCoin",
+			Handler:    _Msg_RedeemCoin_Handler,
+		},
+		{
+			MethodName: "ReleaseRegistrationDeposit",
+			Handler:    _Msg_ReleaseRegistrationDeposit_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evm/erc20/v1/tx.proto",
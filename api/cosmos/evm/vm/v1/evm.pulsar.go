@@ -4,6 +4,7 @@ package vmv1
 import (
 	_ "cosmossdk.io/api/amino"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
@@ -161,6 +162,7 @@ var (
 	fd_Params_evm_channels              protoreflect.FieldDescriptor
 	fd_Params_access_control            protoreflect.FieldDescriptor
 	fd_Params_active_static_precompiles protoreflect.FieldDescriptor
+	fd_Params_emergency_authority       protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -173,6 +175,7 @@ func init() {
 	fd_Params_evm_channels = md_Params.Fields().ByName("evm_channels")
 	fd_Params_access_control = md_Params.Fields().ByName("access_control")
 	fd_Params_active_static_precompiles = md_Params.Fields().ByName("active_static_precompiles")
+	fd_Params_emergency_authority = md_Params.Fields().ByName("emergency_authority")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -282,6 +285,12 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.EmergencyAuthority != "" {
+		value := protoreflect.ValueOfString(x.EmergencyAuthority)
+		if !f(fd_Params_emergency_authority, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -311,6 +320,8 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.AccessControl != nil
 	case "cosmos.evm.vm.v1.Params.active_static_precompiles":
 		return len(x.ActiveStaticPrecompiles) != 0
+	case "cosmos.evm.vm.v1.Params.emergency_authority":
+		return x.EmergencyAuthority != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Params"))
@@ -341,6 +352,8 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.AccessControl = nil
 	case "cosmos.evm.vm.v1.Params.active_static_precompiles":
 		x.ActiveStaticPrecompiles = nil
+	case "cosmos.evm.vm.v1.Params.emergency_authority":
+		x.EmergencyAuthority = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Params"))
@@ -387,6 +400,9 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 		}
 		listValue := &_Params_10_list{list: &x.ActiveStaticPrecompiles}
 		return protoreflect.ValueOfList(listValue)
+	case "cosmos.evm.vm.v1.Params.emergency_authority":
+		value := x.EmergencyAuthority
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Params"))
@@ -427,6 +443,8 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		lv := value.List()
 		clv := lv.(*_Params_10_list)
 		x.ActiveStaticPrecompiles = *clv.list
+	case "cosmos.evm.vm.v1.Params.emergency_authority":
+		x.EmergencyAuthority = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Params"))
@@ -479,6 +497,8 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field evm_denom of message cosmos.evm.vm.v1.Params is not mutable"))
 	case "cosmos.evm.vm.v1.Params.allow_unprotected_txs":
 		panic(fmt.Errorf("field allow_unprotected_txs of message cosmos.evm.vm.v1.Params is not mutable"))
+	case "cosmos.evm.vm.v1.Params.emergency_authority":
+		panic(fmt.Errorf("field emergency_authority of message cosmos.evm.vm.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Params"))
@@ -511,6 +531,8 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 	case "cosmos.evm.vm.v1.Params.active_static_precompiles":
 		list := []string{}
 		return protoreflect.ValueOfList(&_Params_10_list{list: &list})
+	case "cosmos.evm.vm.v1.Params.emergency_authority":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Params"))
@@ -614,6 +636,10 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		l = len(x.EmergencyAuthority)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -643,6 +669,13 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.EmergencyAuthority) > 0 {
+			i -= len(x.EmergencyAuthority)
+			copy(dAtA[i:], x.EmergencyAuthority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EmergencyAuthority)))
+			i--
+			dAtA[i] = 0x5a
+		}
 		if len(x.ActiveStaticPrecompiles) > 0 {
 			for iNdEx := len(x.ActiveStaticPrecompiles) - 1; iNdEx >= 0; iNdEx-- {
 				i -= len(x.ActiveStaticPrecompiles[iNdEx])
@@ -1040,6 +1073,38 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				}
 				x.ActiveStaticPrecompiles = append(x.ActiveStaticPrecompiles, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EmergencyAuthority", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EmergencyAuthority = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2117,68 +2182,78 @@ func (x *fastReflection_AccessControlType) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_AccessControlEntry_4_list)(nil)
+
+type _AccessControlEntry_4_list struct {
+	list *[]string
+}
+
+func (x *_AccessControlEntry_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_AccessControlEntry_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_AccessControlEntry_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_AccessControlEntry_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_AccessControlEntry_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message AccessControlEntry at list field AccessControlList as it is not of Message kind"))
+}
+
+func (x *_AccessControlEntry_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_AccessControlEntry_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_AccessControlEntry_4_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_ChainConfig                      protoreflect.MessageDescriptor
-	fd_ChainConfig_homestead_block      protoreflect.FieldDescriptor
-	fd_ChainConfig_dao_fork_block       protoreflect.FieldDescriptor
-	fd_ChainConfig_dao_fork_support     protoreflect.FieldDescriptor
-	fd_ChainConfig_eip150_block         protoreflect.FieldDescriptor
-	fd_ChainConfig_eip150_hash          protoreflect.FieldDescriptor
-	fd_ChainConfig_eip155_block         protoreflect.FieldDescriptor
-	fd_ChainConfig_eip158_block         protoreflect.FieldDescriptor
-	fd_ChainConfig_byzantium_block      protoreflect.FieldDescriptor
-	fd_ChainConfig_constantinople_block protoreflect.FieldDescriptor
-	fd_ChainConfig_petersburg_block     protoreflect.FieldDescriptor
-	fd_ChainConfig_istanbul_block       protoreflect.FieldDescriptor
-	fd_ChainConfig_muir_glacier_block   protoreflect.FieldDescriptor
-	fd_ChainConfig_berlin_block         protoreflect.FieldDescriptor
-	fd_ChainConfig_london_block         protoreflect.FieldDescriptor
-	fd_ChainConfig_arrow_glacier_block  protoreflect.FieldDescriptor
-	fd_ChainConfig_gray_glacier_block   protoreflect.FieldDescriptor
-	fd_ChainConfig_merge_netsplit_block protoreflect.FieldDescriptor
-	fd_ChainConfig_shanghai_block       protoreflect.FieldDescriptor
-	fd_ChainConfig_cancun_block         protoreflect.FieldDescriptor
-	fd_ChainConfig_chain_id             protoreflect.FieldDescriptor
-	fd_ChainConfig_denom                protoreflect.FieldDescriptor
-	fd_ChainConfig_decimals             protoreflect.FieldDescriptor
+	md_AccessControlEntry                     protoreflect.MessageDescriptor
+	fd_AccessControlEntry_contract            protoreflect.FieldDescriptor
+	fd_AccessControlEntry_selector            protoreflect.FieldDescriptor
+	fd_AccessControlEntry_access_type         protoreflect.FieldDescriptor
+	fd_AccessControlEntry_access_control_list protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_evm_proto_init()
-	md_ChainConfig = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("ChainConfig")
-	fd_ChainConfig_homestead_block = md_ChainConfig.Fields().ByName("homestead_block")
-	fd_ChainConfig_dao_fork_block = md_ChainConfig.Fields().ByName("dao_fork_block")
-	fd_ChainConfig_dao_fork_support = md_ChainConfig.Fields().ByName("dao_fork_support")
-	fd_ChainConfig_eip150_block = md_ChainConfig.Fields().ByName("eip150_block")
-	fd_ChainConfig_eip150_hash = md_ChainConfig.Fields().ByName("eip150_hash")
-	fd_ChainConfig_eip155_block = md_ChainConfig.Fields().ByName("eip155_block")
-	fd_ChainConfig_eip158_block = md_ChainConfig.Fields().ByName("eip158_block")
-	fd_ChainConfig_byzantium_block = md_ChainConfig.Fields().ByName("byzantium_block")
-	fd_ChainConfig_constantinople_block = md_ChainConfig.Fields().ByName("constantinople_block")
-	fd_ChainConfig_petersburg_block = md_ChainConfig.Fields().ByName("petersburg_block")
-	fd_ChainConfig_istanbul_block = md_ChainConfig.Fields().ByName("istanbul_block")
-	fd_ChainConfig_muir_glacier_block = md_ChainConfig.Fields().ByName("muir_glacier_block")
-	fd_ChainConfig_berlin_block = md_ChainConfig.Fields().ByName("berlin_block")
-	fd_ChainConfig_london_block = md_ChainConfig.Fields().ByName("london_block")
-	fd_ChainConfig_arrow_glacier_block = md_ChainConfig.Fields().ByName("arrow_glacier_block")
-	fd_ChainConfig_gray_glacier_block = md_ChainConfig.Fields().ByName("gray_glacier_block")
-	fd_ChainConfig_merge_netsplit_block = md_ChainConfig.Fields().ByName("merge_netsplit_block")
-	fd_ChainConfig_shanghai_block = md_ChainConfig.Fields().ByName("shanghai_block")
-	fd_ChainConfig_cancun_block = md_ChainConfig.Fields().ByName("cancun_block")
-	fd_ChainConfig_chain_id = md_ChainConfig.Fields().ByName("chain_id")
-	fd_ChainConfig_denom = md_ChainConfig.Fields().ByName("denom")
-	fd_ChainConfig_decimals = md_ChainConfig.Fields().ByName("decimals")
+	md_AccessControlEntry = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("AccessControlEntry")
+	fd_AccessControlEntry_contract = md_AccessControlEntry.Fields().ByName("contract")
+	fd_AccessControlEntry_selector = md_AccessControlEntry.Fields().ByName("selector")
+	fd_AccessControlEntry_access_type = md_AccessControlEntry.Fields().ByName("access_type")
+	fd_AccessControlEntry_access_control_list = md_AccessControlEntry.Fields().ByName("access_control_list")
 }
 
-var _ protoreflect.Message = (*fastReflection_ChainConfig)(nil)
+var _ protoreflect.Message = (*fastReflection_AccessControlEntry)(nil)
 
-type fastReflection_ChainConfig ChainConfig
+type fastReflection_AccessControlEntry AccessControlEntry
 
-func (x *ChainConfig) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_ChainConfig)(x)
+func (x *AccessControlEntry) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AccessControlEntry)(x)
 }
 
-func (x *ChainConfig) slowProtoReflect() protoreflect.Message {
+func (x *AccessControlEntry) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2190,43 +2265,43 @@ func (x *ChainConfig) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_ChainConfig_messageType fastReflection_ChainConfig_messageType
-var _ protoreflect.MessageType = fastReflection_ChainConfig_messageType{}
+var _fastReflection_AccessControlEntry_messageType fastReflection_AccessControlEntry_messageType
+var _ protoreflect.MessageType = fastReflection_AccessControlEntry_messageType{}
 
-type fastReflection_ChainConfig_messageType struct{}
+type fastReflection_AccessControlEntry_messageType struct{}
 
-func (x fastReflection_ChainConfig_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_ChainConfig)(nil)
+func (x fastReflection_AccessControlEntry_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AccessControlEntry)(nil)
 }
-func (x fastReflection_ChainConfig_messageType) New() protoreflect.Message {
-	return new(fastReflection_ChainConfig)
+func (x fastReflection_AccessControlEntry_messageType) New() protoreflect.Message {
+	return new(fastReflection_AccessControlEntry)
 }
-func (x fastReflection_ChainConfig_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_ChainConfig
+func (x fastReflection_AccessControlEntry_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AccessControlEntry
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_ChainConfig) Descriptor() protoreflect.MessageDescriptor {
-	return md_ChainConfig
+func (x *fastReflection_AccessControlEntry) Descriptor() protoreflect.MessageDescriptor {
+	return md_AccessControlEntry
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_ChainConfig) Type() protoreflect.MessageType {
-	return _fastReflection_ChainConfig_messageType
+func (x *fastReflection_AccessControlEntry) Type() protoreflect.MessageType {
+	return _fastReflection_AccessControlEntry_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_ChainConfig) New() protoreflect.Message {
-	return new(fastReflection_ChainConfig)
+func (x *fastReflection_AccessControlEntry) New() protoreflect.Message {
+	return new(fastReflection_AccessControlEntry)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_ChainConfig) Interface() protoreflect.ProtoMessage {
-	return (*ChainConfig)(x)
+func (x *fastReflection_AccessControlEntry) Interface() protoreflect.ProtoMessage {
+	return (*AccessControlEntry)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2234,559 +2309,227 @@ func (x *fastReflection_ChainConfig) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_ChainConfig) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.HomesteadBlock != "" {
-		value := protoreflect.ValueOfString(x.HomesteadBlock)
-		if !f(fd_ChainConfig_homestead_block, value) {
-			return
-		}
-	}
-	if x.DaoForkBlock != "" {
-		value := protoreflect.ValueOfString(x.DaoForkBlock)
-		if !f(fd_ChainConfig_dao_fork_block, value) {
+func (x *fastReflection_AccessControlEntry) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Contract != "" {
+		value := protoreflect.ValueOfString(x.Contract)
+		if !f(fd_AccessControlEntry_contract, value) {
 			return
 		}
 	}
-	if x.DaoForkSupport != false {
-		value := protoreflect.ValueOfBool(x.DaoForkSupport)
-		if !f(fd_ChainConfig_dao_fork_support, value) {
+	if x.Selector != "" {
+		value := protoreflect.ValueOfString(x.Selector)
+		if !f(fd_AccessControlEntry_selector, value) {
 			return
 		}
 	}
-	if x.Eip150Block != "" {
-		value := protoreflect.ValueOfString(x.Eip150Block)
-		if !f(fd_ChainConfig_eip150_block, value) {
+	if x.AccessType != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.AccessType))
+		if !f(fd_AccessControlEntry_access_type, value) {
 			return
 		}
 	}
-	if x.Eip150Hash != "" {
-		value := protoreflect.ValueOfString(x.Eip150Hash)
-		if !f(fd_ChainConfig_eip150_hash, value) {
+	if len(x.AccessControlList) != 0 {
+		value := protoreflect.ValueOfList(&_AccessControlEntry_4_list{list: &x.AccessControlList})
+		if !f(fd_AccessControlEntry_access_control_list, value) {
 			return
 		}
 	}
-	if x.Eip155Block != "" {
-		value := protoreflect.ValueOfString(x.Eip155Block)
-		if !f(fd_ChainConfig_eip155_block, value) {
-			return
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_AccessControlEntry) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.AccessControlEntry.contract":
+		return x.Contract != ""
+	case "cosmos.evm.vm.v1.AccessControlEntry.selector":
+		return x.Selector != ""
+	case "cosmos.evm.vm.v1.AccessControlEntry.access_type":
+		return x.AccessType != 0
+	case "cosmos.evm.vm.v1.AccessControlEntry.access_control_list":
+		return len(x.AccessControlList) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControlEntry"))
 		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControlEntry does not contain field %s", fd.FullName()))
 	}
-	if x.Eip158Block != "" {
-		value := protoreflect.ValueOfString(x.Eip158Block)
-		if !f(fd_ChainConfig_eip158_block, value) {
-			return
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AccessControlEntry) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.AccessControlEntry.contract":
+		x.Contract = ""
+	case "cosmos.evm.vm.v1.AccessControlEntry.selector":
+		x.Selector = ""
+	case "cosmos.evm.vm.v1.AccessControlEntry.access_type":
+		x.AccessType = 0
+	case "cosmos.evm.vm.v1.AccessControlEntry.access_control_list":
+		x.AccessControlList = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControlEntry"))
 		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControlEntry does not contain field %s", fd.FullName()))
 	}
-	if x.ByzantiumBlock != "" {
-		value := protoreflect.ValueOfString(x.ByzantiumBlock)
-		if !f(fd_ChainConfig_byzantium_block, value) {
-			return
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_AccessControlEntry) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.evm.vm.v1.AccessControlEntry.contract":
+		value := x.Contract
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.AccessControlEntry.selector":
+		value := x.Selector
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.AccessControlEntry.access_type":
+		value := x.AccessType
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "cosmos.evm.vm.v1.AccessControlEntry.access_control_list":
+		if len(x.AccessControlList) == 0 {
+			return protoreflect.ValueOfList(&_AccessControlEntry_4_list{})
 		}
-	}
-	if x.ConstantinopleBlock != "" {
-		value := protoreflect.ValueOfString(x.ConstantinopleBlock)
-		if !f(fd_ChainConfig_constantinople_block, value) {
-			return
+		listValue := &_AccessControlEntry_4_list{list: &x.AccessControlList}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControlEntry"))
 		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControlEntry does not contain field %s", descriptor.FullName()))
 	}
-	if x.PetersburgBlock != "" {
-		value := protoreflect.ValueOfString(x.PetersburgBlock)
-		if !f(fd_ChainConfig_petersburg_block, value) {
-			return
-		}
-	}
-	if x.IstanbulBlock != "" {
-		value := protoreflect.ValueOfString(x.IstanbulBlock)
-		if !f(fd_ChainConfig_istanbul_block, value) {
-			return
-		}
-	}
-	if x.MuirGlacierBlock != "" {
-		value := protoreflect.ValueOfString(x.MuirGlacierBlock)
-		if !f(fd_ChainConfig_muir_glacier_block, value) {
-			return
-		}
-	}
-	if x.BerlinBlock != "" {
-		value := protoreflect.ValueOfString(x.BerlinBlock)
-		if !f(fd_ChainConfig_berlin_block, value) {
-			return
-		}
-	}
-	if x.LondonBlock != "" {
-		value := protoreflect.ValueOfString(x.LondonBlock)
-		if !f(fd_ChainConfig_london_block, value) {
-			return
-		}
-	}
-	if x.ArrowGlacierBlock != "" {
-		value := protoreflect.ValueOfString(x.ArrowGlacierBlock)
-		if !f(fd_ChainConfig_arrow_glacier_block, value) {
-			return
-		}
-	}
-	if x.GrayGlacierBlock != "" {
-		value := protoreflect.ValueOfString(x.GrayGlacierBlock)
-		if !f(fd_ChainConfig_gray_glacier_block, value) {
-			return
-		}
-	}
-	if x.MergeNetsplitBlock != "" {
-		value := protoreflect.ValueOfString(x.MergeNetsplitBlock)
-		if !f(fd_ChainConfig_merge_netsplit_block, value) {
-			return
-		}
-	}
-	if x.ShanghaiBlock != "" {
-		value := protoreflect.ValueOfString(x.ShanghaiBlock)
-		if !f(fd_ChainConfig_shanghai_block, value) {
-			return
-		}
-	}
-	if x.CancunBlock != "" {
-		value := protoreflect.ValueOfString(x.CancunBlock)
-		if !f(fd_ChainConfig_cancun_block, value) {
-			return
-		}
-	}
-	if x.ChainId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.ChainId)
-		if !f(fd_ChainConfig_chain_id, value) {
-			return
-		}
-	}
-	if x.Denom != "" {
-		value := protoreflect.ValueOfString(x.Denom)
-		if !f(fd_ChainConfig_denom, value) {
-			return
-		}
-	}
-	if x.Decimals != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Decimals)
-		if !f(fd_ChainConfig_decimals, value) {
-			return
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AccessControlEntry) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.AccessControlEntry.contract":
+		x.Contract = value.Interface().(string)
+	case "cosmos.evm.vm.v1.AccessControlEntry.selector":
+		x.Selector = value.Interface().(string)
+	case "cosmos.evm.vm.v1.AccessControlEntry.access_type":
+		x.AccessType = (AccessType)(value.Enum())
+	case "cosmos.evm.vm.v1.AccessControlEntry.access_control_list":
+		lv := value.List()
+		clv := lv.(*_AccessControlEntry_4_list)
+		x.AccessControlList = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControlEntry"))
 		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControlEntry does not contain field %s", fd.FullName()))
 	}
 }
 
-// Has reports whether a field is populated.
+// Mutable returns a mutable reference to a composite type.
 //
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
 //
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_ChainConfig) Has(fd protoreflect.FieldDescriptor) bool {
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AccessControlEntry) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.ChainConfig.homestead_block":
-		return x.HomesteadBlock != ""
-	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_block":
-		return x.DaoForkBlock != ""
-	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_support":
-		return x.DaoForkSupport != false
-	case "cosmos.evm.vm.v1.ChainConfig.eip150_block":
-		return x.Eip150Block != ""
-	case "cosmos.evm.vm.v1.ChainConfig.eip150_hash":
-		return x.Eip150Hash != ""
-	case "cosmos.evm.vm.v1.ChainConfig.eip155_block":
-		return x.Eip155Block != ""
-	case "cosmos.evm.vm.v1.ChainConfig.eip158_block":
-		return x.Eip158Block != ""
-	case "cosmos.evm.vm.v1.ChainConfig.byzantium_block":
-		return x.ByzantiumBlock != ""
-	case "cosmos.evm.vm.v1.ChainConfig.constantinople_block":
-		return x.ConstantinopleBlock != ""
-	case "cosmos.evm.vm.v1.ChainConfig.petersburg_block":
-		return x.PetersburgBlock != ""
-	case "cosmos.evm.vm.v1.ChainConfig.istanbul_block":
-		return x.IstanbulBlock != ""
-	case "cosmos.evm.vm.v1.ChainConfig.muir_glacier_block":
-		return x.MuirGlacierBlock != ""
-	case "cosmos.evm.vm.v1.ChainConfig.berlin_block":
-		return x.BerlinBlock != ""
-	case "cosmos.evm.vm.v1.ChainConfig.london_block":
-		return x.LondonBlock != ""
-	case "cosmos.evm.vm.v1.ChainConfig.arrow_glacier_block":
-		return x.ArrowGlacierBlock != ""
-	case "cosmos.evm.vm.v1.ChainConfig.gray_glacier_block":
-		return x.GrayGlacierBlock != ""
-	case "cosmos.evm.vm.v1.ChainConfig.merge_netsplit_block":
-		return x.MergeNetsplitBlock != ""
-	case "cosmos.evm.vm.v1.ChainConfig.shanghai_block":
-		return x.ShanghaiBlock != ""
-	case "cosmos.evm.vm.v1.ChainConfig.cancun_block":
-		return x.CancunBlock != ""
-	case "cosmos.evm.vm.v1.ChainConfig.chain_id":
-		return x.ChainId != uint64(0)
-	case "cosmos.evm.vm.v1.ChainConfig.denom":
-		return x.Denom != ""
-	case "cosmos.evm.vm.v1.ChainConfig.decimals":
-		return x.Decimals != uint64(0)
+	case "cosmos.evm.vm.v1.AccessControlEntry.access_control_list":
+		if x.AccessControlList == nil {
+			x.AccessControlList = []string{}
+		}
+		value := &_AccessControlEntry_4_list{list: &x.AccessControlList}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.evm.vm.v1.AccessControlEntry.contract":
+		panic(fmt.Errorf("field contract of message cosmos.evm.vm.v1.AccessControlEntry is not mutable"))
+	case "cosmos.evm.vm.v1.AccessControlEntry.selector":
+		panic(fmt.Errorf("field selector of message cosmos.evm.vm.v1.AccessControlEntry is not mutable"))
+	case "cosmos.evm.vm.v1.AccessControlEntry.access_type":
+		panic(fmt.Errorf("field access_type of message cosmos.evm.vm.v1.AccessControlEntry is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ChainConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControlEntry"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.ChainConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControlEntry does not contain field %s", fd.FullName()))
 	}
 }
 
-// Clear clears the field such that a subsequent Has call reports false.
-//
-// Clearing an extension field clears both the extension type and value
-// associated with the given field number.
-//
-// Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ChainConfig) Clear(fd protoreflect.FieldDescriptor) {
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_AccessControlEntry) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.ChainConfig.homestead_block":
-		x.HomesteadBlock = ""
-	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_block":
-		x.DaoForkBlock = ""
-	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_support":
-		x.DaoForkSupport = false
-	case "cosmos.evm.vm.v1.ChainConfig.eip150_block":
-		x.Eip150Block = ""
-	case "cosmos.evm.vm.v1.ChainConfig.eip150_hash":
-		x.Eip150Hash = ""
-	case "cosmos.evm.vm.v1.ChainConfig.eip155_block":
-		x.Eip155Block = ""
-	case "cosmos.evm.vm.v1.ChainConfig.eip158_block":
-		x.Eip158Block = ""
-	case "cosmos.evm.vm.v1.ChainConfig.byzantium_block":
-		x.ByzantiumBlock = ""
-	case "cosmos.evm.vm.v1.ChainConfig.constantinople_block":
-		x.ConstantinopleBlock = ""
-	case "cosmos.evm.vm.v1.ChainConfig.petersburg_block":
-		x.PetersburgBlock = ""
-	case "cosmos.evm.vm.v1.ChainConfig.istanbul_block":
-		x.IstanbulBlock = ""
-	case "cosmos.evm.vm.v1.ChainConfig.muir_glacier_block":
-		x.MuirGlacierBlock = ""
-	case "cosmos.evm.vm.v1.ChainConfig.berlin_block":
-		x.BerlinBlock = ""
-	case "cosmos.evm.vm.v1.ChainConfig.london_block":
-		x.LondonBlock = ""
-	case "cosmos.evm.vm.v1.ChainConfig.arrow_glacier_block":
-		x.ArrowGlacierBlock = ""
-	case "cosmos.evm.vm.v1.ChainConfig.gray_glacier_block":
-		x.GrayGlacierBlock = ""
-	case "cosmos.evm.vm.v1.ChainConfig.merge_netsplit_block":
-		x.MergeNetsplitBlock = ""
-	case "cosmos.evm.vm.v1.ChainConfig.shanghai_block":
-		x.ShanghaiBlock = ""
-	case "cosmos.evm.vm.v1.ChainConfig.cancun_block":
-		x.CancunBlock = ""
-	case "cosmos.evm.vm.v1.ChainConfig.chain_id":
-		x.ChainId = uint64(0)
-	case "cosmos.evm.vm.v1.ChainConfig.denom":
-		x.Denom = ""
-	case "cosmos.evm.vm.v1.ChainConfig.decimals":
-		x.Decimals = uint64(0)
+	case "cosmos.evm.vm.v1.AccessControlEntry.contract":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.AccessControlEntry.selector":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.AccessControlEntry.access_type":
+		return protoreflect.ValueOfEnum(0)
+	case "cosmos.evm.vm.v1.AccessControlEntry.access_control_list":
+		list := []string{}
+		return protoreflect.ValueOfList(&_AccessControlEntry_4_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ChainConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControlEntry"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.ChainConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControlEntry does not contain field %s", fd.FullName()))
 	}
 }
 
-// Get retrieves the value for a field.
-//
-// For unpopulated scalars, it returns the default value, where
-// the default value of a bytes scalar is guaranteed to be a copy.
-// For unpopulated composite types, it returns an empty, read-only view
-// of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ChainConfig) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
-	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.ChainConfig.homestead_block":
-		value := x.HomesteadBlock
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_block":
-		value := x.DaoForkBlock
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_support":
-		value := x.DaoForkSupport
-		return protoreflect.ValueOfBool(value)
-	case "cosmos.evm.vm.v1.ChainConfig.eip150_block":
-		value := x.Eip150Block
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.eip150_hash":
-		value := x.Eip150Hash
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.eip155_block":
-		value := x.Eip155Block
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.eip158_block":
-		value := x.Eip158Block
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.byzantium_block":
-		value := x.ByzantiumBlock
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.constantinople_block":
-		value := x.ConstantinopleBlock
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.petersburg_block":
-		value := x.PetersburgBlock
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.istanbul_block":
-		value := x.IstanbulBlock
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.muir_glacier_block":
-		value := x.MuirGlacierBlock
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.berlin_block":
-		value := x.BerlinBlock
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.london_block":
-		value := x.LondonBlock
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.arrow_glacier_block":
-		value := x.ArrowGlacierBlock
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.gray_glacier_block":
-		value := x.GrayGlacierBlock
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.merge_netsplit_block":
-		value := x.MergeNetsplitBlock
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.shanghai_block":
-		value := x.ShanghaiBlock
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.cancun_block":
-		value := x.CancunBlock
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.chain_id":
-		value := x.ChainId
-		return protoreflect.ValueOfUint64(value)
-	case "cosmos.evm.vm.v1.ChainConfig.denom":
-		value := x.Denom
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.decimals":
-		value := x.Decimals
-		return protoreflect.ValueOfUint64(value)
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ChainConfig"))
-		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.ChainConfig does not contain field %s", descriptor.FullName()))
-	}
-}
-
-// Set stores the value for a field.
-//
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType.
-// When setting a composite type, it is unspecified whether the stored value
-// aliases the source's memory in any way. If the composite value is an
-// empty, read-only value, then it panics.
-//
-// Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ChainConfig) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
-	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.ChainConfig.homestead_block":
-		x.HomesteadBlock = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_block":
-		x.DaoForkBlock = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_support":
-		x.DaoForkSupport = value.Bool()
-	case "cosmos.evm.vm.v1.ChainConfig.eip150_block":
-		x.Eip150Block = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.eip150_hash":
-		x.Eip150Hash = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.eip155_block":
-		x.Eip155Block = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.eip158_block":
-		x.Eip158Block = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.byzantium_block":
-		x.ByzantiumBlock = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.constantinople_block":
-		x.ConstantinopleBlock = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.petersburg_block":
-		x.PetersburgBlock = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.istanbul_block":
-		x.IstanbulBlock = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.muir_glacier_block":
-		x.MuirGlacierBlock = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.berlin_block":
-		x.BerlinBlock = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.london_block":
-		x.LondonBlock = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.arrow_glacier_block":
-		x.ArrowGlacierBlock = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.gray_glacier_block":
-		x.GrayGlacierBlock = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.merge_netsplit_block":
-		x.MergeNetsplitBlock = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.shanghai_block":
-		x.ShanghaiBlock = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.cancun_block":
-		x.CancunBlock = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.chain_id":
-		x.ChainId = value.Uint()
-	case "cosmos.evm.vm.v1.ChainConfig.denom":
-		x.Denom = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.decimals":
-		x.Decimals = value.Uint()
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ChainConfig"))
-		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.ChainConfig does not contain field %s", fd.FullName()))
-	}
-}
-
-// Mutable returns a mutable reference to a composite type.
-//
-// If the field is unpopulated, it may allocate a composite value.
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType
-// if not already stored.
-// It panics if the field does not contain a composite type.
-//
-// Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ChainConfig) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.ChainConfig.homestead_block":
-		panic(fmt.Errorf("field homestead_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_block":
-		panic(fmt.Errorf("field dao_fork_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_support":
-		panic(fmt.Errorf("field dao_fork_support of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.eip150_block":
-		panic(fmt.Errorf("field eip150_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.eip150_hash":
-		panic(fmt.Errorf("field eip150_hash of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.eip155_block":
-		panic(fmt.Errorf("field eip155_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.eip158_block":
-		panic(fmt.Errorf("field eip158_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.byzantium_block":
-		panic(fmt.Errorf("field byzantium_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.constantinople_block":
-		panic(fmt.Errorf("field constantinople_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.petersburg_block":
-		panic(fmt.Errorf("field petersburg_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.istanbul_block":
-		panic(fmt.Errorf("field istanbul_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.muir_glacier_block":
-		panic(fmt.Errorf("field muir_glacier_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.berlin_block":
-		panic(fmt.Errorf("field berlin_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.london_block":
-		panic(fmt.Errorf("field london_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.arrow_glacier_block":
-		panic(fmt.Errorf("field arrow_glacier_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.gray_glacier_block":
-		panic(fmt.Errorf("field gray_glacier_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.merge_netsplit_block":
-		panic(fmt.Errorf("field merge_netsplit_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.shanghai_block":
-		panic(fmt.Errorf("field shanghai_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.cancun_block":
-		panic(fmt.Errorf("field cancun_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.chain_id":
-		panic(fmt.Errorf("field chain_id of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.denom":
-		panic(fmt.Errorf("field denom of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.decimals":
-		panic(fmt.Errorf("field decimals of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ChainConfig"))
-		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.ChainConfig does not contain field %s", fd.FullName()))
-	}
-}
-
-// NewField returns a new value that is assignable to the field
-// for the given descriptor. For scalars, this returns the default value.
-// For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ChainConfig) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.ChainConfig.homestead_block":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_block":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_support":
-		return protoreflect.ValueOfBool(false)
-	case "cosmos.evm.vm.v1.ChainConfig.eip150_block":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.eip150_hash":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.eip155_block":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.eip158_block":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.byzantium_block":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.constantinople_block":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.petersburg_block":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.istanbul_block":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.muir_glacier_block":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.berlin_block":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.london_block":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.arrow_glacier_block":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.gray_glacier_block":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.merge_netsplit_block":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.shanghai_block":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.cancun_block":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.chain_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.evm.vm.v1.ChainConfig.denom":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.decimals":
-		return protoreflect.ValueOfUint64(uint64(0))
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ChainConfig"))
-		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.ChainConfig does not contain field %s", fd.FullName()))
-	}
-}
-
-// WhichOneof reports which field within the oneof is populated,
-// returning nil if none are populated.
-// It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ChainConfig) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
-	switch d.FullName() {
-	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.ChainConfig", d.FullName()))
-	}
-	panic("unreachable")
-}
-
-// GetUnknown retrieves the entire list of unknown fields.
-// The caller may only mutate the contents of the RawFields
-// if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ChainConfig) GetUnknown() protoreflect.RawFields {
-	return x.unknownFields
-}
-
-// SetUnknown stores an entire list of unknown fields.
-// The raw fields must be syntactically valid according to the wire format.
-// An implementation may panic if this is not the case.
-// Once stored, the caller must not mutate the content of the RawFields.
-// An empty RawFields may be passed to clear the fields.
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_AccessControlEntry) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.AccessControlEntry", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_AccessControlEntry) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ChainConfig) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_AccessControlEntry) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2798,7 +2541,7 @@ func (x *fastReflection_ChainConfig) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_ChainConfig) IsValid() bool {
+func (x *fastReflection_AccessControlEntry) IsValid() bool {
 	return x != nil
 }
 
@@ -2808,9 +2551,9 @@ func (x *fastReflection_ChainConfig) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_AccessControlEntry) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ChainConfig)
+		x := input.Message.Interface().(*AccessControlEntry)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2822,90 +2565,22 @@ func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.HomesteadBlock)
+		l = len(x.Contract)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.DaoForkBlock)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.DaoForkSupport {
-			n += 2
-		}
-		l = len(x.Eip150Block)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Eip150Hash)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Eip155Block)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Eip158Block)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.ByzantiumBlock)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.ConstantinopleBlock)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.PetersburgBlock)
+		l = len(x.Selector)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.IstanbulBlock)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.MuirGlacierBlock)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.BerlinBlock)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.LondonBlock)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.ArrowGlacierBlock)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.GrayGlacierBlock)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.MergeNetsplitBlock)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.ShanghaiBlock)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.CancunBlock)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
-		}
-		if x.ChainId != 0 {
-			n += 2 + runtime.Sov(uint64(x.ChainId))
-		}
-		l = len(x.Denom)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
+		if x.AccessType != 0 {
+			n += 1 + runtime.Sov(uint64(x.AccessType))
 		}
-		if x.Decimals != 0 {
-			n += 2 + runtime.Sov(uint64(x.Decimals))
+		if len(x.AccessControlList) > 0 {
+			for _, s := range x.AccessControlList {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -2917,7 +2592,7 @@ func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ChainConfig)
+		x := input.Message.Interface().(*AccessControlEntry)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2936,174 +2611,31 @@ func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Decimals != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Decimals))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xd0
-		}
-		if len(x.Denom) > 0 {
-			i -= len(x.Denom)
-			copy(dAtA[i:], x.Denom)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xca
-		}
-		if x.ChainId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ChainId))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xc0
-		}
-		if len(x.CancunBlock) > 0 {
-			i -= len(x.CancunBlock)
-			copy(dAtA[i:], x.CancunBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CancunBlock)))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xba
-		}
-		if len(x.ShanghaiBlock) > 0 {
-			i -= len(x.ShanghaiBlock)
-			copy(dAtA[i:], x.ShanghaiBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ShanghaiBlock)))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xb2
-		}
-		if len(x.MergeNetsplitBlock) > 0 {
-			i -= len(x.MergeNetsplitBlock)
-			copy(dAtA[i:], x.MergeNetsplitBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MergeNetsplitBlock)))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xaa
-		}
-		if len(x.GrayGlacierBlock) > 0 {
-			i -= len(x.GrayGlacierBlock)
-			copy(dAtA[i:], x.GrayGlacierBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GrayGlacierBlock)))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xa2
-		}
-		if len(x.ArrowGlacierBlock) > 0 {
-			i -= len(x.ArrowGlacierBlock)
-			copy(dAtA[i:], x.ArrowGlacierBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ArrowGlacierBlock)))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x92
-		}
-		if len(x.LondonBlock) > 0 {
-			i -= len(x.LondonBlock)
-			copy(dAtA[i:], x.LondonBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LondonBlock)))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x8a
-		}
-		if len(x.BerlinBlock) > 0 {
-			i -= len(x.BerlinBlock)
-			copy(dAtA[i:], x.BerlinBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BerlinBlock)))
-			i--
-			dAtA[i] = 0x6a
-		}
-		if len(x.MuirGlacierBlock) > 0 {
-			i -= len(x.MuirGlacierBlock)
-			copy(dAtA[i:], x.MuirGlacierBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MuirGlacierBlock)))
-			i--
-			dAtA[i] = 0x62
-		}
-		if len(x.IstanbulBlock) > 0 {
-			i -= len(x.IstanbulBlock)
-			copy(dAtA[i:], x.IstanbulBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.IstanbulBlock)))
-			i--
-			dAtA[i] = 0x5a
-		}
-		if len(x.PetersburgBlock) > 0 {
-			i -= len(x.PetersburgBlock)
-			copy(dAtA[i:], x.PetersburgBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PetersburgBlock)))
-			i--
-			dAtA[i] = 0x52
-		}
-		if len(x.ConstantinopleBlock) > 0 {
-			i -= len(x.ConstantinopleBlock)
-			copy(dAtA[i:], x.ConstantinopleBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ConstantinopleBlock)))
-			i--
-			dAtA[i] = 0x4a
-		}
-		if len(x.ByzantiumBlock) > 0 {
-			i -= len(x.ByzantiumBlock)
-			copy(dAtA[i:], x.ByzantiumBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ByzantiumBlock)))
-			i--
-			dAtA[i] = 0x42
-		}
-		if len(x.Eip158Block) > 0 {
-			i -= len(x.Eip158Block)
-			copy(dAtA[i:], x.Eip158Block)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Eip158Block)))
-			i--
-			dAtA[i] = 0x3a
-		}
-		if len(x.Eip155Block) > 0 {
-			i -= len(x.Eip155Block)
-			copy(dAtA[i:], x.Eip155Block)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Eip155Block)))
-			i--
-			dAtA[i] = 0x32
-		}
-		if len(x.Eip150Hash) > 0 {
-			i -= len(x.Eip150Hash)
-			copy(dAtA[i:], x.Eip150Hash)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Eip150Hash)))
-			i--
-			dAtA[i] = 0x2a
-		}
-		if len(x.Eip150Block) > 0 {
-			i -= len(x.Eip150Block)
-			copy(dAtA[i:], x.Eip150Block)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Eip150Block)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if x.DaoForkSupport {
-			i--
-			if x.DaoForkSupport {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
+		if len(x.AccessControlList) > 0 {
+			for iNdEx := len(x.AccessControlList) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AccessControlList[iNdEx])
+				copy(dAtA[i:], x.AccessControlList[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AccessControlList[iNdEx])))
+				i--
+				dAtA[i] = 0x22
 			}
+		}
+		if x.AccessType != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AccessType))
 			i--
 			dAtA[i] = 0x18
 		}
-		if len(x.DaoForkBlock) > 0 {
-			i -= len(x.DaoForkBlock)
-			copy(dAtA[i:], x.DaoForkBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DaoForkBlock)))
+		if len(x.Selector) > 0 {
+			i -= len(x.Selector)
+			copy(dAtA[i:], x.Selector)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Selector)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.HomesteadBlock) > 0 {
-			i -= len(x.HomesteadBlock)
-			copy(dAtA[i:], x.HomesteadBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.HomesteadBlock)))
+		if len(x.Contract) > 0 {
+			i -= len(x.Contract)
+			copy(dAtA[i:], x.Contract)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Contract)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -3118,7 +2650,7 @@ func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ChainConfig)
+		x := input.Message.Interface().(*AccessControlEntry)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3150,15 +2682,15 @@ func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ChainConfig: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AccessControlEntry: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ChainConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AccessControlEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HomesteadBlock", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3186,11 +2718,11 @@ func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.HomesteadBlock = string(dAtA[iNdEx:postIndex])
+				x.Contract = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DaoForkBlock", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3218,13 +2750,13 @@ func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.DaoForkBlock = string(dAtA[iNdEx:postIndex])
+				x.Selector = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DaoForkSupport", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccessType", wireType)
 				}
-				var v int
+				x.AccessType = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3234,207 +2766,14 @@ func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= int(b&0x7F) << shift
+					x.AccessType |= AccessType(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				x.DaoForkSupport = bool(v != 0)
 			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Eip150Block", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Eip150Block = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 5:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Eip150Hash", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Eip150Hash = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 6:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Eip155Block", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Eip155Block = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 7:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Eip158Block", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Eip158Block = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 8:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ByzantiumBlock", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.ByzantiumBlock = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 9:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConstantinopleBlock", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.ConstantinopleBlock = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 10:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PetersburgBlock", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccessControlList", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3462,53 +2801,1499 @@ func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.PetersburgBlock = string(dAtA[iNdEx:postIndex])
+				x.AccessControlList = append(x.AccessControlList, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
-			case 11:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IstanbulBlock", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if (iNdEx + skippy) > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.IstanbulBlock = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 12:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MuirGlacierBlock", wireType)
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
 				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ChainConfig                      protoreflect.MessageDescriptor
+	fd_ChainConfig_homestead_block      protoreflect.FieldDescriptor
+	fd_ChainConfig_dao_fork_block       protoreflect.FieldDescriptor
+	fd_ChainConfig_dao_fork_support     protoreflect.FieldDescriptor
+	fd_ChainConfig_eip150_block         protoreflect.FieldDescriptor
+	fd_ChainConfig_eip150_hash          protoreflect.FieldDescriptor
+	fd_ChainConfig_eip155_block         protoreflect.FieldDescriptor
+	fd_ChainConfig_eip158_block         protoreflect.FieldDescriptor
+	fd_ChainConfig_byzantium_block      protoreflect.FieldDescriptor
+	fd_ChainConfig_constantinople_block protoreflect.FieldDescriptor
+	fd_ChainConfig_petersburg_block     protoreflect.FieldDescriptor
+	fd_ChainConfig_istanbul_block       protoreflect.FieldDescriptor
+	fd_ChainConfig_muir_glacier_block   protoreflect.FieldDescriptor
+	fd_ChainConfig_berlin_block         protoreflect.FieldDescriptor
+	fd_ChainConfig_london_block         protoreflect.FieldDescriptor
+	fd_ChainConfig_arrow_glacier_block  protoreflect.FieldDescriptor
+	fd_ChainConfig_gray_glacier_block   protoreflect.FieldDescriptor
+	fd_ChainConfig_merge_netsplit_block protoreflect.FieldDescriptor
+	fd_ChainConfig_shanghai_block       protoreflect.FieldDescriptor
+	fd_ChainConfig_cancun_block         protoreflect.FieldDescriptor
+	fd_ChainConfig_chain_id             protoreflect.FieldDescriptor
+	fd_ChainConfig_denom                protoreflect.FieldDescriptor
+	fd_ChainConfig_decimals             protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_evm_vm_v1_evm_proto_init()
+	md_ChainConfig = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("ChainConfig")
+	fd_ChainConfig_homestead_block = md_ChainConfig.Fields().ByName("homestead_block")
+	fd_ChainConfig_dao_fork_block = md_ChainConfig.Fields().ByName("dao_fork_block")
+	fd_ChainConfig_dao_fork_support = md_ChainConfig.Fields().ByName("dao_fork_support")
+	fd_ChainConfig_eip150_block = md_ChainConfig.Fields().ByName("eip150_block")
+	fd_ChainConfig_eip150_hash = md_ChainConfig.Fields().ByName("eip150_hash")
+	fd_ChainConfig_eip155_block = md_ChainConfig.Fields().ByName("eip155_block")
+	fd_ChainConfig_eip158_block = md_ChainConfig.Fields().ByName("eip158_block")
+	fd_ChainConfig_byzantium_block = md_ChainConfig.Fields().ByName("byzantium_block")
+	fd_ChainConfig_constantinople_block = md_ChainConfig.Fields().ByName("constantinople_block")
+	fd_ChainConfig_petersburg_block = md_ChainConfig.Fields().ByName("petersburg_block")
+	fd_ChainConfig_istanbul_block = md_ChainConfig.Fields().ByName("istanbul_block")
+	fd_ChainConfig_muir_glacier_block = md_ChainConfig.Fields().ByName("muir_glacier_block")
+	fd_ChainConfig_berlin_block = md_ChainConfig.Fields().ByName("berlin_block")
+	fd_ChainConfig_london_block = md_ChainConfig.Fields().ByName("london_block")
+	fd_ChainConfig_arrow_glacier_block = md_ChainConfig.Fields().ByName("arrow_glacier_block")
+	fd_ChainConfig_gray_glacier_block = md_ChainConfig.Fields().ByName("gray_glacier_block")
+	fd_ChainConfig_merge_netsplit_block = md_ChainConfig.Fields().ByName("merge_netsplit_block")
+	fd_ChainConfig_shanghai_block = md_ChainConfig.Fields().ByName("shanghai_block")
+	fd_ChainConfig_cancun_block = md_ChainConfig.Fields().ByName("cancun_block")
+	fd_ChainConfig_chain_id = md_ChainConfig.Fields().ByName("chain_id")
+	fd_ChainConfig_denom = md_ChainConfig.Fields().ByName("denom")
+	fd_ChainConfig_decimals = md_ChainConfig.Fields().ByName("decimals")
+}
+
+var _ protoreflect.Message = (*fastReflection_ChainConfig)(nil)
+
+type fastReflection_ChainConfig ChainConfig
+
+func (x *ChainConfig) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ChainConfig)(x)
+}
+
+func (x *ChainConfig) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ChainConfig_messageType fastReflection_ChainConfig_messageType
+var _ protoreflect.MessageType = fastReflection_ChainConfig_messageType{}
+
+type fastReflection_ChainConfig_messageType struct{}
+
+func (x fastReflection_ChainConfig_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ChainConfig)(nil)
+}
+func (x fastReflection_ChainConfig_messageType) New() protoreflect.Message {
+	return new(fastReflection_ChainConfig)
+}
+func (x fastReflection_ChainConfig_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ChainConfig
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ChainConfig) Descriptor() protoreflect.MessageDescriptor {
+	return md_ChainConfig
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ChainConfig) Type() protoreflect.MessageType {
+	return _fastReflection_ChainConfig_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ChainConfig) New() protoreflect.Message {
+	return new(fastReflection_ChainConfig)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ChainConfig) Interface() protoreflect.ProtoMessage {
+	return (*ChainConfig)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ChainConfig) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.HomesteadBlock != "" {
+		value := protoreflect.ValueOfString(x.HomesteadBlock)
+		if !f(fd_ChainConfig_homestead_block, value) {
+			return
+		}
+	}
+	if x.DaoForkBlock != "" {
+		value := protoreflect.ValueOfString(x.DaoForkBlock)
+		if !f(fd_ChainConfig_dao_fork_block, value) {
+			return
+		}
+	}
+	if x.DaoForkSupport != false {
+		value := protoreflect.ValueOfBool(x.DaoForkSupport)
+		if !f(fd_ChainConfig_dao_fork_support, value) {
+			return
+		}
+	}
+	if x.Eip150Block != "" {
+		value := protoreflect.ValueOfString(x.Eip150Block)
+		if !f(fd_ChainConfig_eip150_block, value) {
+			return
+		}
+	}
+	if x.Eip150Hash != "" {
+		value := protoreflect.ValueOfString(x.Eip150Hash)
+		if !f(fd_ChainConfig_eip150_hash, value) {
+			return
+		}
+	}
+	if x.Eip155Block != "" {
+		value := protoreflect.ValueOfString(x.Eip155Block)
+		if !f(fd_ChainConfig_eip155_block, value) {
+			return
+		}
+	}
+	if x.Eip158Block != "" {
+		value := protoreflect.ValueOfString(x.Eip158Block)
+		if !f(fd_ChainConfig_eip158_block, value) {
+			return
+		}
+	}
+	if x.ByzantiumBlock != "" {
+		value := protoreflect.ValueOfString(x.ByzantiumBlock)
+		if !f(fd_ChainConfig_byzantium_block, value) {
+			return
+		}
+	}
+	if x.ConstantinopleBlock != "" {
+		value := protoreflect.ValueOfString(x.ConstantinopleBlock)
+		if !f(fd_ChainConfig_constantinople_block, value) {
+			return
+		}
+	}
+	if x.PetersburgBlock != "" {
+		value := protoreflect.ValueOfString(x.PetersburgBlock)
+		if !f(fd_ChainConfig_petersburg_block, value) {
+			return
+		}
+	}
+	if x.IstanbulBlock != "" {
+		value := protoreflect.ValueOfString(x.IstanbulBlock)
+		if !f(fd_ChainConfig_istanbul_block, value) {
+			return
+		}
+	}
+	if x.MuirGlacierBlock != "" {
+		value := protoreflect.ValueOfString(x.MuirGlacierBlock)
+		if !f(fd_ChainConfig_muir_glacier_block, value) {
+			return
+		}
+	}
+	if x.BerlinBlock != "" {
+		value := protoreflect.ValueOfString(x.BerlinBlock)
+		if !f(fd_ChainConfig_berlin_block, value) {
+			return
+		}
+	}
+	if x.LondonBlock != "" {
+		value := protoreflect.ValueOfString(x.LondonBlock)
+		if !f(fd_ChainConfig_london_block, value) {
+			return
+		}
+	}
+	if x.ArrowGlacierBlock != "" {
+		value := protoreflect.ValueOfString(x.ArrowGlacierBlock)
+		if !f(fd_ChainConfig_arrow_glacier_block, value) {
+			return
+		}
+	}
+	if x.GrayGlacierBlock != "" {
+		value := protoreflect.ValueOfString(x.GrayGlacierBlock)
+		if !f(fd_ChainConfig_gray_glacier_block, value) {
+			return
+		}
+	}
+	if x.MergeNetsplitBlock != "" {
+		value := protoreflect.ValueOfString(x.MergeNetsplitBlock)
+		if !f(fd_ChainConfig_merge_netsplit_block, value) {
+			return
+		}
+	}
+	if x.ShanghaiBlock != "" {
+		value := protoreflect.ValueOfString(x.ShanghaiBlock)
+		if !f(fd_ChainConfig_shanghai_block, value) {
+			return
+		}
+	}
+	if x.CancunBlock != "" {
+		value := protoreflect.ValueOfString(x.CancunBlock)
+		if !f(fd_ChainConfig_cancun_block, value) {
+			return
+		}
+	}
+	if x.ChainId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ChainId)
+		if !f(fd_ChainConfig_chain_id, value) {
+			return
+		}
+	}
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_ChainConfig_denom, value) {
+			return
+		}
+	}
+	if x.Decimals != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Decimals)
+		if !f(fd_ChainConfig_decimals, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ChainConfig) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.ChainConfig.homestead_block":
+		return x.HomesteadBlock != ""
+	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_block":
+		return x.DaoForkBlock != ""
+	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_support":
+		return x.DaoForkSupport != false
+	case "cosmos.evm.vm.v1.ChainConfig.eip150_block":
+		return x.Eip150Block != ""
+	case "cosmos.evm.vm.v1.ChainConfig.eip150_hash":
+		return x.Eip150Hash != ""
+	case "cosmos.evm.vm.v1.ChainConfig.eip155_block":
+		return x.Eip155Block != ""
+	case "cosmos.evm.vm.v1.ChainConfig.eip158_block":
+		return x.Eip158Block != ""
+	case "cosmos.evm.vm.v1.ChainConfig.byzantium_block":
+		return x.ByzantiumBlock != ""
+	case "cosmos.evm.vm.v1.ChainConfig.constantinople_block":
+		return x.ConstantinopleBlock != ""
+	case "cosmos.evm.vm.v1.ChainConfig.petersburg_block":
+		return x.PetersburgBlock != ""
+	case "cosmos.evm.vm.v1.ChainConfig.istanbul_block":
+		return x.IstanbulBlock != ""
+	case "cosmos.evm.vm.v1.ChainConfig.muir_glacier_block":
+		return x.MuirGlacierBlock != ""
+	case "cosmos.evm.vm.v1.ChainConfig.berlin_block":
+		return x.BerlinBlock != ""
+	case "cosmos.evm.vm.v1.ChainConfig.london_block":
+		return x.LondonBlock != ""
+	case "cosmos.evm.vm.v1.ChainConfig.arrow_glacier_block":
+		return x.ArrowGlacierBlock != ""
+	case "cosmos.evm.vm.v1.ChainConfig.gray_glacier_block":
+		return x.GrayGlacierBlock != ""
+	case "cosmos.evm.vm.v1.ChainConfig.merge_netsplit_block":
+		return x.MergeNetsplitBlock != ""
+	case "cosmos.evm.vm.v1.ChainConfig.shanghai_block":
+		return x.ShanghaiBlock != ""
+	case "cosmos.evm.vm.v1.ChainConfig.cancun_block":
+		return x.CancunBlock != ""
+	case "cosmos.evm.vm.v1.ChainConfig.chain_id":
+		return x.ChainId != uint64(0)
+	case "cosmos.evm.vm.v1.ChainConfig.denom":
+		return x.Denom != ""
+	case "cosmos.evm.vm.v1.ChainConfig.decimals":
+		return x.Decimals != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ChainConfig"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.ChainConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ChainConfig) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.ChainConfig.homestead_block":
+		x.HomesteadBlock = ""
+	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_block":
+		x.DaoForkBlock = ""
+	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_support":
+		x.DaoForkSupport = false
+	case "cosmos.evm.vm.v1.ChainConfig.eip150_block":
+		x.Eip150Block = ""
+	case "cosmos.evm.vm.v1.ChainConfig.eip150_hash":
+		x.Eip150Hash = ""
+	case "cosmos.evm.vm.v1.ChainConfig.eip155_block":
+		x.Eip155Block = ""
+	case "cosmos.evm.vm.v1.ChainConfig.eip158_block":
+		x.Eip158Block = ""
+	case "cosmos.evm.vm.v1.ChainConfig.byzantium_block":
+		x.ByzantiumBlock = ""
+	case "cosmos.evm.vm.v1.ChainConfig.constantinople_block":
+		x.ConstantinopleBlock = ""
+	case "cosmos.evm.vm.v1.ChainConfig.petersburg_block":
+		x.PetersburgBlock = ""
+	case "cosmos.evm.vm.v1.ChainConfig.istanbul_block":
+		x.IstanbulBlock = ""
+	case "cosmos.evm.vm.v1.ChainConfig.muir_glacier_block":
+		x.MuirGlacierBlock = ""
+	case "cosmos.evm.vm.v1.ChainConfig.berlin_block":
+		x.BerlinBlock = ""
+	case "cosmos.evm.vm.v1.ChainConfig.london_block":
+		x.LondonBlock = ""
+	case "cosmos.evm.vm.v1.ChainConfig.arrow_glacier_block":
+		x.ArrowGlacierBlock = ""
+	case "cosmos.evm.vm.v1.ChainConfig.gray_glacier_block":
+		x.GrayGlacierBlock = ""
+	case "cosmos.evm.vm.v1.ChainConfig.merge_netsplit_block":
+		x.MergeNetsplitBlock = ""
+	case "cosmos.evm.vm.v1.ChainConfig.shanghai_block":
+		x.ShanghaiBlock = ""
+	case "cosmos.evm.vm.v1.ChainConfig.cancun_block":
+		x.CancunBlock = ""
+	case "cosmos.evm.vm.v1.ChainConfig.chain_id":
+		x.ChainId = uint64(0)
+	case "cosmos.evm.vm.v1.ChainConfig.denom":
+		x.Denom = ""
+	case "cosmos.evm.vm.v1.ChainConfig.decimals":
+		x.Decimals = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ChainConfig"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.ChainConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ChainConfig) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.evm.vm.v1.ChainConfig.homestead_block":
+		value := x.HomesteadBlock
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_block":
+		value := x.DaoForkBlock
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_support":
+		value := x.DaoForkSupport
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.evm.vm.v1.ChainConfig.eip150_block":
+		value := x.Eip150Block
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.eip150_hash":
+		value := x.Eip150Hash
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.eip155_block":
+		value := x.Eip155Block
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.eip158_block":
+		value := x.Eip158Block
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.byzantium_block":
+		value := x.ByzantiumBlock
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.constantinople_block":
+		value := x.ConstantinopleBlock
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.petersburg_block":
+		value := x.PetersburgBlock
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.istanbul_block":
+		value := x.IstanbulBlock
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.muir_glacier_block":
+		value := x.MuirGlacierBlock
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.berlin_block":
+		value := x.BerlinBlock
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.london_block":
+		value := x.LondonBlock
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.arrow_glacier_block":
+		value := x.ArrowGlacierBlock
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.gray_glacier_block":
+		value := x.GrayGlacierBlock
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.merge_netsplit_block":
+		value := x.MergeNetsplitBlock
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.shanghai_block":
+		value := x.ShanghaiBlock
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.cancun_block":
+		value := x.CancunBlock
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.chain_id":
+		value := x.ChainId
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.evm.vm.v1.ChainConfig.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.decimals":
+		value := x.Decimals
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ChainConfig"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.ChainConfig does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ChainConfig) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.ChainConfig.homestead_block":
+		x.HomesteadBlock = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_block":
+		x.DaoForkBlock = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_support":
+		x.DaoForkSupport = value.Bool()
+	case "cosmos.evm.vm.v1.ChainConfig.eip150_block":
+		x.Eip150Block = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.eip150_hash":
+		x.Eip150Hash = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.eip155_block":
+		x.Eip155Block = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.eip158_block":
+		x.Eip158Block = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.byzantium_block":
+		x.ByzantiumBlock = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.constantinople_block":
+		x.ConstantinopleBlock = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.petersburg_block":
+		x.PetersburgBlock = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.istanbul_block":
+		x.IstanbulBlock = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.muir_glacier_block":
+		x.MuirGlacierBlock = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.berlin_block":
+		x.BerlinBlock = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.london_block":
+		x.LondonBlock = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.arrow_glacier_block":
+		x.ArrowGlacierBlock = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.gray_glacier_block":
+		x.GrayGlacierBlock = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.merge_netsplit_block":
+		x.MergeNetsplitBlock = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.shanghai_block":
+		x.ShanghaiBlock = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.cancun_block":
+		x.CancunBlock = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.chain_id":
+		x.ChainId = value.Uint()
+	case "cosmos.evm.vm.v1.ChainConfig.denom":
+		x.Denom = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.decimals":
+		x.Decimals = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ChainConfig"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.ChainConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ChainConfig) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.ChainConfig.homestead_block":
+		panic(fmt.Errorf("field homestead_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_block":
+		panic(fmt.Errorf("field dao_fork_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_support":
+		panic(fmt.Errorf("field dao_fork_support of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.eip150_block":
+		panic(fmt.Errorf("field eip150_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.eip150_hash":
+		panic(fmt.Errorf("field eip150_hash of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.eip155_block":
+		panic(fmt.Errorf("field eip155_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.eip158_block":
+		panic(fmt.Errorf("field eip158_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.byzantium_block":
+		panic(fmt.Errorf("field byzantium_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.constantinople_block":
+		panic(fmt.Errorf("field constantinople_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.petersburg_block":
+		panic(fmt.Errorf("field petersburg_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.istanbul_block":
+		panic(fmt.Errorf("field istanbul_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.muir_glacier_block":
+		panic(fmt.Errorf("field muir_glacier_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.berlin_block":
+		panic(fmt.Errorf("field berlin_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.london_block":
+		panic(fmt.Errorf("field london_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.arrow_glacier_block":
+		panic(fmt.Errorf("field arrow_glacier_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.gray_glacier_block":
+		panic(fmt.Errorf("field gray_glacier_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.merge_netsplit_block":
+		panic(fmt.Errorf("field merge_netsplit_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.shanghai_block":
+		panic(fmt.Errorf("field shanghai_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.cancun_block":
+		panic(fmt.Errorf("field cancun_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.chain_id":
+		panic(fmt.Errorf("field chain_id of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.denom":
+		panic(fmt.Errorf("field denom of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.decimals":
+		panic(fmt.Errorf("field decimals of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ChainConfig"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.ChainConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ChainConfig) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.ChainConfig.homestead_block":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_block":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_support":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.evm.vm.v1.ChainConfig.eip150_block":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.eip150_hash":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.eip155_block":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.eip158_block":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.byzantium_block":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.constantinople_block":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.petersburg_block":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.istanbul_block":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.muir_glacier_block":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.berlin_block":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.london_block":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.arrow_glacier_block":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.gray_glacier_block":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.merge_netsplit_block":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.shanghai_block":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.cancun_block":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.chain_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.evm.vm.v1.ChainConfig.denom":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.decimals":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ChainConfig"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.ChainConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ChainConfig) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.ChainConfig", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ChainConfig) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ChainConfig) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ChainConfig) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ChainConfig)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.HomesteadBlock)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DaoForkBlock)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DaoForkSupport {
+			n += 2
+		}
+		l = len(x.Eip150Block)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Eip150Hash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Eip155Block)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Eip158Block)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ByzantiumBlock)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ConstantinopleBlock)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PetersburgBlock)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.IstanbulBlock)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MuirGlacierBlock)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BerlinBlock)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.LondonBlock)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ArrowGlacierBlock)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.GrayGlacierBlock)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MergeNetsplitBlock)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ShanghaiBlock)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.CancunBlock)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.ChainId != 0 {
+			n += 2 + runtime.Sov(uint64(x.ChainId))
+		}
+		l = len(x.Denom)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.Decimals != 0 {
+			n += 2 + runtime.Sov(uint64(x.Decimals))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ChainConfig)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Decimals != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Decimals))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd0
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+		if x.ChainId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ChainId))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc0
+		}
+		if len(x.CancunBlock) > 0 {
+			i -= len(x.CancunBlock)
+			copy(dAtA[i:], x.CancunBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CancunBlock)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+		if len(x.ShanghaiBlock) > 0 {
+			i -= len(x.ShanghaiBlock)
+			copy(dAtA[i:], x.ShanghaiBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ShanghaiBlock)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+		if len(x.MergeNetsplitBlock) > 0 {
+			i -= len(x.MergeNetsplitBlock)
+			copy(dAtA[i:], x.MergeNetsplitBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MergeNetsplitBlock)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+		if len(x.GrayGlacierBlock) > 0 {
+			i -= len(x.GrayGlacierBlock)
+			copy(dAtA[i:], x.GrayGlacierBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GrayGlacierBlock)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+		if len(x.ArrowGlacierBlock) > 0 {
+			i -= len(x.ArrowGlacierBlock)
+			copy(dAtA[i:], x.ArrowGlacierBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ArrowGlacierBlock)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+		if len(x.LondonBlock) > 0 {
+			i -= len(x.LondonBlock)
+			copy(dAtA[i:], x.LondonBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LondonBlock)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+		if len(x.BerlinBlock) > 0 {
+			i -= len(x.BerlinBlock)
+			copy(dAtA[i:], x.BerlinBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BerlinBlock)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if len(x.MuirGlacierBlock) > 0 {
+			i -= len(x.MuirGlacierBlock)
+			copy(dAtA[i:], x.MuirGlacierBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MuirGlacierBlock)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if len(x.IstanbulBlock) > 0 {
+			i -= len(x.IstanbulBlock)
+			copy(dAtA[i:], x.IstanbulBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.IstanbulBlock)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if len(x.PetersburgBlock) > 0 {
+			i -= len(x.PetersburgBlock)
+			copy(dAtA[i:], x.PetersburgBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PetersburgBlock)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if len(x.ConstantinopleBlock) > 0 {
+			i -= len(x.ConstantinopleBlock)
+			copy(dAtA[i:], x.ConstantinopleBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ConstantinopleBlock)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.ByzantiumBlock) > 0 {
+			i -= len(x.ByzantiumBlock)
+			copy(dAtA[i:], x.ByzantiumBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ByzantiumBlock)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.Eip158Block) > 0 {
+			i -= len(x.Eip158Block)
+			copy(dAtA[i:], x.Eip158Block)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Eip158Block)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.Eip155Block) > 0 {
+			i -= len(x.Eip155Block)
+			copy(dAtA[i:], x.Eip155Block)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Eip155Block)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Eip150Hash) > 0 {
+			i -= len(x.Eip150Hash)
+			copy(dAtA[i:], x.Eip150Hash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Eip150Hash)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Eip150Block) > 0 {
+			i -= len(x.Eip150Block)
+			copy(dAtA[i:], x.Eip150Block)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Eip150Block)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.DaoForkSupport {
+			i--
+			if x.DaoForkSupport {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.DaoForkBlock) > 0 {
+			i -= len(x.DaoForkBlock)
+			copy(dAtA[i:], x.DaoForkBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DaoForkBlock)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.HomesteadBlock) > 0 {
+			i -= len(x.HomesteadBlock)
+			copy(dAtA[i:], x.HomesteadBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.HomesteadBlock)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ChainConfig)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ChainConfig: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ChainConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HomesteadBlock", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.HomesteadBlock = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DaoForkBlock", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DaoForkBlock = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DaoForkSupport", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.DaoForkSupport = bool(v != 0)
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Eip150Block", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Eip150Block = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Eip150Hash", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Eip150Hash = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Eip155Block", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Eip155Block = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Eip158Block", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Eip158Block = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ByzantiumBlock", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ByzantiumBlock = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConstantinopleBlock", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ConstantinopleBlock = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PetersburgBlock", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PetersburgBlock = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IstanbulBlock", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.IstanbulBlock = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MuirGlacierBlock", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MuirGlacierBlock = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BerlinBlock", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BerlinBlock = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LondonBlock", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
 					iNdEx++
 					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
@@ -3526,11 +4311,11 @@ func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.MuirGlacierBlock = string(dAtA[iNdEx:postIndex])
+				x.LondonBlock = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 13:
+			case 18:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BerlinBlock", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ArrowGlacierBlock", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3558,11 +4343,11 @@ func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.BerlinBlock = string(dAtA[iNdEx:postIndex])
+				x.ArrowGlacierBlock = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 17:
+			case 20:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LondonBlock", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GrayGlacierBlock", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3590,11 +4375,11 @@ func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.LondonBlock = string(dAtA[iNdEx:postIndex])
+				x.GrayGlacierBlock = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 18:
+			case 21:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ArrowGlacierBlock", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MergeNetsplitBlock", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3622,11 +4407,11 @@ func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ArrowGlacierBlock = string(dAtA[iNdEx:postIndex])
+				x.MergeNetsplitBlock = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 20:
+			case 22:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GrayGlacierBlock", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ShanghaiBlock", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3654,11 +4439,62 @@ func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.GrayGlacierBlock = string(dAtA[iNdEx:postIndex])
+				x.ShanghaiBlock = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 21:
+			case 23:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MergeNetsplitBlock", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CancunBlock", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CancunBlock = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 24:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				}
+				x.ChainId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ChainId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 25:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3683,46 +4519,453 @@ func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 26:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+				}
+				x.Decimals = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Decimals |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_State       protoreflect.MessageDescriptor
+	fd_State_key   protoreflect.FieldDescriptor
+	fd_State_value protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_evm_vm_v1_evm_proto_init()
+	md_State = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("State")
+	fd_State_key = md_State.Fields().ByName("key")
+	fd_State_value = md_State.Fields().ByName("value")
+}
+
+var _ protoreflect.Message = (*fastReflection_State)(nil)
+
+type fastReflection_State State
+
+func (x *State) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_State)(x)
+}
+
+func (x *State) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_State_messageType fastReflection_State_messageType
+var _ protoreflect.MessageType = fastReflection_State_messageType{}
+
+type fastReflection_State_messageType struct{}
+
+func (x fastReflection_State_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_State)(nil)
+}
+func (x fastReflection_State_messageType) New() protoreflect.Message {
+	return new(fastReflection_State)
+}
+func (x fastReflection_State_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_State
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_State) Descriptor() protoreflect.MessageDescriptor {
+	return md_State
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_State) Type() protoreflect.MessageType {
+	return _fastReflection_State_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_State) New() protoreflect.Message {
+	return new(fastReflection_State)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_State) Interface() protoreflect.ProtoMessage {
+	return (*State)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_State) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Key != "" {
+		value := protoreflect.ValueOfString(x.Key)
+		if !f(fd_State_key, value) {
+			return
+		}
+	}
+	if x.Value != "" {
+		value := protoreflect.ValueOfString(x.Value)
+		if !f(fd_State_value, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_State) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.State.key":
+		return x.Key != ""
+	case "cosmos.evm.vm.v1.State.value":
+		return x.Value != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.State"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.State does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_State) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.State.key":
+		x.Key = ""
+	case "cosmos.evm.vm.v1.State.value":
+		x.Value = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.State"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.State does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_State) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.evm.vm.v1.State.key":
+		value := x.Key
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.State.value":
+		value := x.Value
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.State"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.State does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_State) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.State.key":
+		x.Key = value.Interface().(string)
+	case "cosmos.evm.vm.v1.State.value":
+		x.Value = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.State"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.State does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_State) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.State.key":
+		panic(fmt.Errorf("field key of message cosmos.evm.vm.v1.State is not mutable"))
+	case "cosmos.evm.vm.v1.State.value":
+		panic(fmt.Errorf("field value of message cosmos.evm.vm.v1.State is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.State"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.State does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_State) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.State.key":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.State.value":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.State"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.State does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_State) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.State", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_State) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_State) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_State) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_State) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*State)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Key)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Value)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*State)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Value) > 0 {
+			i -= len(x.Value)
+			copy(dAtA[i:], x.Value)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Value)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Key) > 0 {
+			i -= len(x.Key)
+			copy(dAtA[i:], x.Key)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Key)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*State)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.MergeNetsplitBlock = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 22:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ShanghaiBlock", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				x.ShanghaiBlock = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 23:
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: State: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: State: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CancunBlock", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3750,30 +4993,11 @@ func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.CancunBlock = string(dAtA[iNdEx:postIndex])
+				x.Key = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 24:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
-				}
-				x.ChainId = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.ChainId |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 25:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3801,27 +5025,8 @@ func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Denom = string(dAtA[iNdEx:postIndex])
+				x.Value = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 26:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
-				}
-				x.Decimals = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Decimals |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3857,29 +5062,80 @@ func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_TransactionLogs_2_list)(nil)
+
+type _TransactionLogs_2_list struct {
+	list *[]*Log
+}
+
+func (x *_TransactionLogs_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TransactionLogs_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_TransactionLogs_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Log)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TransactionLogs_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Log)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TransactionLogs_2_list) AppendMutable() protoreflect.Value {
+	v := new(Log)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TransactionLogs_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TransactionLogs_2_list) NewElement() protoreflect.Value {
+	v := new(Log)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TransactionLogs_2_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_State       protoreflect.MessageDescriptor
-	fd_State_key   protoreflect.FieldDescriptor
-	fd_State_value protoreflect.FieldDescriptor
+	md_TransactionLogs      protoreflect.MessageDescriptor
+	fd_TransactionLogs_hash protoreflect.FieldDescriptor
+	fd_TransactionLogs_logs protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_evm_proto_init()
-	md_State = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("State")
-	fd_State_key = md_State.Fields().ByName("key")
-	fd_State_value = md_State.Fields().ByName("value")
+	md_TransactionLogs = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("TransactionLogs")
+	fd_TransactionLogs_hash = md_TransactionLogs.Fields().ByName("hash")
+	fd_TransactionLogs_logs = md_TransactionLogs.Fields().ByName("logs")
 }
 
-var _ protoreflect.Message = (*fastReflection_State)(nil)
+var _ protoreflect.Message = (*fastReflection_TransactionLogs)(nil)
 
-type fastReflection_State State
+type fastReflection_TransactionLogs TransactionLogs
 
-func (x *State) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_State)(x)
+func (x *TransactionLogs) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TransactionLogs)(x)
 }
 
-func (x *State) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[4]
+func (x *TransactionLogs) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3890,43 +5146,43 @@ func (x *State) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_State_messageType fastReflection_State_messageType
-var _ protoreflect.MessageType = fastReflection_State_messageType{}
+var _fastReflection_TransactionLogs_messageType fastReflection_TransactionLogs_messageType
+var _ protoreflect.MessageType = fastReflection_TransactionLogs_messageType{}
 
-type fastReflection_State_messageType struct{}
+type fastReflection_TransactionLogs_messageType struct{}
 
-func (x fastReflection_State_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_State)(nil)
+func (x fastReflection_TransactionLogs_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TransactionLogs)(nil)
 }
-func (x fastReflection_State_messageType) New() protoreflect.Message {
-	return new(fastReflection_State)
+func (x fastReflection_TransactionLogs_messageType) New() protoreflect.Message {
+	return new(fastReflection_TransactionLogs)
 }
-func (x fastReflection_State_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_State
+func (x fastReflection_TransactionLogs_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TransactionLogs
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_State) Descriptor() protoreflect.MessageDescriptor {
-	return md_State
+func (x *fastReflection_TransactionLogs) Descriptor() protoreflect.MessageDescriptor {
+	return md_TransactionLogs
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_State) Type() protoreflect.MessageType {
-	return _fastReflection_State_messageType
+func (x *fastReflection_TransactionLogs) Type() protoreflect.MessageType {
+	return _fastReflection_TransactionLogs_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_State) New() protoreflect.Message {
-	return new(fastReflection_State)
+func (x *fastReflection_TransactionLogs) New() protoreflect.Message {
+	return new(fastReflection_TransactionLogs)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_State) Interface() protoreflect.ProtoMessage {
-	return (*State)(x)
+func (x *fastReflection_TransactionLogs) Interface() protoreflect.ProtoMessage {
+	return (*TransactionLogs)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3934,16 +5190,16 @@ func (x *fastReflection_State) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_State) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Key != "" {
-		value := protoreflect.ValueOfString(x.Key)
-		if !f(fd_State_key, value) {
+func (x *fastReflection_TransactionLogs) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Hash != "" {
+		value := protoreflect.ValueOfString(x.Hash)
+		if !f(fd_TransactionLogs_hash, value) {
 			return
 		}
 	}
-	if x.Value != "" {
-		value := protoreflect.ValueOfString(x.Value)
-		if !f(fd_State_value, value) {
+	if len(x.Logs) != 0 {
+		value := protoreflect.ValueOfList(&_TransactionLogs_2_list{list: &x.Logs})
+		if !f(fd_TransactionLogs_logs, value) {
 			return
 		}
 	}
@@ -3960,17 +5216,17 @@ func (x *fastReflection_State) Range(f func(protoreflect.FieldDescriptor, protor
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_State) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_TransactionLogs) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.State.key":
-		return x.Key != ""
-	case "cosmos.evm.vm.v1.State.value":
-		return x.Value != ""
+	case "cosmos.evm.vm.v1.TransactionLogs.hash":
+		return x.Hash != ""
+	case "cosmos.evm.vm.v1.TransactionLogs.logs":
+		return len(x.Logs) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.State"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TransactionLogs"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.State does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.TransactionLogs does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3980,17 +5236,17 @@ func (x *fastReflection_State) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_State) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_TransactionLogs) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.State.key":
-		x.Key = ""
-	case "cosmos.evm.vm.v1.State.value":
-		x.Value = ""
+	case "cosmos.evm.vm.v1.TransactionLogs.hash":
+		x.Hash = ""
+	case "cosmos.evm.vm.v1.TransactionLogs.logs":
+		x.Logs = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.State"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TransactionLogs"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.State does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.TransactionLogs does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4000,19 +5256,22 @@ func (x *fastReflection_State) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_State) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TransactionLogs) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.State.key":
-		value := x.Key
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.State.value":
-		value := x.Value
+	case "cosmos.evm.vm.v1.TransactionLogs.hash":
+		value := x.Hash
 		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.TransactionLogs.logs":
+		if len(x.Logs) == 0 {
+			return protoreflect.ValueOfList(&_TransactionLogs_2_list{})
+		}
+		listValue := &_TransactionLogs_2_list{list: &x.Logs}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.State"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TransactionLogs"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.State does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.TransactionLogs does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4026,17 +5285,19 @@ func (x *fastReflection_State) Get(descriptor protoreflect.FieldDescriptor) prot
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_State) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_TransactionLogs) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.State.key":
-		x.Key = value.Interface().(string)
-	case "cosmos.evm.vm.v1.State.value":
-		x.Value = value.Interface().(string)
+	case "cosmos.evm.vm.v1.TransactionLogs.hash":
+		x.Hash = value.Interface().(string)
+	case "cosmos.evm.vm.v1.TransactionLogs.logs":
+		lv := value.List()
+		clv := lv.(*_TransactionLogs_2_list)
+		x.Logs = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.State"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TransactionLogs"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.State does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.TransactionLogs does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4050,44 +5311,49 @@ func (x *fastReflection_State) Set(fd protoreflect.FieldDescriptor, value protor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_State) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TransactionLogs) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.State.key":
-		panic(fmt.Errorf("field key of message cosmos.evm.vm.v1.State is not mutable"))
-	case "cosmos.evm.vm.v1.State.value":
-		panic(fmt.Errorf("field value of message cosmos.evm.vm.v1.State is not mutable"))
+	case "cosmos.evm.vm.v1.TransactionLogs.logs":
+		if x.Logs == nil {
+			x.Logs = []*Log{}
+		}
+		value := &_TransactionLogs_2_list{list: &x.Logs}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.evm.vm.v1.TransactionLogs.hash":
+		panic(fmt.Errorf("field hash of message cosmos.evm.vm.v1.TransactionLogs is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.State"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TransactionLogs"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.State does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.TransactionLogs does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_State) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TransactionLogs) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.State.key":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.State.value":
+	case "cosmos.evm.vm.v1.TransactionLogs.hash":
 		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.TransactionLogs.logs":
+		list := []*Log{}
+		return protoreflect.ValueOfList(&_TransactionLogs_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.State"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TransactionLogs"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.State does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.TransactionLogs does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_State) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_TransactionLogs) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.State", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.TransactionLogs", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4095,7 +5361,7 @@ func (x *fastReflection_State) WhichOneof(d protoreflect.OneofDescriptor) protor
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_State) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_TransactionLogs) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4106,7 +5372,7 @@ func (x *fastReflection_State) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_State) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_TransactionLogs) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4118,7 +5384,7 @@ func (x *fastReflection_State) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_State) IsValid() bool {
+func (x *fastReflection_TransactionLogs) IsValid() bool {
 	return x != nil
 }
 
@@ -4128,9 +5394,9 @@ func (x *fastReflection_State) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_State) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_TransactionLogs) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*State)
+		x := input.Message.Interface().(*TransactionLogs)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4142,13 +5408,15 @@ func (x *fastReflection_State) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Key)
+		l = len(x.Hash)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Value)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.Logs) > 0 {
+			for _, e := range x.Logs {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -4160,7 +5428,7 @@ func (x *fastReflection_State) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*State)
+		x := input.Message.Interface().(*TransactionLogs)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4179,17 +5447,26 @@ func (x *fastReflection_State) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Value) > 0 {
-			i -= len(x.Value)
-			copy(dAtA[i:], x.Value)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Value)))
-			i--
-			dAtA[i] = 0x12
+		if len(x.Logs) > 0 {
+			for iNdEx := len(x.Logs) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Logs[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
 		}
-		if len(x.Key) > 0 {
-			i -= len(x.Key)
-			copy(dAtA[i:], x.Key)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Key)))
+		if len(x.Hash) > 0 {
+			i -= len(x.Hash)
+			copy(dAtA[i:], x.Hash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Hash)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -4204,7 +5481,7 @@ func (x *fastReflection_State) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*State)
+		x := input.Message.Interface().(*TransactionLogs)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4236,15 +5513,15 @@ func (x *fastReflection_State) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: State: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TransactionLogs: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: State: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TransactionLogs: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -4272,13 +5549,13 @@ func (x *fastReflection_State) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Key = string(dAtA[iNdEx:postIndex])
+				x.Hash = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4288,23 +5565,25 @@ func (x *fastReflection_State) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Value = string(dAtA[iNdEx:postIndex])
+				x.Logs = append(x.Logs, &Log{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Logs[len(x.Logs)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -4341,80 +5620,89 @@ func (x *fastReflection_State) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_TransactionLogs_2_list)(nil)
+var _ protoreflect.List = (*_Log_2_list)(nil)
 
-type _TransactionLogs_2_list struct {
-	list *[]*Log
+type _Log_2_list struct {
+	list *[]string
 }
 
-func (x *_TransactionLogs_2_list) Len() int {
+func (x *_Log_2_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_TransactionLogs_2_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+func (x *_Log_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
 }
 
-func (x *_TransactionLogs_2_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Log)
+func (x *_Log_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_TransactionLogs_2_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Log)
+func (x *_Log_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_TransactionLogs_2_list) AppendMutable() protoreflect.Value {
-	v := new(Log)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
+func (x *_Log_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Log at list field Topics as it is not of Message kind"))
 }
 
-func (x *_TransactionLogs_2_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
+func (x *_Log_2_list) Truncate(n int) {
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_TransactionLogs_2_list) NewElement() protoreflect.Value {
-	v := new(Log)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
+func (x *_Log_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
 }
 
-func (x *_TransactionLogs_2_list) IsValid() bool {
+func (x *_Log_2_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_TransactionLogs      protoreflect.MessageDescriptor
-	fd_TransactionLogs_hash protoreflect.FieldDescriptor
-	fd_TransactionLogs_logs protoreflect.FieldDescriptor
+	md_Log              protoreflect.MessageDescriptor
+	fd_Log_address      protoreflect.FieldDescriptor
+	fd_Log_topics       protoreflect.FieldDescriptor
+	fd_Log_data         protoreflect.FieldDescriptor
+	fd_Log_block_number protoreflect.FieldDescriptor
+	fd_Log_tx_hash      protoreflect.FieldDescriptor
+	fd_Log_tx_index     protoreflect.FieldDescriptor
+	fd_Log_block_hash   protoreflect.FieldDescriptor
+	fd_Log_index        protoreflect.FieldDescriptor
+	fd_Log_removed      protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_evm_proto_init()
-	md_TransactionLogs = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("TransactionLogs")
-	fd_TransactionLogs_hash = md_TransactionLogs.Fields().ByName("hash")
-	fd_TransactionLogs_logs = md_TransactionLogs.Fields().ByName("logs")
+	md_Log = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("Log")
+	fd_Log_address = md_Log.Fields().ByName("address")
+	fd_Log_topics = md_Log.Fields().ByName("topics")
+	fd_Log_data = md_Log.Fields().ByName("data")
+	fd_Log_block_number = md_Log.Fields().ByName("block_number")
+	fd_Log_tx_hash = md_Log.Fields().ByName("tx_hash")
+	fd_Log_tx_index = md_Log.Fields().ByName("tx_index")
+	fd_Log_block_hash = md_Log.Fields().ByName("block_hash")
+	fd_Log_index = md_Log.Fields().ByName("index")
+	fd_Log_removed = md_Log.Fields().ByName("removed")
 }
 
-var _ protoreflect.Message = (*fastReflection_TransactionLogs)(nil)
+var _ protoreflect.Message = (*fastReflection_Log)(nil)
 
-type fastReflection_TransactionLogs TransactionLogs
+type fastReflection_Log Log
 
-func (x *TransactionLogs) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_TransactionLogs)(x)
+func (x *Log) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Log)(x)
 }
 
-func (x *TransactionLogs) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[5]
+func (x *Log) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4425,43 +5713,43 @@ func (x *TransactionLogs) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_TransactionLogs_messageType fastReflection_TransactionLogs_messageType
-var _ protoreflect.MessageType = fastReflection_TransactionLogs_messageType{}
+var _fastReflection_Log_messageType fastReflection_Log_messageType
+var _ protoreflect.MessageType = fastReflection_Log_messageType{}
 
-type fastReflection_TransactionLogs_messageType struct{}
+type fastReflection_Log_messageType struct{}
 
-func (x fastReflection_TransactionLogs_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_TransactionLogs)(nil)
+func (x fastReflection_Log_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Log)(nil)
 }
-func (x fastReflection_TransactionLogs_messageType) New() protoreflect.Message {
-	return new(fastReflection_TransactionLogs)
+func (x fastReflection_Log_messageType) New() protoreflect.Message {
+	return new(fastReflection_Log)
 }
-func (x fastReflection_TransactionLogs_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_TransactionLogs
+func (x fastReflection_Log_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Log
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_TransactionLogs) Descriptor() protoreflect.MessageDescriptor {
-	return md_TransactionLogs
+func (x *fastReflection_Log) Descriptor() protoreflect.MessageDescriptor {
+	return md_Log
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_TransactionLogs) Type() protoreflect.MessageType {
-	return _fastReflection_TransactionLogs_messageType
+func (x *fastReflection_Log) Type() protoreflect.MessageType {
+	return _fastReflection_Log_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_TransactionLogs) New() protoreflect.Message {
-	return new(fastReflection_TransactionLogs)
+func (x *fastReflection_Log) New() protoreflect.Message {
+	return new(fastReflection_Log)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_TransactionLogs) Interface() protoreflect.ProtoMessage {
-	return (*TransactionLogs)(x)
+func (x *fastReflection_Log) Interface() protoreflect.ProtoMessage {
+	return (*Log)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4469,16 +5757,58 @@ func (x *fastReflection_TransactionLogs) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_TransactionLogs) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Hash != "" {
-		value := protoreflect.ValueOfString(x.Hash)
-		if !f(fd_TransactionLogs_hash, value) {
+func (x *fastReflection_Log) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_Log_address, value) {
 			return
 		}
 	}
-	if len(x.Logs) != 0 {
-		value := protoreflect.ValueOfList(&_TransactionLogs_2_list{list: &x.Logs})
-		if !f(fd_TransactionLogs_logs, value) {
+	if len(x.Topics) != 0 {
+		value := protoreflect.ValueOfList(&_Log_2_list{list: &x.Topics})
+		if !f(fd_Log_topics, value) {
+			return
+		}
+	}
+	if len(x.Data) != 0 {
+		value := protoreflect.ValueOfBytes(x.Data)
+		if !f(fd_Log_data, value) {
+			return
+		}
+	}
+	if x.BlockNumber != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BlockNumber)
+		if !f(fd_Log_block_number, value) {
+			return
+		}
+	}
+	if x.TxHash != "" {
+		value := protoreflect.ValueOfString(x.TxHash)
+		if !f(fd_Log_tx_hash, value) {
+			return
+		}
+	}
+	if x.TxIndex != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TxIndex)
+		if !f(fd_Log_tx_index, value) {
+			return
+		}
+	}
+	if x.BlockHash != "" {
+		value := protoreflect.ValueOfString(x.BlockHash)
+		if !f(fd_Log_block_hash, value) {
+			return
+		}
+	}
+	if x.Index != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Index)
+		if !f(fd_Log_index, value) {
+			return
+		}
+	}
+	if x.Removed != false {
+		value := protoreflect.ValueOfBool(x.Removed)
+		if !f(fd_Log_removed, value) {
 			return
 		}
 	}
@@ -4495,17 +5825,31 @@ func (x *fastReflection_TransactionLogs) Range(f func(protoreflect.FieldDescript
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_TransactionLogs) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_Log) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.TransactionLogs.hash":
-		return x.Hash != ""
-	case "cosmos.evm.vm.v1.TransactionLogs.logs":
-		return len(x.Logs) != 0
+	case "cosmos.evm.vm.v1.Log.address":
+		return x.Address != ""
+	case "cosmos.evm.vm.v1.Log.topics":
+		return len(x.Topics) != 0
+	case "cosmos.evm.vm.v1.Log.data":
+		return len(x.Data) != 0
+	case "cosmos.evm.vm.v1.Log.block_number":
+		return x.BlockNumber != uint64(0)
+	case "cosmos.evm.vm.v1.Log.tx_hash":
+		return x.TxHash != ""
+	case "cosmos.evm.vm.v1.Log.tx_index":
+		return x.TxIndex != uint64(0)
+	case "cosmos.evm.vm.v1.Log.block_hash":
+		return x.BlockHash != ""
+	case "cosmos.evm.vm.v1.Log.index":
+		return x.Index != uint64(0)
+	case "cosmos.evm.vm.v1.Log.removed":
+		return x.Removed != false
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TransactionLogs"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Log"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.TransactionLogs does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.Log does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4515,17 +5859,31 @@ func (x *fastReflection_TransactionLogs) Has(fd protoreflect.FieldDescriptor) bo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TransactionLogs) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_Log) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.TransactionLogs.hash":
-		x.Hash = ""
-	case "cosmos.evm.vm.v1.TransactionLogs.logs":
-		x.Logs = nil
+	case "cosmos.evm.vm.v1.Log.address":
+		x.Address = ""
+	case "cosmos.evm.vm.v1.Log.topics":
+		x.Topics = nil
+	case "cosmos.evm.vm.v1.Log.data":
+		x.Data = nil
+	case "cosmos.evm.vm.v1.Log.block_number":
+		x.BlockNumber = uint64(0)
+	case "cosmos.evm.vm.v1.Log.tx_hash":
+		x.TxHash = ""
+	case "cosmos.evm.vm.v1.Log.tx_index":
+		x.TxIndex = uint64(0)
+	case "cosmos.evm.vm.v1.Log.block_hash":
+		x.BlockHash = ""
+	case "cosmos.evm.vm.v1.Log.index":
+		x.Index = uint64(0)
+	case "cosmos.evm.vm.v1.Log.removed":
+		x.Removed = false
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TransactionLogs"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Log"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.TransactionLogs does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.Log does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4535,22 +5893,43 @@ func (x *fastReflection_TransactionLogs) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_TransactionLogs) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Log) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.TransactionLogs.hash":
-		value := x.Hash
+	case "cosmos.evm.vm.v1.Log.address":
+		value := x.Address
 		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.TransactionLogs.logs":
-		if len(x.Logs) == 0 {
-			return protoreflect.ValueOfList(&_TransactionLogs_2_list{})
+	case "cosmos.evm.vm.v1.Log.topics":
+		if len(x.Topics) == 0 {
+			return protoreflect.ValueOfList(&_Log_2_list{})
 		}
-		listValue := &_TransactionLogs_2_list{list: &x.Logs}
+		listValue := &_Log_2_list{list: &x.Topics}
 		return protoreflect.ValueOfList(listValue)
+	case "cosmos.evm.vm.v1.Log.data":
+		value := x.Data
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.evm.vm.v1.Log.block_number":
+		value := x.BlockNumber
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.evm.vm.v1.Log.tx_hash":
+		value := x.TxHash
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.Log.tx_index":
+		value := x.TxIndex
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.evm.vm.v1.Log.block_hash":
+		value := x.BlockHash
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.Log.index":
+		value := x.Index
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.evm.vm.v1.Log.removed":
+		value := x.Removed
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TransactionLogs"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Log"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.TransactionLogs does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.Log does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4564,19 +5943,33 @@ func (x *fastReflection_TransactionLogs) Get(descriptor protoreflect.FieldDescri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TransactionLogs) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_Log) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.TransactionLogs.hash":
-		x.Hash = value.Interface().(string)
-	case "cosmos.evm.vm.v1.TransactionLogs.logs":
+	case "cosmos.evm.vm.v1.Log.address":
+		x.Address = value.Interface().(string)
+	case "cosmos.evm.vm.v1.Log.topics":
 		lv := value.List()
-		clv := lv.(*_TransactionLogs_2_list)
-		x.Logs = *clv.list
+		clv := lv.(*_Log_2_list)
+		x.Topics = *clv.list
+	case "cosmos.evm.vm.v1.Log.data":
+		x.Data = value.Bytes()
+	case "cosmos.evm.vm.v1.Log.block_number":
+		x.BlockNumber = value.Uint()
+	case "cosmos.evm.vm.v1.Log.tx_hash":
+		x.TxHash = value.Interface().(string)
+	case "cosmos.evm.vm.v1.Log.tx_index":
+		x.TxIndex = value.Uint()
+	case "cosmos.evm.vm.v1.Log.block_hash":
+		x.BlockHash = value.Interface().(string)
+	case "cosmos.evm.vm.v1.Log.index":
+		x.Index = value.Uint()
+	case "cosmos.evm.vm.v1.Log.removed":
+		x.Removed = value.Bool()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TransactionLogs"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Log"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.TransactionLogs does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.Log does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4590,49 +5983,77 @@ func (x *fastReflection_TransactionLogs) Set(fd protoreflect.FieldDescriptor, va
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TransactionLogs) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Log) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.TransactionLogs.logs":
-		if x.Logs == nil {
-			x.Logs = []*Log{}
+	case "cosmos.evm.vm.v1.Log.topics":
+		if x.Topics == nil {
+			x.Topics = []string{}
 		}
-		value := &_TransactionLogs_2_list{list: &x.Logs}
+		value := &_Log_2_list{list: &x.Topics}
 		return protoreflect.ValueOfList(value)
-	case "cosmos.evm.vm.v1.TransactionLogs.hash":
-		panic(fmt.Errorf("field hash of message cosmos.evm.vm.v1.TransactionLogs is not mutable"))
+	case "cosmos.evm.vm.v1.Log.address":
+		panic(fmt.Errorf("field address of message cosmos.evm.vm.v1.Log is not mutable"))
+	case "cosmos.evm.vm.v1.Log.data":
+		panic(fmt.Errorf("field data of message cosmos.evm.vm.v1.Log is not mutable"))
+	case "cosmos.evm.vm.v1.Log.block_number":
+		panic(fmt.Errorf("field block_number of message cosmos.evm.vm.v1.Log is not mutable"))
+	case "cosmos.evm.vm.v1.Log.tx_hash":
+		panic(fmt.Errorf("field tx_hash of message cosmos.evm.vm.v1.Log is not mutable"))
+	case "cosmos.evm.vm.v1.Log.tx_index":
+		panic(fmt.Errorf("field tx_index of message cosmos.evm.vm.v1.Log is not mutable"))
+	case "cosmos.evm.vm.v1.Log.block_hash":
+		panic(fmt.Errorf("field block_hash of message cosmos.evm.vm.v1.Log is not mutable"))
+	case "cosmos.evm.vm.v1.Log.index":
+		panic(fmt.Errorf("field index of message cosmos.evm.vm.v1.Log is not mutable"))
+	case "cosmos.evm.vm.v1.Log.removed":
+		panic(fmt.Errorf("field removed of message cosmos.evm.vm.v1.Log is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TransactionLogs"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Log"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.TransactionLogs does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.Log does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_TransactionLogs) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Log) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.TransactionLogs.hash":
+	case "cosmos.evm.vm.v1.Log.address":
 		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.TransactionLogs.logs":
-		list := []*Log{}
-		return protoreflect.ValueOfList(&_TransactionLogs_2_list{list: &list})
+	case "cosmos.evm.vm.v1.Log.topics":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Log_2_list{list: &list})
+	case "cosmos.evm.vm.v1.Log.data":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.evm.vm.v1.Log.block_number":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.evm.vm.v1.Log.tx_hash":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.Log.tx_index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.evm.vm.v1.Log.block_hash":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.Log.index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.evm.vm.v1.Log.removed":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TransactionLogs"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Log"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.TransactionLogs does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.Log does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_TransactionLogs) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_Log) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.TransactionLogs", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.Log", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4640,7 +6061,7 @@ func (x *fastReflection_TransactionLogs) WhichOneof(d protoreflect.OneofDescript
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_TransactionLogs) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_Log) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4651,7 +6072,7 @@ func (x *fastReflection_TransactionLogs) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TransactionLogs) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_Log) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4663,7 +6084,7 @@ func (x *fastReflection_TransactionLogs) SetUnknown(fields protoreflect.RawField
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_TransactionLogs) IsValid() bool {
+func (x *fastReflection_Log) IsValid() bool {
 	return x != nil
 }
 
@@ -4673,9 +6094,9 @@ func (x *fastReflection_TransactionLogs) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_TransactionLogs) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_Log) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*TransactionLogs)
+		x := input.Message.Interface().(*Log)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4687,16 +6108,40 @@ func (x *fastReflection_TransactionLogs) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Hash)
+		l = len(x.Address)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.Logs) > 0 {
-			for _, e := range x.Logs {
-				l = options.Size(e)
+		if len(x.Topics) > 0 {
+			for _, s := range x.Topics {
+				l = len(s)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		l = len(x.Data)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BlockNumber != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockNumber))
+		}
+		l = len(x.TxHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TxIndex != 0 {
+			n += 1 + runtime.Sov(uint64(x.TxIndex))
+		}
+		l = len(x.BlockHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Index != 0 {
+			n += 1 + runtime.Sov(uint64(x.Index))
+		}
+		if x.Removed {
+			n += 2
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -4707,7 +6152,7 @@ func (x *fastReflection_TransactionLogs) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*TransactionLogs)
+		x := input.Message.Interface().(*Log)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4726,26 +6171,65 @@ func (x *fastReflection_TransactionLogs) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Logs) > 0 {
-			for iNdEx := len(x.Logs) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Logs[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if x.Removed {
+			i--
+			if x.Removed {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.Index != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Index))
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.BlockHash) > 0 {
+			i -= len(x.BlockHash)
+			copy(dAtA[i:], x.BlockHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BlockHash)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.TxIndex != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TxIndex))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.TxHash) > 0 {
+			i -= len(x.TxHash)
+			copy(dAtA[i:], x.TxHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TxHash)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.BlockNumber != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockNumber))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Data) > 0 {
+			i -= len(x.Data)
+			copy(dAtA[i:], x.Data)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Data)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Topics) > 0 {
+			for iNdEx := len(x.Topics) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Topics[iNdEx])
+				copy(dAtA[i:], x.Topics[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Topics[iNdEx])))
 				i--
 				dAtA[i] = 0x12
 			}
 		}
-		if len(x.Hash) > 0 {
-			i -= len(x.Hash)
-			copy(dAtA[i:], x.Hash)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Hash)))
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -4760,7 +6244,7 @@ func (x *fastReflection_TransactionLogs) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*TransactionLogs)
+		x := input.Message.Interface().(*Log)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4788,19 +6272,136 @@ func (x *fastReflection_TransactionLogs) ProtoMethods() *protoiface.Methods {
 				if b < 0x80 {
 					break
 				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TransactionLogs: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TransactionLogs: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Log: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Log: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Topics = append(x.Topics, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Data = append(x.Data[:0], dAtA[iNdEx:postIndex]...)
+				if x.Data == nil {
+					x.Data = []byte{}
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+				}
+				x.BlockNumber = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockNumber |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -4828,13 +6429,32 @@ func (x *fastReflection_TransactionLogs) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Hash = string(dAtA[iNdEx:postIndex])
+				x.TxHash = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 2:
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+				}
+				x.TxIndex = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TxIndex |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4844,26 +6464,63 @@ func (x *fastReflection_TransactionLogs) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Logs = append(x.Logs, &Log{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Logs[len(x.Logs)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.BlockHash = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				}
+				x.Index = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Index |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Removed = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -4899,89 +6556,37 @@ func (x *fastReflection_TransactionLogs) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_Log_2_list)(nil)
-
-type _Log_2_list struct {
-	list *[]string
-}
-
-func (x *_Log_2_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_Log_2_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
-}
-
-func (x *_Log_2_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_Log_2_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_Log_2_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message Log at list field Topics as it is not of Message kind"))
-}
-
-func (x *_Log_2_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_Log_2_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
-}
-
-func (x *_Log_2_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_Log              protoreflect.MessageDescriptor
-	fd_Log_address      protoreflect.FieldDescriptor
-	fd_Log_topics       protoreflect.FieldDescriptor
-	fd_Log_data         protoreflect.FieldDescriptor
-	fd_Log_block_number protoreflect.FieldDescriptor
-	fd_Log_tx_hash      protoreflect.FieldDescriptor
-	fd_Log_tx_index     protoreflect.FieldDescriptor
-	fd_Log_block_hash   protoreflect.FieldDescriptor
-	fd_Log_index        protoreflect.FieldDescriptor
-	fd_Log_removed      protoreflect.FieldDescriptor
+	md_TxResult                  protoreflect.MessageDescriptor
+	fd_TxResult_contract_address protoreflect.FieldDescriptor
+	fd_TxResult_bloom            protoreflect.FieldDescriptor
+	fd_TxResult_tx_logs          protoreflect.FieldDescriptor
+	fd_TxResult_ret              protoreflect.FieldDescriptor
+	fd_TxResult_reverted         protoreflect.FieldDescriptor
+	fd_TxResult_gas_used         protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_evm_proto_init()
-	md_Log = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("Log")
-	fd_Log_address = md_Log.Fields().ByName("address")
-	fd_Log_topics = md_Log.Fields().ByName("topics")
-	fd_Log_data = md_Log.Fields().ByName("data")
-	fd_Log_block_number = md_Log.Fields().ByName("block_number")
-	fd_Log_tx_hash = md_Log.Fields().ByName("tx_hash")
-	fd_Log_tx_index = md_Log.Fields().ByName("tx_index")
-	fd_Log_block_hash = md_Log.Fields().ByName("block_hash")
-	fd_Log_index = md_Log.Fields().ByName("index")
-	fd_Log_removed = md_Log.Fields().ByName("removed")
+	md_TxResult = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("TxResult")
+	fd_TxResult_contract_address = md_TxResult.Fields().ByName("contract_address")
+	fd_TxResult_bloom = md_TxResult.Fields().ByName("bloom")
+	fd_TxResult_tx_logs = md_TxResult.Fields().ByName("tx_logs")
+	fd_TxResult_ret = md_TxResult.Fields().ByName("ret")
+	fd_TxResult_reverted = md_TxResult.Fields().ByName("reverted")
+	fd_TxResult_gas_used = md_TxResult.Fields().ByName("gas_used")
 }
 
-var _ protoreflect.Message = (*fastReflection_Log)(nil)
+var _ protoreflect.Message = (*fastReflection_TxResult)(nil)
 
-type fastReflection_Log Log
+type fastReflection_TxResult TxResult
 
-func (x *Log) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Log)(x)
+func (x *TxResult) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TxResult)(x)
 }
 
-func (x *Log) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[6]
+func (x *TxResult) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4992,43 +6597,43 @@ func (x *Log) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Log_messageType fastReflection_Log_messageType
-var _ protoreflect.MessageType = fastReflection_Log_messageType{}
+var _fastReflection_TxResult_messageType fastReflection_TxResult_messageType
+var _ protoreflect.MessageType = fastReflection_TxResult_messageType{}
 
-type fastReflection_Log_messageType struct{}
+type fastReflection_TxResult_messageType struct{}
 
-func (x fastReflection_Log_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Log)(nil)
+func (x fastReflection_TxResult_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TxResult)(nil)
 }
-func (x fastReflection_Log_messageType) New() protoreflect.Message {
-	return new(fastReflection_Log)
+func (x fastReflection_TxResult_messageType) New() protoreflect.Message {
+	return new(fastReflection_TxResult)
 }
-func (x fastReflection_Log_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Log
+func (x fastReflection_TxResult_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TxResult
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Log) Descriptor() protoreflect.MessageDescriptor {
-	return md_Log
+func (x *fastReflection_TxResult) Descriptor() protoreflect.MessageDescriptor {
+	return md_TxResult
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Log) Type() protoreflect.MessageType {
-	return _fastReflection_Log_messageType
+func (x *fastReflection_TxResult) Type() protoreflect.MessageType {
+	return _fastReflection_TxResult_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Log) New() protoreflect.Message {
-	return new(fastReflection_Log)
+func (x *fastReflection_TxResult) New() protoreflect.Message {
+	return new(fastReflection_TxResult)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Log) Interface() protoreflect.ProtoMessage {
-	return (*Log)(x)
+func (x *fastReflection_TxResult) Interface() protoreflect.ProtoMessage {
+	return (*TxResult)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5036,58 +6641,40 @@ func (x *fastReflection_Log) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Log) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Address != "" {
-		value := protoreflect.ValueOfString(x.Address)
-		if !f(fd_Log_address, value) {
-			return
-		}
-	}
-	if len(x.Topics) != 0 {
-		value := protoreflect.ValueOfList(&_Log_2_list{list: &x.Topics})
-		if !f(fd_Log_topics, value) {
-			return
-		}
-	}
-	if len(x.Data) != 0 {
-		value := protoreflect.ValueOfBytes(x.Data)
-		if !f(fd_Log_data, value) {
-			return
-		}
-	}
-	if x.BlockNumber != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.BlockNumber)
-		if !f(fd_Log_block_number, value) {
+func (x *fastReflection_TxResult) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ContractAddress != "" {
+		value := protoreflect.ValueOfString(x.ContractAddress)
+		if !f(fd_TxResult_contract_address, value) {
 			return
 		}
 	}
-	if x.TxHash != "" {
-		value := protoreflect.ValueOfString(x.TxHash)
-		if !f(fd_Log_tx_hash, value) {
+	if len(x.Bloom) != 0 {
+		value := protoreflect.ValueOfBytes(x.Bloom)
+		if !f(fd_TxResult_bloom, value) {
 			return
 		}
 	}
-	if x.TxIndex != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.TxIndex)
-		if !f(fd_Log_tx_index, value) {
+	if x.TxLogs != nil {
+		value := protoreflect.ValueOfMessage(x.TxLogs.ProtoReflect())
+		if !f(fd_TxResult_tx_logs, value) {
 			return
 		}
 	}
-	if x.BlockHash != "" {
-		value := protoreflect.ValueOfString(x.BlockHash)
-		if !f(fd_Log_block_hash, value) {
+	if len(x.Ret) != 0 {
+		value := protoreflect.ValueOfBytes(x.Ret)
+		if !f(fd_TxResult_ret, value) {
 			return
 		}
 	}
-	if x.Index != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Index)
-		if !f(fd_Log_index, value) {
+	if x.Reverted != false {
+		value := protoreflect.ValueOfBool(x.Reverted)
+		if !f(fd_TxResult_reverted, value) {
 			return
 		}
 	}
-	if x.Removed != false {
-		value := protoreflect.ValueOfBool(x.Removed)
-		if !f(fd_Log_removed, value) {
+	if x.GasUsed != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GasUsed)
+		if !f(fd_TxResult_gas_used, value) {
 			return
 		}
 	}
@@ -5104,31 +6691,25 @@ func (x *fastReflection_Log) Range(f func(protoreflect.FieldDescriptor, protoref
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Log) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_TxResult) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.Log.address":
-		return x.Address != ""
-	case "cosmos.evm.vm.v1.Log.topics":
-		return len(x.Topics) != 0
-	case "cosmos.evm.vm.v1.Log.data":
-		return len(x.Data) != 0
-	case "cosmos.evm.vm.v1.Log.block_number":
-		return x.BlockNumber != uint64(0)
-	case "cosmos.evm.vm.v1.Log.tx_hash":
-		return x.TxHash != ""
-	case "cosmos.evm.vm.v1.Log.tx_index":
-		return x.TxIndex != uint64(0)
-	case "cosmos.evm.vm.v1.Log.block_hash":
-		return x.BlockHash != ""
-	case "cosmos.evm.vm.v1.Log.index":
-		return x.Index != uint64(0)
-	case "cosmos.evm.vm.v1.Log.removed":
-		return x.Removed != false
+	case "cosmos.evm.vm.v1.TxResult.contract_address":
+		return x.ContractAddress != ""
+	case "cosmos.evm.vm.v1.TxResult.bloom":
+		return len(x.Bloom) != 0
+	case "cosmos.evm.vm.v1.TxResult.tx_logs":
+		return x.TxLogs != nil
+	case "cosmos.evm.vm.v1.TxResult.ret":
+		return len(x.Ret) != 0
+	case "cosmos.evm.vm.v1.TxResult.reverted":
+		return x.Reverted != false
+	case "cosmos.evm.vm.v1.TxResult.gas_used":
+		return x.GasUsed != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Log"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TxResult"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.Log does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.TxResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5138,31 +6719,25 @@ func (x *fastReflection_Log) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Log) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_TxResult) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.Log.address":
-		x.Address = ""
-	case "cosmos.evm.vm.v1.Log.topics":
-		x.Topics = nil
-	case "cosmos.evm.vm.v1.Log.data":
-		x.Data = nil
-	case "cosmos.evm.vm.v1.Log.block_number":
-		x.BlockNumber = uint64(0)
-	case "cosmos.evm.vm.v1.Log.tx_hash":
-		x.TxHash = ""
-	case "cosmos.evm.vm.v1.Log.tx_index":
-		x.TxIndex = uint64(0)
-	case "cosmos.evm.vm.v1.Log.block_hash":
-		x.BlockHash = ""
-	case "cosmos.evm.vm.v1.Log.index":
-		x.Index = uint64(0)
-	case "cosmos.evm.vm.v1.Log.removed":
-		x.Removed = false
+	case "cosmos.evm.vm.v1.TxResult.contract_address":
+		x.ContractAddress = ""
+	case "cosmos.evm.vm.v1.TxResult.bloom":
+		x.Bloom = nil
+	case "cosmos.evm.vm.v1.TxResult.tx_logs":
+		x.TxLogs = nil
+	case "cosmos.evm.vm.v1.TxResult.ret":
+		x.Ret = nil
+	case "cosmos.evm.vm.v1.TxResult.reverted":
+		x.Reverted = false
+	case "cosmos.evm.vm.v1.TxResult.gas_used":
+		x.GasUsed = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Log"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TxResult"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.Log does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.TxResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5172,43 +6747,31 @@ func (x *fastReflection_Log) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Log) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TxResult) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.Log.address":
-		value := x.Address
+	case "cosmos.evm.vm.v1.TxResult.contract_address":
+		value := x.ContractAddress
 		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.Log.topics":
-		if len(x.Topics) == 0 {
-			return protoreflect.ValueOfList(&_Log_2_list{})
-		}
-		listValue := &_Log_2_list{list: &x.Topics}
-		return protoreflect.ValueOfList(listValue)
-	case "cosmos.evm.vm.v1.Log.data":
-		value := x.Data
+	case "cosmos.evm.vm.v1.TxResult.bloom":
+		value := x.Bloom
 		return protoreflect.ValueOfBytes(value)
-	case "cosmos.evm.vm.v1.Log.block_number":
-		value := x.BlockNumber
-		return protoreflect.ValueOfUint64(value)
-	case "cosmos.evm.vm.v1.Log.tx_hash":
-		value := x.TxHash
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.Log.tx_index":
-		value := x.TxIndex
-		return protoreflect.ValueOfUint64(value)
-	case "cosmos.evm.vm.v1.Log.block_hash":
-		value := x.BlockHash
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.Log.index":
-		value := x.Index
-		return protoreflect.ValueOfUint64(value)
-	case "cosmos.evm.vm.v1.Log.removed":
-		value := x.Removed
+	case "cosmos.evm.vm.v1.TxResult.tx_logs":
+		value := x.TxLogs
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.evm.vm.v1.TxResult.ret":
+		value := x.Ret
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.evm.vm.v1.TxResult.reverted":
+		value := x.Reverted
 		return protoreflect.ValueOfBool(value)
+	case "cosmos.evm.vm.v1.TxResult.gas_used":
+		value := x.GasUsed
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Log"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TxResult"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.Log does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.TxResult does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5222,33 +6785,25 @@ func (x *fastReflection_Log) Get(descriptor protoreflect.FieldDescriptor) protor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Log) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_TxResult) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.Log.address":
-		x.Address = value.Interface().(string)
-	case "cosmos.evm.vm.v1.Log.topics":
-		lv := value.List()
-		clv := lv.(*_Log_2_list)
-		x.Topics = *clv.list
-	case "cosmos.evm.vm.v1.Log.data":
-		x.Data = value.Bytes()
-	case "cosmos.evm.vm.v1.Log.block_number":
-		x.BlockNumber = value.Uint()
-	case "cosmos.evm.vm.v1.Log.tx_hash":
-		x.TxHash = value.Interface().(string)
-	case "cosmos.evm.vm.v1.Log.tx_index":
-		x.TxIndex = value.Uint()
-	case "cosmos.evm.vm.v1.Log.block_hash":
-		x.BlockHash = value.Interface().(string)
-	case "cosmos.evm.vm.v1.Log.index":
-		x.Index = value.Uint()
-	case "cosmos.evm.vm.v1.Log.removed":
-		x.Removed = value.Bool()
+	case "cosmos.evm.vm.v1.TxResult.contract_address":
+		x.ContractAddress = value.Interface().(string)
+	case "cosmos.evm.vm.v1.TxResult.bloom":
+		x.Bloom = value.Bytes()
+	case "cosmos.evm.vm.v1.TxResult.tx_logs":
+		x.TxLogs = value.Message().Interface().(*TransactionLogs)
+	case "cosmos.evm.vm.v1.TxResult.ret":
+		x.Ret = value.Bytes()
+	case "cosmos.evm.vm.v1.TxResult.reverted":
+		x.Reverted = value.Bool()
+	case "cosmos.evm.vm.v1.TxResult.gas_used":
+		x.GasUsed = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Log"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TxResult"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.Log does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.TxResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5262,77 +6817,64 @@ func (x *fastReflection_Log) Set(fd protoreflect.FieldDescriptor, value protoref
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Log) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TxResult) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.Log.topics":
-		if x.Topics == nil {
-			x.Topics = []string{}
+	case "cosmos.evm.vm.v1.TxResult.tx_logs":
+		if x.TxLogs == nil {
+			x.TxLogs = new(TransactionLogs)
 		}
-		value := &_Log_2_list{list: &x.Topics}
-		return protoreflect.ValueOfList(value)
-	case "cosmos.evm.vm.v1.Log.address":
-		panic(fmt.Errorf("field address of message cosmos.evm.vm.v1.Log is not mutable"))
-	case "cosmos.evm.vm.v1.Log.data":
-		panic(fmt.Errorf("field data of message cosmos.evm.vm.v1.Log is not mutable"))
-	case "cosmos.evm.vm.v1.Log.block_number":
-		panic(fmt.Errorf("field block_number of message cosmos.evm.vm.v1.Log is not mutable"))
-	case "cosmos.evm.vm.v1.Log.tx_hash":
-		panic(fmt.Errorf("field tx_hash of message cosmos.evm.vm.v1.Log is not mutable"))
-	case "cosmos.evm.vm.v1.Log.tx_index":
-		panic(fmt.Errorf("field tx_index of message cosmos.evm.vm.v1.Log is not mutable"))
-	case "cosmos.evm.vm.v1.Log.block_hash":
-		panic(fmt.Errorf("field block_hash of message cosmos.evm.vm.v1.Log is not mutable"))
-	case "cosmos.evm.vm.v1.Log.index":
-		panic(fmt.Errorf("field index of message cosmos.evm.vm.v1.Log is not mutable"))
-	case "cosmos.evm.vm.v1.Log.removed":
-		panic(fmt.Errorf("field removed of message cosmos.evm.vm.v1.Log is not mutable"))
+		return protoreflect.ValueOfMessage(x.TxLogs.ProtoReflect())
+	case "cosmos.evm.vm.v1.TxResult.contract_address":
+		panic(fmt.Errorf("field contract_address of message cosmos.evm.vm.v1.TxResult is not mutable"))
+	case "cosmos.evm.vm.v1.TxResult.bloom":
+		panic(fmt.Errorf("field bloom of message cosmos.evm.vm.v1.TxResult is not mutable"))
+	case "cosmos.evm.vm.v1.TxResult.ret":
+		panic(fmt.Errorf("field ret of message cosmos.evm.vm.v1.TxResult is not mutable"))
+	case "cosmos.evm.vm.v1.TxResult.reverted":
+		panic(fmt.Errorf("field reverted of message cosmos.evm.vm.v1.TxResult is not mutable"))
+	case "cosmos.evm.vm.v1.TxResult.gas_used":
+		panic(fmt.Errorf("field gas_used of message cosmos.evm.vm.v1.TxResult is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Log"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TxResult"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.Log does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.TxResult does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Log) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TxResult) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.Log.address":
+	case "cosmos.evm.vm.v1.TxResult.contract_address":
 		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.Log.topics":
-		list := []string{}
-		return protoreflect.ValueOfList(&_Log_2_list{list: &list})
-	case "cosmos.evm.vm.v1.Log.data":
+	case "cosmos.evm.vm.v1.TxResult.bloom":
 		return protoreflect.ValueOfBytes(nil)
-	case "cosmos.evm.vm.v1.Log.block_number":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.evm.vm.v1.Log.tx_hash":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.Log.tx_index":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.evm.vm.v1.Log.block_hash":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.Log.index":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.evm.vm.v1.Log.removed":
+	case "cosmos.evm.vm.v1.TxResult.tx_logs":
+		m := new(TransactionLogs)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.evm.vm.v1.TxResult.ret":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.evm.vm.v1.TxResult.reverted":
 		return protoreflect.ValueOfBool(false)
+	case "cosmos.evm.vm.v1.TxResult.gas_used":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Log"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TxResult"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.Log does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.TxResult does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Log) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_TxResult) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.Log", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.TxResult", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5340,7 +6882,7 @@ func (x *fastReflection_Log) WhichOneof(d protoreflect.OneofDescriptor) protoref
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Log) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_TxResult) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5351,7 +6893,7 @@ func (x *fastReflection_Log) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Log) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_TxResult) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5363,7 +6905,7 @@ func (x *fastReflection_Log) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Log) IsValid() bool {
+func (x *fastReflection_TxResult) IsValid() bool {
 	return x != nil
 }
 
@@ -5373,9 +6915,9 @@ func (x *fastReflection_Log) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Log) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_TxResult) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Log)
+		x := input.Message.Interface().(*TxResult)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5387,40 +6929,28 @@ func (x *fastReflection_Log) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Address)
+		l = len(x.ContractAddress)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.Topics) > 0 {
-			for _, s := range x.Topics {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		l = len(x.Data)
+		l = len(x.Bloom)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.BlockNumber != 0 {
-			n += 1 + runtime.Sov(uint64(x.BlockNumber))
-		}
-		l = len(x.TxHash)
-		if l > 0 {
+		if x.TxLogs != nil {
+			l = options.Size(x.TxLogs)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.TxIndex != 0 {
-			n += 1 + runtime.Sov(uint64(x.TxIndex))
-		}
-		l = len(x.BlockHash)
+		l = len(x.Ret)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Index != 0 {
-			n += 1 + runtime.Sov(uint64(x.Index))
-		}
-		if x.Removed {
+		if x.Reverted {
 			n += 2
 		}
+		if x.GasUsed != 0 {
+			n += 1 + runtime.Sov(uint64(x.GasUsed))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -5431,7 +6961,7 @@ func (x *fastReflection_Log) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Log)
+		x := input.Message.Interface().(*TxResult)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5450,65 +6980,53 @@ func (x *fastReflection_Log) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Removed {
+		if x.GasUsed != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasUsed))
 			i--
-			if x.Removed {
+			dAtA[i] = 0x30
+		}
+		if x.Reverted {
+			i--
+			if x.Reverted {
 				dAtA[i] = 1
 			} else {
 				dAtA[i] = 0
 			}
 			i--
-			dAtA[i] = 0x48
-		}
-		if x.Index != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Index))
-			i--
-			dAtA[i] = 0x40
-		}
-		if len(x.BlockHash) > 0 {
-			i -= len(x.BlockHash)
-			copy(dAtA[i:], x.BlockHash)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BlockHash)))
-			i--
-			dAtA[i] = 0x3a
-		}
-		if x.TxIndex != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.TxIndex))
-			i--
-			dAtA[i] = 0x30
-		}
-		if len(x.TxHash) > 0 {
-			i -= len(x.TxHash)
-			copy(dAtA[i:], x.TxHash)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TxHash)))
-			i--
-			dAtA[i] = 0x2a
+			dAtA[i] = 0x28
 		}
-		if x.BlockNumber != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockNumber))
+		if len(x.Ret) > 0 {
+			i -= len(x.Ret)
+			copy(dAtA[i:], x.Ret)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Ret)))
 			i--
-			dAtA[i] = 0x20
+			dAtA[i] = 0x22
 		}
-		if len(x.Data) > 0 {
-			i -= len(x.Data)
-			copy(dAtA[i:], x.Data)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Data)))
+		if x.TxLogs != nil {
+			encoded, err := options.Marshal(x.TxLogs)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0x1a
 		}
-		if len(x.Topics) > 0 {
-			for iNdEx := len(x.Topics) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.Topics[iNdEx])
-				copy(dAtA[i:], x.Topics[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Topics[iNdEx])))
-				i--
-				dAtA[i] = 0x12
-			}
+		if len(x.Bloom) > 0 {
+			i -= len(x.Bloom)
+			copy(dAtA[i:], x.Bloom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Bloom)))
+			i--
+			dAtA[i] = 0x12
 		}
-		if len(x.Address) > 0 {
-			i -= len(x.Address)
-			copy(dAtA[i:], x.Address)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+		if len(x.ContractAddress) > 0 {
+			i -= len(x.ContractAddress)
+			copy(dAtA[i:], x.ContractAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ContractAddress)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -5523,7 +7041,7 @@ func (x *fastReflection_Log) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Log)
+		x := input.Message.Interface().(*TxResult)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5555,15 +7073,15 @@ func (x *fastReflection_Log) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Log: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxResult: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Log: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxResult: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -5591,43 +7109,11 @@ func (x *fastReflection_Log) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Address = string(dAtA[iNdEx:postIndex])
+				x.ContractAddress = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Topics = append(x.Topics, string(dAtA[iNdEx:postIndex]))
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Bloom", wireType)
 				}
 				var byteLen int
 				for shift := uint(0); ; shift += 7 {
@@ -5654,35 +7140,16 @@ func (x *fastReflection_Log) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Data = append(x.Data[:0], dAtA[iNdEx:postIndex]...)
-				if x.Data == nil {
-					x.Data = []byte{}
+				x.Bloom = append(x.Bloom[:0], dAtA[iNdEx:postIndex]...)
+				if x.Bloom == nil {
+					x.Bloom = []byte{}
 				}
 				iNdEx = postIndex
-			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
-				}
-				x.BlockNumber = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.BlockNumber |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 5:
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxLogs", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5692,48 +7159,33 @@ func (x *fastReflection_Log) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.TxHash = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 6:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+				if x.TxLogs == nil {
+					x.TxLogs = &TransactionLogs{}
 				}
-				x.TxIndex = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.TxIndex |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TxLogs); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-			case 7:
+				iNdEx = postIndex
+			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Ret", wireType)
 				}
-				var stringLen uint64
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5743,29 +7195,31 @@ func (x *fastReflection_Log) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + byteLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.BlockHash = string(dAtA[iNdEx:postIndex])
+				x.Ret = append(x.Ret[:0], dAtA[iNdEx:postIndex]...)
+				if x.Ret == nil {
+					x.Ret = []byte{}
+				}
 				iNdEx = postIndex
-			case 8:
+			case 5:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reverted", wireType)
 				}
-				x.Index = 0
+				var v int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5775,16 +7229,17 @@ func (x *fastReflection_Log) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Index |= uint64(b&0x7F) << shift
+					v |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 9:
+				x.Reverted = bool(v != 0)
+			case 6:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
 				}
-				var v int
+				x.GasUsed = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5794,12 +7249,11 @@ func (x *fastReflection_Log) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= int(b&0x7F) << shift
+					x.GasUsed |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				x.Removed = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -5835,37 +7289,75 @@ func (x *fastReflection_Log) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_AccessTuple_2_list)(nil)
+
+type _AccessTuple_2_list struct {
+	list *[]string
+}
+
+func (x *_AccessTuple_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_AccessTuple_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_AccessTuple_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_AccessTuple_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_AccessTuple_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message AccessTuple at list field StorageKeys as it is not of Message kind"))
+}
+
+func (x *_AccessTuple_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_AccessTuple_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_AccessTuple_2_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_TxResult                  protoreflect.MessageDescriptor
-	fd_TxResult_contract_address protoreflect.FieldDescriptor
-	fd_TxResult_bloom            protoreflect.FieldDescriptor
-	fd_TxResult_tx_logs          protoreflect.FieldDescriptor
-	fd_TxResult_ret              protoreflect.FieldDescriptor
-	fd_TxResult_reverted         protoreflect.FieldDescriptor
-	fd_TxResult_gas_used         protoreflect.FieldDescriptor
+	md_AccessTuple              protoreflect.MessageDescriptor
+	fd_AccessTuple_address      protoreflect.FieldDescriptor
+	fd_AccessTuple_storage_keys protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_evm_proto_init()
-	md_TxResult = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("TxResult")
-	fd_TxResult_contract_address = md_TxResult.Fields().ByName("contract_address")
-	fd_TxResult_bloom = md_TxResult.Fields().ByName("bloom")
-	fd_TxResult_tx_logs = md_TxResult.Fields().ByName("tx_logs")
-	fd_TxResult_ret = md_TxResult.Fields().ByName("ret")
-	fd_TxResult_reverted = md_TxResult.Fields().ByName("reverted")
-	fd_TxResult_gas_used = md_TxResult.Fields().ByName("gas_used")
+	md_AccessTuple = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("AccessTuple")
+	fd_AccessTuple_address = md_AccessTuple.Fields().ByName("address")
+	fd_AccessTuple_storage_keys = md_AccessTuple.Fields().ByName("storage_keys")
 }
 
-var _ protoreflect.Message = (*fastReflection_TxResult)(nil)
+var _ protoreflect.Message = (*fastReflection_AccessTuple)(nil)
 
-type fastReflection_TxResult TxResult
+type fastReflection_AccessTuple AccessTuple
 
-func (x *TxResult) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_TxResult)(x)
+func (x *AccessTuple) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AccessTuple)(x)
 }
 
-func (x *TxResult) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[7]
+func (x *AccessTuple) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5876,43 +7368,43 @@ func (x *TxResult) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_TxResult_messageType fastReflection_TxResult_messageType
-var _ protoreflect.MessageType = fastReflection_TxResult_messageType{}
+var _fastReflection_AccessTuple_messageType fastReflection_AccessTuple_messageType
+var _ protoreflect.MessageType = fastReflection_AccessTuple_messageType{}
 
-type fastReflection_TxResult_messageType struct{}
+type fastReflection_AccessTuple_messageType struct{}
 
-func (x fastReflection_TxResult_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_TxResult)(nil)
+func (x fastReflection_AccessTuple_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AccessTuple)(nil)
 }
-func (x fastReflection_TxResult_messageType) New() protoreflect.Message {
-	return new(fastReflection_TxResult)
+func (x fastReflection_AccessTuple_messageType) New() protoreflect.Message {
+	return new(fastReflection_AccessTuple)
 }
-func (x fastReflection_TxResult_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_TxResult
+func (x fastReflection_AccessTuple_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AccessTuple
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_TxResult) Descriptor() protoreflect.MessageDescriptor {
-	return md_TxResult
+func (x *fastReflection_AccessTuple) Descriptor() protoreflect.MessageDescriptor {
+	return md_AccessTuple
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_TxResult) Type() protoreflect.MessageType {
-	return _fastReflection_TxResult_messageType
+func (x *fastReflection_AccessTuple) Type() protoreflect.MessageType {
+	return _fastReflection_AccessTuple_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_TxResult) New() protoreflect.Message {
-	return new(fastReflection_TxResult)
+func (x *fastReflection_AccessTuple) New() protoreflect.Message {
+	return new(fastReflection_AccessTuple)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_TxResult) Interface() protoreflect.ProtoMessage {
-	return (*TxResult)(x)
+func (x *fastReflection_AccessTuple) Interface() protoreflect.ProtoMessage {
+	return (*AccessTuple)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5920,40 +7412,16 @@ func (x *fastReflection_TxResult) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_TxResult) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ContractAddress != "" {
-		value := protoreflect.ValueOfString(x.ContractAddress)
-		if !f(fd_TxResult_contract_address, value) {
-			return
-		}
-	}
-	if len(x.Bloom) != 0 {
-		value := protoreflect.ValueOfBytes(x.Bloom)
-		if !f(fd_TxResult_bloom, value) {
-			return
-		}
-	}
-	if x.TxLogs != nil {
-		value := protoreflect.ValueOfMessage(x.TxLogs.ProtoReflect())
-		if !f(fd_TxResult_tx_logs, value) {
-			return
-		}
-	}
-	if len(x.Ret) != 0 {
-		value := protoreflect.ValueOfBytes(x.Ret)
-		if !f(fd_TxResult_ret, value) {
-			return
-		}
-	}
-	if x.Reverted != false {
-		value := protoreflect.ValueOfBool(x.Reverted)
-		if !f(fd_TxResult_reverted, value) {
+func (x *fastReflection_AccessTuple) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_AccessTuple_address, value) {
 			return
 		}
 	}
-	if x.GasUsed != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.GasUsed)
-		if !f(fd_TxResult_gas_used, value) {
+	if len(x.StorageKeys) != 0 {
+		value := protoreflect.ValueOfList(&_AccessTuple_2_list{list: &x.StorageKeys})
+		if !f(fd_AccessTuple_storage_keys, value) {
 			return
 		}
 	}
@@ -5970,25 +7438,17 @@ func (x *fastReflection_TxResult) Range(f func(protoreflect.FieldDescriptor, pro
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_TxResult) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_AccessTuple) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.TxResult.contract_address":
-		return x.ContractAddress != ""
-	case "cosmos.evm.vm.v1.TxResult.bloom":
-		return len(x.Bloom) != 0
-	case "cosmos.evm.vm.v1.TxResult.tx_logs":
-		return x.TxLogs != nil
-	case "cosmos.evm.vm.v1.TxResult.ret":
-		return len(x.Ret) != 0
-	case "cosmos.evm.vm.v1.TxResult.reverted":
-		return x.Reverted != false
-	case "cosmos.evm.vm.v1.TxResult.gas_used":
-		return x.GasUsed != uint64(0)
+	case "cosmos.evm.vm.v1.AccessTuple.address":
+		return x.Address != ""
+	case "cosmos.evm.vm.v1.AccessTuple.storage_keys":
+		return len(x.StorageKeys) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TxResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessTuple"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.TxResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessTuple does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5998,25 +7458,17 @@ func (x *fastReflection_TxResult) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxResult) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_AccessTuple) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.TxResult.contract_address":
-		x.ContractAddress = ""
-	case "cosmos.evm.vm.v1.TxResult.bloom":
-		x.Bloom = nil
-	case "cosmos.evm.vm.v1.TxResult.tx_logs":
-		x.TxLogs = nil
-	case "cosmos.evm.vm.v1.TxResult.ret":
-		x.Ret = nil
-	case "cosmos.evm.vm.v1.TxResult.reverted":
-		x.Reverted = false
-	case "cosmos.evm.vm.v1.TxResult.gas_used":
-		x.GasUsed = uint64(0)
+	case "cosmos.evm.vm.v1.AccessTuple.address":
+		x.Address = ""
+	case "cosmos.evm.vm.v1.AccessTuple.storage_keys":
+		x.StorageKeys = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TxResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessTuple"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.TxResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessTuple does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6026,31 +7478,22 @@ func (x *fastReflection_TxResult) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_TxResult) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AccessTuple) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.TxResult.contract_address":
-		value := x.ContractAddress
+	case "cosmos.evm.vm.v1.AccessTuple.address":
+		value := x.Address
 		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.TxResult.bloom":
-		value := x.Bloom
-		return protoreflect.ValueOfBytes(value)
-	case "cosmos.evm.vm.v1.TxResult.tx_logs":
-		value := x.TxLogs
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.evm.vm.v1.TxResult.ret":
-		value := x.Ret
-		return protoreflect.ValueOfBytes(value)
-	case "cosmos.evm.vm.v1.TxResult.reverted":
-		value := x.Reverted
-		return protoreflect.ValueOfBool(value)
-	case "cosmos.evm.vm.v1.TxResult.gas_used":
-		value := x.GasUsed
-		return protoreflect.ValueOfUint64(value)
+	case "cosmos.evm.vm.v1.AccessTuple.storage_keys":
+		if len(x.StorageKeys) == 0 {
+			return protoreflect.ValueOfList(&_AccessTuple_2_list{})
+		}
+		listValue := &_AccessTuple_2_list{list: &x.StorageKeys}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TxResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessTuple"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.TxResult does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessTuple does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6064,25 +7507,19 @@ func (x *fastReflection_TxResult) Get(descriptor protoreflect.FieldDescriptor) p
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxResult) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_AccessTuple) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.TxResult.contract_address":
-		x.ContractAddress = value.Interface().(string)
-	case "cosmos.evm.vm.v1.TxResult.bloom":
-		x.Bloom = value.Bytes()
-	case "cosmos.evm.vm.v1.TxResult.tx_logs":
-		x.TxLogs = value.Message().Interface().(*TransactionLogs)
-	case "cosmos.evm.vm.v1.TxResult.ret":
-		x.Ret = value.Bytes()
-	case "cosmos.evm.vm.v1.TxResult.reverted":
-		x.Reverted = value.Bool()
-	case "cosmos.evm.vm.v1.TxResult.gas_used":
-		x.GasUsed = value.Uint()
+	case "cosmos.evm.vm.v1.AccessTuple.address":
+		x.Address = value.Interface().(string)
+	case "cosmos.evm.vm.v1.AccessTuple.storage_keys":
+		lv := value.List()
+		clv := lv.(*_AccessTuple_2_list)
+		x.StorageKeys = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TxResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessTuple"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.TxResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessTuple does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6096,64 +7533,49 @@ func (x *fastReflection_TxResult) Set(fd protoreflect.FieldDescriptor, value pro
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxResult) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AccessTuple) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.TxResult.tx_logs":
-		if x.TxLogs == nil {
-			x.TxLogs = new(TransactionLogs)
+	case "cosmos.evm.vm.v1.AccessTuple.storage_keys":
+		if x.StorageKeys == nil {
+			x.StorageKeys = []string{}
 		}
-		return protoreflect.ValueOfMessage(x.TxLogs.ProtoReflect())
-	case "cosmos.evm.vm.v1.TxResult.contract_address":
-		panic(fmt.Errorf("field contract_address of message cosmos.evm.vm.v1.TxResult is not mutable"))
-	case "cosmos.evm.vm.v1.TxResult.bloom":
-		panic(fmt.Errorf("field bloom of message cosmos.evm.vm.v1.TxResult is not mutable"))
-	case "cosmos.evm.vm.v1.TxResult.ret":
-		panic(fmt.Errorf("field ret of message cosmos.evm.vm.v1.TxResult is not mutable"))
-	case "cosmos.evm.vm.v1.TxResult.reverted":
-		panic(fmt.Errorf("field reverted of message cosmos.evm.vm.v1.TxResult is not mutable"))
-	case "cosmos.evm.vm.v1.TxResult.gas_used":
-		panic(fmt.Errorf("field gas_used of message cosmos.evm.vm.v1.TxResult is not mutable"))
+		value := &_AccessTuple_2_list{list: &x.StorageKeys}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.evm.vm.v1.AccessTuple.address":
+		panic(fmt.Errorf("field address of message cosmos.evm.vm.v1.AccessTuple is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TxResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessTuple"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.TxResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessTuple does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_TxResult) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AccessTuple) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.TxResult.contract_address":
+	case "cosmos.evm.vm.v1.AccessTuple.address":
 		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.TxResult.bloom":
-		return protoreflect.ValueOfBytes(nil)
-	case "cosmos.evm.vm.v1.TxResult.tx_logs":
-		m := new(TransactionLogs)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.evm.vm.v1.TxResult.ret":
-		return protoreflect.ValueOfBytes(nil)
-	case "cosmos.evm.vm.v1.TxResult.reverted":
-		return protoreflect.ValueOfBool(false)
-	case "cosmos.evm.vm.v1.TxResult.gas_used":
-		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.evm.vm.v1.AccessTuple.storage_keys":
+		list := []string{}
+		return protoreflect.ValueOfList(&_AccessTuple_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TxResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessTuple"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.TxResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessTuple does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_TxResult) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_AccessTuple) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.TxResult", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.AccessTuple", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6161,7 +7583,7 @@ func (x *fastReflection_TxResult) WhichOneof(d protoreflect.OneofDescriptor) pro
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_TxResult) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_AccessTuple) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6172,7 +7594,7 @@ func (x *fastReflection_TxResult) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxResult) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_AccessTuple) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6184,7 +7606,7 @@ func (x *fastReflection_TxResult) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_TxResult) IsValid() bool {
+func (x *fastReflection_AccessTuple) IsValid() bool {
 	return x != nil
 }
 
@@ -6194,9 +7616,9 @@ func (x *fastReflection_TxResult) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_TxResult) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_AccessTuple) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*TxResult)
+		x := input.Message.Interface().(*AccessTuple)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6208,27 +7630,15 @@ func (x *fastReflection_TxResult) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.ContractAddress)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Bloom)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.TxLogs != nil {
-			l = options.Size(x.TxLogs)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Ret)
+		l = len(x.Address)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Reverted {
-			n += 2
-		}
-		if x.GasUsed != 0 {
-			n += 1 + runtime.Sov(uint64(x.GasUsed))
+		if len(x.StorageKeys) > 0 {
+			for _, s := range x.StorageKeys {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -6240,7 +7650,7 @@ func (x *fastReflection_TxResult) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*TxResult)
+		x := input.Message.Interface().(*AccessTuple)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6259,53 +7669,19 @@ func (x *fastReflection_TxResult) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.GasUsed != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasUsed))
-			i--
-			dAtA[i] = 0x30
-		}
-		if x.Reverted {
-			i--
-			if x.Reverted {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x28
-		}
-		if len(x.Ret) > 0 {
-			i -= len(x.Ret)
-			copy(dAtA[i:], x.Ret)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Ret)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if x.TxLogs != nil {
-			encoded, err := options.Marshal(x.TxLogs)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
+		if len(x.StorageKeys) > 0 {
+			for iNdEx := len(x.StorageKeys) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.StorageKeys[iNdEx])
+				copy(dAtA[i:], x.StorageKeys[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.StorageKeys[iNdEx])))
+				i--
+				dAtA[i] = 0x12
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.Bloom) > 0 {
-			i -= len(x.Bloom)
-			copy(dAtA[i:], x.Bloom)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Bloom)))
-			i--
-			dAtA[i] = 0x12
 		}
-		if len(x.ContractAddress) > 0 {
-			i -= len(x.ContractAddress)
-			copy(dAtA[i:], x.ContractAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ContractAddress)))
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -6320,7 +7696,7 @@ func (x *fastReflection_TxResult) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*TxResult)
+		x := input.Message.Interface().(*AccessTuple)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6352,119 +7728,17 @@ func (x *fastReflection_TxResult) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxResult: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxResult: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AccessTuple: wiretype end group for non-group")
 			}
-			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.ContractAddress = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Bloom", wireType)
-				}
-				var byteLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					byteLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Bloom = append(x.Bloom[:0], dAtA[iNdEx:postIndex]...)
-				if x.Bloom == nil {
-					x.Bloom = []byte{}
-				}
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxLogs", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.TxLogs == nil {
-					x.TxLogs = &TransactionLogs{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TxLogs); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 4:
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AccessTuple: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Ret", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 				}
-				var byteLen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6474,31 +7748,29 @@ func (x *fastReflection_TxResult) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Ret = append(x.Ret[:0], dAtA[iNdEx:postIndex]...)
-				if x.Ret == nil {
-					x.Ret = []byte{}
-				}
+				x.Address = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 5:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reverted", wireType)
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StorageKeys", wireType)
 				}
-				var v int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6508,31 +7780,24 @@ func (x *fastReflection_TxResult) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				x.Reverted = bool(v != 0)
-			case 6:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				x.GasUsed = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.GasUsed |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
+				x.StorageKeys = append(x.StorageKeys, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -6568,75 +7833,47 @@ func (x *fastReflection_TxResult) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_AccessTuple_2_list)(nil)
-
-type _AccessTuple_2_list struct {
-	list *[]string
-}
-
-func (x *_AccessTuple_2_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_AccessTuple_2_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
-}
-
-func (x *_AccessTuple_2_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_AccessTuple_2_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_AccessTuple_2_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message AccessTuple at list field StorageKeys as it is not of Message kind"))
-}
-
-func (x *_AccessTuple_2_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_AccessTuple_2_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
-}
-
-func (x *_AccessTuple_2_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_AccessTuple              protoreflect.MessageDescriptor
-	fd_AccessTuple_address      protoreflect.FieldDescriptor
-	fd_AccessTuple_storage_keys protoreflect.FieldDescriptor
+	md_TraceConfig                    protoreflect.MessageDescriptor
+	fd_TraceConfig_tracer             protoreflect.FieldDescriptor
+	fd_TraceConfig_timeout            protoreflect.FieldDescriptor
+	fd_TraceConfig_reexec             protoreflect.FieldDescriptor
+	fd_TraceConfig_disable_stack      protoreflect.FieldDescriptor
+	fd_TraceConfig_disable_storage    protoreflect.FieldDescriptor
+	fd_TraceConfig_debug              protoreflect.FieldDescriptor
+	fd_TraceConfig_limit              protoreflect.FieldDescriptor
+	fd_TraceConfig_overrides          protoreflect.FieldDescriptor
+	fd_TraceConfig_enable_memory      protoreflect.FieldDescriptor
+	fd_TraceConfig_enable_return_data protoreflect.FieldDescriptor
+	fd_TraceConfig_tracer_json_config protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_evm_proto_init()
-	md_AccessTuple = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("AccessTuple")
-	fd_AccessTuple_address = md_AccessTuple.Fields().ByName("address")
-	fd_AccessTuple_storage_keys = md_AccessTuple.Fields().ByName("storage_keys")
+	md_TraceConfig = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("TraceConfig")
+	fd_TraceConfig_tracer = md_TraceConfig.Fields().ByName("tracer")
+	fd_TraceConfig_timeout = md_TraceConfig.Fields().ByName("timeout")
+	fd_TraceConfig_reexec = md_TraceConfig.Fields().ByName("reexec")
+	fd_TraceConfig_disable_stack = md_TraceConfig.Fields().ByName("disable_stack")
+	fd_TraceConfig_disable_storage = md_TraceConfig.Fields().ByName("disable_storage")
+	fd_TraceConfig_debug = md_TraceConfig.Fields().ByName("debug")
+	fd_TraceConfig_limit = md_TraceConfig.Fields().ByName("limit")
+	fd_TraceConfig_overrides = md_TraceConfig.Fields().ByName("overrides")
+	fd_TraceConfig_enable_memory = md_TraceConfig.Fields().ByName("enable_memory")
+	fd_TraceConfig_enable_return_data = md_TraceConfig.Fields().ByName("enable_return_data")
+	fd_TraceConfig_tracer_json_config = md_TraceConfig.Fields().ByName("tracer_json_config")
 }
 
-var _ protoreflect.Message = (*fastReflection_AccessTuple)(nil)
+var _ protoreflect.Message = (*fastReflection_TraceConfig)(nil)
 
-type fastReflection_AccessTuple AccessTuple
+type fastReflection_TraceConfig TraceConfig
 
-func (x *AccessTuple) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_AccessTuple)(x)
+func (x *TraceConfig) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TraceConfig)(x)
 }
 
-func (x *AccessTuple) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[8]
+func (x *TraceConfig) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6647,43 +7884,43 @@ func (x *AccessTuple) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_AccessTuple_messageType fastReflection_AccessTuple_messageType
-var _ protoreflect.MessageType = fastReflection_AccessTuple_messageType{}
+var _fastReflection_TraceConfig_messageType fastReflection_TraceConfig_messageType
+var _ protoreflect.MessageType = fastReflection_TraceConfig_messageType{}
 
-type fastReflection_AccessTuple_messageType struct{}
+type fastReflection_TraceConfig_messageType struct{}
 
-func (x fastReflection_AccessTuple_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_AccessTuple)(nil)
+func (x fastReflection_TraceConfig_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TraceConfig)(nil)
 }
-func (x fastReflection_AccessTuple_messageType) New() protoreflect.Message {
-	return new(fastReflection_AccessTuple)
+func (x fastReflection_TraceConfig_messageType) New() protoreflect.Message {
+	return new(fastReflection_TraceConfig)
 }
-func (x fastReflection_AccessTuple_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_AccessTuple
+func (x fastReflection_TraceConfig_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TraceConfig
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_AccessTuple) Descriptor() protoreflect.MessageDescriptor {
-	return md_AccessTuple
+func (x *fastReflection_TraceConfig) Descriptor() protoreflect.MessageDescriptor {
+	return md_TraceConfig
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_AccessTuple) Type() protoreflect.MessageType {
-	return _fastReflection_AccessTuple_messageType
+func (x *fastReflection_TraceConfig) Type() protoreflect.MessageType {
+	return _fastReflection_TraceConfig_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_AccessTuple) New() protoreflect.Message {
-	return new(fastReflection_AccessTuple)
+func (x *fastReflection_TraceConfig) New() protoreflect.Message {
+	return new(fastReflection_TraceConfig)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_AccessTuple) Interface() protoreflect.ProtoMessage {
-	return (*AccessTuple)(x)
+func (x *fastReflection_TraceConfig) Interface() protoreflect.ProtoMessage {
+	return (*TraceConfig)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6691,16 +7928,70 @@ func (x *fastReflection_AccessTuple) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_AccessTuple) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Address != "" {
-		value := protoreflect.ValueOfString(x.Address)
-		if !f(fd_AccessTuple_address, value) {
+func (x *fastReflection_TraceConfig) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Tracer != "" {
+		value := protoreflect.ValueOfString(x.Tracer)
+		if !f(fd_TraceConfig_tracer, value) {
 			return
 		}
 	}
-	if len(x.StorageKeys) != 0 {
-		value := protoreflect.ValueOfList(&_AccessTuple_2_list{list: &x.StorageKeys})
-		if !f(fd_AccessTuple_storage_keys, value) {
+	if x.Timeout != "" {
+		value := protoreflect.ValueOfString(x.Timeout)
+		if !f(fd_TraceConfig_timeout, value) {
+			return
+		}
+	}
+	if x.Reexec != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Reexec)
+		if !f(fd_TraceConfig_reexec, value) {
+			return
+		}
+	}
+	if x.DisableStack != false {
+		value := protoreflect.ValueOfBool(x.DisableStack)
+		if !f(fd_TraceConfig_disable_stack, value) {
+			return
+		}
+	}
+	if x.DisableStorage != false {
+		value := protoreflect.ValueOfBool(x.DisableStorage)
+		if !f(fd_TraceConfig_disable_storage, value) {
+			return
+		}
+	}
+	if x.Debug != false {
+		value := protoreflect.ValueOfBool(x.Debug)
+		if !f(fd_TraceConfig_debug, value) {
+			return
+		}
+	}
+	if x.Limit != int32(0) {
+		value := protoreflect.ValueOfInt32(x.Limit)
+		if !f(fd_TraceConfig_limit, value) {
+			return
+		}
+	}
+	if x.Overrides != nil {
+		value := protoreflect.ValueOfMessage(x.Overrides.ProtoReflect())
+		if !f(fd_TraceConfig_overrides, value) {
+			return
+		}
+	}
+	if x.EnableMemory != false {
+		value := protoreflect.ValueOfBool(x.EnableMemory)
+		if !f(fd_TraceConfig_enable_memory, value) {
+			return
+		}
+	}
+	if x.EnableReturnData != false {
+		value := protoreflect.ValueOfBool(x.EnableReturnData)
+		if !f(fd_TraceConfig_enable_return_data, value) {
+			return
+		}
+	}
+	if x.TracerJsonConfig != "" {
+		value := protoreflect.ValueOfString(x.TracerJsonConfig)
+		if !f(fd_TraceConfig_tracer_json_config, value) {
 			return
 		}
 	}
@@ -6717,17 +8008,35 @@ func (x *fastReflection_AccessTuple) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_AccessTuple) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_TraceConfig) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.AccessTuple.address":
-		return x.Address != ""
-	case "cosmos.evm.vm.v1.AccessTuple.storage_keys":
-		return len(x.StorageKeys) != 0
+	case "cosmos.evm.vm.v1.TraceConfig.tracer":
+		return x.Tracer != ""
+	case "cosmos.evm.vm.v1.TraceConfig.timeout":
+		return x.Timeout != ""
+	case "cosmos.evm.vm.v1.TraceConfig.reexec":
+		return x.Reexec != uint64(0)
+	case "cosmos.evm.vm.v1.TraceConfig.disable_stack":
+		return x.DisableStack != false
+	case "cosmos.evm.vm.v1.TraceConfig.disable_storage":
+		return x.DisableStorage != false
+	case "cosmos.evm.vm.v1.TraceConfig.debug":
+		return x.Debug != false
+	case "cosmos.evm.vm.v1.TraceConfig.limit":
+		return x.Limit != int32(0)
+	case "cosmos.evm.vm.v1.TraceConfig.overrides":
+		return x.Overrides != nil
+	case "cosmos.evm.vm.v1.TraceConfig.enable_memory":
+		return x.EnableMemory != false
+	case "cosmos.evm.vm.v1.TraceConfig.enable_return_data":
+		return x.EnableReturnData != false
+	case "cosmos.evm.vm.v1.TraceConfig.tracer_json_config":
+		return x.TracerJsonConfig != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessTuple"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TraceConfig"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessTuple does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.TraceConfig does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6737,17 +8046,35 @@ func (x *fastReflection_AccessTuple) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AccessTuple) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_TraceConfig) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.AccessTuple.address":
-		x.Address = ""
-	case "cosmos.evm.vm.v1.AccessTuple.storage_keys":
-		x.StorageKeys = nil
+	case "cosmos.evm.vm.v1.TraceConfig.tracer":
+		x.Tracer = ""
+	case "cosmos.evm.vm.v1.TraceConfig.timeout":
+		x.Timeout = ""
+	case "cosmos.evm.vm.v1.TraceConfig.reexec":
+		x.Reexec = uint64(0)
+	case "cosmos.evm.vm.v1.TraceConfig.disable_stack":
+		x.DisableStack = false
+	case "cosmos.evm.vm.v1.TraceConfig.disable_storage":
+		x.DisableStorage = false
+	case "cosmos.evm.vm.v1.TraceConfig.debug":
+		x.Debug = false
+	case "cosmos.evm.vm.v1.TraceConfig.limit":
+		x.Limit = int32(0)
+	case "cosmos.evm.vm.v1.TraceConfig.overrides":
+		x.Overrides = nil
+	case "cosmos.evm.vm.v1.TraceConfig.enable_memory":
+		x.EnableMemory = false
+	case "cosmos.evm.vm.v1.TraceConfig.enable_return_data":
+		x.EnableReturnData = false
+	case "cosmos.evm.vm.v1.TraceConfig.tracer_json_config":
+		x.TracerJsonConfig = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessTuple"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TraceConfig"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessTuple does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.TraceConfig does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6757,22 +8084,46 @@ func (x *fastReflection_AccessTuple) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_AccessTuple) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TraceConfig) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.AccessTuple.address":
-		value := x.Address
+	case "cosmos.evm.vm.v1.TraceConfig.tracer":
+		value := x.Tracer
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.TraceConfig.timeout":
+		value := x.Timeout
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.TraceConfig.reexec":
+		value := x.Reexec
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.evm.vm.v1.TraceConfig.disable_stack":
+		value := x.DisableStack
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.evm.vm.v1.TraceConfig.disable_storage":
+		value := x.DisableStorage
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.evm.vm.v1.TraceConfig.debug":
+		value := x.Debug
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.evm.vm.v1.TraceConfig.limit":
+		value := x.Limit
+		return protoreflect.ValueOfInt32(value)
+	case "cosmos.evm.vm.v1.TraceConfig.overrides":
+		value := x.Overrides
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.evm.vm.v1.TraceConfig.enable_memory":
+		value := x.EnableMemory
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.evm.vm.v1.TraceConfig.enable_return_data":
+		value := x.EnableReturnData
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.evm.vm.v1.TraceConfig.tracer_json_config":
+		value := x.TracerJsonConfig
 		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.AccessTuple.storage_keys":
-		if len(x.StorageKeys) == 0 {
-			return protoreflect.ValueOfList(&_AccessTuple_2_list{})
-		}
-		listValue := &_AccessTuple_2_list{list: &x.StorageKeys}
-		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessTuple"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TraceConfig"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessTuple does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.TraceConfig does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6786,19 +8137,35 @@ func (x *fastReflection_AccessTuple) Get(descriptor protoreflect.FieldDescriptor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AccessTuple) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_TraceConfig) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.AccessTuple.address":
-		x.Address = value.Interface().(string)
-	case "cosmos.evm.vm.v1.AccessTuple.storage_keys":
-		lv := value.List()
-		clv := lv.(*_AccessTuple_2_list)
-		x.StorageKeys = *clv.list
+	case "cosmos.evm.vm.v1.TraceConfig.tracer":
+		x.Tracer = value.Interface().(string)
+	case "cosmos.evm.vm.v1.TraceConfig.timeout":
+		x.Timeout = value.Interface().(string)
+	case "cosmos.evm.vm.v1.TraceConfig.reexec":
+		x.Reexec = value.Uint()
+	case "cosmos.evm.vm.v1.TraceConfig.disable_stack":
+		x.DisableStack = value.Bool()
+	case "cosmos.evm.vm.v1.TraceConfig.disable_storage":
+		x.DisableStorage = value.Bool()
+	case "cosmos.evm.vm.v1.TraceConfig.debug":
+		x.Debug = value.Bool()
+	case "cosmos.evm.vm.v1.TraceConfig.limit":
+		x.Limit = int32(value.Int())
+	case "cosmos.evm.vm.v1.TraceConfig.overrides":
+		x.Overrides = value.Message().Interface().(*ChainConfig)
+	case "cosmos.evm.vm.v1.TraceConfig.enable_memory":
+		x.EnableMemory = value.Bool()
+	case "cosmos.evm.vm.v1.TraceConfig.enable_return_data":
+		x.EnableReturnData = value.Bool()
+	case "cosmos.evm.vm.v1.TraceConfig.tracer_json_config":
+		x.TracerJsonConfig = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessTuple"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TraceConfig"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessTuple does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.TraceConfig does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6812,49 +8179,84 @@ func (x *fastReflection_AccessTuple) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AccessTuple) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TraceConfig) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.AccessTuple.storage_keys":
-		if x.StorageKeys == nil {
-			x.StorageKeys = []string{}
+	case "cosmos.evm.vm.v1.TraceConfig.overrides":
+		if x.Overrides == nil {
+			x.Overrides = new(ChainConfig)
 		}
-		value := &_AccessTuple_2_list{list: &x.StorageKeys}
-		return protoreflect.ValueOfList(value)
-	case "cosmos.evm.vm.v1.AccessTuple.address":
-		panic(fmt.Errorf("field address of message cosmos.evm.vm.v1.AccessTuple is not mutable"))
+		return protoreflect.ValueOfMessage(x.Overrides.ProtoReflect())
+	case "cosmos.evm.vm.v1.TraceConfig.tracer":
+		panic(fmt.Errorf("field tracer of message cosmos.evm.vm.v1.TraceConfig is not mutable"))
+	case "cosmos.evm.vm.v1.TraceConfig.timeout":
+		panic(fmt.Errorf("field timeout of message cosmos.evm.vm.v1.TraceConfig is not mutable"))
+	case "cosmos.evm.vm.v1.TraceConfig.reexec":
+		panic(fmt.Errorf("field reexec of message cosmos.evm.vm.v1.TraceConfig is not mutable"))
+	case "cosmos.evm.vm.v1.TraceConfig.disable_stack":
+		panic(fmt.Errorf("field disable_stack of message cosmos.evm.vm.v1.TraceConfig is not mutable"))
+	case "cosmos.evm.vm.v1.TraceConfig.disable_storage":
+		panic(fmt.Errorf("field disable_storage of message cosmos.evm.vm.v1.TraceConfig is not mutable"))
+	case "cosmos.evm.vm.v1.TraceConfig.debug":
+		panic(fmt.Errorf("field debug of message cosmos.evm.vm.v1.TraceConfig is not mutable"))
+	case "cosmos.evm.vm.v1.TraceConfig.limit":
+		panic(fmt.Errorf("field limit of message cosmos.evm.vm.v1.TraceConfig is not mutable"))
+	case "cosmos.evm.vm.v1.TraceConfig.enable_memory":
+		panic(fmt.Errorf("field enable_memory of message cosmos.evm.vm.v1.TraceConfig is not mutable"))
+	case "cosmos.evm.vm.v1.TraceConfig.enable_return_data":
+		panic(fmt.Errorf("field enable_return_data of message cosmos.evm.vm.v1.TraceConfig is not mutable"))
+	case "cosmos.evm.vm.v1.TraceConfig.tracer_json_config":
+		panic(fmt.Errorf("field tracer_json_config of message cosmos.evm.vm.v1.TraceConfig is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessTuple"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TraceConfig"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessTuple does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.TraceConfig does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_AccessTuple) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TraceConfig) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.AccessTuple.address":
+	case "cosmos.evm.vm.v1.TraceConfig.tracer":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.TraceConfig.timeout":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.TraceConfig.reexec":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.evm.vm.v1.TraceConfig.disable_stack":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.evm.vm.v1.TraceConfig.disable_storage":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.evm.vm.v1.TraceConfig.debug":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.evm.vm.v1.TraceConfig.limit":
+		return protoreflect.ValueOfInt32(int32(0))
+	case "cosmos.evm.vm.v1.TraceConfig.overrides":
+		m := new(ChainConfig)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.evm.vm.v1.TraceConfig.enable_memory":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.evm.vm.v1.TraceConfig.enable_return_data":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.evm.vm.v1.TraceConfig.tracer_json_config":
 		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.AccessTuple.storage_keys":
-		list := []string{}
-		return protoreflect.ValueOfList(&_AccessTuple_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessTuple"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TraceConfig"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessTuple does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.TraceConfig does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_AccessTuple) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_TraceConfig) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.AccessTuple", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.TraceConfig", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6862,7 +8264,7 @@ func (x *fastReflection_AccessTuple) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_AccessTuple) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_TraceConfig) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6873,7 +8275,7 @@ func (x *fastReflection_AccessTuple) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AccessTuple) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_TraceConfig) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6885,7 +8287,7 @@ func (x *fastReflection_AccessTuple) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_AccessTuple) IsValid() bool {
+func (x *fastReflection_TraceConfig) IsValid() bool {
 	return x != nil
 }
 
@@ -6895,30 +8297,57 @@ func (x *fastReflection_AccessTuple) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_AccessTuple) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_TraceConfig) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*AccessTuple)
+		x := input.Message.Interface().(*TraceConfig)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
 				Size:              0,
 			}
 		}
-		options := runtime.SizeInputToOptions(input)
-		_ = options
-		var n int
-		var l int
-		_ = l
-		l = len(x.Address)
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Tracer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Timeout)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Reexec != 0 {
+			n += 1 + runtime.Sov(uint64(x.Reexec))
+		}
+		if x.DisableStack {
+			n += 2
+		}
+		if x.DisableStorage {
+			n += 2
+		}
+		if x.Debug {
+			n += 2
+		}
+		if x.Limit != 0 {
+			n += 1 + runtime.Sov(uint64(x.Limit))
+		}
+		if x.Overrides != nil {
+			l = options.Size(x.Overrides)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.EnableMemory {
+			n += 2
+		}
+		if x.EnableReturnData {
+			n += 2
+		}
+		l = len(x.TracerJsonConfig)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.StorageKeys) > 0 {
-			for _, s := range x.StorageKeys {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -6929,7 +8358,7 @@ func (x *fastReflection_AccessTuple) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*AccessTuple)
+		x := input.Message.Interface().(*TraceConfig)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6948,19 +8377,98 @@ func (x *fastReflection_AccessTuple) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.StorageKeys) > 0 {
-			for iNdEx := len(x.StorageKeys) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.StorageKeys[iNdEx])
-				copy(dAtA[i:], x.StorageKeys[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.StorageKeys[iNdEx])))
-				i--
-				dAtA[i] = 0x12
+		if len(x.TracerJsonConfig) > 0 {
+			i -= len(x.TracerJsonConfig)
+			copy(dAtA[i:], x.TracerJsonConfig)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TracerJsonConfig)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if x.EnableReturnData {
+			i--
+			if x.EnableReturnData {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
 			}
+			i--
+			dAtA[i] = 0x60
 		}
-		if len(x.Address) > 0 {
-			i -= len(x.Address)
-			copy(dAtA[i:], x.Address)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+		if x.EnableMemory {
+			i--
+			if x.EnableMemory {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.Overrides != nil {
+			encoded, err := options.Marshal(x.Overrides)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if x.Limit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Limit))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.Debug {
+			i--
+			if x.Debug {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.DisableStorage {
+			i--
+			if x.DisableStorage {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.DisableStack {
+			i--
+			if x.DisableStack {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.Reexec != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Reexec))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Timeout) > 0 {
+			i -= len(x.Timeout)
+			copy(dAtA[i:], x.Timeout)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Timeout)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Tracer) > 0 {
+			i -= len(x.Tracer)
+			copy(dAtA[i:], x.Tracer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Tracer)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -6975,7 +8483,7 @@ func (x *fastReflection_AccessTuple) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*AccessTuple)
+		x := input.Message.Interface().(*TraceConfig)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6994,30 +8502,192 @@ func (x *fastReflection_AccessTuple) ProtoMethods() *protoiface.Methods {
 				if shift >= 64 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				if iNdEx >= l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TraceConfig: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TraceConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tracer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Tracer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Timeout = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reexec", wireType)
+				}
+				x.Reexec = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Reexec |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DisableStack", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.DisableStack = bool(v != 0)
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DisableStorage", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.DisableStorage = bool(v != 0)
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Debug", wireType)
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AccessTuple: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AccessTuple: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
+				x.Debug = bool(v != 0)
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+				}
+				x.Limit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Limit |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7027,27 +8697,71 @@ func (x *fastReflection_AccessTuple) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Address = string(dAtA[iNdEx:postIndex])
+				if x.Overrides == nil {
+					x.Overrides = &ChainConfig{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Overrides); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
-			case 2:
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EnableMemory", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.EnableMemory = bool(v != 0)
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EnableReturnData", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.EnableReturnData = bool(v != 0)
+			case 13:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StorageKeys", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TracerJsonConfig", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -7075,7 +8789,7 @@ func (x *fastReflection_AccessTuple) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.StorageKeys = append(x.StorageKeys, string(dAtA[iNdEx:postIndex]))
+				x.TracerJsonConfig = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -7113,46 +8827,30 @@ func (x *fastReflection_AccessTuple) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_TraceConfig                    protoreflect.MessageDescriptor
-	fd_TraceConfig_tracer             protoreflect.FieldDescriptor
-	fd_TraceConfig_timeout            protoreflect.FieldDescriptor
-	fd_TraceConfig_reexec             protoreflect.FieldDescriptor
-	fd_TraceConfig_disable_stack      protoreflect.FieldDescriptor
-	fd_TraceConfig_disable_storage    protoreflect.FieldDescriptor
-	fd_TraceConfig_debug              protoreflect.FieldDescriptor
-	fd_TraceConfig_limit              protoreflect.FieldDescriptor
-	fd_TraceConfig_overrides          protoreflect.FieldDescriptor
-	fd_TraceConfig_enable_memory      protoreflect.FieldDescriptor
-	fd_TraceConfig_enable_return_data protoreflect.FieldDescriptor
-	fd_TraceConfig_tracer_json_config protoreflect.FieldDescriptor
+	md_Preinstall         protoreflect.MessageDescriptor
+	fd_Preinstall_name    protoreflect.FieldDescriptor
+	fd_Preinstall_address protoreflect.FieldDescriptor
+	fd_Preinstall_code    protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_evm_proto_init()
-	md_TraceConfig = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("TraceConfig")
-	fd_TraceConfig_tracer = md_TraceConfig.Fields().ByName("tracer")
-	fd_TraceConfig_timeout = md_TraceConfig.Fields().ByName("timeout")
-	fd_TraceConfig_reexec = md_TraceConfig.Fields().ByName("reexec")
-	fd_TraceConfig_disable_stack = md_TraceConfig.Fields().ByName("disable_stack")
-	fd_TraceConfig_disable_storage = md_TraceConfig.Fields().ByName("disable_storage")
-	fd_TraceConfig_debug = md_TraceConfig.Fields().ByName("debug")
-	fd_TraceConfig_limit = md_TraceConfig.Fields().ByName("limit")
-	fd_TraceConfig_overrides = md_TraceConfig.Fields().ByName("overrides")
-	fd_TraceConfig_enable_memory = md_TraceConfig.Fields().ByName("enable_memory")
-	fd_TraceConfig_enable_return_data = md_TraceConfig.Fields().ByName("enable_return_data")
-	fd_TraceConfig_tracer_json_config = md_TraceConfig.Fields().ByName("tracer_json_config")
+	md_Preinstall = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("Preinstall")
+	fd_Preinstall_name = md_Preinstall.Fields().ByName("name")
+	fd_Preinstall_address = md_Preinstall.Fields().ByName("address")
+	fd_Preinstall_code = md_Preinstall.Fields().ByName("code")
 }
 
-var _ protoreflect.Message = (*fastReflection_TraceConfig)(nil)
+var _ protoreflect.Message = (*fastReflection_Preinstall)(nil)
 
-type fastReflection_TraceConfig TraceConfig
+type fastReflection_Preinstall Preinstall
 
-func (x *TraceConfig) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_TraceConfig)(x)
+func (x *Preinstall) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Preinstall)(x)
 }
 
-func (x *TraceConfig) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[9]
+func (x *Preinstall) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7163,43 +8861,43 @@ func (x *TraceConfig) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_TraceConfig_messageType fastReflection_TraceConfig_messageType
-var _ protoreflect.MessageType = fastReflection_TraceConfig_messageType{}
+var _fastReflection_Preinstall_messageType fastReflection_Preinstall_messageType
+var _ protoreflect.MessageType = fastReflection_Preinstall_messageType{}
 
-type fastReflection_TraceConfig_messageType struct{}
+type fastReflection_Preinstall_messageType struct{}
 
-func (x fastReflection_TraceConfig_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_TraceConfig)(nil)
+func (x fastReflection_Preinstall_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Preinstall)(nil)
 }
-func (x fastReflection_TraceConfig_messageType) New() protoreflect.Message {
-	return new(fastReflection_TraceConfig)
+func (x fastReflection_Preinstall_messageType) New() protoreflect.Message {
+	return new(fastReflection_Preinstall)
 }
-func (x fastReflection_TraceConfig_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_TraceConfig
+func (x fastReflection_Preinstall_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Preinstall
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_TraceConfig) Descriptor() protoreflect.MessageDescriptor {
-	return md_TraceConfig
+func (x *fastReflection_Preinstall) Descriptor() protoreflect.MessageDescriptor {
+	return md_Preinstall
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_TraceConfig) Type() protoreflect.MessageType {
-	return _fastReflection_TraceConfig_messageType
+func (x *fastReflection_Preinstall) Type() protoreflect.MessageType {
+	return _fastReflection_Preinstall_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_TraceConfig) New() protoreflect.Message {
-	return new(fastReflection_TraceConfig)
+func (x *fastReflection_Preinstall) New() protoreflect.Message {
+	return new(fastReflection_Preinstall)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_TraceConfig) Interface() protoreflect.ProtoMessage {
-	return (*TraceConfig)(x)
+func (x *fastReflection_Preinstall) Interface() protoreflect.ProtoMessage {
+	return (*Preinstall)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7207,70 +8905,22 @@ func (x *fastReflection_TraceConfig) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_TraceConfig) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Tracer != "" {
-		value := protoreflect.ValueOfString(x.Tracer)
-		if !f(fd_TraceConfig_tracer, value) {
-			return
-		}
-	}
-	if x.Timeout != "" {
-		value := protoreflect.ValueOfString(x.Timeout)
-		if !f(fd_TraceConfig_timeout, value) {
-			return
-		}
-	}
-	if x.Reexec != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Reexec)
-		if !f(fd_TraceConfig_reexec, value) {
-			return
-		}
-	}
-	if x.DisableStack != false {
-		value := protoreflect.ValueOfBool(x.DisableStack)
-		if !f(fd_TraceConfig_disable_stack, value) {
-			return
-		}
-	}
-	if x.DisableStorage != false {
-		value := protoreflect.ValueOfBool(x.DisableStorage)
-		if !f(fd_TraceConfig_disable_storage, value) {
-			return
-		}
-	}
-	if x.Debug != false {
-		value := protoreflect.ValueOfBool(x.Debug)
-		if !f(fd_TraceConfig_debug, value) {
-			return
-		}
-	}
-	if x.Limit != int32(0) {
-		value := protoreflect.ValueOfInt32(x.Limit)
-		if !f(fd_TraceConfig_limit, value) {
-			return
-		}
-	}
-	if x.Overrides != nil {
-		value := protoreflect.ValueOfMessage(x.Overrides.ProtoReflect())
-		if !f(fd_TraceConfig_overrides, value) {
-			return
-		}
-	}
-	if x.EnableMemory != false {
-		value := protoreflect.ValueOfBool(x.EnableMemory)
-		if !f(fd_TraceConfig_enable_memory, value) {
+func (x *fastReflection_Preinstall) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_Preinstall_name, value) {
 			return
 		}
 	}
-	if x.EnableReturnData != false {
-		value := protoreflect.ValueOfBool(x.EnableReturnData)
-		if !f(fd_TraceConfig_enable_return_data, value) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_Preinstall_address, value) {
 			return
 		}
 	}
-	if x.TracerJsonConfig != "" {
-		value := protoreflect.ValueOfString(x.TracerJsonConfig)
-		if !f(fd_TraceConfig_tracer_json_config, value) {
+	if x.Code != "" {
+		value := protoreflect.ValueOfString(x.Code)
+		if !f(fd_Preinstall_code, value) {
 			return
 		}
 	}
@@ -7287,35 +8937,19 @@ func (x *fastReflection_TraceConfig) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_TraceConfig) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_Preinstall) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.TraceConfig.tracer":
-		return x.Tracer != ""
-	case "cosmos.evm.vm.v1.TraceConfig.timeout":
-		return x.Timeout != ""
-	case "cosmos.evm.vm.v1.TraceConfig.reexec":
-		return x.Reexec != uint64(0)
-	case "cosmos.evm.vm.v1.TraceConfig.disable_stack":
-		return x.DisableStack != false
-	case "cosmos.evm.vm.v1.TraceConfig.disable_storage":
-		return x.DisableStorage != false
-	case "cosmos.evm.vm.v1.TraceConfig.debug":
-		return x.Debug != false
-	case "cosmos.evm.vm.v1.TraceConfig.limit":
-		return x.Limit != int32(0)
-	case "cosmos.evm.vm.v1.TraceConfig.overrides":
-		return x.Overrides != nil
-	case "cosmos.evm.vm.v1.TraceConfig.enable_memory":
-		return x.EnableMemory != false
-	case "cosmos.evm.vm.v1.TraceConfig.enable_return_data":
-		return x.EnableReturnData != false
-	case "cosmos.evm.vm.v1.TraceConfig.tracer_json_config":
-		return x.TracerJsonConfig != ""
+	case "cosmos.evm.vm.v1.Preinstall.name":
+		return x.Name != ""
+	case "cosmos.evm.vm.v1.Preinstall.address":
+		return x.Address != ""
+	case "cosmos.evm.vm.v1.Preinstall.code":
+		return x.Code != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TraceConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Preinstall"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.TraceConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.Preinstall does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7325,35 +8959,19 @@ func (x *fastReflection_TraceConfig) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TraceConfig) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_Preinstall) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.TraceConfig.tracer":
-		x.Tracer = ""
-	case "cosmos.evm.vm.v1.TraceConfig.timeout":
-		x.Timeout = ""
-	case "cosmos.evm.vm.v1.TraceConfig.reexec":
-		x.Reexec = uint64(0)
-	case "cosmos.evm.vm.v1.TraceConfig.disable_stack":
-		x.DisableStack = false
-	case "cosmos.evm.vm.v1.TraceConfig.disable_storage":
-		x.DisableStorage = false
-	case "cosmos.evm.vm.v1.TraceConfig.debug":
-		x.Debug = false
-	case "cosmos.evm.vm.v1.TraceConfig.limit":
-		x.Limit = int32(0)
-	case "cosmos.evm.vm.v1.TraceConfig.overrides":
-		x.Overrides = nil
-	case "cosmos.evm.vm.v1.TraceConfig.enable_memory":
-		x.EnableMemory = false
-	case "cosmos.evm.vm.v1.TraceConfig.enable_return_data":
-		x.EnableReturnData = false
-	case "cosmos.evm.vm.v1.TraceConfig.tracer_json_config":
-		x.TracerJsonConfig = ""
+	case "cosmos.evm.vm.v1.Preinstall.name":
+		x.Name = ""
+	case "cosmos.evm.vm.v1.Preinstall.address":
+		x.Address = ""
+	case "cosmos.evm.vm.v1.Preinstall.code":
+		x.Code = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TraceConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Preinstall"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.TraceConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.Preinstall does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7363,46 +8981,22 @@ func (x *fastReflection_TraceConfig) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_TraceConfig) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Preinstall) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.TraceConfig.tracer":
-		value := x.Tracer
+	case "cosmos.evm.vm.v1.Preinstall.name":
+		value := x.Name
 		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.TraceConfig.timeout":
-		value := x.Timeout
+	case "cosmos.evm.vm.v1.Preinstall.address":
+		value := x.Address
 		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.TraceConfig.reexec":
-		value := x.Reexec
-		return protoreflect.ValueOfUint64(value)
-	case "cosmos.evm.vm.v1.TraceConfig.disable_stack":
-		value := x.DisableStack
-		return protoreflect.ValueOfBool(value)
-	case "cosmos.evm.vm.v1.TraceConfig.disable_storage":
-		value := x.DisableStorage
-		return protoreflect.ValueOfBool(value)
-	case "cosmos.evm.vm.v1.TraceConfig.debug":
-		value := x.Debug
-		return protoreflect.ValueOfBool(value)
-	case "cosmos.evm.vm.v1.TraceConfig.limit":
-		value := x.Limit
-		return protoreflect.ValueOfInt32(value)
-	case "cosmos.evm.vm.v1.TraceConfig.overrides":
-		value := x.Overrides
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.evm.vm.v1.TraceConfig.enable_memory":
-		value := x.EnableMemory
-		return protoreflect.ValueOfBool(value)
-	case "cosmos.evm.vm.v1.TraceConfig.enable_return_data":
-		value := x.EnableReturnData
-		return protoreflect.ValueOfBool(value)
-	case "cosmos.evm.vm.v1.TraceConfig.tracer_json_config":
-		value := x.TracerJsonConfig
+	case "cosmos.evm.vm.v1.Preinstall.code":
+		value := x.Code
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TraceConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Preinstall"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.TraceConfig does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.Preinstall does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7416,35 +9010,19 @@ func (x *fastReflection_TraceConfig) Get(descriptor protoreflect.FieldDescriptor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TraceConfig) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_Preinstall) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.TraceConfig.tracer":
-		x.Tracer = value.Interface().(string)
-	case "cosmos.evm.vm.v1.TraceConfig.timeout":
-		x.Timeout = value.Interface().(string)
-	case "cosmos.evm.vm.v1.TraceConfig.reexec":
-		x.Reexec = value.Uint()
-	case "cosmos.evm.vm.v1.TraceConfig.disable_stack":
-		x.DisableStack = value.Bool()
-	case "cosmos.evm.vm.v1.TraceConfig.disable_storage":
-		x.DisableStorage = value.Bool()
-	case "cosmos.evm.vm.v1.TraceConfig.debug":
-		x.Debug = value.Bool()
-	case "cosmos.evm.vm.v1.TraceConfig.limit":
-		x.Limit = int32(value.Int())
-	case "cosmos.evm.vm.v1.TraceConfig.overrides":
-		x.Overrides = value.Message().Interface().(*ChainConfig)
-	case "cosmos.evm.vm.v1.TraceConfig.enable_memory":
-		x.EnableMemory = value.Bool()
-	case "cosmos.evm.vm.v1.TraceConfig.enable_return_data":
-		x.EnableReturnData = value.Bool()
-	case "cosmos.evm.vm.v1.TraceConfig.tracer_json_config":
-		x.TracerJsonConfig = value.Interface().(string)
+	case "cosmos.evm.vm.v1.Preinstall.name":
+		x.Name = value.Interface().(string)
+	case "cosmos.evm.vm.v1.Preinstall.address":
+		x.Address = value.Interface().(string)
+	case "cosmos.evm.vm.v1.Preinstall.code":
+		x.Code = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TraceConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Preinstall"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.TraceConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.Preinstall does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7458,84 +9036,48 @@ func (x *fastReflection_TraceConfig) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TraceConfig) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Preinstall) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.TraceConfig.overrides":
-		if x.Overrides == nil {
-			x.Overrides = new(ChainConfig)
-		}
-		return protoreflect.ValueOfMessage(x.Overrides.ProtoReflect())
-	case "cosmos.evm.vm.v1.TraceConfig.tracer":
-		panic(fmt.Errorf("field tracer of message cosmos.evm.vm.v1.TraceConfig is not mutable"))
-	case "cosmos.evm.vm.v1.TraceConfig.timeout":
-		panic(fmt.Errorf("field timeout of message cosmos.evm.vm.v1.TraceConfig is not mutable"))
-	case "cosmos.evm.vm.v1.TraceConfig.reexec":
-		panic(fmt.Errorf("field reexec of message cosmos.evm.vm.v1.TraceConfig is not mutable"))
-	case "cosmos.evm.vm.v1.TraceConfig.disable_stack":
-		panic(fmt.Errorf("field disable_stack of message cosmos.evm.vm.v1.TraceConfig is not mutable"))
-	case "cosmos.evm.vm.v1.TraceConfig.disable_storage":
-		panic(fmt.Errorf("field disable_storage of message cosmos.evm.vm.v1.TraceConfig is not mutable"))
-	case "cosmos.evm.vm.v1.TraceConfig.debug":
-		panic(fmt.Errorf("field debug of message cosmos.evm.vm.v1.TraceConfig is not mutable"))
-	case "cosmos.evm.vm.v1.TraceConfig.limit":
-		panic(fmt.Errorf("field limit of message cosmos.evm.vm.v1.TraceConfig is not mutable"))
-	case "cosmos.evm.vm.v1.TraceConfig.enable_memory":
-		panic(fmt.Errorf("field enable_memory of message cosmos.evm.vm.v1.TraceConfig is not mutable"))
-	case "cosmos.evm.vm.v1.TraceConfig.enable_return_data":
-		panic(fmt.Errorf("field enable_return_data of message cosmos.evm.vm.v1.TraceConfig is not mutable"))
-	case "cosmos.evm.vm.v1.TraceConfig.tracer_json_config":
-		panic(fmt.Errorf("field tracer_json_config of message cosmos.evm.vm.v1.TraceConfig is not mutable"))
+	case "cosmos.evm.vm.v1.Preinstall.name":
+		panic(fmt.Errorf("field name of message cosmos.evm.vm.v1.Preinstall is not mutable"))
+	case "cosmos.evm.vm.v1.Preinstall.address":
+		panic(fmt.Errorf("field address of message cosmos.evm.vm.v1.Preinstall is not mutable"))
+	case "cosmos.evm.vm.v1.Preinstall.code":
+		panic(fmt.Errorf("field code of message cosmos.evm.vm.v1.Preinstall is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TraceConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Preinstall"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.TraceConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.Preinstall does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_TraceConfig) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Preinstall) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.TraceConfig.tracer":
+	case "cosmos.evm.vm.v1.Preinstall.name":
 		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.TraceConfig.timeout":
+	case "cosmos.evm.vm.v1.Preinstall.address":
 		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.TraceConfig.reexec":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.evm.vm.v1.TraceConfig.disable_stack":
-		return protoreflect.ValueOfBool(false)
-	case "cosmos.evm.vm.v1.TraceConfig.disable_storage":
-		return protoreflect.ValueOfBool(false)
-	case "cosmos.evm.vm.v1.TraceConfig.debug":
-		return protoreflect.ValueOfBool(false)
-	case "cosmos.evm.vm.v1.TraceConfig.limit":
-		return protoreflect.ValueOfInt32(int32(0))
-	case "cosmos.evm.vm.v1.TraceConfig.overrides":
-		m := new(ChainConfig)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.evm.vm.v1.TraceConfig.enable_memory":
-		return protoreflect.ValueOfBool(false)
-	case "cosmos.evm.vm.v1.TraceConfig.enable_return_data":
-		return protoreflect.ValueOfBool(false)
-	case "cosmos.evm.vm.v1.TraceConfig.tracer_json_config":
+	case "cosmos.evm.vm.v1.Preinstall.code":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TraceConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Preinstall"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.TraceConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.Preinstall does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_TraceConfig) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_Preinstall) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.TraceConfig", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.Preinstall", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7543,7 +9085,7 @@ func (x *fastReflection_TraceConfig) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_TraceConfig) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_Preinstall) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7554,7 +9096,7 @@ func (x *fastReflection_TraceConfig) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TraceConfig) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_Preinstall) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7566,7 +9108,7 @@ func (x *fastReflection_TraceConfig) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_TraceConfig) IsValid() bool {
+func (x *fastReflection_Preinstall) IsValid() bool {
 	return x != nil
 }
 
@@ -7576,9 +9118,9 @@ func (x *fastReflection_TraceConfig) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_TraceConfig) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_Preinstall) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*TraceConfig)
+		x := input.Message.Interface().(*Preinstall)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7587,167 +9129,68 @@ func (x *fastReflection_TraceConfig) ProtoMethods() *protoiface.Methods {
 		}
 		options := runtime.SizeInputToOptions(input)
 		_ = options
-		var n int
-		var l int
-		_ = l
-		l = len(x.Tracer)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Timeout)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.Reexec != 0 {
-			n += 1 + runtime.Sov(uint64(x.Reexec))
-		}
-		if x.DisableStack {
-			n += 2
-		}
-		if x.DisableStorage {
-			n += 2
-		}
-		if x.Debug {
-			n += 2
-		}
-		if x.Limit != 0 {
-			n += 1 + runtime.Sov(uint64(x.Limit))
-		}
-		if x.Overrides != nil {
-			l = options.Size(x.Overrides)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.EnableMemory {
-			n += 2
-		}
-		if x.EnableReturnData {
-			n += 2
-		}
-		l = len(x.TracerJsonConfig)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
-		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
-		}
-	}
-
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*TraceConfig)
-		if x == nil {
-			return protoiface.MarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Buf:               input.Buf,
-			}, nil
-		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
-		}
-		if len(x.TracerJsonConfig) > 0 {
-			i -= len(x.TracerJsonConfig)
-			copy(dAtA[i:], x.TracerJsonConfig)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TracerJsonConfig)))
-			i--
-			dAtA[i] = 0x6a
-		}
-		if x.EnableReturnData {
-			i--
-			if x.EnableReturnData {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x60
-		}
-		if x.EnableMemory {
-			i--
-			if x.EnableMemory {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x58
-		}
-		if x.Overrides != nil {
-			encoded, err := options.Marshal(x.Overrides)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x52
+		var n int
+		var l int
+		_ = l
+		l = len(x.Name)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Limit != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Limit))
-			i--
-			dAtA[i] = 0x48
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Debug {
-			i--
-			if x.Debug {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x40
+		l = len(x.Code)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.DisableStorage {
-			i--
-			if x.DisableStorage {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x30
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
 		}
-		if x.DisableStack {
-			i--
-			if x.DisableStack {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x28
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
 		}
-		if x.Reexec != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Reexec))
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Preinstall)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Code) > 0 {
+			i -= len(x.Code)
+			copy(dAtA[i:], x.Code)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Code)))
 			i--
-			dAtA[i] = 0x18
+			dAtA[i] = 0x1a
 		}
-		if len(x.Timeout) > 0 {
-			i -= len(x.Timeout)
-			copy(dAtA[i:], x.Timeout)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Timeout)))
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Tracer) > 0 {
-			i -= len(x.Tracer)
-			copy(dAtA[i:], x.Tracer)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Tracer)))
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -7762,211 +9205,49 @@ func (x *fastReflection_TraceConfig) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*TraceConfig)
+		x := input.Message.Interface().(*Preinstall)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
 				Flags:             input.Flags,
 			}, nil
 		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-				}
-				if iNdEx >= l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TraceConfig: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TraceConfig: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tracer", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Tracer = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Timeout = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reexec", wireType)
-				}
-				x.Reexec = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Reexec |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 5:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DisableStack", wireType)
-				}
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				x.DisableStack = bool(v != 0)
-			case 6:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DisableStorage", wireType)
-				}
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				x.DisableStorage = bool(v != 0)
-			case 8:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Debug", wireType)
-				}
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				x.Debug = bool(v != 0)
-			case 9:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Limit = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Limit |= int32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			case 10:
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Preinstall: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Preinstall: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7976,33 +9257,29 @@ func (x *fastReflection_TraceConfig) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Overrides == nil {
-					x.Overrides = &ChainConfig{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Overrides); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Name = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 11:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EnableMemory", wireType)
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 				}
-				var v int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8012,35 +9289,27 @@ func (x *fastReflection_TraceConfig) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				x.EnableMemory = bool(v != 0)
-			case 12:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EnableReturnData", wireType)
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				x.EnableReturnData = bool(v != 0)
-			case 13:
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TracerJsonConfig", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -8068,7 +9337,7 @@ func (x *fastReflection_TraceConfig) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.TracerJsonConfig = string(dAtA[iNdEx:postIndex])
+				x.Code = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -8194,6 +9463,10 @@ type Params struct {
 	// active_static_precompiles defines the slice of hex addresses of the
 	// precompiled contracts that are active
 	ActiveStaticPrecompiles []string `protobuf:"bytes,10,rep,name=active_static_precompiles,json=activeStaticPrecompiles,proto3" json:"active_static_precompiles,omitempty"`
+	// emergency_authority defines the bech32 address of the account that can
+	// freeze and unfreeze contracts without a governance proposal. It is
+	// disabled if empty.
+	EmergencyAuthority string `protobuf:"bytes,11,opt,name=emergency_authority,json=emergencyAuthority,proto3" json:"emergency_authority,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -8265,6 +9538,13 @@ func (x *Params) GetActiveStaticPrecompiles() []string {
 	return nil
 }
 
+func (x *Params) GetEmergencyAuthority() string {
+	if x != nil {
+		return x.EmergencyAuthority
+	}
+	return ""
+}
+
 // AccessControl defines the permission policy of the EVM
 // for creating and calling contracts
 type AccessControl struct {
@@ -8364,6 +9644,76 @@ func (x *AccessControlType) GetAccessControlList() []string {
 	return nil
 }
 
+// AccessControlEntry defines a call permission rule for a contract, or for one
+// of its functions. It applies on top of the call policy of the AccessControl
+// parameters.
+type AccessControlEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// contract defines the hex address of the contract the rule applies to
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// selector defines the hex 4-byte function selector the rule applies to. The
+	// rule applies to all the calls to the contract if empty.
+	Selector string `protobuf:"bytes,2,opt,name=selector,proto3" json:"selector,omitempty"`
+	// access_type defines which type of permission is required to perform the
+	// call
+	AccessType AccessType `protobuf:"varint,3,opt,name=access_type,json=accessType,proto3,enum=cosmos.evm.vm.v1.AccessType" json:"access_type,omitempty"`
+	// access_control_list defines the list of addresses that are blocked from
+	// (ACCESS_TYPE_PERMISSIONLESS) or allowed to (ACCESS_TYPE_PERMISSIONED)
+	// perform the call
+	AccessControlList []string `protobuf:"bytes,4,rep,name=access_control_list,json=accessControlList,proto3" json:"access_control_list,omitempty"`
+}
+
+func (x *AccessControlEntry) Reset() {
+	*x = AccessControlEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccessControlEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessControlEntry) ProtoMessage() {}
+
+// Deprecated: Use AccessControlEntry.ProtoReflect.Descriptor instead.
+func (*AccessControlEntry) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AccessControlEntry) GetContract() string {
+	if x != nil {
+		return x.Contract
+	}
+	return ""
+}
+
+func (x *AccessControlEntry) GetSelector() string {
+	if x != nil {
+		return x.Selector
+	}
+	return ""
+}
+
+func (x *AccessControlEntry) GetAccessType() AccessType {
+	if x != nil {
+		return x.AccessType
+	}
+	return AccessType_ACCESS_TYPE_PERMISSIONLESS
+}
+
+func (x *AccessControlEntry) GetAccessControlList() []string {
+	if x != nil {
+		return x.AccessControlList
+	}
+	return nil
+}
+
 // ChainConfig defines the Ethereum ChainConfig parameters using *sdk.Int values
 // instead of *big.Int.
 type ChainConfig struct {
@@ -8430,7 +9780,7 @@ type ChainConfig struct {
 func (x *ChainConfig) Reset() {
 	*x = ChainConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[3]
+		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8444,7 +9794,7 @@ func (*ChainConfig) ProtoMessage() {}
 
 // Deprecated: Use ChainConfig.ProtoReflect.Descriptor instead.
 func (*ChainConfig) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{3}
+	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ChainConfig) GetHomesteadBlock() string {
@@ -8616,7 +9966,7 @@ type State struct {
 func (x *State) Reset() {
 	*x = State{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[4]
+		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8630,7 +9980,7 @@ func (*State) ProtoMessage() {}
 
 // Deprecated: Use State.ProtoReflect.Descriptor instead.
 func (*State) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{4}
+	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *State) GetKey() string {
@@ -8664,7 +10014,7 @@ type TransactionLogs struct {
 func (x *TransactionLogs) Reset() {
 	*x = TransactionLogs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[5]
+		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8678,7 +10028,7 @@ func (*TransactionLogs) ProtoMessage() {}
 
 // Deprecated: Use TransactionLogs.ProtoReflect.Descriptor instead.
 func (*TransactionLogs) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{5}
+	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *TransactionLogs) GetHash() string {
@@ -8731,7 +10081,7 @@ type Log struct {
 func (x *Log) Reset() {
 	*x = Log{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[6]
+		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8745,7 +10095,7 @@ func (*Log) ProtoMessage() {}
 
 // Deprecated: Use Log.ProtoReflect.Descriptor instead.
 func (*Log) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{6}
+	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Log) GetAddress() string {
@@ -8837,7 +10187,7 @@ type TxResult struct {
 func (x *TxResult) Reset() {
 	*x = TxResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[7]
+		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8851,7 +10201,7 @@ func (*TxResult) ProtoMessage() {}
 
 // Deprecated: Use TxResult.ProtoReflect.Descriptor instead.
 func (*TxResult) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{7}
+	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *TxResult) GetContractAddress() string {
@@ -8911,7 +10261,7 @@ type AccessTuple struct {
 func (x *AccessTuple) Reset() {
 	*x = AccessTuple{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[8]
+		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8925,7 +10275,7 @@ func (*AccessTuple) ProtoMessage() {}
 
 // Deprecated: Use AccessTuple.ProtoReflect.Descriptor instead.
 func (*AccessTuple) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{8}
+	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *AccessTuple) GetAddress() string {
@@ -8976,7 +10326,7 @@ type TraceConfig struct {
 func (x *TraceConfig) Reset() {
 	*x = TraceConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[9]
+		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8990,7 +10340,7 @@ func (*TraceConfig) ProtoMessage() {}
 
 // Deprecated: Use TraceConfig.ProtoReflect.Descriptor instead.
 func (*TraceConfig) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{9}
+	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *TraceConfig) GetTracer() string {
@@ -9070,6 +10420,63 @@ func (x *TraceConfig) GetTracerJsonConfig() string {
 	return ""
 }
 
+// Preinstall defines a contract that is deployed at a fixed address, either at
+// genesis or through governance, without going through a deployment
+// transaction.
+type Preinstall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name of the preinstall contract
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// address in hex format of the preinstall contract
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// code in hex format for the preinstall contract
+	Code string `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *Preinstall) Reset() {
+	*x = Preinstall{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Preinstall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Preinstall) ProtoMessage() {}
+
+// Deprecated: Use Preinstall.ProtoReflect.Descriptor instead.
+func (*Preinstall) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Preinstall) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Preinstall) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Preinstall) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
 var File_cosmos_evm_vm_v1_evm_proto protoreflect.FileDescriptor
 
 var file_cosmos_evm_vm_v1_evm_proto_rawDesc = []byte{
@@ -9077,300 +10484,328 @@ var file_cosmos_evm_vm_v1_evm_proto_rawDesc = []byte{
 	0x76, 0x31, 0x2f, 0x65, 0x76, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x10, 0x63, 0x6f,
 	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x1a, 0x11,
 	0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67,
-	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x94, 0x04, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x12, 0x31, 0x0a, 0x09, 0x65, 0x76, 0x6d, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0xf2, 0xde, 0x1f, 0x10, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
-	0x22, 0x65, 0x76, 0x6d, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x52, 0x08, 0x65, 0x76, 0x6d,
-	0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x41, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x65,
-	0x69, 0x70, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x03, 0x42, 0x22, 0xe2, 0xde, 0x1f, 0x09, 0x45,
-	0x78, 0x74, 0x72, 0x61, 0x45, 0x49, 0x50, 0x73, 0xf2, 0xde, 0x1f, 0x11, 0x79, 0x61, 0x6d, 0x6c,
-	0x3a, 0x22, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x65, 0x69, 0x70, 0x73, 0x22, 0x52, 0x09, 0x65,
-	0x78, 0x74, 0x72, 0x61, 0x45, 0x69, 0x70, 0x73, 0x12, 0x62, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
-	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76,
-	0x31, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x20, 0xc8,
-	0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
-	0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x32, 0x0a, 0x15,
-	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x75, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65,
-	0x64, 0x5f, 0x74, 0x78, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x61, 0x6c, 0x6c,
-	0x6f, 0x77, 0x55, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x78, 0x73,
-	0x12, 0x32, 0x0a, 0x0c, 0x65, 0x76, 0x6d, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73,
-	0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0f, 0xe2, 0xde, 0x1f, 0x0b, 0x45, 0x56, 0x4d, 0x43,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x0b, 0x65, 0x76, 0x6d, 0x43, 0x68, 0x61, 0x6e,
-	0x6e, 0x65, 0x6c, 0x73, 0x12, 0x5d, 0x0a, 0x0e, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x63,
-	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63,
+	0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f,
+	0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0xdf, 0x04, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x31, 0x0a,
+	0x09, 0x65, 0x76, 0x6d, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x14, 0xf2, 0xde, 0x1f, 0x10, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x65, 0x76, 0x6d, 0x5f,
+	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x52, 0x08, 0x65, 0x76, 0x6d, 0x44, 0x65, 0x6e, 0x6f, 0x6d,
+	0x12, 0x41, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x65, 0x69, 0x70, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x03, 0x42, 0x22, 0xe2, 0xde, 0x1f, 0x09, 0x45, 0x78, 0x74, 0x72, 0x61, 0x45,
+	0x49, 0x50, 0x73, 0xf2, 0xde, 0x1f, 0x11, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x65, 0x78, 0x74,
+	0x72, 0x61, 0x5f, 0x65, 0x69, 0x70, 0x73, 0x22, 0x52, 0x09, 0x65, 0x78, 0x74, 0x72, 0x61, 0x45,
+	0x69, 0x70, 0x73, 0x12, 0x62, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61,
+	0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x20, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde,
+	0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x22, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x32, 0x0a, 0x15, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x5f, 0x75, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x6e, 0x70,
+	0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x78, 0x73, 0x12, 0x32, 0x0a, 0x0c, 0x65,
+	0x76, 0x6d, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x09, 0x42, 0x0f, 0xe2, 0xde, 0x1f, 0x0b, 0x45, 0x56, 0x4d, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x73, 0x52, 0x0b, 0x65, 0x76, 0x6d, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12,
+	0x5d, 0x0a, 0x0e, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x42, 0x15, 0xc8, 0xde, 0x1f, 0x00, 0xe2, 0xde,
+	0x1f, 0x0d, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52,
+	0x0d, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x3a,
+	0x0a, 0x19, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x5f,
+	0x70, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x17, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x50,
+	0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x49, 0x0a, 0x13, 0x65, 0x6d,
+	0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x12, 0x65, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x3a, 0x1b, 0x8a, 0xe7, 0xb0, 0x2a, 0x16, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x78, 0x2f, 0x76, 0x6d, 0x2f, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x4a, 0x04,
+	0x08, 0x07, 0x10, 0x08, 0x22, 0x91, 0x01, 0x0a, 0x0d, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x41, 0x0a, 0x06, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x06, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x3d, 0x0a, 0x04, 0x63, 0x61, 0x6c,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x04, 0x63, 0x61, 0x6c, 0x6c, 0x22, 0xdd, 0x01, 0x0a, 0x11, 0x41, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x63,
+	0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d,
+	0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70,
+	0x65, 0x42, 0x24, 0xe2, 0xde, 0x1f, 0x0a, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70,
+	0x65, 0xf2, 0xde, 0x1f, 0x12, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x63, 0x0a, 0x13, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x42, 0x33, 0xe2, 0xde, 0x1f, 0x11, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0xf2, 0xde, 0x1f, 0x1a, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
+	0x22, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f,
+	0x6c, 0x69, 0x73, 0x74, 0x22, 0x52, 0x11, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x96, 0x02, 0x0a, 0x12, 0x41, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x63, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e,
-	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x42, 0x15, 0xc8,
-	0xde, 0x1f, 0x00, 0xe2, 0xde, 0x1f, 0x0d, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e,
-	0x74, 0x72, 0x6f, 0x6c, 0x52, 0x0d, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74,
-	0x72, 0x6f, 0x6c, 0x12, 0x3a, 0x0a, 0x19, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x73, 0x74,
-	0x61, 0x74, 0x69, 0x63, 0x5f, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x73,
-	0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x17, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x53, 0x74,
-	0x61, 0x74, 0x69, 0x63, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x73, 0x3a,
-	0x1b, 0x8a, 0xe7, 0xb0, 0x2a, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d,
-	0x2f, 0x78, 0x2f, 0x76, 0x6d, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x4a, 0x04, 0x08, 0x02,
-	0x10, 0x03, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x4a, 0x04, 0x08, 0x07, 0x10, 0x08, 0x22, 0x91,
-	0x01, 0x0a, 0x0d, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
-	0x12, 0x41, 0x0a, 0x06, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x23, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d,
-	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
-	0x6c, 0x54, 0x79, 0x70, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x12, 0x3d, 0x0a, 0x04, 0x63, 0x61, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76,
-	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72,
-	0x6f, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x04, 0x63, 0x61,
-	0x6c, 0x6c, 0x22, 0xdd, 0x01, 0x0a, 0x11, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e,
-	0x74, 0x72, 0x6f, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x63, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31,
-	0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x42, 0x24, 0xe2, 0xde, 0x1f,
-	0x0a, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0xf2, 0xde, 0x1f, 0x12, 0x79,
-	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65,
-	0x22, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x63, 0x0a,
-	0x13, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f,
-	0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x33, 0xe2, 0xde, 0x1f, 0x11,
-	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x4c, 0x69, 0x73,
-	0x74, 0xf2, 0xde, 0x1f, 0x1a, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x52,
-	0x11, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x4c, 0x69,
-	0x73, 0x74, 0x22, 0xca, 0x0f, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x12, 0x5c, 0x0a, 0x0f, 0x68, 0x6f, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x61, 0x64, 0x5f,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xda, 0xde, 0x1f,
-	0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
-	0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x16, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
-	0x68, 0x6f, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x61, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22,
-	0x52, 0x0e, 0x68, 0x6f, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x12, 0x68, 0x0a, 0x0e, 0x64, 0x61, 0x6f, 0x5f, 0x66, 0x6f, 0x72, 0x6b, 0x5f, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x42, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f,
+	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x42, 0x24, 0xe2, 0xde, 0x1f, 0x0a,
+	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0xf2, 0xde, 0x1f, 0x12, 0x79, 0x61,
+	0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22,
+	0x52, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x63, 0x0a, 0x13,
+	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x6c,
+	0x69, 0x73, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x42, 0x33, 0xe2, 0xde, 0x1f, 0x11, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x4c, 0x69, 0x73, 0x74,
+	0xf2, 0xde, 0x1f, 0x1a, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x52, 0x11,
+	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x4c, 0x69, 0x73,
+	0x74, 0x22, 0xca, 0x0f, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x5c, 0x0a, 0x0f, 0x68, 0x6f, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x61, 0x64, 0x5f, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xda, 0xde, 0x1f, 0x15,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x16, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x68,
+	0x6f, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x61, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52,
+	0x0e, 0x68, 0x6f, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
+	0x68, 0x0a, 0x0e, 0x64, 0x61, 0x6f, 0x5f, 0x66, 0x6f, 0x72, 0x6b, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x42, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49,
+	0x6e, 0x74, 0xe2, 0xde, 0x1f, 0x0c, 0x44, 0x41, 0x4f, 0x46, 0x6f, 0x72, 0x6b, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0xf2, 0xde, 0x1f, 0x15, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x61, 0x6f, 0x5f,
+	0x66, 0x6f, 0x72, 0x6b, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0c, 0x64, 0x61, 0x6f,
+	0x46, 0x6f, 0x72, 0x6b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x57, 0x0a, 0x10, 0x64, 0x61, 0x6f,
+	0x5f, 0x66, 0x6f, 0x72, 0x6b, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x42, 0x2d, 0xe2, 0xde, 0x1f, 0x0e, 0x44, 0x41, 0x4f, 0x46, 0x6f, 0x72, 0x6b,
+	0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0xf2, 0xde, 0x1f, 0x17, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
+	0x22, 0x64, 0x61, 0x6f, 0x5f, 0x66, 0x6f, 0x72, 0x6b, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72,
+	0x74, 0x22, 0x52, 0x0e, 0x64, 0x61, 0x6f, 0x46, 0x6f, 0x72, 0x6b, 0x53, 0x75, 0x70, 0x70, 0x6f,
+	0x72, 0x74, 0x12, 0x62, 0x0a, 0x0c, 0x65, 0x69, 0x70, 0x31, 0x35, 0x30, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3f, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f,
 	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
-	0x49, 0x6e, 0x74, 0xe2, 0xde, 0x1f, 0x0c, 0x44, 0x41, 0x4f, 0x46, 0x6f, 0x72, 0x6b, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0xf2, 0xde, 0x1f, 0x15, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x61, 0x6f,
-	0x5f, 0x66, 0x6f, 0x72, 0x6b, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0c, 0x64, 0x61,
-	0x6f, 0x46, 0x6f, 0x72, 0x6b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x57, 0x0a, 0x10, 0x64, 0x61,
-	0x6f, 0x5f, 0x66, 0x6f, 0x72, 0x6b, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x08, 0x42, 0x2d, 0xe2, 0xde, 0x1f, 0x0e, 0x44, 0x41, 0x4f, 0x46, 0x6f, 0x72,
-	0x6b, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0xf2, 0xde, 0x1f, 0x17, 0x79, 0x61, 0x6d, 0x6c,
-	0x3a, 0x22, 0x64, 0x61, 0x6f, 0x5f, 0x66, 0x6f, 0x72, 0x6b, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f,
-	0x72, 0x74, 0x22, 0x52, 0x0e, 0x64, 0x61, 0x6f, 0x46, 0x6f, 0x72, 0x6b, 0x53, 0x75, 0x70, 0x70,
-	0x6f, 0x72, 0x74, 0x12, 0x62, 0x0a, 0x0c, 0x65, 0x69, 0x70, 0x31, 0x35, 0x30, 0x5f, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3f, 0xda, 0xde, 0x1f, 0x15, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
-	0x2e, 0x49, 0x6e, 0x74, 0xe2, 0xde, 0x1f, 0x0b, 0x45, 0x49, 0x50, 0x31, 0x35, 0x30, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x65, 0x69, 0x70,
-	0x31, 0x35, 0x30, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0b, 0x65, 0x69, 0x70, 0x31,
-	0x35, 0x30, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x49, 0x0a, 0x0b, 0x65, 0x69, 0x70, 0x31, 0x35,
-	0x30, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xe2, 0xde,
-	0x1f, 0x0a, 0x45, 0x49, 0x50, 0x31, 0x35, 0x30, 0x48, 0x61, 0x73, 0x68, 0xf2, 0xde, 0x1f, 0x16,
-	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x79, 0x7a, 0x61, 0x6e, 0x74, 0x69, 0x75, 0x6d, 0x5f,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0a, 0x65, 0x69, 0x70, 0x31, 0x35, 0x30, 0x48, 0x61,
-	0x73, 0x68, 0x12, 0x62, 0x0a, 0x0c, 0x65, 0x69, 0x70, 0x31, 0x35, 0x35, 0x5f, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3f, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
-	0x49, 0x6e, 0x74, 0xe2, 0xde, 0x1f, 0x0b, 0x45, 0x49, 0x50, 0x31, 0x35, 0x35, 0x42, 0x6c, 0x6f,
+	0x49, 0x6e, 0x74, 0xe2, 0xde, 0x1f, 0x0b, 0x45, 0x49, 0x50, 0x31, 0x35, 0x30, 0x42, 0x6c, 0x6f,
 	0x63, 0x6b, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x65, 0x69, 0x70, 0x31,
-	0x35, 0x35, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0b, 0x65, 0x69, 0x70, 0x31, 0x35,
-	0x35, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x62, 0x0a, 0x0c, 0x65, 0x69, 0x70, 0x31, 0x35, 0x38,
-	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3f, 0xda, 0xde,
-	0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
-	0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xe2, 0xde, 0x1f, 0x0b, 0x45, 0x49, 0x50, 0x31, 0x35,
-	0x38, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
-	0x65, 0x69, 0x70, 0x31, 0x35, 0x38, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0b, 0x65,
-	0x69, 0x70, 0x31, 0x35, 0x38, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x5c, 0x0a, 0x0f, 0x62, 0x79,
-	0x7a, 0x61, 0x6e, 0x74, 0x69, 0x75, 0x6d, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x33, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
-	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde,
-	0x1f, 0x16, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x79, 0x7a, 0x61, 0x6e, 0x74, 0x69, 0x75,
-	0x6d, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0e, 0x62, 0x79, 0x7a, 0x61, 0x6e, 0x74,
-	0x69, 0x75, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x6b, 0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x6f, 0x70, 0x6c, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x38, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e,
-	0x74, 0xf2, 0xde, 0x1f, 0x1b, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x6f, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x74, 0x69, 0x6e, 0x6f, 0x70, 0x6c, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22,
-	0x52, 0x13, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x6f, 0x70, 0x6c, 0x65,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x5f, 0x0a, 0x10, 0x70, 0x65, 0x74, 0x65, 0x72, 0x73, 0x62,
-	0x75, 0x72, 0x67, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x34, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
-	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x17, 0x79, 0x61,
-	0x6d, 0x6c, 0x3a, 0x22, 0x70, 0x65, 0x74, 0x65, 0x72, 0x73, 0x62, 0x75, 0x72, 0x67, 0x5f, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0f, 0x70, 0x65, 0x74, 0x65, 0x72, 0x73, 0x62, 0x75, 0x72,
-	0x67, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x59, 0x0a, 0x0e, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x62,
-	0x75, 0x6c, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x32,
+	0x35, 0x30, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0b, 0x65, 0x69, 0x70, 0x31, 0x35,
+	0x30, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x49, 0x0a, 0x0b, 0x65, 0x69, 0x70, 0x31, 0x35, 0x30,
+	0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xe2, 0xde, 0x1f,
+	0x0a, 0x45, 0x49, 0x50, 0x31, 0x35, 0x30, 0x48, 0x61, 0x73, 0x68, 0xf2, 0xde, 0x1f, 0x16, 0x79,
+	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x79, 0x7a, 0x61, 0x6e, 0x74, 0x69, 0x75, 0x6d, 0x5f, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0a, 0x65, 0x69, 0x70, 0x31, 0x35, 0x30, 0x48, 0x61, 0x73,
+	0x68, 0x12, 0x62, 0x0a, 0x0c, 0x65, 0x69, 0x70, 0x31, 0x35, 0x35, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3f, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49,
+	0x6e, 0x74, 0xe2, 0xde, 0x1f, 0x0b, 0x45, 0x49, 0x50, 0x31, 0x35, 0x35, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x65, 0x69, 0x70, 0x31, 0x35,
+	0x35, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0b, 0x65, 0x69, 0x70, 0x31, 0x35, 0x35,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x62, 0x0a, 0x0c, 0x65, 0x69, 0x70, 0x31, 0x35, 0x38, 0x5f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3f, 0xda, 0xde, 0x1f,
+	0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xe2, 0xde, 0x1f, 0x0b, 0x45, 0x49, 0x50, 0x31, 0x35, 0x38,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x65,
+	0x69, 0x70, 0x31, 0x35, 0x38, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0b, 0x65, 0x69,
+	0x70, 0x31, 0x35, 0x38, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x5c, 0x0a, 0x0f, 0x62, 0x79, 0x7a,
+	0x61, 0x6e, 0x74, 0x69, 0x75, 0x6d, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x33, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f,
+	0x16, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x79, 0x7a, 0x61, 0x6e, 0x74, 0x69, 0x75, 0x6d,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0e, 0x62, 0x79, 0x7a, 0x61, 0x6e, 0x74, 0x69,
+	0x75, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x6b, 0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x74, 0x69, 0x6e, 0x6f, 0x70, 0x6c, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x38, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74,
+	0xf2, 0xde, 0x1f, 0x1b, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x74, 0x69, 0x6e, 0x6f, 0x70, 0x6c, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52,
+	0x13, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x6f, 0x70, 0x6c, 0x65, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x5f, 0x0a, 0x10, 0x70, 0x65, 0x74, 0x65, 0x72, 0x73, 0x62, 0x75,
+	0x72, 0x67, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x34,
 	0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
-	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x15, 0x79, 0x61, 0x6d,
-	0x6c, 0x3a, 0x22, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x62, 0x75, 0x6c, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x22, 0x52, 0x0d, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x62, 0x75, 0x6c, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x12, 0x64, 0x0a, 0x12, 0x6d, 0x75, 0x69, 0x72, 0x5f, 0x67, 0x6c, 0x61, 0x63, 0x69, 0x65,
-	0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xda,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x17, 0x79, 0x61, 0x6d,
+	0x6c, 0x3a, 0x22, 0x70, 0x65, 0x74, 0x65, 0x72, 0x73, 0x62, 0x75, 0x72, 0x67, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0f, 0x70, 0x65, 0x74, 0x65, 0x72, 0x73, 0x62, 0x75, 0x72, 0x67,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x59, 0x0a, 0x0e, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x62, 0x75,
+	0x6c, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x32, 0xda,
 	0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
-	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x19, 0x79, 0x61, 0x6d, 0x6c,
-	0x3a, 0x22, 0x6d, 0x75, 0x69, 0x72, 0x5f, 0x67, 0x6c, 0x61, 0x63, 0x69, 0x65, 0x72, 0x5f, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x10, 0x6d, 0x75, 0x69, 0x72, 0x47, 0x6c, 0x61, 0x63, 0x69,
-	0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x53, 0x0a, 0x0c, 0x62, 0x65, 0x72, 0x6c, 0x69,
-	0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xda,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x15, 0x79, 0x61, 0x6d, 0x6c,
+	0x3a, 0x22, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x62, 0x75, 0x6c, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x22, 0x52, 0x0d, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x62, 0x75, 0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x12, 0x64, 0x0a, 0x12, 0x6d, 0x75, 0x69, 0x72, 0x5f, 0x67, 0x6c, 0x61, 0x63, 0x69, 0x65, 0x72,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xda, 0xde,
+	0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
+	0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x19, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
+	0x22, 0x6d, 0x75, 0x69, 0x72, 0x5f, 0x67, 0x6c, 0x61, 0x63, 0x69, 0x65, 0x72, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x22, 0x52, 0x10, 0x6d, 0x75, 0x69, 0x72, 0x47, 0x6c, 0x61, 0x63, 0x69, 0x65,
+	0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x53, 0x0a, 0x0c, 0x62, 0x65, 0x72, 0x6c, 0x69, 0x6e,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xda, 0xde,
+	0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
+	0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
+	0x22, 0x62, 0x65, 0x72, 0x6c, 0x69, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0b,
+	0x62, 0x65, 0x72, 0x6c, 0x69, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x53, 0x0a, 0x0c, 0x6c,
+	0x6f, 0x6e, 0x64, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x30, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x13,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6c, 0x6f, 0x6e, 0x64, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x22, 0x52, 0x0b, 0x6c, 0x6f, 0x6e, 0x64, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x12, 0x67, 0x0a, 0x13, 0x61, 0x72, 0x72, 0x6f, 0x77, 0x5f, 0x67, 0x6c, 0x61, 0x63, 0x69, 0x65,
+	0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xda,
 	0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
-	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c,
-	0x3a, 0x22, 0x62, 0x65, 0x72, 0x6c, 0x69, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52,
-	0x0b, 0x62, 0x65, 0x72, 0x6c, 0x69, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x53, 0x0a, 0x0c,
-	0x6c, 0x6f, 0x6e, 0x64, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x11, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x30, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
-	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f,
-	0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6c, 0x6f, 0x6e, 0x64, 0x6f, 0x6e, 0x5f, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0b, 0x6c, 0x6f, 0x6e, 0x64, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x12, 0x67, 0x0a, 0x13, 0x61, 0x72, 0x72, 0x6f, 0x77, 0x5f, 0x67, 0x6c, 0x61, 0x63, 0x69,
-	0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37,
-	0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
-	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x1a, 0x79, 0x61, 0x6d,
-	0x6c, 0x3a, 0x22, 0x61, 0x72, 0x72, 0x6f, 0x77, 0x5f, 0x67, 0x6c, 0x61, 0x63, 0x69, 0x65, 0x72,
-	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x11, 0x61, 0x72, 0x72, 0x6f, 0x77, 0x47, 0x6c,
-	0x61, 0x63, 0x69, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x64, 0x0a, 0x12, 0x67, 0x72,
-	0x61, 0x79, 0x5f, 0x67, 0x6c, 0x61, 0x63, 0x69, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e,
-	0x74, 0xf2, 0xde, 0x1f, 0x19, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x67, 0x72, 0x61, 0x79, 0x5f,
-	0x67, 0x6c, 0x61, 0x63, 0x69, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x10,
-	0x67, 0x72, 0x61, 0x79, 0x47, 0x6c, 0x61, 0x63, 0x69, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x12, 0x6a, 0x0a, 0x14, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x5f, 0x6e, 0x65, 0x74, 0x73, 0x70, 0x6c,
-	0x69, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x42, 0x38,
-	0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
-	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x1b, 0x79, 0x61, 0x6d,
-	0x6c, 0x3a, 0x22, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x5f, 0x6e, 0x65, 0x74, 0x73, 0x70, 0x6c, 0x69,
-	0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x12, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x4e,
-	0x65, 0x74, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x59, 0x0a, 0x0e,
-	0x73, 0x68, 0x61, 0x6e, 0x67, 0x68, 0x61, 0x69, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x16,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x32, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2,
-	0xde, 0x1f, 0x15, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x68, 0x61, 0x6e, 0x67, 0x68, 0x61,
-	0x69, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0d, 0x73, 0x68, 0x61, 0x6e, 0x67, 0x68,
-	0x61, 0x69, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x53, 0x0a, 0x0c, 0x63, 0x61, 0x6e, 0x63, 0x75,
-	0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x17, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xda,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x1a, 0x79, 0x61, 0x6d, 0x6c,
+	0x3a, 0x22, 0x61, 0x72, 0x72, 0x6f, 0x77, 0x5f, 0x67, 0x6c, 0x61, 0x63, 0x69, 0x65, 0x72, 0x5f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x11, 0x61, 0x72, 0x72, 0x6f, 0x77, 0x47, 0x6c, 0x61,
+	0x63, 0x69, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x64, 0x0a, 0x12, 0x67, 0x72, 0x61,
+	0x79, 0x5f, 0x67, 0x6c, 0x61, 0x63, 0x69, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18,
+	0x14, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74,
+	0xf2, 0xde, 0x1f, 0x19, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x67, 0x72, 0x61, 0x79, 0x5f, 0x67,
+	0x6c, 0x61, 0x63, 0x69, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x10, 0x67,
+	0x72, 0x61, 0x79, 0x47, 0x6c, 0x61, 0x63, 0x69, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
+	0x6a, 0x0a, 0x14, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x5f, 0x6e, 0x65, 0x74, 0x73, 0x70, 0x6c, 0x69,
+	0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x42, 0x38, 0xda,
 	0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
-	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c,
-	0x3a, 0x22, 0x63, 0x61, 0x6e, 0x63, 0x75, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52,
-	0x0b, 0x63, 0x61, 0x6e, 0x63, 0x75, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x19, 0x0a, 0x08,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x18, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
-	0x18, 0x19, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x1a, 0x0a,
-	0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x4a, 0x04, 0x08, 0x0e, 0x10, 0x0f, 0x4a,
-	0x04, 0x08, 0x0f, 0x10, 0x10, 0x4a, 0x04, 0x08, 0x10, 0x10, 0x11, 0x4a, 0x04, 0x08, 0x13, 0x10,
-	0x14, 0x52, 0x0d, 0x79, 0x6f, 0x6c, 0x6f, 0x5f, 0x76, 0x33, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x52, 0x0b, 0x65, 0x77, 0x61, 0x73, 0x6d, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x0e, 0x63,
-	0x61, 0x74, 0x61, 0x6c, 0x79, 0x73, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x10, 0x6d,
-	0x65, 0x72, 0x67, 0x65, 0x5f, 0x66, 0x6f, 0x72, 0x6b, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22,
-	0x2f, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x22, 0x50, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c,
-	0x6f, 0x67, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x29, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65,
-	0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x04, 0x6c, 0x6f,
-	0x67, 0x73, 0x22, 0xca, 0x02, 0x0a, 0x03, 0x4c, 0x6f, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x12, 0x12, 0x0a, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x12, 0x32, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0f, 0xea, 0xde, 0x1f, 0x0b, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x13, 0xea, 0xde, 0x1f, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61,
-	0x73, 0x68, 0x12, 0x2f, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x04, 0x42, 0x14, 0xea, 0xde, 0x1f, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x07, 0x74, 0x78, 0x49, 0x6e,
-	0x64, 0x65, 0x78, 0x12, 0x2c, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73,
-	0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0xea, 0xde, 0x1f, 0x09, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73,
-	0x68, 0x12, 0x22, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04,
-	0x42, 0x0c, 0xea, 0xde, 0x1f, 0x08, 0x6c, 0x6f, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x05,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x22,
-	0x90, 0x02, 0x0a, 0x08, 0x54, 0x78, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x46, 0x0a, 0x10,
-	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xf2, 0xde, 0x1f, 0x17, 0x79, 0x61, 0x6d, 0x6c,
-	0x3a, 0x22, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x22, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x6f, 0x6d, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x6f, 0x6d, 0x12, 0x57, 0x0a, 0x07, 0x74, 0x78,
-	0x5f, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x73, 0x42, 0x1b,
-	0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x0e, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x74, 0x78,
-	0x5f, 0x6c, 0x6f, 0x67, 0x73, 0x22, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x74, 0x78, 0x4c,
-	0x6f, 0x67, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x03, 0x72, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x65, 0x72, 0x74, 0x65,
-	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x76, 0x65, 0x72, 0x74, 0x65,
-	0x64, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x61, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x3a, 0x04, 0x88, 0xa0,
-	0x1f, 0x00, 0x22, 0x61, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x75, 0x70, 0x6c,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x32, 0x0a, 0x0c, 0x73,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x09, 0x42, 0x0f, 0xea, 0xde, 0x1f, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4b, 0x65,
-	0x79, 0x73, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x3a,
-	0x04, 0x88, 0xa0, 0x1f, 0x00, 0x22, 0xa0, 0x04, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x12, 0x18, 0x0a,
-	0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x65, 0x78, 0x65,
-	0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x72, 0x65, 0x65, 0x78, 0x65, 0x63, 0x12,
-	0x35, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x63, 0x6b,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x42, 0x10, 0xea, 0xde, 0x1f, 0x0c, 0x64, 0x69, 0x73, 0x61,
-	0x62, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
-	0x65, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x3b, 0x0a, 0x0f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
-	0x65, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x42,
-	0x12, 0xea, 0xde, 0x1f, 0x0e, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x52, 0x0e, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x62, 0x75, 0x67, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x05, 0x64, 0x65, 0x62, 0x75, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
-	0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12,
-	0x3b, 0x0a, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e,
-	0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x52, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x0d,
-	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x0b, 0x20,
-	0x01, 0x28, 0x08, 0x42, 0x10, 0xea, 0xde, 0x1f, 0x0c, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x4d,
-	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x0c, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x65, 0x6d,
-	0x6f, 0x72, 0x79, 0x12, 0x42, 0x0a, 0x12, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x72, 0x65,
-	0x74, 0x75, 0x72, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x42,
-	0x14, 0xea, 0xde, 0x1f, 0x10, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72,
-	0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x10, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x74,
-	0x75, 0x72, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x3e, 0x0a, 0x12, 0x74, 0x72, 0x61, 0x63, 0x65,
-	0x72, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x0d, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x10, 0xea, 0xde, 0x1f, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x10, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x4a, 0x73, 0x6f,
-	0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x4a, 0x04, 0x08,
-	0x07, 0x10, 0x08, 0x52, 0x0e, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6d, 0x65, 0x6d,
-	0x6f, 0x72, 0x79, 0x52, 0x13, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x74,
-	0x75, 0x72, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x2a, 0xc0, 0x01, 0x0a, 0x0a, 0x41, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3c, 0x0a, 0x1a, 0x41, 0x43, 0x43, 0x45, 0x53,
-	0x53, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f,
-	0x4e, 0x4c, 0x45, 0x53, 0x53, 0x10, 0x00, 0x1a, 0x1c, 0x8a, 0x9d, 0x20, 0x18, 0x41, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x6c, 0x65, 0x73, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f,
-	0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x52, 0x49, 0x43, 0x54, 0x45, 0x44, 0x10,
-	0x01, 0x1a, 0x18, 0x8a, 0x9d, 0x20, 0x14, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x18, 0x41,
-	0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x45, 0x52, 0x4d, 0x49,
-	0x53, 0x53, 0x49, 0x4f, 0x4e, 0x45, 0x44, 0x10, 0x02, 0x1a, 0x1a, 0x8a, 0x9d, 0x20, 0x16, 0x41,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x65, 0x64, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42, 0xab, 0x01, 0x0a, 0x14,
-	0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76,
-	0x6d, 0x2e, 0x76, 0x31, 0x42, 0x08, 0x45, 0x76, 0x6d, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
-	0x5a, 0x26, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d,
-	0x2f, 0x76, 0x31, 0x3b, 0x76, 0x6d, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x45, 0x56, 0xaa, 0x02,
-	0x10, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x45, 0x76, 0x6d, 0x2e, 0x56, 0x6d, 0x2e, 0x56,
-	0x31, 0xca, 0x02, 0x10, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x56,
-	0x6d, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1c, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x45, 0x76,
-	0x6d, 0x5c, 0x56, 0x6d, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0xea, 0x02, 0x13, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x45, 0x76,
-	0x6d, 0x3a, 0x3a, 0x56, 0x6d, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x1b, 0x79, 0x61, 0x6d, 0x6c,
+	0x3a, 0x22, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x5f, 0x6e, 0x65, 0x74, 0x73, 0x70, 0x6c, 0x69, 0x74,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x12, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x4e, 0x65,
+	0x74, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x59, 0x0a, 0x0e, 0x73,
+	0x68, 0x61, 0x6e, 0x67, 0x68, 0x61, 0x69, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x16, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x32, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde,
+	0x1f, 0x15, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x68, 0x61, 0x6e, 0x67, 0x68, 0x61, 0x69,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0d, 0x73, 0x68, 0x61, 0x6e, 0x67, 0x68, 0x61,
+	0x69, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x53, 0x0a, 0x0c, 0x63, 0x61, 0x6e, 0x63, 0x75, 0x6e,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x17, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xda, 0xde,
+	0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
+	0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
+	0x22, 0x63, 0x61, 0x6e, 0x63, 0x75, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0b,
+	0x63, 0x61, 0x6e, 0x63, 0x75, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x19, 0x0a, 0x08, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x18, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18,
+	0x19, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x1a, 0x0a, 0x08,
+	0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x4a, 0x04, 0x08, 0x0e, 0x10, 0x0f, 0x4a, 0x04,
+	0x08, 0x0f, 0x10, 0x10, 0x4a, 0x04, 0x08, 0x10, 0x10, 0x11, 0x4a, 0x04, 0x08, 0x13, 0x10, 0x14,
+	0x52, 0x0d, 0x79, 0x6f, 0x6c, 0x6f, 0x5f, 0x76, 0x33, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
+	0x0b, 0x65, 0x77, 0x61, 0x73, 0x6d, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x0e, 0x63, 0x61,
+	0x74, 0x61, 0x6c, 0x79, 0x73, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x10, 0x6d, 0x65,
+	0x72, 0x67, 0x65, 0x5f, 0x66, 0x6f, 0x72, 0x6b, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x2f,
+	0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
+	0x50, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f,
+	0x67, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x29, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76,
+	0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x04, 0x6c, 0x6f, 0x67,
+	0x73, 0x22, 0xca, 0x02, 0x0a, 0x03, 0x4c, 0x6f, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x32, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0f, 0xea, 0xde, 0x1f, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x13, 0xea, 0xde, 0x1f, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73,
+	0x68, 0x12, 0x2f, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x14, 0xea, 0xde, 0x1f, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x07, 0x74, 0x78, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x2c, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0xea, 0xde, 0x1f, 0x09, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x48, 0x61, 0x73, 0x68, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x22, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x0c, 0xea, 0xde, 0x1f, 0x08, 0x6c, 0x6f, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x05, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x22, 0x90,
+	0x02, 0x0a, 0x08, 0x54, 0x78, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x46, 0x0a, 0x10, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xf2, 0xde, 0x1f, 0x17, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
+	0x22, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x22, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x6f, 0x6d, 0x12, 0x57, 0x0a, 0x07, 0x74, 0x78, 0x5f,
+	0x6c, 0x6f, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x73, 0x42, 0x1b, 0xc8,
+	0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x0e, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x74, 0x78, 0x5f,
+	0x6c, 0x6f, 0x67, 0x73, 0x22, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x74, 0x78, 0x4c, 0x6f,
+	0x67, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x03, 0x72, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64,
+	0x12, 0x19, 0x0a, 0x08, 0x67, 0x61, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x67, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x3a, 0x04, 0x88, 0xa0, 0x1f,
+	0x00, 0x22, 0x61, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x75, 0x70, 0x6c, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x32, 0x0a, 0x0c, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x42, 0x0f, 0xea, 0xde, 0x1f, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4b, 0x65, 0x79,
+	0x73, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x3a, 0x04,
+	0x88, 0xa0, 0x1f, 0x00, 0x22, 0xa0, 0x04, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x65, 0x78, 0x65, 0x63,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x72, 0x65, 0x65, 0x78, 0x65, 0x63, 0x12, 0x35,
+	0x0a, 0x0d, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x42, 0x10, 0xea, 0xde, 0x1f, 0x0c, 0x64, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
+	0x53, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x3b, 0x0a, 0x0f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
+	0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x42, 0x12,
+	0xea, 0xde, 0x1f, 0x0e, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x52, 0x0e, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x62, 0x75, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x64, 0x65, 0x62, 0x75, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x3b,
+	0x0a, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76,
+	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x0d, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x08, 0x42, 0x10, 0xea, 0xde, 0x1f, 0x0c, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x52, 0x0c, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x12, 0x42, 0x0a, 0x12, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x74,
+	0x75, 0x72, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x42, 0x14,
+	0xea, 0xde, 0x1f, 0x10, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x10, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x74, 0x75,
+	0x72, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x3e, 0x0a, 0x12, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72,
+	0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x10, 0xea, 0xde, 0x1f, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x10, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x4a, 0x73, 0x6f, 0x6e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x4a, 0x04, 0x08, 0x07,
+	0x10, 0x08, 0x52, 0x0e, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x52, 0x13, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x74, 0x75,
+	0x72, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x22, 0x4e, 0x0a, 0x0a, 0x50, 0x72, 0x65, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x2a, 0xc0, 0x01, 0x0a, 0x0a, 0x41, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3c, 0x0a, 0x1a, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e,
+	0x4c, 0x45, 0x53, 0x53, 0x10, 0x00, 0x1a, 0x1c, 0x8a, 0x9d, 0x20, 0x18, 0x41, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x6c, 0x65, 0x73, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x52, 0x49, 0x43, 0x54, 0x45, 0x44, 0x10, 0x01,
+	0x1a, 0x18, 0x8a, 0x9d, 0x20, 0x14, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x18, 0x41, 0x43,
+	0x43, 0x45, 0x53, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53,
+	0x53, 0x49, 0x4f, 0x4e, 0x45, 0x44, 0x10, 0x02, 0x1a, 0x1a, 0x8a, 0x9d, 0x20, 0x16, 0x41, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x65, 0x64, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42, 0xab, 0x01, 0x0a, 0x14, 0x63,
+	0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d,
+	0x2e, 0x76, 0x31, 0x42, 0x08, 0x45, 0x76, 0x6d, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
+	0x26, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f,
+	0x76, 0x31, 0x3b, 0x76, 0x6d, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x45, 0x56, 0xaa, 0x02, 0x10,
+	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x45, 0x76, 0x6d, 0x2e, 0x56, 0x6d, 0x2e, 0x56, 0x31,
+	0xca, 0x02, 0x10, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x56, 0x6d,
+	0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1c, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x45, 0x76, 0x6d,
+	0x5c, 0x56, 0x6d, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0xea, 0x02, 0x13, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x45, 0x76, 0x6d,
+	0x3a, 0x3a, 0x56, 0x6d, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -9386,34 +10821,37 @@ func file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP() []byte {
 }
 
 var file_cosmos_evm_vm_v1_evm_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_cosmos_evm_vm_v1_evm_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_cosmos_evm_vm_v1_evm_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_cosmos_evm_vm_v1_evm_proto_goTypes = []interface{}{
-	(AccessType)(0),           // 0: cosmos.evm.vm.v1.AccessType
-	(*Params)(nil),            // 1: cosmos.evm.vm.v1.Params
-	(*AccessControl)(nil),     // 2: cosmos.evm.vm.v1.AccessControl
-	(*AccessControlType)(nil), // 3: cosmos.evm.vm.v1.AccessControlType
-	(*ChainConfig)(nil),       // 4: cosmos.evm.vm.v1.ChainConfig
-	(*State)(nil),             // 5: cosmos.evm.vm.v1.State
-	(*TransactionLogs)(nil),   // 6: cosmos.evm.vm.v1.TransactionLogs
-	(*Log)(nil),               // 7: cosmos.evm.vm.v1.Log
-	(*TxResult)(nil),          // 8: cosmos.evm.vm.v1.TxResult
-	(*AccessTuple)(nil),       // 9: cosmos.evm.vm.v1.AccessTuple
-	(*TraceConfig)(nil),       // 10: cosmos.evm.vm.v1.TraceConfig
+	(AccessType)(0),            // 0: cosmos.evm.vm.v1.AccessType
+	(*Params)(nil),             // 1: cosmos.evm.vm.v1.Params
+	(*AccessControl)(nil),      // 2: cosmos.evm.vm.v1.AccessControl
+	(*AccessControlType)(nil),  // 3: cosmos.evm.vm.v1.AccessControlType
+	(*AccessControlEntry)(nil), // 4: cosmos.evm.vm.v1.AccessControlEntry
+	(*ChainConfig)(nil),        // 5: cosmos.evm.vm.v1.ChainConfig
+	(*State)(nil),              // 6: cosmos.evm.vm.v1.State
+	(*TransactionLogs)(nil),    // 7: cosmos.evm.vm.v1.TransactionLogs
+	(*Log)(nil),                // 8: cosmos.evm.vm.v1.Log
+	(*TxResult)(nil),           // 9: cosmos.evm.vm.v1.TxResult
+	(*AccessTuple)(nil),        // 10: cosmos.evm.vm.v1.AccessTuple
+	(*TraceConfig)(nil),        // 11: cosmos.evm.vm.v1.TraceConfig
+	(*Preinstall)(nil),         // 12: cosmos.evm.vm.v1.Preinstall
 }
 var file_cosmos_evm_vm_v1_evm_proto_depIdxs = []int32{
-	4, // 0: cosmos.evm.vm.v1.Params.chain_config:type_name -> cosmos.evm.vm.v1.ChainConfig
+	5, // 0: cosmos.evm.vm.v1.Params.chain_config:type_name -> cosmos.evm.vm.v1.ChainConfig
 	2, // 1: cosmos.evm.vm.v1.Params.access_control:type_name -> cosmos.evm.vm.v1.AccessControl
 	3, // 2: cosmos.evm.vm.v1.AccessControl.create:type_name -> cosmos.evm.vm.v1.AccessControlType
 	3, // 3: cosmos.evm.vm.v1.AccessControl.call:type_name -> cosmos.evm.vm.v1.AccessControlType
 	0, // 4: cosmos.evm.vm.v1.AccessControlType.access_type:type_name -> cosmos.evm.vm.v1.AccessType
-	7, // 5: cosmos.evm.vm.v1.TransactionLogs.logs:type_name -> cosmos.evm.vm.v1.Log
-	6, // 6: cosmos.evm.vm.v1.TxResult.tx_logs:type_name -> cosmos.evm.vm.v1.TransactionLogs
-	4, // 7: cosmos.evm.vm.v1.TraceConfig.overrides:type_name -> cosmos.evm.vm.v1.ChainConfig
-	8, // [8:8] is the sub-list for method output_type
-	8, // [8:8] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	0, // 5: cosmos.evm.vm.v1.AccessControlEntry.access_type:type_name -> cosmos.evm.vm.v1.AccessType
+	8, // 6: cosmos.evm.vm.v1.TransactionLogs.logs:type_name -> cosmos.evm.vm.v1.Log
+	7, // 7: cosmos.evm.vm.v1.TxResult.tx_logs:type_name -> cosmos.evm.vm.v1.TransactionLogs
+	5, // 8: cosmos.evm.vm.v1.TraceConfig.overrides:type_name -> cosmos.evm.vm.v1.ChainConfig
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_evm_vm_v1_evm_proto_init() }
@@ -9459,7 +10897,7 @@ func file_cosmos_evm_vm_v1_evm_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_evm_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChainConfig); i {
+			switch v := v.(*AccessControlEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9471,7 +10909,7 @@ func file_cosmos_evm_vm_v1_evm_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_evm_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*State); i {
+			switch v := v.(*ChainConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9483,7 +10921,7 @@ func file_cosmos_evm_vm_v1_evm_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_evm_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransactionLogs); i {
+			switch v := v.(*State); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9495,7 +10933,7 @@ func file_cosmos_evm_vm_v1_evm_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_evm_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Log); i {
+			switch v := v.(*TransactionLogs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9507,7 +10945,7 @@ func file_cosmos_evm_vm_v1_evm_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_evm_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TxResult); i {
+			switch v := v.(*Log); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9519,7 +10957,7 @@ func file_cosmos_evm_vm_v1_evm_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_evm_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AccessTuple); i {
+			switch v := v.(*TxResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9531,6 +10969,18 @@ func file_cosmos_evm_vm_v1_evm_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_evm_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccessTuple); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_evm_vm_v1_evm_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TraceConfig); i {
 			case 0:
 				return &v.state
@@ -9542,6 +10992,18 @@ func file_cosmos_evm_vm_v1_evm_proto_init() {
 				return nil
 			}
 		}
+		file_cosmos_evm_vm_v1_evm_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Preinstall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -9549,7 +11011,7 @@ func file_cosmos_evm_vm_v1_evm_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_evm_vm_v1_evm_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   10,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
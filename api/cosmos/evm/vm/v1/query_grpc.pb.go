@@ -19,20 +19,23 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Account_FullMethodName           = "/cosmos.evm.vm.v1.Query/Account"
-	Query_CosmosAccount_FullMethodName     = "/cosmos.evm.vm.v1.Query/CosmosAccount"
-	Query_ValidatorAccount_FullMethodName  = "/cosmos.evm.vm.v1.Query/ValidatorAccount"
-	Query_Balance_FullMethodName           = "/cosmos.evm.vm.v1.Query/Balance"
-	Query_Storage_FullMethodName           = "/cosmos.evm.vm.v1.Query/Storage"
-	Query_Code_FullMethodName              = "/cosmos.evm.vm.v1.Query/Code"
-	Query_Params_FullMethodName            = "/cosmos.evm.vm.v1.Query/Params"
-	Query_EthCall_FullMethodName           = "/cosmos.evm.vm.v1.Query/EthCall"
-	Query_EstimateGas_FullMethodName       = "/cosmos.evm.vm.v1.Query/EstimateGas"
-	Query_TraceTx_FullMethodName           = "/cosmos.evm.vm.v1.Query/TraceTx"
-	Query_TraceBlock_FullMethodName        = "/cosmos.evm.vm.v1.Query/TraceBlock"
-	Query_BaseFee_FullMethodName           = "/cosmos.evm.vm.v1.Query/BaseFee"
-	Query_Config_FullMethodName            = "/cosmos.evm.vm.v1.Query/Config"
-	Query_GlobalMinGasPrice_FullMethodName = "/cosmos.evm.vm.v1.Query/GlobalMinGasPrice"
+	Query_Account_FullMethodName              = "/cosmos.evm.vm.v1.Query/Account"
+	Query_CosmosAccount_FullMethodName        = "/cosmos.evm.vm.v1.Query/CosmosAccount"
+	Query_ValidatorAccount_FullMethodName     = "/cosmos.evm.vm.v1.Query/ValidatorAccount"
+	Query_Balance_FullMethodName              = "/cosmos.evm.vm.v1.Query/Balance"
+	Query_Storage_FullMethodName              = "/cosmos.evm.vm.v1.Query/Storage"
+	Query_Code_FullMethodName                 = "/cosmos.evm.vm.v1.Query/Code"
+	Query_Params_FullMethodName               = "/cosmos.evm.vm.v1.Query/Params"
+	Query_EthCall_FullMethodName              = "/cosmos.evm.vm.v1.Query/EthCall"
+	Query_EstimateGas_FullMethodName          = "/cosmos.evm.vm.v1.Query/EstimateGas"
+	Query_TraceTx_FullMethodName              = "/cosmos.evm.vm.v1.Query/TraceTx"
+	Query_TraceBlock_FullMethodName           = "/cosmos.evm.vm.v1.Query/TraceBlock"
+	Query_BaseFee_FullMethodName              = "/cosmos.evm.vm.v1.Query/BaseFee"
+	Query_Config_FullMethodName               = "/cosmos.evm.vm.v1.Query/Config"
+	Query_GlobalMinGasPrice_FullMethodName    = "/cosmos.evm.vm.v1.Query/GlobalMinGasPrice"
+	Query_AccessControlEntries_FullMethodName = "/cosmos.evm.vm.v1.Query/AccessControlEntries"
+	Query_AccessControlEntry_FullMethodName   = "/cosmos.evm.vm.v1.Query/AccessControlEntry"
+	Query_FrozenContracts_FullMethodName      = "/cosmos.evm.vm.v1.Query/FrozenContracts"
 )
 
 // QueryClient is the client API for Query service.
@@ -75,6 +78,14 @@ type QueryClient interface {
 	// but makes the conversion to 18 decimals
 	// when the evm denom is represented with a different precision.
 	GlobalMinGasPrice(ctx context.Context, in *QueryGlobalMinGasPriceRequest, opts ...grpc.CallOption) (*QueryGlobalMinGasPriceResponse, error)
+	// AccessControlEntries queries the per-contract and per-function call
+	// permission rules, optionally filtered by contract.
+	AccessControlEntries(ctx context.Context, in *QueryAccessControlEntriesRequest, opts ...grpc.CallOption) (*QueryAccessControlEntriesResponse, error)
+	// AccessControlEntry queries the call permission rule of a contract, or of
+	// one of its functions.
+	AccessControlEntry(ctx context.Context, in *QueryAccessControlEntryRequest, opts ...grpc.CallOption) (*QueryAccessControlEntryResponse, error)
+	// FrozenContracts queries the contracts frozen by governance.
+	FrozenContracts(ctx context.Context, in *QueryFrozenContractsRequest, opts ...grpc.CallOption) (*QueryFrozenContractsResponse, error)
 }
 
 type queryClient struct {
@@ -211,6 +222,33 @@ func (c *queryClient) GlobalMinGasPrice(ctx context.Context, in *QueryGlobalMinG
 	return out, nil
 }
 
+func (c *queryClient) AccessControlEntries(ctx context.Context, in *QueryAccessControlEntriesRequest, opts ...grpc.CallOption) (*QueryAccessControlEntriesResponse, error) {
+	out := new(QueryAccessControlEntriesResponse)
+	err := c.cc.Invoke(ctx, Query_AccessControlEntries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccessControlEntry(ctx context.Context, in *QueryAccessControlEntryRequest, opts ...grpc.CallOption) (*QueryAccessControlEntryResponse, error) {
+	out := new(QueryAccessControlEntryResponse)
+	err := c.cc.Invoke(ctx, Query_AccessControlEntry_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FrozenContracts(ctx context.Context, in *QueryFrozenContractsRequest, opts ...grpc.CallOption) (*QueryFrozenContractsResponse, error) {
+	out := new(QueryFrozenContractsResponse)
+	err := c.cc.Invoke(ctx, Query_FrozenContracts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -251,6 +289,14 @@ type QueryServer interface {
 	// but makes the conversion to 18 decimals
 	// when the evm denom is represented with a different precision.
 	GlobalMinGasPrice(context.Context, *QueryGlobalMinGasPriceRequest) (*QueryGlobalMinGasPriceResponse, error)
+	// AccessControlEntries queries the per-contract and per-function call
+	// permission rules, optionally filtered by contract.
+	AccessControlEntries(context.Context, *QueryAccessControlEntriesRequest) (*QueryAccessControlEntriesResponse, error)
+	// AccessControlEntry queries the call permission rule of a contract, or of
+	// one of its functions.
+	AccessControlEntry(context.Context, *QueryAccessControlEntryRequest) (*QueryAccessControlEntryResponse, error)
+	// FrozenContracts queries the contracts frozen by governance.
+	FrozenContracts(context.Context, *QueryFrozenContractsRequest) (*QueryFrozenContractsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -300,6 +346,15 @@ func (UnimplementedQueryServer) Config(context.Context, *QueryConfigRequest) (*Q
 func (UnimplementedQueryServer) GlobalMinGasPrice(context.Context, *QueryGlobalMinGasPriceRequest) (*QueryGlobalMinGasPriceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GlobalMinGasPrice not implemented")
 }
+func (UnimplementedQueryServer) AccessControlEntries(context.Context, *QueryAccessControlEntriesRequest) (*QueryAccessControlEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccessControlEntries not implemented")
+}
+func (UnimplementedQueryServer) AccessControlEntry(context.Context, *QueryAccessControlEntryRequest) (*QueryAccessControlEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccessControlEntry not implemented")
+}
+func (UnimplementedQueryServer) FrozenContracts(context.Context, *QueryFrozenContractsRequest) (*QueryFrozenContractsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FrozenContracts not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -565,6 +620,60 @@ func _Query_GlobalMinGasPrice_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AccessControlEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccessControlEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccessControlEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AccessControlEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccessControlEntries(ctx, req.(*QueryAccessControlEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccessControlEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccessControlEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccessControlEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AccessControlEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccessControlEntry(ctx, req.(*QueryAccessControlEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FrozenContracts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFrozenContractsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FrozenContracts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FrozenContracts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FrozenContracts(ctx, req.(*QueryFrozenContractsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -628,6 +737,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GlobalMinGasPrice",
 			Handler:    _Query_GlobalMinGasPrice_Handler,
 		},
+		{
+			MethodName: "AccessControlEntries",
+			Handler:    _Query_AccessControlEntries_Handler,
+		},
+		{
+			MethodName: "AccessControlEntry",
+			Handler:    _Query_AccessControlEntry_Handler,
+		},
+		{
+			MethodName: "FrozenContracts",
+			Handler:    _Query_FrozenContracts_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evm/vm/v1/query.proto",
@@ -19,8 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_EthereumTx_FullMethodName   = "/cosmos.evm.vm.v1.Msg/EthereumTx"
-	Msg_UpdateParams_FullMethodName = "/cosmos.evm.vm.v1.Msg/UpdateParams"
+	Msg_EthereumTx_FullMethodName                 = "/cosmos.evm.vm.v1.Msg/EthereumTx"
+	Msg_UpdateParams_FullMethodName               = "/cosmos.evm.vm.v1.Msg/UpdateParams"
+	Msg_RegisterPreinstalls_FullMethodName        = "/cosmos.evm.vm.v1.Msg/RegisterPreinstalls"
+	Msg_AddAccessControlEntries_FullMethodName    = "/cosmos.evm.vm.v1.Msg/AddAccessControlEntries"
+	Msg_RemoveAccessControlEntries_FullMethodName = "/cosmos.evm.vm.v1.Msg/RemoveAccessControlEntries"
+	Msg_FreezeContract_FullMethodName             = "/cosmos.evm.vm.v1.Msg/FreezeContract"
+	Msg_UnfreezeContract_FullMethodName           = "/cosmos.evm.vm.v1.Msg/UnfreezeContract"
+	Msg_CallContract_FullMethodName               = "/cosmos.evm.vm.v1.Msg/CallContract"
+	Msg_DeployContract_FullMethodName             = "/cosmos.evm.vm.v1.Msg/DeployContract"
 )
 
 // MsgClient is the client API for Msg service.
@@ -33,6 +40,32 @@ type MsgClient interface {
 	// parameters. The authority is hard-coded to the Cosmos SDK x/gov module
 	// account
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// RegisterPreinstalls defines a governance operation for directly registering
+	// preinstalled contracts in the EVM. The authority is the same as is used for
+	// Params updates.
+	RegisterPreinstalls(ctx context.Context, in *MsgRegisterPreinstalls, opts ...grpc.CallOption) (*MsgRegisterPreinstallsResponse, error)
+	// AddAccessControlEntries defines a governance operation for adding
+	// per-contract and per-function call permission rules, or adding addresses to
+	// the existing ones. The authority is the same as is used for Params updates.
+	AddAccessControlEntries(ctx context.Context, in *MsgAddAccessControlEntries, opts ...grpc.CallOption) (*MsgAddAccessControlEntriesResponse, error)
+	// RemoveAccessControlEntries defines a governance operation for removing
+	// per-contract and per-function call permission rules, or removing addresses
+	// from the existing ones. The authority is the same as is used for Params
+	// updates.
+	RemoveAccessControlEntries(ctx context.Context, in *MsgRemoveAccessControlEntries, opts ...grpc.CallOption) (*MsgRemoveAccessControlEntriesResponse, error)
+	// FreezeContract defines a governance operation for freezing a contract, so
+	// that all the calls to it revert. The authority is the same as is used for
+	// Params updates.
+	FreezeContract(ctx context.Context, in *MsgFreezeContract, opts ...grpc.CallOption) (*MsgFreezeContractResponse, error)
+	// UnfreezeContract defines a governance operation for unfreezing a frozen
+	// contract. The authority is the same as is used for Params updates.
+	UnfreezeContract(ctx context.Context, in *MsgUnfreezeContract, opts ...grpc.CallOption) (*MsgUnfreezeContractResponse, error)
+	// CallContract defines a method for calling a contract from a Cosmos
+	// account, using the EVM address derived from the sender's address.
+	CallContract(ctx context.Context, in *MsgCallEVM, opts ...grpc.CallOption) (*MsgCallEVMResponse, error)
+	// DeployContract defines a method for deploying a contract from a Cosmos
+	// account, using the EVM address derived from the sender's address.
+	DeployContract(ctx context.Context, in *MsgDeployContract, opts ...grpc.CallOption) (*MsgDeployContractResponse, error)
 }
 
 type msgClient struct {
@@ -61,6 +94,69 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) RegisterPreinstalls(ctx context.Context, in *MsgRegisterPreinstalls, opts ...grpc.CallOption) (*MsgRegisterPreinstallsResponse, error) {
+	out := new(MsgRegisterPreinstallsResponse)
+	err := c.cc.Invoke(ctx, Msg_RegisterPreinstalls_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddAccessControlEntries(ctx context.Context, in *MsgAddAccessControlEntries, opts ...grpc.CallOption) (*MsgAddAccessControlEntriesResponse, error) {
+	out := new(MsgAddAccessControlEntriesResponse)
+	err := c.cc.Invoke(ctx, Msg_AddAccessControlEntries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveAccessControlEntries(ctx context.Context, in *MsgRemoveAccessControlEntries, opts ...grpc.CallOption) (*MsgRemoveAccessControlEntriesResponse, error) {
+	out := new(MsgRemoveAccessControlEntriesResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveAccessControlEntries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FreezeContract(ctx context.Context, in *MsgFreezeContract, opts ...grpc.CallOption) (*MsgFreezeContractResponse, error) {
+	out := new(MsgFreezeContractResponse)
+	err := c.cc.Invoke(ctx, Msg_FreezeContract_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnfreezeContract(ctx context.Context, in *MsgUnfreezeContract, opts ...grpc.CallOption) (*MsgUnfreezeContractResponse, error) {
+	out := new(MsgUnfreezeContractResponse)
+	err := c.cc.Invoke(ctx, Msg_UnfreezeContract_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CallContract(ctx context.Context, in *MsgCallEVM, opts ...grpc.CallOption) (*MsgCallEVMResponse, error) {
+	out := new(MsgCallEVMResponse)
+	err := c.cc.Invoke(ctx, Msg_CallContract_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeployContract(ctx context.Context, in *MsgDeployContract, opts ...grpc.CallOption) (*MsgDeployContractResponse, error) {
+	out := new(MsgDeployContractResponse)
+	err := c.cc.Invoke(ctx, Msg_DeployContract_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -71,6 +167,32 @@ type MsgServer interface {
 	// parameters. The authority is hard-coded to the Cosmos SDK x/gov module
 	// account
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// RegisterPreinstalls defines a governance operation for directly registering
+	// preinstalled contracts in the EVM. The authority is the same as is used for
+	// Params updates.
+	RegisterPreinstalls(context.Context, *MsgRegisterPreinstalls) (*MsgRegisterPreinstallsResponse, error)
+	// AddAccessControlEntries defines a governance operation for adding
+	// per-contract and per-function call permission rules, or adding addresses to
+	// the existing ones. The authority is the same as is used for Params updates.
+	AddAccessControlEntries(context.Context, *MsgAddAccessControlEntries) (*MsgAddAccessControlEntriesResponse, error)
+	// RemoveAccessControlEntries defines a governance operation for removing
+	// per-contract and per-function call permission rules, or removing addresses
+	// from the existing ones. The authority is the same as is used for Params
+	// updates.
+	RemoveAccessControlEntries(context.Context, *MsgRemoveAccessControlEntries) (*MsgRemoveAccessControlEntriesResponse, error)
+	// FreezeContract defines a governance operation for freezing a contract, so
+	// that all the calls to it revert. The authority is the same as is used for
+	// Params updates.
+	FreezeContract(context.Context, *MsgFreezeContract) (*MsgFreezeContractResponse, error)
+	// UnfreezeContract defines a governance operation for unfreezing a frozen
+	// contract. The authority is the same as is used for Params updates.
+	UnfreezeContract(context.Context, *MsgUnfreezeContract) (*MsgUnfreezeContractResponse, error)
+	// CallContract defines a method for calling a contract from a Cosmos
+	// account, using the EVM address derived from the sender's address.
+	CallContract(context.Context, *MsgCallEVM) (*MsgCallEVMResponse, error)
+	// DeployContract defines a method for deploying a contract from a Cosmos
+	// account, using the EVM address derived from the sender's address.
+	DeployContract(context.Context, *MsgDeployContract) (*MsgDeployContractResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -84,6 +206,27 @@ func (UnimplementedMsgServer) EthereumTx(context.Context, *MsgEthereumTx) (*MsgE
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) RegisterPreinstalls(context.Context, *MsgRegisterPreinstalls) (*MsgRegisterPreinstallsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterPreinstalls not implemented")
+}
+func (UnimplementedMsgServer) AddAccessControlEntries(context.Context, *MsgAddAccessControlEntries) (*MsgAddAccessControlEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAccessControlEntries not implemented")
+}
+func (UnimplementedMsgServer) RemoveAccessControlEntries(context.Context, *MsgRemoveAccessControlEntries) (*MsgRemoveAccessControlEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAccessControlEntries not implemented")
+}
+func (UnimplementedMsgServer) FreezeContract(context.Context, *MsgFreezeContract) (*MsgFreezeContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreezeContract not implemented")
+}
+func (UnimplementedMsgServer) UnfreezeContract(context.Context, *MsgUnfreezeContract) (*MsgUnfreezeContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfreezeContract not implemented")
+}
+func (UnimplementedMsgServer) CallContract(context.Context, *MsgCallEVM) (*MsgCallEVMResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CallContract not implemented")
+}
+func (UnimplementedMsgServer) DeployContract(context.Context, *MsgDeployContract) (*MsgDeployContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeployContract not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -133,6 +276,132 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RegisterPreinstalls_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterPreinstalls)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterPreinstalls(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RegisterPreinstalls_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterPreinstalls(ctx, req.(*MsgRegisterPreinstalls))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddAccessControlEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddAccessControlEntries)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddAccessControlEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AddAccessControlEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddAccessControlEntries(ctx, req.(*MsgAddAccessControlEntries))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveAccessControlEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveAccessControlEntries)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveAccessControlEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveAccessControlEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveAccessControlEntries(ctx, req.(*MsgRemoveAccessControlEntries))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FreezeContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFreezeContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FreezeContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_FreezeContract_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FreezeContract(ctx, req.(*MsgFreezeContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnfreezeContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnfreezeContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnfreezeContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UnfreezeContract_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnfreezeContract(ctx, req.(*MsgUnfreezeContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CallContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCallEVM)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CallContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CallContract_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CallContract(ctx, req.(*MsgCallEVM))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeployContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeployContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeployContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DeployContract_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeployContract(ctx, req.(*MsgDeployContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -148,6 +417,34 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "RegisterPreinstalls",
+			Handler:    _Msg_RegisterPreinstalls_Handler,
+		},
+		{
+			MethodName: "AddAccessControlEntries",
+			Handler:    _Msg_AddAccessControlEntries_Handler,
+		},
+		{
+			MethodName: "RemoveAccessControlEntries",
+			Handler:    _Msg_RemoveAccessControlEntries_Handler,
+		},
+		{
+			MethodName: "FreezeContract",
+			Handler:    _Msg_FreezeContract_Handler,
+		},
+		{
+			MethodName: "UnfreezeContract",
+			Handler:    _Msg_UnfreezeContract_Handler,
+		},
+		{
+			MethodName: "CallContract",
+			Handler:    _Msg_CallContract_Handler,
+		},
+		{
+			MethodName: "DeployContract",
+			Handler:    _Msg_DeployContract_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evm/vm/v1/tx.proto",
@@ -20,6 +20,7 @@ import (
 	cosmosevmserver "github.com/cosmos/evm/server"
 	cosmosevmserverconfig "github.com/cosmos/evm/server/config"
 	srvflags "github.com/cosmos/evm/server/flags"
+	evmcli "github.com/cosmos/evm/x/vm/client/cli"
 
 	"cosmossdk.io/log"
 	"cosmossdk.io/store"
@@ -46,6 +47,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/tx"
 	txmodule "github.com/cosmos/cosmos-sdk/x/auth/tx/config"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis"
 	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
 )
 
@@ -213,12 +215,15 @@ func initRootCmd(rootCmd *cobra.Command, osApp *evmd.EVMD) {
 	cfg := sdk.GetConfig()
 	cfg.Seal()
 
+	genesisCmd := genutilcli.Commands(osApp.TxConfig(), osApp.BasicModuleManager, evmd.DefaultNodeHome)
+	genesisCmd.AddCommand(evmcli.ImportGethStateCmd(evmd.DefaultNodeHome, evmd.EvmAppOptions))
+
 	rootCmd.AddCommand(
 		genutilcli.InitCmd(
 			osApp.BasicModuleManager,
 			evmd.DefaultNodeHome,
 		),
-		genutilcli.Commands(osApp.TxConfig(), osApp.BasicModuleManager, evmd.DefaultNodeHome),
+		genesisCmd,
 		cmtcli.NewCompletionCmd(rootCmd, true),
 		debug.Cmd(),
 		confixcmd.ConfigCommand(),
@@ -254,7 +259,9 @@ func initRootCmd(rootCmd *cobra.Command, osApp *evmd.EVMD) {
 	}
 }
 
-func addModuleInitFlags(_ *cobra.Command) {}
+func addModuleInitFlags(startCmd *cobra.Command) {
+	crisis.AddModuleInitFlags(startCmd)
+}
 
 func queryCommand() *cobra.Command {
 	cmd := &cobra.Command{
@@ -418,7 +425,14 @@ func appExport(
 		exampleApp = evmd.NewExampleApp(logger, db, traceStore, true, appOpts, evmd.EvmAppOptions, baseapp.SetChainID(chainID))
 	}
 
-	return exampleApp.ExportAppStateAndValidators(forZeroHeight, jailAllowedAddrs, modulesToExport)
+	exported, err := exampleApp.ExportAppStateAndValidators(forZeroHeight, jailAllowedAddrs, modulesToExport)
+	if err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+
+	// write the EVM genesis accounts to the genesis stream file, if enabled
+	exported.AppState, err = exampleApp.ExportEVMGenesisStream(exported.AppState)
+	return exported, err
 }
 
 // getChainIDFromOpts returns the chain Id from app Opts
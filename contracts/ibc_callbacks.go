@@ -0,0 +1,28 @@
+package contracts
+
+import (
+	_ "embed"
+
+	contractutils "github.com/cosmos/evm/contracts/utils"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+var (
+	// IBCCallbacksJSON are the compiled bytes of the IBCCallbacksContract
+	//
+	//go:embed solidity/IIBCCallbacks.json
+	IBCCallbacksJSON []byte
+
+	// IBCCallbacksContract is the compiled interface of the contracts receiving
+	// the IBC packet lifecycle callbacks
+	IBCCallbacksContract evmtypes.CompiledContract
+)
+
+func init() {
+	var err error
+	if IBCCallbacksContract, err = contractutils.ConvertPrecompileHardhatBytesToCompiledContract(
+		IBCCallbacksJSON,
+	); err != nil {
+		panic(err)
+	}
+}
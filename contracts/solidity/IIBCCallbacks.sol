@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity ^0.8.0;
+
+/// @title IBC Callbacks Interface
+/// @dev The interface that contracts must implement to receive the IBC packet
+/// lifecycle callbacks requested in the packet memo. The callbacks are called
+/// by the x/vm module account, so implementations should check the caller.
+interface IIBCCallbacks {
+    /// @dev Called on the destination chain after a packet with a `dest_callback`
+    /// memo entry is received. Reverting makes the chain write an error
+    /// acknowledgement, which reverts the packet on the source chain.
+    /// @param channelId The destination channel of the packet
+    /// @param portId The destination port of the packet
+    /// @param sequence The sequence of the packet
+    /// @param data The packet data
+    function onPacketReceive(
+        string calldata channelId,
+        string calldata portId,
+        uint64 sequence,
+        bytes calldata data
+    ) external;
+
+    /// @dev Called on the source chain when a packet with a `src_callback`
+    /// memo entry is acknowledged.
+    /// @param channelId The source channel of the packet
+    /// @param portId The source port of the packet
+    /// @param sequence The sequence of the packet
+    /// @param data The packet data
+    /// @param acknowledgement The packet acknowledgement
+    function onPacketAcknowledgement(
+        string calldata channelId,
+        string calldata portId,
+        uint64 sequence,
+        bytes calldata data,
+        bytes calldata acknowledgement
+    ) external;
+
+    /// @dev Called on the source chain when a packet with a `src_callback`
+    /// memo entry times out.
+    /// @param channelId The source channel of the packet
+    /// @param portId The source port of the packet
+    /// @param sequence The sequence of the packet
+    /// @param data The packet data
+    function onPacketTimeout(
+        string calldata channelId,
+        string calldata portId,
+        uint64 sequence,
+        bytes calldata data
+    ) external;
+}
@@ -7,6 +7,9 @@ import (
 
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
+	"github.com/cosmos/evm/x/vm"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
 	storetypes "cosmossdk.io/store/types"
 
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
@@ -51,6 +54,43 @@ func (app *EVMD) ExportAppStateAndValidators(forZeroHeight bool, jailAllowedAddr
 	}, err
 }
 
+// ExportEVMGenesisStream writes the EVM genesis accounts to the genesis stream
+// file, if a genesis stream directory is set, and sets the commitment to the file
+// in the EVM genesis state of the exported app state. The app state is returned
+// unchanged otherwise.
+func (app *EVMD) ExportEVMGenesisStream(appState json.RawMessage) (json.RawMessage, error) {
+	if app.evmGenesisStreamDir == "" {
+		return appState, nil
+	}
+
+	var genState map[string]json.RawMessage
+	if err := json.Unmarshal(appState, &genState); err != nil {
+		return nil, err
+	}
+
+	evmGenStateBz, ok := genState[evmtypes.ModuleName]
+	if !ok {
+		return appState, nil
+	}
+
+	var evmGenState evmtypes.GenesisState
+	if err := app.appCodec.UnmarshalJSON(evmGenStateBz, &evmGenState); err != nil {
+		return nil, err
+	}
+
+	ctx := app.NewContextLegacy(true, tmproto.Header{Height: app.LastBlockHeight()})
+	stream, err := vm.ExportGenesisStream(ctx, app.EVMKeeper, app.evmGenesisStreamDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export the EVM genesis stream: %w", err)
+	}
+	evmGenState.GenesisStream = stream
+
+	if genState[evmtypes.ModuleName], err = app.appCodec.MarshalJSON(&evmGenState); err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(genState, "", "  ")
+}
+
 // prepare for fresh start at zero height
 // NOTE zero height genesis is a temporary feature which will be deprecated
 //
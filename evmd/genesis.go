@@ -5,7 +5,14 @@ import (
 
 	erc20types "github.com/cosmos/evm/x/erc20/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
+	icagenesistypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/genesis/types"
+	icahosttypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
@@ -20,11 +27,12 @@ type GenesisState map[string]json.RawMessage
 
 // NewEVMGenesisState returns the default genesis state for the EVM module.
 //
-// NOTE: for the example chain implementation we need to set the default EVM denomination
-// and enable ALL precompiles.
+// NOTE: for the example chain implementation we need to set the default EVM denomination,
+// enable ALL precompiles and deploy the default preinstalled contracts.
 func NewEVMGenesisState() *evmtypes.GenesisState {
 	evmGenState := evmtypes.DefaultGenesisState()
 	evmGenState.Params.ActiveStaticPrecompiles = evmtypes.AvailableStaticPrecompiles
+	evmGenState.Preinstalls = evmtypes.DefaultPreinstalls
 
 	return evmGenState
 }
@@ -50,3 +58,46 @@ func NewMintGenesisState() *minttypes.GenesisState {
 
 	return mintGenState
 }
+
+// NewCrisisGenesisState returns the default genesis state for the crisis module.
+//
+// NOTE: for the example chain implementation the invariant verification fee is
+// set in the chain's base denomination.
+func NewCrisisGenesisState() *crisistypes.GenesisState {
+	crisisGenState := crisistypes.DefaultGenesisState()
+	crisisGenState.ConstantFee.Denom = ExampleChainDenom
+
+	return crisisGenState
+}
+
+// NewICAGenesisState returns the default genesis state for the interchain
+// accounts module.
+//
+// NOTE: for the example chain implementation the ICA host only allows bank
+// transfers and the Cosmos-signed EVM messages, so that interchain accounts
+// can operate contracts on the chain. As their addresses are 32 bytes long, the
+// EVM messages are executed from an EVM address derived from them, which uses
+// the interchain account balance (see evmtypes.CosmosSenderEVMAddress).
+func NewICAGenesisState() *icagenesistypes.GenesisState {
+	icaGenState := icagenesistypes.DefaultGenesis()
+	icaGenState.HostGenesisState.Params = icahosttypes.NewParams(true, []string{
+		sdk.MsgTypeURL(&banktypes.MsgSend{}),
+		sdk.MsgTypeURL(&evmtypes.MsgCallEVM{}),
+		sdk.MsgTypeURL(&evmtypes.MsgDeployContract{}),
+	})
+
+	return icaGenState
+}
+
+// RandomGenesisAccounts returns the simulation accounts as base accounts.
+//
+// NOTE: the example chain doesn't register the vesting module, so the vesting accounts
+// that are randomly generated by the auth module can't be used in the simulations.
+func RandomGenesisAccounts(simState *module.SimulationState) authtypes.GenesisAccounts {
+	genesisAccs := make(authtypes.GenesisAccounts, len(simState.Accounts))
+	for i, acc := range simState.Accounts {
+		genesisAccs[i] = authtypes.NewBaseAccountWithAddress(acc.Address)
+	}
+
+	return genesisAccs
+}
@@ -0,0 +1,113 @@
+package evmd
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	vmsimulation "github.com/cosmos/evm/x/vm/simulation"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	simcli "github.com/cosmos/cosmos-sdk/x/simulation/client/cli"
+)
+
+// SimAppChainID is the chain ID used in the app simulations. It must be registered in
+// ChainsCoinInfo, so that the EVM is configured with the example chain denomination.
+const SimAppChainID = EighteenDecimalsChainID + "-1"
+
+func init() {
+	simcli.GetSimulatorFlags()
+}
+
+// TestFullAppSimulation runs the app simulation with the randomized operations of all the
+// modules, including the EVM transactions. It is skipped unless the simulation is enabled,
+// e.g.:
+//
+//	go test -tags=test ./evmd -run TestFullAppSimulation -Enabled=true -NumBlocks=50 -BlockSize=20 -Commit=true
+func TestFullAppSimulation(t *testing.T) {
+	config := simcli.NewConfigFromFlags()
+	config.ChainID = SimAppChainID
+
+	db, dir, logger, skip, err := simtestutil.SetupSimulation(config, "leveldb-app-sim", "Simulation", simcli.FlagVerboseValue, simcli.FlagEnabledValue)
+	if skip {
+		t.Skip("skipping application simulation")
+	}
+	require.NoError(t, err, "simulation setup failed")
+
+	defer func() {
+		require.NoError(t, db.Close())
+		require.NoError(t, os.RemoveAll(dir))
+	}()
+
+	// the simulated genesis uses the default bond denomination for the staking, mint and
+	// bank balances, so it has to match the EVM denomination
+	bondDenom := sdk.DefaultBondDenom
+	sdk.DefaultBondDenom = ExampleChainDenom
+	defer func() { sdk.DefaultBondDenom = bondDenom }()
+
+	appOptions := make(simtestutil.AppOptionsMap, 0)
+	appOptions[flags.FlagHome] = DefaultNodeHome
+	appOptions[server.FlagInvCheckPeriod] = simcli.FlagPeriodValue
+
+	app := NewExampleApp(logger, db, nil, true, appOptions, EvmAppOptions, baseapp.SetChainID(SimAppChainID))
+	require.Equal(t, "evmd", app.Name())
+
+	// run randomized simulation with eth_secp256k1 accounts, so that they can sign the
+	// Ethereum transactions
+	_, simParams, simErr := simulation.SimulateFromSeed(
+		t,
+		os.Stdout,
+		app.BaseApp,
+		simtestutil.AppStateFn(app.AppCodec(), app.SimulationManager(), app.DefaultGenesis()),
+		vmsimulation.RandomAccounts,
+		simulationOperations(app, config),
+		BlockedAddresses(),
+		config,
+		app.AppCodec(),
+	)
+
+	// export state and simParams before the simulation error is checked
+	err = simtestutil.CheckExportSimulation(app, config, simParams)
+	require.NoError(t, err)
+	require.NoError(t, simErr)
+
+	if config.Commit {
+		simtestutil.PrintStats(db)
+	}
+}
+
+// simulationOperations returns the weighted operations of all the modules, as
+// simtestutil.SimulationOperations does, except for the legacy gov v1beta1 proposals, since
+// no legacy proposal router is set on the app.
+func simulationOperations(app *EVMD, config simtypes.Config) []simtypes.WeightedOperation {
+	simState := module.SimulationState{
+		AppParams: make(simtypes.AppParams),
+		Cdc:       app.AppCodec(),
+		TxConfig:  moduletestutil.MakeTestTxConfig(),
+		BondDenom: sdk.DefaultBondDenom,
+	}
+
+	if config.ParamsFile != "" {
+		bz, err := os.ReadFile(config.ParamsFile)
+		if err != nil {
+			panic(err)
+		}
+
+		if err := json.Unmarshal(bz, &simState.AppParams); err != nil {
+			panic(err)
+		}
+	}
+
+	simState.ProposalMsgs = app.SimulationManager().GetProposalMsgs(simState)
+	return app.SimulationManager().WeightedOperations(simState)
+}
@@ -47,7 +47,8 @@ func NewKVIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context) *KVInd
 // IndexBlock index all the eth txs in a block through the following steps:
 // - Iterates over all of the Txs in Block
 // - Parses eth Tx infos from cosmos-sdk events for every TxResult
-// - Iterates over all the messages of the Tx
+// - Iterates over all the messages of the Tx, or over the synthetic txs of the
+// EVM calls executed on behalf of Cosmos accounts for the other Cosmos txs
 // - Builds and stores a indexer.TxResult based on parsed events for every message
 func (kv *KVIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxResult) error {
 	height := block.Header.Height
@@ -69,8 +70,21 @@ func (kv *KVIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxR
 			continue
 		}
 
-		if !isEthTx(tx) {
-			continue
+		var ethMsgs []*evmtypes.MsgEthereumTx
+		if isEthTx(tx) {
+			for _, msg := range tx.GetMsgs() {
+				ethMsgs = append(ethMsgs, msg.(*evmtypes.MsgEthereumTx))
+			}
+		} else {
+			// the EVM calls executed on behalf of Cosmos accounts are indexed as synthetic txs
+			ethMsgs, err = rpctypes.SyntheticTxsFromResult(result)
+			if err != nil {
+				kv.logger.Error("Fail to parse synthetic txs", "err", err, "block", height, "txIndex", txIndex)
+				continue
+			}
+			if len(ethMsgs) == 0 {
+				continue
+			}
 		}
 
 		txs, err := rpctypes.ParseTxResult(result, tx)
@@ -80,8 +94,7 @@ func (kv *KVIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxR
 		}
 
 		var cumulativeGasUsed uint64
-		for msgIndex, msg := range tx.GetMsgs() {
-			ethMsg := msg.(*evmtypes.MsgEthereumTx)
+		for msgIndex, ethMsg := range ethMsgs {
 			txHash := common.HexToHash(ethMsg.Hash)
 
 			txResult := cosmosevmtypes.TxResult{
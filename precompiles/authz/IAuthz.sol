@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+import "../common/Types.sol";
+
+/// @dev The IAuthz contract's address.
+address constant AUTHZ_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000808;
+
+/// @dev The IAuthz contract's instance.
+IAuthz constant AUTHZ_CONTRACT = IAuthz(AUTHZ_PRECOMPILE_ADDRESS);
+
+/// @dev The GrantAuthorization struct contains the information of an authz grant.
+struct GrantAuthorization {
+    // granter is the address of the account that gave the grant
+    address granter;
+    // grantee is the address of the account that received the grant
+    address grantee;
+    // authorization is the JSON-encoded authorization, including its type URL
+    string authorization;
+    // expiration is the unix timestamp in seconds at which the grant expires, or zero if it does not expire
+    int64 expiration;
+}
+
+/// @author The Evmos Core Team
+/// @title Authz Precompile Contract
+/// @dev The interface through which solidity contracts will interact with the x/authz module
+interface IAuthz {
+    /// @dev Event emitted when a grant is given.
+    /// @param granter The address of the granter
+    /// @param grantee The address of the grantee
+    /// @param msgTypeUrl The type URL of the granted message
+    event Grant(address indexed granter, address indexed grantee, string msgTypeUrl);
+
+    /// @dev Event emitted when a grant is revoked.
+    /// @param granter The address of the granter
+    /// @param grantee The address of the grantee
+    /// @param msgTypeUrl The type URL of the revoked message
+    event Revoke(address indexed granter, address indexed grantee, string msgTypeUrl);
+
+    /// @dev Event emitted when messages are executed with the grants of a grantee.
+    /// @param grantee The address of the grantee
+    /// @param msgTypeUrls The type URLs of the executed messages
+    event Exec(address indexed grantee, string[] msgTypeUrls);
+
+    /// @dev Grants a generic authorization for the given message type from the caller to the grantee.
+    /// @param grantee The address of the grantee
+    /// @param msgTypeUrl The type URL of the message the grantee is allowed to execute
+    /// @param expiration The unix timestamp in seconds at which the grant expires, or zero if it does not expire
+    /// @return success True if the grant was given successfully
+    function grant(address grantee, string calldata msgTypeUrl, int64 expiration) external returns (bool success);
+
+    /// @dev Revokes the grant of the given message type from the caller to the grantee.
+    /// @param grantee The address of the grantee
+    /// @param msgTypeUrl The type URL of the revoked message
+    /// @return success True if the grant was revoked successfully
+    function revoke(address grantee, string calldata msgTypeUrl) external returns (bool success);
+
+    /// @dev Executes the given messages with the grants of the caller. Each message is
+    /// JSON-encoded with its type URL, e.g. {"@type":"/cosmos.bank.v1beta1.MsgSend",...}.
+    /// @param msgs The JSON-encoded messages to execute
+    /// @return results The result data of each executed message
+    function exec(bytes[] calldata msgs) external returns (bytes[] memory results);
+
+    /// @dev Queries the grants from the granter to the grantee.
+    /// @param granter The address of the granter
+    /// @param grantee The address of the grantee
+    /// @param msgTypeUrl The type URL of the message to filter the grants by, or empty for all of them
+    /// @param pageRequest Pagination request
+    /// @return grants The grants from the granter to the grantee
+    /// @return pageResponse Pagination response
+    function grants(
+        address granter,
+        address grantee,
+        string calldata msgTypeUrl,
+        PageRequest calldata pageRequest
+    ) external view returns (GrantAuthorization[] memory grants, PageResponse memory pageResponse);
+
+    /// @dev Queries the grants given by the granter.
+    /// @param granter The address of the granter
+    /// @param pageRequest Pagination request
+    /// @return grants The grants given by the granter
+    /// @return pageResponse Pagination response
+    function granterGrants(
+        address granter,
+        PageRequest calldata pageRequest
+    ) external view returns (GrantAuthorization[] memory grants, PageResponse memory pageResponse);
+
+    /// @dev Queries the grants received by the grantee.
+    /// @param grantee The address of the grantee
+    /// @param pageRequest Pagination request
+    /// @return grants The grants received by the grantee
+    /// @return pageResponse Pagination response
+    function granteeGrants(
+        address grantee,
+        PageRequest calldata pageRequest
+    ) external view returns (GrantAuthorization[] memory grants, PageResponse memory pageResponse);
+}
@@ -0,0 +1,18 @@
+package authz
+
+const (
+	// ErrInvalidGrantee is raised when the grantee address is not valid.
+	ErrInvalidGrantee = "invalid grantee address: %s"
+	// ErrInvalidGranter is raised when the granter address is not valid.
+	ErrInvalidGranter = "invalid granter address: %s"
+	// ErrInvalidMsgTypeURL is raised when the message type URL of a grant is not valid.
+	ErrInvalidMsgTypeURL = "invalid msg type url: %q"
+	// ErrInvalidExpiration is raised when the expiration of a grant is not valid.
+	ErrInvalidExpiration = "invalid expiration: %d"
+	// ErrNoMsgs is raised when no messages are given to the exec transaction.
+	ErrNoMsgs = "no messages to execute"
+	// ErrInvalidMsgJSON is raised when a message of the exec transaction cannot be decoded.
+	ErrInvalidMsgJSON = "invalid message JSON: %v"
+	// ErrMsgNotAllowed is raised when a message type cannot be executed through the precompile.
+	ErrMsgNotAllowed = "message type %s is not allowed"
+)
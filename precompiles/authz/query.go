@@ -0,0 +1,156 @@
+package authz
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	sdkauthz "github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// Grants returns the grants from the granter to the grantee. If the message
+// type URL is not empty, only the grant of that message type is returned.
+func (p Precompile) Grants(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 4 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	var input GrantsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to GrantsInput: %s", err)
+	}
+
+	res, err := p.AuthzKeeper.Grants(ctx, &sdkauthz.QueryGrantsRequest{
+		Granter:    sdk.AccAddress(input.Granter.Bytes()).String(),
+		Grantee:    sdk.AccAddress(input.Grantee.Bytes()).String(),
+		MsgTypeUrl: input.MsgTypeUrl,
+		Pagination: &input.PageRequest,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	grants := make([]GrantAuthorization, len(res.Grants))
+	for i, grant := range res.Grants {
+		if grants[i], err = NewGrantAuthorization(p.cdc, input.Granter, input.Grantee, grant); err != nil {
+			return nil, err
+		}
+	}
+
+	return method.Outputs.Pack(grants, pageResponse(res.Pagination))
+}
+
+// GranterGrants returns the grants given by the granter.
+func (p Precompile) GranterGrants(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input GranterGrantsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to GranterGrantsInput: %s", err)
+	}
+
+	if input.Granter == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGranter, input.Granter)
+	}
+
+	res, err := p.AuthzKeeper.GranterGrants(ctx, &sdkauthz.QueryGranterGrantsRequest{
+		Granter:    sdk.AccAddress(input.Granter.Bytes()).String(),
+		Pagination: &input.PageRequest,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	grants, err := p.newGrantAuthorizations(res.Grants)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(grants, pageResponse(res.Pagination))
+}
+
+// GranteeGrants returns the grants received by the grantee.
+func (p Precompile) GranteeGrants(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input GranteeGrantsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to GranteeGrantsInput: %s", err)
+	}
+
+	if input.Grantee == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGrantee, input.Grantee)
+	}
+
+	res, err := p.AuthzKeeper.GranteeGrants(ctx, &sdkauthz.QueryGranteeGrantsRequest{
+		Grantee:    sdk.AccAddress(input.Grantee.Bytes()).String(),
+		Pagination: &input.PageRequest,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	grants, err := p.newGrantAuthorizations(res.Grants)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(grants, pageResponse(res.Pagination))
+}
+
+// newGrantAuthorizations converts the grant authorizations returned by the
+// authz queries to their Solidity representation.
+func (p Precompile) newGrantAuthorizations(grants []*sdkauthz.GrantAuthorization) ([]GrantAuthorization, error) {
+	res := make([]GrantAuthorization, len(grants))
+	for i, grant := range grants {
+		granter, err := cmn.HexAddressFromBech32String(grant.Granter)
+		if err != nil {
+			return nil, err
+		}
+
+		grantee, err := cmn.HexAddressFromBech32String(grant.Grantee)
+		if err != nil {
+			return nil, err
+		}
+
+		res[i], err = NewGrantAuthorization(p.cdc, granter, grantee, &sdkauthz.Grant{
+			Authorization: grant.Authorization,
+			Expiration:    grant.Expiration,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
+
+// pageResponse returns the given page response, or an empty one if it is nil
+// so that it can be packed.
+func pageResponse(res *query.PageResponse) query.PageResponse {
+	if res == nil {
+		return query.PageResponse{}
+	}
+	return *res
+}
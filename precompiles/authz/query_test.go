@@ -0,0 +1,109 @@
+package authz_test
+
+import (
+	"github.com/cosmos/evm/precompiles/authz"
+	"github.com/cosmos/evm/precompiles/testutil"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func (s *PrecompileTestSuite) TestGrants() {
+	method := s.precompile.Methods[authz.GrantsMethod]
+
+	testCases := []struct {
+		name        string
+		msgTypeURL  string
+		expPass     bool
+		errContains string
+	}{
+		{
+			"fail - grant of msg type not found",
+			"/cosmos.gov.v1.MsgVote",
+			false,
+			"authorization not found",
+		},
+		{
+			"pass - grant of msg type",
+			msgSendTypeURL,
+			true,
+			"",
+		},
+		{
+			"pass - all grants",
+			"",
+			true,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			granter, grantee := s.keyring.GetAddr(0), s.keyring.GetAddr(1)
+			_, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), granter, s.precompile, 200_000)
+			s.newGrant(ctx, granter, grantee)
+
+			bz, err := s.precompile.Grants(ctx, &method, []interface{}{granter, grantee, tc.msgTypeURL, query.PageRequest{}})
+
+			if tc.expPass {
+				s.Require().NoError(err)
+				var out authz.GrantsOutput
+				err = s.precompile.UnpackIntoInterface(&out, method.Name, bz)
+				s.Require().NoError(err)
+				s.Require().Len(out.Grants, 1)
+				s.Require().Equal(granter, out.Grants[0].Granter)
+				s.Require().Equal(grantee, out.Grants[0].Grantee)
+				s.Require().Contains(out.Grants[0].Authorization, msgSendTypeURL)
+				s.Require().Zero(out.Grants[0].Expiration)
+			} else {
+				s.Require().ErrorContains(err, tc.errContains)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGranterGrants() {
+	method := s.precompile.Methods[authz.GranterGrantsMethod]
+	s.SetupTest()
+
+	granter, grantee := s.keyring.GetAddr(0), s.keyring.GetAddr(1)
+	_, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), granter, s.precompile, 200_000)
+	s.newGrant(ctx, granter, grantee)
+
+	bz, err := s.precompile.GranterGrants(ctx, &method, []interface{}{granter, query.PageRequest{CountTotal: true}})
+	s.Require().NoError(err)
+
+	var out authz.GrantsOutput
+	err = s.precompile.UnpackIntoInterface(&out, method.Name, bz)
+	s.Require().NoError(err)
+	s.Require().Len(out.Grants, 1)
+	s.Require().Equal(grantee, out.Grants[0].Grantee)
+	s.Require().Equal(uint64(1), out.PageResponse.Total)
+
+	// the grantee has not given any grant
+	bz, err = s.precompile.GranterGrants(ctx, &method, []interface{}{grantee, query.PageRequest{}})
+	s.Require().NoError(err)
+	err = s.precompile.UnpackIntoInterface(&out, method.Name, bz)
+	s.Require().NoError(err)
+	s.Require().Empty(out.Grants)
+}
+
+func (s *PrecompileTestSuite) TestGranteeGrants() {
+	method := s.precompile.Methods[authz.GranteeGrantsMethod]
+	s.SetupTest()
+
+	granter, grantee := s.keyring.GetAddr(0), s.keyring.GetAddr(1)
+	_, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), granter, s.precompile, 200_000)
+	s.newGrant(ctx, granter, grantee)
+
+	bz, err := s.precompile.GranteeGrants(ctx, &method, []interface{}{grantee, query.PageRequest{CountTotal: true}})
+	s.Require().NoError(err)
+
+	var out authz.GrantsOutput
+	err = s.precompile.UnpackIntoInterface(&out, method.Name, bz)
+	s.Require().NoError(err)
+	s.Require().Len(out.Grants, 1)
+	s.Require().Equal(granter, out.Grants[0].Granter)
+	s.Require().Equal(uint64(1), out.PageResponse.Total)
+}
@@ -0,0 +1,168 @@
+package authz
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// Grant grants a generic authorization for the given message type URL from the
+// caller to the grantee, with an optional expiration.
+func (p Precompile) Grant(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	granter := contract.CallerAddress
+	msg, err := NewMsgGrant(method, granter, args)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = p.AuthzKeeper.Grant(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	grantee := common.BytesToAddress(sdk.MustAccAddressFromBech32(msg.Grantee))
+	authorization, err := msg.GetAuthorization()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitGrantEvent(ctx, stateDB, granter, grantee, authorization.MsgTypeURL()); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Revoke revokes the grant of the given message type URL from the caller to
+// the grantee.
+func (p Precompile) Revoke(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	granter := contract.CallerAddress
+	msg, grantee, err := NewMsgRevoke(method, granter, args)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = p.AuthzKeeper.Revoke(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitRevokeEvent(ctx, stateDB, granter, grantee, msg.MsgTypeUrl); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Exec executes the given JSON-encoded messages with the grants of the caller,
+// returning the result data of each message.
+//
+// As the executed messages can move funds between arbitrary accounts, the
+// balance changes of the EVM coin are taken from the bank events emitted during
+// the execution and mirrored to the EVM stateDB.
+func (p *Precompile) Exec(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	grantee := contract.CallerAddress
+	msg, typeURLs, err := NewMsgExec(grantee, args, p.cdc, p.allowedExecMsgs)
+	if err != nil {
+		return nil, err
+	}
+
+	execCtx := ctx.WithEventManager(sdk.NewEventManager())
+	res, err := p.AuthzKeeper.Exec(execCtx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	events := execCtx.EventManager().Events()
+	ctx.EventManager().EmitEvents(events)
+
+	entries, err := balanceChangeEntries(events)
+	if err != nil {
+		return nil, err
+	}
+	p.SetBalanceChangeEntries(entries...)
+
+	if err = p.EmitExecEvent(ctx, stateDB, grantee, typeURLs); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.Results)
+}
+
+// balanceChangeEntries returns the balance changes of the EVM coin from the
+// coin spent and coin received events emitted by the bank module.
+//
+// NOTE: This ensures that the changes in the bank keeper are correctly mirrored to the EVM stateDB.
+// This prevents the stateDB from overwriting the changed balance in the bank keeper when committing the EVM state.
+func balanceChangeEntries(events sdk.Events) ([]cmn.BalanceChangeEntry, error) {
+	var entries []cmn.BalanceChangeEntry
+	for _, event := range events {
+		var (
+			addrKey string
+			op      cmn.Operation
+		)
+
+		switch event.Type {
+		case banktypes.EventTypeCoinSpent:
+			addrKey, op = banktypes.AttributeKeySpender, cmn.Sub
+		case banktypes.EventTypeCoinReceived:
+			addrKey, op = banktypes.AttributeKeyReceiver, cmn.Add
+		default:
+			continue
+		}
+
+		var (
+			account common.Address
+			amount  *big.Int
+		)
+
+		for _, attr := range event.Attributes {
+			switch attr.Key {
+			case addrKey:
+				accAddr, err := sdk.AccAddressFromBech32(attr.Value)
+				if err != nil {
+					return nil, err
+				}
+				account = common.BytesToAddress(accAddr)
+			case sdk.AttributeKeyAmount:
+				coins, err := sdk.ParseCoinsNormalized(attr.Value)
+				if err != nil {
+					return nil, err
+				}
+				if evmAmount := coins.AmountOf(evmtypes.GetEVMCoinDenom()); evmAmount.IsPositive() {
+					amount = evmtypes.ConvertAmountTo18DecimalsBigInt(evmAmount.BigInt())
+				}
+			}
+		}
+
+		if amount != nil {
+			entries = append(entries, cmn.NewBalanceChangeEntry(account, amount, op))
+		}
+	}
+
+	return entries, nil
+}
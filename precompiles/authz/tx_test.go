@@ -0,0 +1,439 @@
+package authz_test
+
+import (
+	"time"
+
+	"github.com/cosmos/evm/precompiles/authz"
+	"github.com/cosmos/evm/precompiles/testutil"
+	cosmosevmutiltx "github.com/cosmos/evm/testutil/tx"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkauthz "github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var msgSendTypeURL = sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+func (s *PrecompileTestSuite) TestGrant() {
+	method := s.precompile.Methods[authz.GrantMethod]
+	grantee := cosmosevmutiltx.GenerateAddress()
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expPass     bool
+		errContains string
+		postCheck   func(ctx sdk.Context)
+	}{
+		{
+			"fail - invalid number of arguments",
+			func() []interface{} {
+				return []interface{}{grantee}
+			},
+			false,
+			"invalid number of arguments",
+			nil,
+		},
+		{
+			"fail - empty msg type url",
+			func() []interface{} {
+				return []interface{}{grantee, "", int64(0)}
+			},
+			false,
+			"invalid msg type url",
+			nil,
+		},
+		{
+			"fail - grantee is the granter",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), msgSendTypeURL, int64(0)}
+			},
+			false,
+			sdkauthz.ErrGranteeIsGranter.Error(),
+			nil,
+		},
+		{
+			"fail - unknown msg type url",
+			func() []interface{} {
+				return []interface{}{grantee, "/cosmos.bank.v1beta1.MsgUnknown", int64(0)}
+			},
+			false,
+			"doesn't exist",
+			nil,
+		},
+		{
+			"pass - grant without expiration",
+			func() []interface{} {
+				return []interface{}{grantee, msgSendTypeURL, int64(0)}
+			},
+			true,
+			"",
+			func(ctx sdk.Context) {
+				authorization, expiration := s.network.App.AuthzKeeper.GetAuthorization(ctx, grantee.Bytes(), s.keyring.GetAccAddr(0), msgSendTypeURL)
+				s.Require().NotNil(authorization)
+				s.Require().Nil(expiration)
+			},
+		},
+		{
+			"pass - grant with expiration",
+			func() []interface{} {
+				return []interface{}{grantee, msgSendTypeURL, s.network.GetContext().BlockTime().Add(time.Hour).Unix()}
+			},
+			true,
+			"",
+			func(ctx sdk.Context) {
+				authorization, expiration := s.network.App.AuthzKeeper.GetAuthorization(ctx, grantee.Bytes(), s.keyring.GetAccAddr(0), msgSendTypeURL)
+				s.Require().NotNil(authorization)
+				s.Require().NotNil(expiration)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile, 200_000)
+
+			bz, err := s.precompile.Grant(ctx, contract, s.network.GetStateDB(), &method, tc.malleate())
+
+			if tc.expPass {
+				s.Require().NoError(err)
+				var success bool
+				err = s.precompile.UnpackIntoInterface(&success, method.Name, bz)
+				s.Require().NoError(err)
+				s.Require().True(success)
+				tc.postCheck(ctx)
+			} else {
+				s.Require().ErrorContains(err, tc.errContains)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRevoke() {
+	method := s.precompile.Methods[authz.RevokeMethod]
+	grantee := s.keyring.GetAddr(1)
+
+	testCases := []struct {
+		name        string
+		malleate    func(ctx sdk.Context) []interface{}
+		expPass     bool
+		errContains string
+	}{
+		{
+			"fail - invalid number of arguments",
+			func(_ sdk.Context) []interface{} {
+				return []interface{}{grantee}
+			},
+			false,
+			"invalid number of arguments",
+		},
+		{
+			"fail - grant not found",
+			func(_ sdk.Context) []interface{} {
+				return []interface{}{grantee, msgSendTypeURL}
+			},
+			false,
+			"authorization not found",
+		},
+		{
+			"pass - revoke existing grant",
+			func(ctx sdk.Context) []interface{} {
+				s.newGrant(ctx, s.keyring.GetAddr(0), grantee)
+				return []interface{}{grantee, msgSendTypeURL}
+			},
+			true,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile, 200_000)
+
+			bz, err := s.precompile.Revoke(ctx, contract, s.network.GetStateDB(), &method, tc.malleate(ctx))
+
+			if tc.expPass {
+				s.Require().NoError(err)
+				var success bool
+				err = s.precompile.UnpackIntoInterface(&success, method.Name, bz)
+				s.Require().NoError(err)
+				s.Require().True(success)
+
+				authorization, _ := s.network.App.AuthzKeeper.GetAuthorization(ctx, grantee.Bytes(), s.keyring.GetAccAddr(0), msgSendTypeURL)
+				s.Require().Nil(authorization)
+			} else {
+				s.Require().ErrorContains(err, tc.errContains)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestExec() {
+	method := s.precompile.Methods[authz.ExecMethod]
+	receiver := cosmosevmutiltx.GenerateAddress()
+	amount := math.NewInt(1e18)
+
+	// newMsgSend returns the JSON-encoded MsgSend of the granter funds to the receiver
+	newMsgSend := func() []byte {
+		msg := banktypes.NewMsgSend(s.keyring.GetAccAddr(1), receiver.Bytes(), sdk.NewCoins(sdk.NewCoin(s.network.GetBaseDenom(), amount)))
+		bz, err := s.network.App.AppCodec().MarshalInterfaceJSON(msg)
+		s.Require().NoError(err)
+		return bz
+	}
+
+	// newMsgTransfer returns the ICS20 transfer of the granter coins of the given denomination
+	newMsgTransfer := func(denom string) *transfertypes.MsgTransfer {
+		return transfertypes.NewMsgTransfer(
+			transfertypes.PortID,
+			"channel-0",
+			sdk.Coin{Denom: denom, Amount: amount},
+			s.keyring.GetAccAddr(1).String(),
+			"cosmos1receiver",
+			clienttypes.NewHeight(1, 100),
+			0,
+			"",
+		)
+	}
+
+	// newNestedExec returns the JSON-encoded msg wrapped in depth nested MsgExec
+	newNestedExec := func(depth int, msg sdk.Msg) []byte {
+		for i := 0; i < depth; i++ {
+			exec := sdkauthz.NewMsgExec(s.keyring.GetAccAddr(0), []sdk.Msg{msg})
+			msg = &exec
+		}
+		bz, err := s.network.App.AppCodec().MarshalInterfaceJSON(msg)
+		s.Require().NoError(err)
+		return bz
+	}
+
+	testCases := []struct {
+		name        string
+		malleate    func(ctx sdk.Context) []interface{}
+		expPass     bool
+		errContains string
+	}{
+		{
+			"fail - invalid number of arguments",
+			func(_ sdk.Context) []interface{} {
+				return []interface{}{}
+			},
+			false,
+			"invalid number of arguments",
+		},
+		{
+			"fail - no messages",
+			func(_ sdk.Context) []interface{} {
+				return []interface{}{[][]byte{}}
+			},
+			false,
+			authz.ErrNoMsgs,
+		},
+		{
+			"fail - invalid message JSON",
+			func(_ sdk.Context) []interface{} {
+				return []interface{}{[][]byte{[]byte("{")}}
+			},
+			false,
+			"invalid message JSON",
+		},
+		{
+			"fail - EVM transactions are not allowed",
+			func(_ sdk.Context) []interface{} {
+				bz, err := s.network.App.AppCodec().MarshalInterfaceJSON(&evmtypes.MsgEthereumTx{})
+				s.Require().NoError(err)
+				return []interface{}{[][]byte{bz}}
+			},
+			false,
+			"is not allowed",
+		},
+		{
+			"fail - EVM calls are not allowed",
+			func(_ sdk.Context) []interface{} {
+				bz, err := s.network.App.AppCodec().MarshalInterfaceJSON(&evmtypes.MsgCallEVM{})
+				s.Require().NoError(err)
+				return []interface{}{[][]byte{bz}}
+			},
+			false,
+			"is not allowed",
+		},
+		{
+			"fail - contract deployments are not allowed",
+			func(_ sdk.Context) []interface{} {
+				bz, err := s.network.App.AppCodec().MarshalInterfaceJSON(&evmtypes.MsgDeployContract{})
+				s.Require().NoError(err)
+				return []interface{}{[][]byte{bz}}
+			},
+			false,
+			"is not allowed",
+		},
+		{
+			"fail - EVM transactions nested in a MsgExec are not allowed",
+			func(_ sdk.Context) []interface{} {
+				return []interface{}{[][]byte{newNestedExec(1, &evmtypes.MsgEthereumTx{})}}
+			},
+			false,
+			"is not allowed",
+		},
+		{
+			"fail - EVM transactions nested in several MsgExec are not allowed",
+			func(_ sdk.Context) []interface{} {
+				return []interface{}{[][]byte{newNestedExec(3, &evmtypes.MsgEthereumTx{})}}
+			},
+			false,
+			"is not allowed",
+		},
+		{
+			"fail - EVM calls nested in a MsgExec are not allowed",
+			func(_ sdk.Context) []interface{} {
+				return []interface{}{[][]byte{newNestedExec(2, &evmtypes.MsgCallEVM{})}}
+			},
+			false,
+			"is not allowed",
+		},
+		{
+			"fail - contract deployments nested in a MsgExec are not allowed",
+			func(_ sdk.Context) []interface{} {
+				return []interface{}{[][]byte{newNestedExec(2, &evmtypes.MsgDeployContract{})}}
+			},
+			false,
+			"is not allowed",
+		},
+		{
+			"fail - ERC20 conversions are not allowed",
+			func(_ sdk.Context) []interface{} {
+				msg := erc20types.NewMsgConvertERC20(amount, s.keyring.GetAccAddr(1), cosmosevmutiltx.GenerateAddress(), s.keyring.GetAddr(1))
+				bz, err := s.network.App.AppCodec().MarshalInterfaceJSON(msg)
+				s.Require().NoError(err)
+				return []interface{}{[][]byte{bz}}
+			},
+			false,
+			"is not allowed",
+		},
+		{
+			"fail - ERC20 conversions nested in a MsgExec are not allowed",
+			func(_ sdk.Context) []interface{} {
+				msg := erc20types.NewMsgConvertERC20(amount, s.keyring.GetAccAddr(1), cosmosevmutiltx.GenerateAddress(), s.keyring.GetAddr(1))
+				return []interface{}{[][]byte{newNestedExec(2, msg)}}
+			},
+			false,
+			"is not allowed",
+		},
+		{
+			"fail - ICS20 transfers of an ERC20 token pair are not allowed",
+			func(_ sdk.Context) []interface{} {
+				msg := newMsgTransfer(erc20types.CreateDenom(cosmosevmutiltx.GenerateAddress().String()))
+				bz, err := s.network.App.AppCodec().MarshalInterfaceJSON(msg)
+				s.Require().NoError(err)
+				return []interface{}{[][]byte{bz}}
+			},
+			false,
+			"is not allowed",
+		},
+		{
+			"fail - ICS20 transfers of an ERC20 contract address nested in a MsgExec are not allowed",
+			func(_ sdk.Context) []interface{} {
+				msg := newMsgTransfer(cosmosevmutiltx.GenerateAddress().String())
+				return []interface{}{[][]byte{newNestedExec(1, msg)}}
+			},
+			false,
+			"is not allowed",
+		},
+		{
+			"fail - messages that aren't allowed",
+			func(_ sdk.Context) []interface{} {
+				msg := &erc20types.MsgConvertCoin{
+					Coin:     sdk.NewCoin(s.network.GetBaseDenom(), amount),
+					Receiver: s.keyring.GetAddr(1).Hex(),
+					Sender:   s.keyring.GetAccAddr(1).String(),
+				}
+				bz, err := s.network.App.AppCodec().MarshalInterfaceJSON(msg)
+				s.Require().NoError(err)
+				return []interface{}{[][]byte{bz}}
+			},
+			false,
+			"is not allowed",
+		},
+		{
+			"fail - messages that aren't allowed nested in a MsgExec",
+			func(_ sdk.Context) []interface{} {
+				msg := &erc20types.MsgReleaseRegistrationDeposit{
+					Authority: s.keyring.GetAccAddr(1).String(),
+					Token:     cosmosevmutiltx.GenerateAddress().Hex(),
+				}
+				return []interface{}{[][]byte{newNestedExec(2, msg)}}
+			},
+			false,
+			"is not allowed",
+		},
+		{
+			"fail - granted send removed from the allowed messages",
+			func(ctx sdk.Context) []interface{} {
+				s.newGrant(ctx, s.keyring.GetAddr(1), s.keyring.GetAddr(0))
+				s.precompile.SetAllowedExecMsgs(sdk.MsgTypeURL(&sdkauthz.MsgExec{}))
+				return []interface{}{[][]byte{newMsgSend()}}
+			},
+			false,
+			"is not allowed",
+		},
+		{
+			"fail - no grant for the ICS20 transfer of a coin",
+			func(_ sdk.Context) []interface{} {
+				bz, err := s.network.App.AppCodec().MarshalInterfaceJSON(newMsgTransfer(s.network.GetBaseDenom()))
+				s.Require().NoError(err)
+				return []interface{}{[][]byte{bz}}
+			},
+			false,
+			"authorization not found",
+		},
+		{
+			"fail - no grant",
+			func(_ sdk.Context) []interface{} {
+				return []interface{}{[][]byte{newMsgSend()}}
+			},
+			false,
+			"authorization not found",
+		},
+		{
+			"pass - execute granted send",
+			func(ctx sdk.Context) []interface{} {
+				s.newGrant(ctx, s.keyring.GetAddr(1), s.keyring.GetAddr(0))
+				return []interface{}{[][]byte{newMsgSend()}}
+			},
+			true,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile, 200_000)
+
+			bz, err := s.precompile.Exec(ctx, contract, s.network.GetStateDB(), &method, tc.malleate(ctx))
+
+			if tc.expPass {
+				s.Require().NoError(err)
+				var results [][]byte
+				err = s.precompile.UnpackIntoInterface(&results, method.Name, bz)
+				s.Require().NoError(err)
+				s.Require().Len(results, 1)
+
+				balance := s.network.App.BankKeeper.GetBalance(ctx, receiver.Bytes(), s.network.GetBaseDenom())
+				s.Require().Equal(amount, balance.Amount)
+			} else {
+				s.Require().ErrorContains(err, tc.errContains)
+			}
+		})
+	}
+}
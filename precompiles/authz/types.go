@@ -0,0 +1,268 @@
+package authz
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	sdkauthz "github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+const (
+	// GrantMethod defines the ABI method name for the authz Grant transaction.
+	GrantMethod = "grant"
+	// RevokeMethod defines the ABI method name for the authz Revoke transaction.
+	RevokeMethod = "revoke"
+	// ExecMethod defines the ABI method name for the authz Exec transaction.
+	ExecMethod = "exec"
+	// GrantsMethod defines the ABI method name for the authz Grants query.
+	GrantsMethod = "grants"
+	// GranterGrantsMethod defines the ABI method name for the authz GranterGrants query.
+	GranterGrantsMethod = "granterGrants"
+	// GranteeGrantsMethod defines the ABI method name for the authz GranteeGrants query.
+	GranteeGrantsMethod = "granteeGrants"
+)
+
+// DefaultAllowedExecMsgs defines the message type URLs that can be executed
+// through the precompile by default. None of them reenters the EVM.
+var DefaultAllowedExecMsgs = []string{
+	sdk.MsgTypeURL(&banktypes.MsgSend{}),
+	sdk.MsgTypeURL(&banktypes.MsgMultiSend{}),
+	sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}),
+	sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{}),
+	sdk.MsgTypeURL(&stakingtypes.MsgBeginRedelegate{}),
+	sdk.MsgTypeURL(&stakingtypes.MsgCancelUnbondingDelegation{}),
+	sdk.MsgTypeURL(&distrtypes.MsgWithdrawDelegatorReward{}),
+	sdk.MsgTypeURL(&distrtypes.MsgWithdrawValidatorCommission{}),
+	sdk.MsgTypeURL(&distrtypes.MsgSetWithdrawAddress{}),
+	sdk.MsgTypeURL(&distrtypes.MsgFundCommunityPool{}),
+	sdk.MsgTypeURL(&govv1.MsgVote{}),
+	sdk.MsgTypeURL(&govv1.MsgVoteWeighted{}),
+	sdk.MsgTypeURL(&govv1.MsgDeposit{}),
+	sdk.MsgTypeURL(&transfertypes.MsgTransfer{}),
+	sdk.MsgTypeURL(&sdkauthz.MsgExec{}),
+}
+
+// GrantInput defines the input for the Grant transaction.
+type GrantInput struct {
+	Grantee    common.Address
+	MsgTypeUrl string //nolint:revive,stylecheck
+	Expiration int64
+}
+
+// RevokeInput defines the input for the Revoke transaction.
+type RevokeInput struct {
+	Grantee    common.Address
+	MsgTypeUrl string //nolint:revive,stylecheck
+}
+
+// GrantsInput defines the input for the Grants query.
+type GrantsInput struct {
+	Granter     common.Address
+	Grantee     common.Address
+	MsgTypeUrl  string //nolint:revive,stylecheck
+	PageRequest query.PageRequest
+}
+
+// GranterGrantsInput defines the input for the GranterGrants query.
+type GranterGrantsInput struct {
+	Granter     common.Address
+	PageRequest query.PageRequest
+}
+
+// GranteeGrantsInput defines the input for the GranteeGrants query.
+type GranteeGrantsInput struct {
+	Grantee     common.Address
+	PageRequest query.PageRequest
+}
+
+// GrantAuthorization represents the Solidity GrantAuthorization struct. The
+// authorization is JSON-encoded with its type URL and the expiration is a unix
+// timestamp in seconds, or zero if the grant does not expire.
+type GrantAuthorization struct {
+	Granter       common.Address
+	Grantee       common.Address
+	Authorization string
+	Expiration    int64
+}
+
+// GrantsOutput defines the output for the Grants, GranterGrants and
+// GranteeGrants queries.
+type GrantsOutput struct {
+	Grants       []GrantAuthorization
+	PageResponse query.PageResponse
+}
+
+// NewMsgGrant creates a new MsgGrant instance that grants a generic
+// authorization for the given message type URL from the granter to the
+// grantee.
+func NewMsgGrant(method *abi.Method, granter common.Address, args []interface{}) (*sdkauthz.MsgGrant, error) {
+	if len(args) != 3 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	var input GrantInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to GrantInput: %s", err)
+	}
+
+	if input.Grantee == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGrantee, input.Grantee)
+	}
+
+	if input.MsgTypeUrl == "" {
+		return nil, fmt.Errorf(ErrInvalidMsgTypeURL, input.MsgTypeUrl)
+	}
+
+	if input.Expiration < 0 {
+		return nil, fmt.Errorf(ErrInvalidExpiration, input.Expiration)
+	}
+
+	var expiration *time.Time
+	if input.Expiration != 0 {
+		exp := time.Unix(input.Expiration, 0).UTC()
+		expiration = &exp
+	}
+
+	return sdkauthz.NewMsgGrant(
+		granter.Bytes(),
+		input.Grantee.Bytes(),
+		sdkauthz.NewGenericAuthorization(input.MsgTypeUrl),
+		expiration,
+	)
+}
+
+// NewMsgRevoke creates a new MsgRevoke instance that revokes the grant of the
+// given message type URL from the granter to the grantee.
+func NewMsgRevoke(method *abi.Method, granter common.Address, args []interface{}) (*sdkauthz.MsgRevoke, common.Address, error) {
+	if len(args) != 2 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input RevokeInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, common.Address{}, fmt.Errorf("error while unpacking args to RevokeInput: %s", err)
+	}
+
+	if input.Grantee == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidGrantee, input.Grantee)
+	}
+
+	msg := sdkauthz.NewMsgRevoke(granter.Bytes(), input.Grantee.Bytes(), input.MsgTypeUrl)
+	return &msg, input.Grantee, nil
+}
+
+// NewMsgExec creates a new MsgExec instance that executes the given
+// JSON-encoded messages on behalf of their signers with the grants of the
+// grantee. Only the allowed message types, directly or nested in another
+// MsgExec, can be executed, so that the messages can't reenter the EVM.
+func NewMsgExec(grantee common.Address, args []interface{}, cdc codec.Codec, allowedMsgs map[string]bool) (*sdkauthz.MsgExec, []string, error) {
+	if len(args) != 1 {
+		return nil, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	jsonMsgs, ok := args[0].([][]byte)
+	if !ok {
+		return nil, nil, fmt.Errorf(cmn.ErrInvalidType, "msgs", [][]byte{}, args[0])
+	}
+
+	if len(jsonMsgs) == 0 {
+		return nil, nil, errors.New(ErrNoMsgs)
+	}
+
+	msgs := make([]sdk.Msg, len(jsonMsgs))
+	typeURLs := make([]string, len(jsonMsgs))
+	for i, jsonMsg := range jsonMsgs {
+		var msg sdk.Msg
+		if err := cdc.UnmarshalInterfaceJSON(jsonMsg, &msg); err != nil {
+			return nil, nil, fmt.Errorf(ErrInvalidMsgJSON, err)
+		}
+
+		if err := validateExecMsg(msg, allowedMsgs); err != nil {
+			return nil, nil, err
+		}
+
+		msgs[i] = msg
+		typeURLs[i] = sdk.MsgTypeURL(msg)
+	}
+
+	msg := sdkauthz.NewMsgExec(grantee.Bytes(), msgs)
+	return &msg, typeURLs, nil
+}
+
+// validateExecMsg returns an error if the message type isn't allowed. The
+// allowed messages must not reenter the EVM, neither directly (EVM
+// transactions, contract calls and deployments) nor through the ERC20
+// conversions and compliance checks of the erc20 module. The ICS20 transfers
+// are only allowed for coins, as the transfer of a native ERC20 token converts
+// the ERC20 tokens to coins first. It walks the messages of nested MsgExec so
+// that they can't be used to wrap a message that isn't allowed.
+func validateExecMsg(msg sdk.Msg, allowedMsgs map[string]bool) error {
+	typeURL := sdk.MsgTypeURL(msg)
+	if !allowedMsgs[typeURL] {
+		return fmt.Errorf(ErrMsgNotAllowed, typeURL)
+	}
+
+	switch msg := msg.(type) {
+	case *transfertypes.MsgTransfer:
+		// the denomination of a native ERC20 token is either the contract address
+		// or the coin denomination of the token pair, prefixed by the erc20 module
+		// name
+		if common.IsHexAddress(strings.TrimPrefix(msg.Token.Denom, erc20types.ModuleName+"/")) {
+			return fmt.Errorf(ErrMsgNotAllowed, typeURL)
+		}
+	case *sdkauthz.MsgExec:
+		nestedMsgs, err := msg.GetMessages()
+		if err != nil {
+			return fmt.Errorf(ErrInvalidMsgJSON, err)
+		}
+
+		for _, nestedMsg := range nestedMsgs {
+			if err := validateExecMsg(nestedMsg, allowedMsgs); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// NewGrantAuthorization creates a new GrantAuthorization from the given
+// granter, grantee and grant, encoding the authorization with the codec.
+func NewGrantAuthorization(
+	cdc codec.Codec,
+	granter, grantee common.Address,
+	authorization *sdkauthz.Grant,
+) (GrantAuthorization, error) {
+	authorizationJSON, err := cdc.MarshalJSON(authorization.Authorization)
+	if err != nil {
+		return GrantAuthorization{}, err
+	}
+
+	var expiration int64
+	if authorization.Expiration != nil {
+		expiration = authorization.Expiration.Unix()
+	}
+
+	return GrantAuthorization{
+		Granter:       granter,
+		Grantee:       grantee,
+		Authorization: string(authorizationJSON),
+		Expiration:    expiration,
+	}, nil
+}
@@ -1,6 +1,8 @@
 // SPDX-License-Identifier: LGPL-3.0-only
 pragma solidity >=0.8.18;
 
+import "../common/Types.sol";
+
 /// @dev The IBank contract's address.
 address constant IBANK_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000804;
 
@@ -15,12 +17,47 @@ struct Balance {
     uint256 amount;
 }
 
+/// @dev Output specifies the recipient and the amount of a multiSend transaction.
+struct Output {
+    /// to defines the recipient address.
+    address to;
+    /// amount of coins to send to the recipient.
+    Coin[] amount;
+}
+
 /**
  * @author Evmos Team
  * @title Bank Interface
- * @dev Interface for querying balances and supply from the Bank module.
+ * @dev Interface for querying balances and supply from the Bank module and
+ * sending native coins.
  */
 interface IBank {
+    /// @dev Send defines an Event emitted when coins are sent.
+    /// @param from the address of the sender
+    /// @param to the address of the recipient
+    /// @param amount the coins sent
+    event Send(address indexed from, address indexed to, Coin[] amount);
+
+    /// @dev send defines a method for sending native coins from the caller to
+    /// the given address. The x/bank send restrictions and blocked addresses
+    /// are enforced.
+    /// @param to the address of the recipient.
+    /// @param amount the coins to send.
+    /// @return success Whether the transaction was successful or not
+    function send(
+        address to,
+        Coin[] calldata amount
+    ) external returns (bool success);
+
+    /// @dev multiSend defines a method for sending native coins from the caller
+    /// to multiple addresses. The x/bank send restrictions and blocked addresses
+    /// are enforced.
+    /// @param outputs the recipients and the coins to send to each of them.
+    /// @return success Whether the transaction was successful or not
+    function multiSend(
+        Output[] calldata outputs
+    ) external returns (bool success);
+
     /// @dev balances defines a method for retrieving all the native token balances
     /// for a given account.
     /// @param account the address of the account to query balances for.
@@ -0,0 +1,130 @@
+package bank
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+const (
+	// SendMethod defines the ABI method name for the bank Send
+	// transaction.
+	SendMethod = "send"
+	// MultiSendMethod defines the ABI method name for the bank MultiSend
+	// transaction.
+	MultiSendMethod = "multiSend"
+)
+
+// Send sends the given coins from the caller to the recipient address through
+// the x/bank message server, so the send enabled flags, the blocked addresses
+// and the send restrictions of the x/bank module are enforced.
+func (p *Precompile) Send(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	to, amount, err := ParseSendArgs(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	from := contract.CallerAddress
+	msg := banktypes.NewMsgSend(from.Bytes(), to.Bytes(), amount)
+
+	msgSrv := bankkeeper.NewMsgServerImpl(p.bankKeeper)
+	if _, err = msgSrv.Send(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if evmAmount := evmCoinAmount(amount); evmAmount != nil {
+		p.SetBalanceChangeEntries(
+			cmn.NewBalanceChangeEntry(from, evmAmount, cmn.Sub),
+			cmn.NewBalanceChangeEntry(to, evmAmount, cmn.Add),
+		)
+	}
+
+	if err = p.EmitSendEvent(ctx, stateDB, from, to, amount); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// MultiSend sends coins from the caller to each of the given outputs through
+// the x/bank message server. The caller is charged the gas of a single Send
+// for each output.
+func (p *Precompile) MultiSend(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	outputs, err := ParseMultiSendArgs(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: we already charged for a single send in RequiredGas so we don't
+	// need to charge for the first output
+	for i := 1; i < len(outputs); i++ {
+		ctx.GasMeter().ConsumeGas(GasSend, "bank extension multiSend method")
+	}
+
+	from := contract.CallerAddress
+	bankOutputs := make([]banktypes.Output, len(outputs))
+	total := sdk.NewCoins()
+	for i, output := range outputs {
+		bankOutputs[i] = banktypes.NewOutput(output.To.Bytes(), output.Amount)
+		total = total.Add(output.Amount...)
+	}
+
+	msg := banktypes.NewMsgMultiSend(banktypes.NewInput(from.Bytes(), total), bankOutputs)
+
+	msgSrv := bankkeeper.NewMsgServerImpl(p.bankKeeper)
+	if _, err = msgSrv.MultiSend(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if evmAmount := evmCoinAmount(total); evmAmount != nil {
+		entries := []cmn.BalanceChangeEntry{cmn.NewBalanceChangeEntry(from, evmAmount, cmn.Sub)}
+		for _, output := range outputs {
+			if outputAmount := evmCoinAmount(output.Amount); outputAmount != nil {
+				entries = append(entries, cmn.NewBalanceChangeEntry(output.To, outputAmount, cmn.Add))
+			}
+		}
+		p.SetBalanceChangeEntries(entries...)
+	}
+
+	for _, output := range outputs {
+		if err = p.EmitSendEvent(ctx, stateDB, from, output.To, output.Amount); err != nil {
+			return nil, err
+		}
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// evmCoinAmount returns the amount of the EVM coin in the given coins, scaled
+// to 18 decimals, or nil if there is none.
+//
+// NOTE: This ensures that the changes in the bank keeper are correctly mirrored to the EVM stateDB.
+// This prevents the stateDB from overwriting the changed balance in the bank keeper when committing the EVM state.
+func evmCoinAmount(coins sdk.Coins) *big.Int {
+	amount := coins.AmountOf(evmtypes.GetEVMCoinDenom())
+	if !amount.IsPositive() {
+		return nil
+	}
+
+	return evmtypes.ConvertAmountTo18DecimalsBigInt(amount.BigInt())
+}
@@ -0,0 +1,206 @@
+package bank_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/cosmos/evm/precompiles/bank"
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/testutil"
+	"github.com/cosmos/evm/testutil/integration/os/network"
+	cosmosevmutiltx "github.com/cosmos/evm/testutil/tx"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *PrecompileTestSuite) TestSend() {
+	var ctx sdk.Context
+	// setup test in order to have s.precompile defined
+	s.SetupTest()
+	method := s.precompile.Methods[bank.SendMethod]
+	receiver := cosmosevmutiltx.GenerateAddress()
+
+	testcases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expPass     bool
+		errContains string
+		postCheck   func()
+	}{
+		{
+			"fail - invalid number of arguments",
+			func() []interface{} {
+				return []interface{}{receiver}
+			},
+			false,
+			"invalid number of arguments",
+			func() {},
+		},
+		{
+			"fail - empty coins",
+			func() []interface{} {
+				return []interface{}{receiver, []cmn.Coin{}}
+			},
+			false,
+			"invalid amount",
+			func() {},
+		},
+		{
+			"fail - blocked recipient address",
+			func() []interface{} {
+				return []interface{}{
+					common.HexToAddress(evmtypes.StakingPrecompileAddress),
+					[]cmn.Coin{{Denom: s.tokenDenom, Amount: big.NewInt(1e18)}},
+				}
+			},
+			false,
+			"is not allowed to receive funds",
+			func() {},
+		},
+		{
+			"fail - insufficient funds",
+			func() []interface{} {
+				return []interface{}{
+					receiver,
+					[]cmn.Coin{{Denom: s.tokenDenom, Amount: network.PrefundedAccountInitialBalance.AddRaw(1).BigInt()}},
+				}
+			},
+			false,
+			"insufficient funds",
+			func() {},
+		},
+		{
+			"pass - send native coins",
+			func() []interface{} {
+				return []interface{}{
+					receiver,
+					[]cmn.Coin{
+						{Denom: s.tokenDenom, Amount: big.NewInt(1e18)},
+						{Denom: s.bondDenom, Amount: big.NewInt(2e18)},
+					},
+				}
+			},
+			true,
+			"",
+			func() {
+				balances := s.network.App.BankKeeper.GetAllBalances(ctx, receiver.Bytes())
+				s.Require().Equal(math.NewInt(1e18), balances.AmountOf(s.tokenDenom))
+				s.Require().Equal(math.NewInt(2e18), balances.AmountOf(s.bondDenom))
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			ctx = s.SetupTest() // reset the chain each test
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, 200_000)
+
+			bz, err := s.precompile.Send(ctx, contract, s.network.GetStateDB(), &method, tc.malleate())
+
+			if tc.expPass {
+				s.Require().NoError(err)
+				var success bool
+				err = s.precompile.UnpackIntoInterface(&success, method.Name, bz)
+				s.Require().NoError(err)
+				s.Require().True(success)
+				tc.postCheck()
+			} else {
+				s.Require().ErrorContains(err, tc.errContains)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestMultiSend() {
+	var ctx sdk.Context
+	// setup test in order to have s.precompile defined
+	s.SetupTest()
+	method := s.precompile.Methods[bank.MultiSendMethod]
+	receiver1 := cosmosevmutiltx.GenerateAddress()
+	receiver2 := cosmosevmutiltx.GenerateAddress()
+
+	testcases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expPass     bool
+		errContains string
+		postCheck   func()
+	}{
+		{
+			"fail - invalid number of arguments",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			false,
+			"invalid number of arguments",
+			func() {},
+		},
+		{
+			"fail - no outputs",
+			func() []interface{} {
+				return []interface{}{[]bank.Output{}}
+			},
+			false,
+			"no outputs",
+			func() {},
+		},
+		{
+			"fail - blocked recipient address",
+			func() []interface{} {
+				return []interface{}{[]bank.Output{
+					{To: receiver1, Amount: []cmn.Coin{{Denom: s.tokenDenom, Amount: big.NewInt(1e18)}}},
+					{To: common.HexToAddress(evmtypes.StakingPrecompileAddress), Amount: []cmn.Coin{{Denom: s.tokenDenom, Amount: big.NewInt(1e18)}}},
+				}}
+			},
+			false,
+			"is not allowed to receive funds",
+			func() {},
+		},
+		{
+			"pass - send native coins to multiple recipients",
+			func() []interface{} {
+				return []interface{}{[]bank.Output{
+					{To: receiver1, Amount: []cmn.Coin{{Denom: s.tokenDenom, Amount: big.NewInt(1e18)}}},
+					{To: receiver2, Amount: []cmn.Coin{{Denom: s.bondDenom, Amount: big.NewInt(2e18)}}},
+				}}
+			},
+			true,
+			"",
+			func() {
+				balance := s.network.App.BankKeeper.GetBalance(ctx, receiver1.Bytes(), s.tokenDenom)
+				s.Require().Equal(math.NewInt(1e18), balance.Amount)
+				balance = s.network.App.BankKeeper.GetBalance(ctx, receiver2.Bytes(), s.bondDenom)
+				s.Require().Equal(math.NewInt(2e18), balance.Amount)
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			ctx = s.SetupTest() // reset the chain each test
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, 200_000)
+
+			bz, err := s.precompile.MultiSend(ctx, contract, s.network.GetStateDB(), &method, tc.malleate())
+
+			if tc.expPass {
+				s.Require().NoError(err)
+				var success bool
+				err = s.precompile.UnpackIntoInterface(&success, method.Name, bz)
+				s.Require().NoError(err)
+				s.Require().True(success)
+				tc.postCheck()
+			} else {
+				s.Require().ErrorContains(err, tc.errContains)
+			}
+		})
+	}
+}
@@ -4,10 +4,13 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 
 	cmn "github.com/cosmos/evm/precompiles/common"
 
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -17,6 +20,25 @@ type Balance struct {
 	Amount          *big.Int
 }
 
+// SendInput defines the input for the Send transaction.
+type SendInput struct {
+	To     common.Address
+	Amount []cmn.Coin
+}
+
+// Output defines a recipient and the amount of the MultiSend transaction, in
+// types native to the EVM.
+type Output struct {
+	To     common.Address
+	Amount []cmn.Coin
+}
+
+// SendOutput defines a recipient and the amount of the MultiSend transaction.
+type SendOutput struct {
+	To     common.Address
+	Amount sdk.Coins
+}
+
 // ParseBalancesArgs parses the call arguments for the bank Balances query.
 func ParseBalancesArgs(args []interface{}) (sdk.AccAddress, error) {
 	if len(args) != 1 {
@@ -44,3 +66,71 @@ func ParseSupplyOfArgs(args []interface{}) (common.Address, error) {
 
 	return erc20Address, nil
 }
+
+// ParseSendArgs parses the call arguments for the bank Send transaction.
+func ParseSendArgs(method *abi.Method, args []interface{}) (common.Address, sdk.Coins, error) {
+	if len(args) != 2 {
+		return common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input SendInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return common.Address{}, nil, fmt.Errorf("error while unpacking args to SendInput: %s", err)
+	}
+
+	amount, err := newCoins(input.Amount)
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+
+	return input.To, amount, nil
+}
+
+// ParseMultiSendArgs parses the call arguments for the bank MultiSend transaction.
+func ParseMultiSendArgs(method *abi.Method, args []interface{}) ([]SendOutput, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	var outputs []Output
+	arguments := abi.Arguments{method.Inputs[0]}
+	if err := arguments.Copy(&outputs, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to Output array: %s", err)
+	}
+
+	if len(outputs) == 0 {
+		return nil, fmt.Errorf("no outputs to send to")
+	}
+
+	sendOutputs := make([]SendOutput, len(outputs))
+	for i, output := range outputs {
+		amount, err := newCoins(output.Amount)
+		if err != nil {
+			return nil, err
+		}
+		sendOutputs[i] = SendOutput{To: output.To, Amount: amount}
+	}
+
+	return sendOutputs, nil
+}
+
+// newCoins converts the given coins to valid, sorted Cosmos SDK coins.
+func newCoins(coins []cmn.Coin) (sdk.Coins, error) {
+	amount := make(sdk.Coins, 0, len(coins))
+	for _, coin := range coins {
+		if coin.Amount == nil {
+			return nil, fmt.Errorf(cmn.ErrInvalidAmount, coin.Amount)
+		}
+		amount = append(amount, sdk.Coin{Denom: coin.Denom, Amount: math.NewIntFromBigInt(coin.Amount)})
+	}
+
+	amount = amount.Sort()
+	if err := amount.Validate(); err != nil {
+		return nil, err
+	}
+	if amount.Empty() {
+		return nil, fmt.Errorf(cmn.ErrInvalidAmount, "empty coins")
+	}
+
+	return amount, nil
+}
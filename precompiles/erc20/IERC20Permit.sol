@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+/**
+ * @author Evmos Team
+ * @title ERC20 Permit Interface
+ * @dev Interface for the ERC20 permit extension as defined in EIP-2612, which
+ * allows approvals to be made via signatures.
+ */
+interface IERC20Permit {
+    /** @dev Sets value as the allowance of spender over owner's tokens, given
+      * owner's signed approval. The signature is an EIP-712 signature of the
+      * Permit(address owner,address spender,uint256 value,uint256 nonce,uint256 deadline)
+      * message.
+      * @param owner The address of the tokens owner.
+      * @param spender The address which will spend the funds.
+      * @param value The amount of tokens to be approved.
+      * @param deadline The timestamp until which the signature is valid.
+      * @param v The recovery ID of the signature.
+      * @param r The r value of the signature.
+      * @param s The s value of the signature.
+    */
+    function permit(
+        address owner,
+        address spender,
+        uint256 value,
+        uint256 deadline,
+        uint8 v,
+        bytes32 r,
+        bytes32 s
+    ) external;
+
+    /** @dev Returns the current nonce for owner. This value must be included
+      * whenever a signature is generated for permit.
+      * @param owner The address of the tokens owner.
+      * @return The current nonce of the owner.
+    */
+    function nonces(address owner) external view returns (uint256);
+
+    /** @dev Returns the EIP-712 domain separator used in the encoding of the
+      * signature for permit and transferWithAuthorization. It is bound to the
+      * chain ID and the token address.
+      * @return The domain separator.
+    */
+    // solhint-disable-next-line func-name-mixedcase
+    function DOMAIN_SEPARATOR() external view returns (bytes32);
+}
@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+/**
+ * @author Evmos Team
+ * @title ERC20 Transfer With Authorization Interface
+ * @dev Interface for the transfers via signed authorizations as defined in
+ * EIP-3009.
+ */
+interface IERC3009 {
+    /** @dev Emitted when an authorization is used.
+      * @param authorizer The address of the authorizer.
+      * @param nonce The nonce of the used authorization.
+    */
+    event AuthorizationUsed(address indexed authorizer, bytes32 indexed nonce);
+
+    /** @dev Executes a transfer with a signed authorization. The signature is an
+      * EIP-712 signature of the
+      * TransferWithAuthorization(address from,address to,uint256 value,uint256 validAfter,uint256 validBefore,bytes32 nonce)
+      * message.
+      * @param from The address of the payer (authorizer).
+      * @param to The address of the payee.
+      * @param value The amount of tokens to be transferred.
+      * @param validAfter The timestamp after which the authorization is valid.
+      * @param validBefore The timestamp before which the authorization is valid.
+      * @param nonce The unique nonce of the authorization.
+      * @param v The recovery ID of the signature.
+      * @param r The r value of the signature.
+      * @param s The s value of the signature.
+    */
+    function transferWithAuthorization(
+        address from,
+        address to,
+        uint256 value,
+        uint256 validAfter,
+        uint256 validBefore,
+        bytes32 nonce,
+        uint8 v,
+        bytes32 r,
+        bytes32 s
+    ) external;
+
+    /** @dev Returns the state of an authorization.
+      * @param authorizer The address of the authorizer.
+      * @param nonce The nonce of the authorization.
+      * @return True if the nonce is used.
+    */
+    function authorizationState(
+        address authorizer,
+        bytes32 nonce
+    ) external view returns (bool);
+}
@@ -44,20 +44,36 @@ func (p Precompile) Approve(
 		return nil, err
 	}
 
+	if err := p.approve(ctx, contract.CallerAddress, spender, amount); err != nil {
+		return nil, err
+	}
+
+	// TODO: check owner?
+	if err := p.EmitApprovalEvent(ctx, stateDB, p.Address(), spender, amount); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// approve sets the given amount as the allowance of the spender address over
+// the owner's tokens.
+func (p Precompile) approve(ctx sdk.Context, owner, spender common.Address, amount *big.Int) error {
 	grantee := spender
-	granter := contract.CallerAddress
+	granter := owner
 
 	// NOTE: We do not support approvals if the grantee is the granter.
 	// This is different from the ERC20 standard but there is no reason to
 	// do so, since in that case the grantee can just transfer the tokens
 	// without authorization.
 	if bytes.Equal(grantee.Bytes(), granter.Bytes()) {
-		return nil, ErrSpenderIsOwner
+		return ErrSpenderIsOwner
 	}
 
 	// TODO: owner should be the owner of the contract
 	authorization, expiration, _ := auth.CheckAuthzExists(ctx, p.AuthzKeeper, grantee, granter, SendMsgURL) //#nosec:G703 -- we are handling the error case (authorization == nil) in the switch statement below
 
+	var err error
 	switch {
 	case authorization == nil && amount != nil && amount.Sign() < 0:
 		// case 1: no authorization, amount 0 or negative -> error
@@ -72,22 +88,13 @@ func (p Precompile) Approve(
 		// case 4: authorization exists, amount positive -> update authorization
 		sendAuthz, ok := authorization.(*banktypes.SendAuthorization)
 		if !ok {
-			return nil, authz.ErrUnknownAuthorizationType
+			return authz.ErrUnknownAuthorizationType
 		}
 
 		err = p.updateAuthorization(ctx, grantee, granter, amount, sendAuthz, expiration)
 	}
 
-	if err != nil {
-		return nil, err
-	}
-
-	// TODO: check owner?
-	if err := p.EmitApprovalEvent(ctx, stateDB, p.Address(), spender, amount); err != nil {
-		return nil, err
-	}
-
-	return method.Outputs.Pack(true)
+	return err
 }
 
 // IncreaseAllowance increases the allowance of the spender address over
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 
 	auth "github.com/cosmos/evm/precompiles/authorization"
@@ -33,6 +34,14 @@ const (
 	GasTotalSupply       = 2_477
 	GasBalanceOf         = 2_851
 	GasAllowance         = 3_246
+
+	// GasPermit and GasTransferWithAuthorization add the cost of the ecrecover
+	// precompile to the approve and transfer costs
+	GasPermit                    = GasApprove + 3_000
+	GasTransferWithAuthorization = GasTransfer + 3_000
+	GasNonces                    = 2_851
+	GasDomainSeparator           = 3_421
+	GasAuthorizationState        = 2_851
 )
 
 // Embed abi json file to the executable binary. Needed when importing as dependency.
@@ -42,11 +51,21 @@ var f embed.FS
 
 var _ vm.PrecompiledContract = &Precompile{}
 
+// NonceKeeper defines the expected keeper to store the EIP-2612 permit nonces
+// and the used EIP-3009 transfer authorizations of the ERC-20 precompiles.
+type NonceKeeper interface {
+	GetPermitNonce(ctx sdk.Context, token, owner common.Address) uint64
+	SetPermitNonce(ctx sdk.Context, token, owner common.Address, nonce uint64)
+	IsTransferAuthorizationUsed(ctx sdk.Context, token, authorizer common.Address, nonce common.Hash) bool
+	SetTransferAuthorizationUsed(ctx sdk.Context, token, authorizer common.Address, nonce common.Hash)
+}
+
 // Precompile defines the precompiled contract for ERC-20.
 type Precompile struct {
 	cmn.Precompile
 	tokenPair      erc20types.TokenPair
 	transferKeeper transferkeeper.Keeper
+	nonceKeeper    NonceKeeper
 	// BankKeeper is a public field so that the werc20 precompile can use it.
 	BankKeeper bankkeeper.Keeper
 }
@@ -56,6 +75,7 @@ type Precompile struct {
 func NewPrecompile(
 	tokenPair erc20types.TokenPair,
 	bankKeeper bankkeeper.Keeper,
+	nonceKeeper NonceKeeper,
 	authzKeeper authzkeeper.Keeper,
 	transferKeeper transferkeeper.Keeper,
 ) (*Precompile, error) {
@@ -75,6 +95,7 @@ func NewPrecompile(
 		tokenPair:      tokenPair,
 		BankKeeper:     bankKeeper,
 		transferKeeper: transferKeeper,
+		nonceKeeper:    nonceKeeper,
 	}
 	// Address defines the address of the ERC-20 precompile contract.
 	p.SetAddress(p.tokenPair.GetERC20Contract())
@@ -109,6 +130,10 @@ func (p Precompile) RequiredGas(input []byte) uint64 {
 		return GasIncreaseAllowance
 	case auth.DecreaseAllowanceMethod:
 		return GasDecreaseAllowance
+	case PermitMethod:
+		return GasPermit
+	case TransferWithAuthorizationMethod:
+		return GasTransferWithAuthorization
 	// ERC-20 queries
 	case NameMethod:
 		return GasName
@@ -122,6 +147,12 @@ func (p Precompile) RequiredGas(input []byte) uint64 {
 		return GasBalanceOf
 	case auth.AllowanceMethod:
 		return GasAllowance
+	case NoncesMethod:
+		return GasNonces
+	case DomainSeparatorMethod:
+		return GasDomainSeparator
+	case AuthorizationStateMethod:
+		return GasAuthorizationState
 	default:
 		return 0
 	}
@@ -169,7 +200,9 @@ func (Precompile) IsTransaction(method *abi.Method) bool {
 		TransferFromMethod,
 		auth.ApproveMethod,
 		auth.IncreaseAllowanceMethod,
-		auth.DecreaseAllowanceMethod:
+		auth.DecreaseAllowanceMethod,
+		PermitMethod,
+		TransferWithAuthorizationMethod:
 		return true
 	default:
 		return false
@@ -196,6 +229,10 @@ func (p *Precompile) HandleMethod(
 		bz, err = p.IncreaseAllowance(ctx, contract, stateDB, method, args)
 	case auth.DecreaseAllowanceMethod:
 		bz, err = p.DecreaseAllowance(ctx, contract, stateDB, method, args)
+	case PermitMethod:
+		bz, err = p.Permit(ctx, contract, stateDB, method, args)
+	case TransferWithAuthorizationMethod:
+		bz, err = p.TransferWithAuthorization(ctx, contract, stateDB, method, args)
 	// ERC-20 queries
 	case NameMethod:
 		bz, err = p.Name(ctx, contract, stateDB, method, args)
@@ -209,6 +246,12 @@ func (p *Precompile) HandleMethod(
 		bz, err = p.BalanceOf(ctx, contract, stateDB, method, args)
 	case auth.AllowanceMethod:
 		bz, err = p.Allowance(ctx, contract, stateDB, method, args)
+	case NoncesMethod:
+		bz, err = p.Nonces(ctx, contract, stateDB, method, args)
+	case DomainSeparatorMethod:
+		bz, err = p.DomainSeparator(ctx, contract, stateDB, method, args)
+	case AuthorizationStateMethod:
+		bz, err = p.AuthorizationState(ctx, contract, stateDB, method, args)
 	default:
 		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
 	}
@@ -39,6 +39,15 @@ var (
 	ErrDecreasedAllowanceBelowZero  = errors.New("ERC20: decreased allowance below zero")
 	ErrInsufficientAllowance        = errors.New("ERC20: insufficient allowance")
 	ErrTransferAmountExceedsBalance = errors.New("ERC20: transfer amount exceeds balance")
+
+	// EIP-2612 and EIP-3009 errors
+	ErrPermitExpired                 = errors.New("ERC20Permit: expired deadline")
+	ErrPermitInvalidSignature        = errors.New("ERC20Permit: invalid signature")
+	ErrAuthorizationNotYetValid      = errors.New("EIP3009: authorization is not yet valid")
+	ErrAuthorizationExpired          = errors.New("EIP3009: authorization is expired")
+	ErrAuthorizationUsed             = errors.New("EIP3009: authorization is used")
+	ErrAuthorizationInvalidSignature = errors.New("EIP3009: invalid signature")
+	ErrInvalidSignatureValues        = errors.New("invalid signature values")
 )
 
 // BuildExecRevertedErr returns a mocked error that should align with the
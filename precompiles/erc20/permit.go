@@ -0,0 +1,290 @@
+package erc20
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+const (
+	// PermitMethod defines the ABI method name for the EIP-2612 permit
+	// transaction.
+	PermitMethod = "permit"
+	// TransferWithAuthorizationMethod defines the ABI method name for the
+	// EIP-3009 transferWithAuthorization transaction.
+	TransferWithAuthorizationMethod = "transferWithAuthorization"
+	// NoncesMethod defines the ABI method name for the EIP-2612 nonces query.
+	NoncesMethod = "nonces"
+	// DomainSeparatorMethod defines the ABI method name for the EIP-712
+	// DOMAIN_SEPARATOR query.
+	DomainSeparatorMethod = "DOMAIN_SEPARATOR"
+	// AuthorizationStateMethod defines the ABI method name for the EIP-3009
+	// authorizationState query.
+	AuthorizationStateMethod = "authorizationState"
+
+	// DomainVersion defines the version of the EIP-712 signing domain of the
+	// ERC-20 precompiles.
+	DomainVersion = "1"
+)
+
+var (
+	// DomainTypehash is the EIP-712 type hash of the signing domain.
+	DomainTypehash = crypto.Keccak256Hash([]byte("EIP712Domain(string name,string version,uint256 chainId,address verifyingContract)"))
+	// PermitTypehash is the EIP-712 type hash of the EIP-2612 permit message.
+	PermitTypehash = crypto.Keccak256Hash([]byte("Permit(address owner,address spender,uint256 value,uint256 nonce,uint256 deadline)"))
+	// TransferWithAuthorizationTypehash is the EIP-712 type hash of the EIP-3009
+	// transferWithAuthorization message.
+	TransferWithAuthorizationTypehash = crypto.Keccak256Hash([]byte("TransferWithAuthorization(address from,address to,uint256 value,uint256 validAfter,uint256 validBefore,bytes32 nonce)"))
+)
+
+// Permit sets the given value as the allowance of the spender over the owner's
+// tokens, authorized by the owner's EIP-712 signature as defined by EIP-2612.
+// The permit nonce of the owner is incremented on success, so a signature can
+// only be used once.
+func (p Precompile) Permit(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	owner, spender, value, deadline, sig, err := ParsePermitArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if big.NewInt(ctx.BlockTime().Unix()).Cmp(deadline) > 0 {
+		return nil, ErrPermitExpired
+	}
+
+	domainSeparator, err := p.domainSeparator(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := p.nonceKeeper.GetPermitNonce(ctx, p.Address(), owner)
+	structHash := hashWords(
+		PermitTypehash.Bytes(),
+		owner.Bytes(),
+		spender.Bytes(),
+		value.Bytes(),
+		new(big.Int).SetUint64(nonce).Bytes(),
+		deadline.Bytes(),
+	)
+
+	signer, err := recoverSigner(domainSeparator, structHash, sig)
+	if err != nil || signer != owner {
+		return nil, ErrPermitInvalidSignature
+	}
+
+	p.nonceKeeper.SetPermitNonce(ctx, p.Address(), owner, nonce+1)
+
+	if err := p.approve(ctx, owner, spender, value); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitApprovalEvent(ctx, stateDB, owner, spender, value); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack()
+}
+
+// TransferWithAuthorization transfers the given value from the authorizer to
+// the recipient, authorized by the authorizer's EIP-712 signature as defined by
+// EIP-3009. Each authorization nonce can only be used once.
+func (p *Precompile) TransferWithAuthorization(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	transferAuth, err := ParseTransferWithAuthorizationArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	now := big.NewInt(ctx.BlockTime().Unix())
+	if now.Cmp(transferAuth.ValidAfter) <= 0 {
+		return nil, ErrAuthorizationNotYetValid
+	}
+	if now.Cmp(transferAuth.ValidBefore) >= 0 {
+		return nil, ErrAuthorizationExpired
+	}
+
+	if p.nonceKeeper.IsTransferAuthorizationUsed(ctx, p.Address(), transferAuth.From, transferAuth.Nonce) {
+		return nil, ErrAuthorizationUsed
+	}
+
+	domainSeparator, err := p.domainSeparator(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	structHash := hashWords(
+		TransferWithAuthorizationTypehash.Bytes(),
+		transferAuth.From.Bytes(),
+		transferAuth.To.Bytes(),
+		transferAuth.Value.Bytes(),
+		transferAuth.ValidAfter.Bytes(),
+		transferAuth.ValidBefore.Bytes(),
+		transferAuth.Nonce.Bytes(),
+	)
+
+	signer, err := recoverSigner(domainSeparator, structHash, transferAuth.Signature)
+	if err != nil || signer != transferAuth.From {
+		return nil, ErrAuthorizationInvalidSignature
+	}
+
+	p.nonceKeeper.SetTransferAuthorizationUsed(ctx, p.Address(), transferAuth.From, transferAuth.Nonce)
+
+	coins := sdk.Coins{{Denom: p.tokenPair.Denom, Amount: math.NewIntFromBigInt(transferAuth.Value)}}
+	msg := banktypes.NewMsgSend(transferAuth.From.Bytes(), transferAuth.To.Bytes(), coins)
+	if err = msg.Amount.Validate(); err != nil {
+		return nil, err
+	}
+
+	msgSrv := bankkeeper.NewMsgServerImpl(p.BankKeeper)
+	if _, err = msgSrv.Send(ctx, msg); err != nil {
+		return nil, ConvertErrToERC20Error(err)
+	}
+
+	if p.tokenPair.Denom == evmtypes.GetEVMCoinDenom() {
+		convertedAmount := evmtypes.ConvertAmountTo18DecimalsBigInt(transferAuth.Value)
+		p.SetBalanceChangeEntries(cmn.NewBalanceChangeEntry(transferAuth.From, convertedAmount, cmn.Sub),
+			cmn.NewBalanceChangeEntry(transferAuth.To, convertedAmount, cmn.Add))
+	}
+
+	if err = p.EmitAuthorizationUsedEvent(ctx, stateDB, transferAuth.From, transferAuth.Nonce); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitTransferEvent(ctx, stateDB, transferAuth.From, transferAuth.To, transferAuth.Value); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack()
+}
+
+// Nonces returns the current EIP-2612 permit nonce of the given owner.
+func (p Precompile) Nonces(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	owner, err := ParseNoncesArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := p.nonceKeeper.GetPermitNonce(ctx, p.Address(), owner)
+	return method.Outputs.Pack(new(big.Int).SetUint64(nonce))
+}
+
+// DomainSeparator returns the EIP-712 domain separator of the token, bound to
+// the EVM chain ID and the precompile address.
+func (p Precompile) DomainSeparator(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	_ []interface{},
+) ([]byte, error) {
+	domainSeparator, err := p.domainSeparator(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack([32]byte(domainSeparator))
+}
+
+// AuthorizationState returns true if the given EIP-3009 authorization nonce of
+// the authorizer was already used.
+func (p Precompile) AuthorizationState(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	authorizer, nonce, err := ParseAuthorizationStateArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	used := p.nonceKeeper.IsTransferAuthorizationUsed(ctx, p.Address(), authorizer, nonce)
+	return method.Outputs.Pack(used)
+}
+
+// domainSeparator computes the EIP-712 domain separator of the token from its
+// name, the domain version, the EVM chain ID and the precompile address.
+func (p Precompile) domainSeparator(ctx sdk.Context) (common.Hash, error) {
+	name, err := p.name(ctx)
+	if err != nil {
+		return common.Hash{}, ConvertErrToERC20Error(err)
+	}
+
+	return hashWords(
+		DomainTypehash.Bytes(),
+		crypto.Keccak256([]byte(name)),
+		crypto.Keccak256([]byte(DomainVersion)),
+		evmtypes.GetEthChainConfig().ChainID.Bytes(),
+		p.Address().Bytes(),
+	), nil
+}
+
+// hashWords returns the keccak256 hash of the given values, each one left
+// padded to a 32 bytes word as in the Solidity abi.encode function.
+func hashWords(words ...[]byte) common.Hash {
+	encoded := make([]byte, 0, len(words)*common.HashLength)
+	for _, word := range words {
+		encoded = append(encoded, common.LeftPadBytes(word, common.HashLength)...)
+	}
+
+	return crypto.Keccak256Hash(encoded)
+}
+
+// recoverSigner returns the address that signed the EIP-712 typed data with the
+// given domain separator and struct hash.
+func recoverSigner(domainSeparator, structHash common.Hash, sig Signature) (common.Address, error) {
+	digest := crypto.Keccak256([]byte("\x19\x01"), domainSeparator.Bytes(), structHash.Bytes())
+
+	// the recovery ID is 27 or 28 in Ethereum signatures
+	v := sig.V
+	if v >= 27 {
+		v -= 27
+	}
+
+	r := new(big.Int).SetBytes(sig.R[:])
+	s := new(big.Int).SetBytes(sig.S[:])
+	if !crypto.ValidateSignatureValues(v, r, s, true) {
+		return common.Address{}, ErrInvalidSignatureValues
+	}
+
+	rawSig := make([]byte, 0, crypto.SignatureLength)
+	rawSig = append(rawSig, sig.R[:]...)
+	rawSig = append(rawSig, sig.S[:]...)
+	rawSig = append(rawSig, v)
+
+	pubKey, err := crypto.SigToPub(digest, rawSig)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	return crypto.PubkeyToAddress(*pubKey), nil
+}
@@ -0,0 +1,367 @@
+package erc20_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/precompiles/erc20"
+	"github.com/cosmos/evm/precompiles/testutil"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// tokenName returns the name of the test precompile token, which is part of
+// the EIP-712 signing domain.
+func (s *PrecompileTestSuite) tokenName() string {
+	method := s.precompile.Methods[erc20.NameMethod]
+	bz, err := s.precompile.Name(s.network.GetContext(), nil, nil, &method, nil)
+	s.Require().NoError(err, "failed to query the token name")
+
+	out, err := method.Outputs.Unpack(bz)
+	s.Require().NoError(err)
+	name, ok := out[0].(string)
+	s.Require().True(ok)
+	return name
+}
+
+// signTypedData signs the EIP-712 typed data of the given primary type and
+// message with the private key of the keyring account at the given index,
+// using the signing domain of the test precompile.
+func (s *PrecompileTestSuite) signTypedData(keyIndex int, primaryType string, types []apitypes.Type, message apitypes.TypedDataMessage) (uint8, [32]byte, [32]byte) {
+	typedData := apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+				{Name: "verifyingContract", Type: "address"},
+			},
+			primaryType: types,
+		},
+		PrimaryType: primaryType,
+		Domain: apitypes.TypedDataDomain{
+			Name:              s.tokenName(),
+			Version:           erc20.DomainVersion,
+			ChainId:           (*math.HexOrDecimal256)(evmtypes.GetEthChainConfig().ChainID),
+			VerifyingContract: s.precompile.Address().Hex(),
+		},
+		Message: message,
+	}
+
+	hash, _, err := apitypes.TypedDataAndHash(typedData)
+	s.Require().NoError(err, "failed to hash typed data")
+
+	privKey, ok := s.keyring.GetPrivKey(keyIndex).(*ethsecp256k1.PrivKey)
+	s.Require().True(ok, "expected an ethsecp256k1 private key")
+	key, err := privKey.ToECDSA()
+	s.Require().NoError(err)
+
+	sig, err := crypto.Sign(hash, key)
+	s.Require().NoError(err, "failed to sign typed data")
+
+	var r, sv [32]byte
+	copy(r[:], sig[:32])
+	copy(sv[:], sig[32:64])
+	return sig[64] + 27, r, sv
+}
+
+// signPermit signs an EIP-2612 permit with the keyring account at the given index.
+func (s *PrecompileTestSuite) signPermit(keyIndex int, spender common.Address, value, nonce, deadline *big.Int) (uint8, [32]byte, [32]byte) {
+	return s.signTypedData(keyIndex, "Permit", []apitypes.Type{
+		{Name: "owner", Type: "address"},
+		{Name: "spender", Type: "address"},
+		{Name: "value", Type: "uint256"},
+		{Name: "nonce", Type: "uint256"},
+		{Name: "deadline", Type: "uint256"},
+	}, apitypes.TypedDataMessage{
+		"owner":    s.keyring.GetAddr(keyIndex).Hex(),
+		"spender":  spender.Hex(),
+		"value":    value.String(),
+		"nonce":    nonce.String(),
+		"deadline": deadline.String(),
+	})
+}
+
+// signTransferAuthorization signs an EIP-3009 transfer authorization with the
+// keyring account at the given index.
+func (s *PrecompileTestSuite) signTransferAuthorization(keyIndex int, to common.Address, value, validAfter, validBefore *big.Int, nonce [32]byte) (uint8, [32]byte, [32]byte) {
+	return s.signTypedData(keyIndex, "TransferWithAuthorization", []apitypes.Type{
+		{Name: "from", Type: "address"},
+		{Name: "to", Type: "address"},
+		{Name: "value", Type: "uint256"},
+		{Name: "validAfter", Type: "uint256"},
+		{Name: "validBefore", Type: "uint256"},
+		{Name: "nonce", Type: "bytes32"},
+	}, apitypes.TypedDataMessage{
+		"from":        s.keyring.GetAddr(keyIndex).Hex(),
+		"to":          to.Hex(),
+		"value":       value.String(),
+		"validAfter":  validAfter.String(),
+		"validBefore": validBefore.String(),
+		"nonce":       hexutil.Encode(nonce[:]),
+	})
+}
+
+func (s *PrecompileTestSuite) TestPermit() {
+	method := s.precompile.Methods[erc20.PermitMethod]
+	owner := s.keyring.GetAddr(0)
+	spender := utiltx.GenerateAddress()
+	value := big.NewInt(100)
+
+	testcases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expErr      bool
+		errContains string
+	}{
+		{
+			"fail - invalid number of arguments",
+			func() []interface{} {
+				return []interface{}{owner, spender, value}
+			},
+			func() {},
+			true,
+			"invalid number of arguments",
+		},
+		{
+			"fail - expired deadline",
+			func() []interface{} {
+				deadline := big.NewInt(s.network.GetContext().BlockTime().Unix() - 1)
+				v, r, sv := s.signPermit(0, spender, value, big.NewInt(0), deadline)
+				return []interface{}{owner, spender, value, deadline, v, r, sv}
+			},
+			func() {},
+			true,
+			erc20.ErrPermitExpired.Error(),
+		},
+		{
+			"fail - signed by another account",
+			func() []interface{} {
+				deadline := big.NewInt(s.network.GetContext().BlockTime().Unix() + 3600)
+				v, r, sv := s.signPermit(1, spender, value, big.NewInt(0), deadline)
+				return []interface{}{owner, spender, value, deadline, v, r, sv}
+			},
+			func() {},
+			true,
+			erc20.ErrPermitInvalidSignature.Error(),
+		},
+		{
+			"fail - signed with a wrong nonce",
+			func() []interface{} {
+				deadline := big.NewInt(s.network.GetContext().BlockTime().Unix() + 3600)
+				v, r, sv := s.signPermit(0, spender, value, big.NewInt(1), deadline)
+				return []interface{}{owner, spender, value, deadline, v, r, sv}
+			},
+			func() {},
+			true,
+			erc20.ErrPermitInvalidSignature.Error(),
+		},
+		{
+			"pass",
+			func() []interface{} {
+				deadline := big.NewInt(s.network.GetContext().BlockTime().Unix() + 3600)
+				v, r, sv := s.signPermit(0, spender, value, big.NewInt(0), deadline)
+				return []interface{}{owner, spender, value, deadline, v, r, sv}
+			},
+			func() {
+				ctx := s.network.GetContext()
+				authorization, _ := s.network.App.AuthzKeeper.GetAuthorization(ctx, spender.Bytes(), owner.Bytes(), erc20.SendMsgURL)
+				s.Require().NotNil(authorization, "expected authorization to be created")
+				sendAuthz, ok := authorization.(*banktypes.SendAuthorization)
+				s.Require().True(ok, "expected send authorization")
+				s.Require().Equal(value, sendAuthz.SpendLimit.AmountOf(s.tokenDenom).BigInt())
+
+				nonce := s.network.App.Erc20Keeper.GetPermitNonce(ctx, s.precompile.Address(), owner)
+				s.Require().Equal(uint64(1), nonce, "expected the permit nonce to be incremented")
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stateDB := s.network.GetStateDB()
+
+			var contract *vm.Contract
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), utiltx.GenerateAddress(), s.precompile, 0)
+
+			_, err := s.precompile.Permit(ctx, contract, stateDB, &method, tc.malleate())
+			if tc.expErr {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestTransferWithAuthorization() {
+	method := s.precompile.Methods[erc20.TransferWithAuthorizationMethod]
+	from := s.keyring.GetAddr(0)
+	to := utiltx.GenerateAddress()
+	value := big.NewInt(100)
+	nonce := [32]byte(crypto.Keccak256Hash([]byte("nonce")))
+
+	testcases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expErr      bool
+		errContains string
+	}{
+		{
+			"fail - invalid number of arguments",
+			func() []interface{} {
+				return []interface{}{from, to, value}
+			},
+			func() {},
+			true,
+			"invalid number of arguments",
+		},
+		{
+			"fail - authorization not yet valid",
+			func() []interface{} {
+				now := s.network.GetContext().BlockTime().Unix()
+				validAfter, validBefore := big.NewInt(now+60), big.NewInt(now+3600)
+				v, r, sv := s.signTransferAuthorization(0, to, value, validAfter, validBefore, nonce)
+				return []interface{}{from, to, value, validAfter, validBefore, nonce, v, r, sv}
+			},
+			func() {},
+			true,
+			erc20.ErrAuthorizationNotYetValid.Error(),
+		},
+		{
+			"fail - authorization expired",
+			func() []interface{} {
+				now := s.network.GetContext().BlockTime().Unix()
+				validAfter, validBefore := big.NewInt(0), big.NewInt(now)
+				v, r, sv := s.signTransferAuthorization(0, to, value, validAfter, validBefore, nonce)
+				return []interface{}{from, to, value, validAfter, validBefore, nonce, v, r, sv}
+			},
+			func() {},
+			true,
+			erc20.ErrAuthorizationExpired.Error(),
+		},
+		{
+			"fail - authorization already used",
+			func() []interface{} {
+				s.network.App.Erc20Keeper.SetTransferAuthorizationUsed(s.network.GetContext(), s.precompile.Address(), from, nonce)
+				now := s.network.GetContext().BlockTime().Unix()
+				validAfter, validBefore := big.NewInt(0), big.NewInt(now+3600)
+				v, r, sv := s.signTransferAuthorization(0, to, value, validAfter, validBefore, nonce)
+				return []interface{}{from, to, value, validAfter, validBefore, nonce, v, r, sv}
+			},
+			func() {},
+			true,
+			erc20.ErrAuthorizationUsed.Error(),
+		},
+		{
+			"fail - signed by another account",
+			func() []interface{} {
+				now := s.network.GetContext().BlockTime().Unix()
+				validAfter, validBefore := big.NewInt(0), big.NewInt(now+3600)
+				v, r, sv := s.signTransferAuthorization(1, to, value, validAfter, validBefore, nonce)
+				return []interface{}{from, to, value, validAfter, validBefore, nonce, v, r, sv}
+			},
+			func() {},
+			true,
+			erc20.ErrAuthorizationInvalidSignature.Error(),
+		},
+		{
+			"pass",
+			func() []interface{} {
+				now := s.network.GetContext().BlockTime().Unix()
+				validAfter, validBefore := big.NewInt(0), big.NewInt(now+3600)
+				v, r, sv := s.signTransferAuthorization(0, to, value, validAfter, validBefore, nonce)
+				return []interface{}{from, to, value, validAfter, validBefore, nonce, v, r, sv}
+			},
+			func() {
+				ctx := s.network.GetContext()
+				toBalance := s.network.App.BankKeeper.GetBalance(ctx, to.Bytes(), s.tokenDenom)
+				s.Require().Equal(value, toBalance.Amount.BigInt(), "expected the recipient to receive the tokens")
+
+				used := s.network.App.Erc20Keeper.IsTransferAuthorizationUsed(ctx, s.precompile.Address(), from, nonce)
+				s.Require().True(used, "expected the authorization to be used")
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stateDB := s.network.GetStateDB()
+
+			var contract *vm.Contract
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), utiltx.GenerateAddress(), s.precompile, 0)
+
+			// Mint some coins to the module account and then send to the from address
+			err := s.network.App.BankKeeper.MintCoins(s.network.GetContext(), erc20types.ModuleName, XMPLCoin)
+			s.Require().NoError(err, "failed to mint coins")
+			err = s.network.App.BankKeeper.SendCoinsFromModuleToAccount(s.network.GetContext(), erc20types.ModuleName, from.Bytes(), XMPLCoin)
+			s.Require().NoError(err, "failed to send coins from module to account")
+
+			_, err = s.precompile.TransferWithAuthorization(ctx, contract, stateDB, &method, tc.malleate())
+			if tc.expErr {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestNoncesAndDomainSeparator() {
+	ctx := s.network.GetContext()
+	owner := s.keyring.GetAddr(0)
+
+	s.network.App.Erc20Keeper.SetPermitNonce(ctx, s.precompile.Address(), owner, 5)
+
+	method := s.precompile.Methods[erc20.NoncesMethod]
+	bz, err := s.precompile.Nonces(ctx, nil, nil, &method, []interface{}{owner})
+	s.Require().NoError(err)
+	out, err := method.Outputs.Unpack(bz)
+	s.Require().NoError(err)
+	s.Require().Equal(big.NewInt(5), out[0])
+
+	method = s.precompile.Methods[erc20.DomainSeparatorMethod]
+	bz, err = s.precompile.DomainSeparator(ctx, nil, nil, &method, nil)
+	s.Require().NoError(err)
+	out, err = method.Outputs.Unpack(bz)
+	s.Require().NoError(err)
+
+	domain := apitypes.TypedDataDomain{
+		Name:              s.tokenName(),
+		Version:           erc20.DomainVersion,
+		ChainId:           (*math.HexOrDecimal256)(evmtypes.GetEthChainConfig().ChainID),
+		VerifyingContract: s.precompile.Address().Hex(),
+	}
+	typedData := apitypes.TypedData{
+		Types: apitypes.Types{"EIP712Domain": {
+			{Name: "name", Type: "string"},
+			{Name: "version", Type: "string"},
+			{Name: "chainId", Type: "uint256"},
+			{Name: "verifyingContract", Type: "address"},
+		}},
+		Domain: domain,
+	}
+	expSeparator, err := typedData.HashStruct("EIP712Domain", domain.Map())
+	s.Require().NoError(err)
+	s.Require().Equal([32]byte(expSeparator), out[0])
+}
@@ -49,18 +49,27 @@ func (p Precompile) Name(
 	method *abi.Method,
 	_ []interface{},
 ) ([]byte, error) {
+	name, err := p.name(ctx)
+	if err != nil {
+		return nil, ConvertErrToERC20Error(err)
+	}
+
+	return method.Outputs.Pack(name)
+}
+
+// name returns the name of the token, as returned by the Name query.
+func (p Precompile) name(ctx sdk.Context) (string, error) {
 	metadata, found := p.BankKeeper.GetDenomMetaData(ctx, p.tokenPair.Denom)
 	if found {
-		return method.Outputs.Pack(metadata.Name)
+		return metadata.Name, nil
 	}
 
 	baseDenom, err := p.getBaseDenomFromIBCVoucher(ctx, p.tokenPair.Denom)
 	if err != nil {
-		return nil, ConvertErrToERC20Error(err)
+		return "", err
 	}
 
-	name := strings.ToUpper(string(baseDenom[1])) + baseDenom[2:]
-	return method.Outputs.Pack(name)
+	return strings.ToUpper(string(baseDenom[1])) + baseDenom[2:], nil
 }
 
 // Symbol returns the symbol of the token. If the token metadata is registered in the
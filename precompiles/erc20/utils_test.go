@@ -246,6 +246,7 @@ func (is *IntegrationTestSuite) setupERC20Precompile(denom string, tokenPairs []
 	precompile, err := erc20.NewPrecompile(
 		tokenPair,
 		is.network.App.BankKeeper,
+		is.network.App.Erc20Keeper,
 		is.network.App.AuthzKeeper,
 		is.network.App.TransferKeeper,
 	)
@@ -263,6 +264,7 @@ func setupERC20PrecompileForTokenPair(
 	precompile, err := erc20.NewPrecompile(
 		tokenPair,
 		unitNetwork.App.BankKeeper,
+		unitNetwork.App.Erc20Keeper,
 		unitNetwork.App.AuthzKeeper,
 		unitNetwork.App.TransferKeeper,
 	)
@@ -292,6 +294,7 @@ func setupNewERC20PrecompileForTokenPair(
 	precompile, err := erc20.NewPrecompile(
 		tokenPair,
 		unitNetwork.App.BankKeeper,
+		unitNetwork.App.Erc20Keeper,
 		unitNetwork.App.AuthzKeeper,
 		unitNetwork.App.TransferKeeper,
 	)
@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+import "../common/Types.sol";
+
+/// @dev The IFeegrant contract's address.
+address constant FEEGRANT_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000809;
+
+/// @dev The IFeegrant contract's instance.
+IFeegrant constant FEEGRANT_CONTRACT = IFeegrant(FEEGRANT_PRECOMPILE_ADDRESS);
+
+/// @dev The Allowance struct contains the information of a fee allowance.
+struct Allowance {
+    // granter is the address of the account paying the fees
+    address granter;
+    // grantee is the address of the account whose fees are paid
+    address grantee;
+    // spendLimit is the maximum amount of fees that can be paid, or empty if there is no limit
+    Coin[] spendLimit;
+    // expiration is the unix timestamp in seconds at which the allowance expires, or zero if it does not expire
+    int64 expiration;
+    // allowedMsgs are the type URLs of the messages whose fees can be paid, or empty for any message
+    string[] allowedMsgs;
+}
+
+/// @author The Evmos Core Team
+/// @title Feegrant Precompile Contract
+/// @dev The interface through which solidity contracts will interact with the x/feegrant module
+interface IFeegrant {
+    /// @dev Event emitted when a fee allowance is granted.
+    /// @param granter The address of the granter
+    /// @param grantee The address of the grantee
+    event GrantAllowance(address indexed granter, address indexed grantee);
+
+    /// @dev Event emitted when a fee allowance is revoked.
+    /// @param granter The address of the granter
+    /// @param grantee The address of the grantee
+    event RevokeAllowance(address indexed granter, address indexed grantee);
+
+    /// @dev Grants a fee allowance from the caller to the grantee.
+    /// @param grantee The address of the grantee
+    /// @param spendLimit The maximum amount of fees that can be paid, or empty if there is no limit
+    /// @param expiration The unix timestamp in seconds at which the allowance expires, or zero if it does not expire
+    /// @param allowedMsgs The type URLs of the messages whose fees can be paid, or empty for any message
+    /// @return success True if the allowance was granted successfully
+    function grantAllowance(
+        address grantee,
+        Coin[] calldata spendLimit,
+        int64 expiration,
+        string[] calldata allowedMsgs
+    ) external returns (bool success);
+
+    /// @dev Revokes the fee allowance from the caller to the grantee.
+    /// @param grantee The address of the grantee
+    /// @return success True if the allowance was revoked successfully
+    function revokeAllowance(address grantee) external returns (bool success);
+
+    /// @dev Queries the fee allowance from the granter to the grantee.
+    /// @param granter The address of the granter
+    /// @param grantee The address of the grantee
+    /// @return allowance The fee allowance
+    function allowance(address granter, address grantee) external view returns (Allowance memory allowance);
+
+    /// @dev Queries the fee allowances received by the grantee.
+    /// @param grantee The address of the grantee
+    /// @param pageRequest Pagination request
+    /// @return allowances The fee allowances received by the grantee
+    /// @return pageResponse Pagination response
+    function allowances(
+        address grantee,
+        PageRequest calldata pageRequest
+    ) external view returns (Allowance[] memory allowances, PageResponse memory pageResponse);
+
+    /// @dev Queries the fee allowances given by the granter.
+    /// @param granter The address of the granter
+    /// @param pageRequest Pagination request
+    /// @return allowances The fee allowances given by the granter
+    /// @return pageResponse Pagination response
+    function allowancesByGranter(
+        address granter,
+        PageRequest calldata pageRequest
+    ) external view returns (Allowance[] memory allowances, PageResponse memory pageResponse);
+}
@@ -0,0 +1,12 @@
+package feegrant
+
+const (
+	// ErrInvalidGrantee is raised when the grantee address is not valid.
+	ErrInvalidGrantee = "invalid grantee address: %s"
+	// ErrInvalidGranter is raised when the granter address is not valid.
+	ErrInvalidGranter = "invalid granter address: %s"
+	// ErrInvalidExpiration is raised when the expiration of an allowance is not valid.
+	ErrInvalidExpiration = "invalid expiration: %d"
+	// ErrUnsupportedAllowance is raised when an allowance type cannot be represented by the precompile.
+	ErrUnsupportedAllowance = "unsupported allowance type: %T"
+)
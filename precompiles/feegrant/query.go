@@ -0,0 +1,139 @@
+package feegrant
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	"cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// Allowance returns the fee allowance from the granter to the grantee.
+func (p Precompile) Allowance(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input AllowanceInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to AllowanceInput: %s", err)
+	}
+
+	res, err := p.feegrantKeeper.Allowance(ctx, &feegrant.QueryAllowanceRequest{
+		Granter: sdk.AccAddress(input.Granter.Bytes()).String(),
+		Grantee: sdk.AccAddress(input.Grantee.Bytes()).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	allowance, err := NewAllowance(res.Allowance)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(allowance)
+}
+
+// Allowances returns the fee allowances received by the grantee.
+func (p Precompile) Allowances(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input AllowancesInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to AllowancesInput: %s", err)
+	}
+
+	if input.Grantee == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGrantee, input.Grantee)
+	}
+
+	res, err := p.feegrantKeeper.Allowances(ctx, &feegrant.QueryAllowancesRequest{
+		Grantee:    sdk.AccAddress(input.Grantee.Bytes()).String(),
+		Pagination: &input.PageRequest,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	allowances, err := newAllowances(res.Allowances)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(allowances, pageResponse(res.Pagination))
+}
+
+// AllowancesByGranter returns the fee allowances given by the granter.
+func (p Precompile) AllowancesByGranter(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input AllowancesByGranterInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to AllowancesByGranterInput: %s", err)
+	}
+
+	if input.Granter == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGranter, input.Granter)
+	}
+
+	res, err := p.feegrantKeeper.AllowancesByGranter(ctx, &feegrant.QueryAllowancesByGranterRequest{
+		Granter:    sdk.AccAddress(input.Granter.Bytes()).String(),
+		Pagination: &input.PageRequest,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	allowances, err := newAllowances(res.Allowances)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(allowances, pageResponse(res.Pagination))
+}
+
+// newAllowances converts the fee grants returned by the feegrant queries to
+// their Solidity representation.
+func newAllowances(grants []*feegrant.Grant) ([]Allowance, error) {
+	allowances := make([]Allowance, len(grants))
+	for i, grant := range grants {
+		var err error
+		if allowances[i], err = NewAllowance(grant); err != nil {
+			return nil, err
+		}
+	}
+
+	return allowances, nil
+}
+
+// pageResponse returns the given page response, or an empty one if it is nil
+// so that it can be packed.
+func pageResponse(res *query.PageResponse) query.PageResponse {
+	if res == nil {
+		return query.PageResponse{}
+	}
+	return *res
+}
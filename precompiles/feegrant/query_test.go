@@ -0,0 +1,81 @@
+package feegrant_test
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/precompiles/feegrant"
+	"github.com/cosmos/evm/precompiles/testutil"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func (s *PrecompileTestSuite) TestAllowance() {
+	s.SetupTest()
+
+	granter, grantee := s.keyring.GetAddr(0), s.keyring.GetAddr(1)
+	_, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), granter, s.precompile, 200_000)
+
+	// no allowance was granted yet
+	method := s.precompile.Methods[feegrant.AllowanceMethod]
+	_, err := s.precompile.Allowance(ctx, &method, []interface{}{granter, grantee})
+	s.Require().ErrorContains(err, "fee-grant not found")
+
+	s.newAllowance(ctx, granter, grantee)
+
+	allowance := s.queryAllowance(ctx, granter, grantee)
+	s.Require().Equal(granter, allowance.Granter)
+	s.Require().Equal(grantee, allowance.Grantee)
+	s.Require().Empty(allowance.SpendLimit)
+}
+
+func (s *PrecompileTestSuite) TestAllowances() {
+	method := s.precompile.Methods[feegrant.AllowancesMethod]
+	s.SetupTest()
+
+	granter, grantee := s.keyring.GetAddr(0), s.keyring.GetAddr(1)
+	_, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), granter, s.precompile, 200_000)
+	s.newAllowance(ctx, granter, grantee)
+
+	bz, err := s.precompile.Allowances(ctx, &method, []interface{}{grantee, query.PageRequest{CountTotal: true}})
+	s.Require().NoError(err)
+
+	var out feegrant.AllowancesOutput
+	err = s.precompile.UnpackIntoInterface(&out, method.Name, bz)
+	s.Require().NoError(err)
+	s.Require().Len(out.Allowances, 1)
+	s.Require().Equal(granter, out.Allowances[0].Granter)
+	s.Require().Equal(uint64(1), out.PageResponse.Total)
+}
+
+func (s *PrecompileTestSuite) TestAllowancesByGranter() {
+	method := s.precompile.Methods[feegrant.AllowancesByGranterMethod]
+	s.SetupTest()
+
+	granter, grantee := s.keyring.GetAddr(0), s.keyring.GetAddr(1)
+	_, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), granter, s.precompile, 200_000)
+	s.newAllowance(ctx, granter, grantee)
+
+	bz, err := s.precompile.AllowancesByGranter(ctx, &method, []interface{}{granter, query.PageRequest{CountTotal: true}})
+	s.Require().NoError(err)
+
+	var out feegrant.AllowancesOutput
+	err = s.precompile.UnpackIntoInterface(&out, method.Name, bz)
+	s.Require().NoError(err)
+	s.Require().Len(out.Allowances, 1)
+	s.Require().Equal(grantee, out.Allowances[0].Grantee)
+	s.Require().Equal(uint64(1), out.PageResponse.Total)
+}
+
+// queryAllowance returns the allowance from the granter to the grantee
+// through the precompile allowance query.
+func (s *PrecompileTestSuite) queryAllowance(ctx sdk.Context, granter, grantee common.Address) feegrant.Allowance {
+	method := s.precompile.Methods[feegrant.AllowanceMethod]
+	bz, err := s.precompile.Allowance(ctx, &method, []interface{}{granter, grantee})
+	s.Require().NoError(err)
+
+	var out feegrant.AllowanceOutput
+	err = s.precompile.UnpackIntoInterface(&out, method.Name, bz)
+	s.Require().NoError(err)
+	return out.Allowance
+}
@@ -0,0 +1,63 @@
+package feegrant
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GrantAllowance grants a fee allowance from the caller to the grantee, so the
+// grantee can pay the fees of its transactions with the caller's funds.
+func (p Precompile) GrantAllowance(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	granter := contract.CallerAddress
+	msg, grantee, err := NewMsgGrantAllowance(method, granter, args)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSrv := feegrantkeeper.NewMsgServerImpl(p.feegrantKeeper)
+	if _, err = msgSrv.GrantAllowance(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitGrantAllowanceEvent(ctx, stateDB, granter, grantee); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// RevokeAllowance revokes the fee allowance from the caller to the grantee.
+func (p Precompile) RevokeAllowance(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	granter := contract.CallerAddress
+	msg, grantee, err := NewMsgRevokeAllowance(granter, args)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSrv := feegrantkeeper.NewMsgServerImpl(p.feegrantKeeper)
+	if _, err = msgSrv.RevokeAllowance(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitRevokeAllowanceEvent(ctx, stateDB, granter, grantee); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
@@ -0,0 +1,185 @@
+package feegrant_test
+
+import (
+	"math/big"
+	"time"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/feegrant"
+	"github.com/cosmos/evm/precompiles/testutil"
+	cosmosevmutiltx "github.com/cosmos/evm/testutil/tx"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (s *PrecompileTestSuite) TestGrantAllowance() {
+	method := s.precompile.Methods[feegrant.GrantAllowanceMethod]
+	grantee := cosmosevmutiltx.GenerateAddress()
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expPass     bool
+		errContains string
+		postCheck   func(allowance feegrant.Allowance)
+	}{
+		{
+			"fail - invalid number of arguments",
+			func() []interface{} {
+				return []interface{}{grantee}
+			},
+			false,
+			"invalid number of arguments",
+			nil,
+		},
+		{
+			"fail - negative expiration",
+			func() []interface{} {
+				return []interface{}{grantee, []cmn.Coin{}, int64(-1), []string{}}
+			},
+			false,
+			"invalid expiration",
+			nil,
+		},
+		{
+			"fail - grantee is the granter",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), []cmn.Coin{}, int64(0), []string{}}
+			},
+			false,
+			"cannot self-grant fee authorization",
+			nil,
+		},
+		{
+			"fail - expiration in the past",
+			func() []interface{} {
+				return []interface{}{grantee, []cmn.Coin{}, s.network.GetContext().BlockTime().Add(-time.Hour).Unix(), []string{}}
+			},
+			false,
+			"expiration is before current block time",
+			nil,
+		},
+		{
+			"pass - unlimited allowance",
+			func() []interface{} {
+				return []interface{}{grantee, []cmn.Coin{}, int64(0), []string{}}
+			},
+			true,
+			"",
+			func(allowance feegrant.Allowance) {
+				s.Require().Empty(allowance.SpendLimit)
+				s.Require().Zero(allowance.Expiration)
+				s.Require().Empty(allowance.AllowedMsgs)
+			},
+		},
+		{
+			"pass - allowance with spend limit, expiration and allowed messages",
+			func() []interface{} {
+				return []interface{}{
+					grantee,
+					[]cmn.Coin{{Denom: s.network.GetBaseDenom(), Amount: big.NewInt(1e18)}},
+					s.network.GetContext().BlockTime().Add(time.Hour).Unix(),
+					[]string{sdk.MsgTypeURL(&banktypes.MsgSend{})},
+				}
+			},
+			true,
+			"",
+			func(allowance feegrant.Allowance) {
+				s.Require().Equal([]cmn.Coin{{Denom: s.network.GetBaseDenom(), Amount: big.NewInt(1e18)}}, allowance.SpendLimit)
+				s.Require().Equal(s.network.GetContext().BlockTime().Add(time.Hour).Unix(), allowance.Expiration)
+				s.Require().Equal([]string{sdk.MsgTypeURL(&banktypes.MsgSend{})}, allowance.AllowedMsgs)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile, 200_000)
+
+			bz, err := s.precompile.GrantAllowance(ctx, contract, s.network.GetStateDB(), &method, tc.malleate())
+
+			if tc.expPass {
+				s.Require().NoError(err)
+				var success bool
+				err = s.precompile.UnpackIntoInterface(&success, method.Name, bz)
+				s.Require().NoError(err)
+				s.Require().True(success)
+
+				grant, err := s.network.App.FeeGrantKeeper.GetAllowance(ctx, s.keyring.GetAccAddr(0), grantee.Bytes())
+				s.Require().NoError(err)
+				s.Require().NotNil(grant)
+
+				allowance := s.queryAllowance(ctx, s.keyring.GetAddr(0), grantee)
+				s.Require().Equal(s.keyring.GetAddr(0), allowance.Granter)
+				s.Require().Equal(grantee, allowance.Grantee)
+				tc.postCheck(allowance)
+			} else {
+				s.Require().ErrorContains(err, tc.errContains)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRevokeAllowance() {
+	method := s.precompile.Methods[feegrant.RevokeAllowanceMethod]
+	grantee := s.keyring.GetAddr(1)
+
+	testCases := []struct {
+		name        string
+		malleate    func(ctx sdk.Context) []interface{}
+		expPass     bool
+		errContains string
+	}{
+		{
+			"fail - invalid number of arguments",
+			func(_ sdk.Context) []interface{} {
+				return []interface{}{}
+			},
+			false,
+			"invalid number of arguments",
+		},
+		{
+			"fail - allowance not found",
+			func(_ sdk.Context) []interface{} {
+				return []interface{}{grantee}
+			},
+			false,
+			"fee-grant not found",
+		},
+		{
+			"pass - revoke existing allowance",
+			func(ctx sdk.Context) []interface{} {
+				s.newAllowance(ctx, s.keyring.GetAddr(0), grantee)
+				return []interface{}{grantee}
+			},
+			true,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile, 200_000)
+
+			bz, err := s.precompile.RevokeAllowance(ctx, contract, s.network.GetStateDB(), &method, tc.malleate(ctx))
+
+			if tc.expPass {
+				s.Require().NoError(err)
+				var success bool
+				err = s.precompile.UnpackIntoInterface(&success, method.Name, bz)
+				s.Require().NoError(err)
+				s.Require().True(success)
+
+				_, err = s.network.App.FeeGrantKeeper.GetAllowance(ctx, s.keyring.GetAccAddr(0), grantee.Bytes())
+				s.Require().Error(err)
+			} else {
+				s.Require().ErrorContains(err, tc.errContains)
+			}
+		})
+	}
+}
@@ -0,0 +1,200 @@
+package feegrant
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	"cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+const (
+	// GrantAllowanceMethod defines the ABI method name for the feegrant
+	// GrantAllowance transaction.
+	GrantAllowanceMethod = "grantAllowance"
+	// RevokeAllowanceMethod defines the ABI method name for the feegrant
+	// RevokeAllowance transaction.
+	RevokeAllowanceMethod = "revokeAllowance"
+	// AllowanceMethod defines the ABI method name for the feegrant Allowance query.
+	AllowanceMethod = "allowance"
+	// AllowancesMethod defines the ABI method name for the feegrant Allowances query.
+	AllowancesMethod = "allowances"
+	// AllowancesByGranterMethod defines the ABI method name for the feegrant
+	// AllowancesByGranter query.
+	AllowancesByGranterMethod = "allowancesByGranter"
+)
+
+// GrantAllowanceInput defines the input for the GrantAllowance transaction.
+type GrantAllowanceInput struct {
+	Grantee     common.Address
+	SpendLimit  []cmn.Coin
+	Expiration  int64
+	AllowedMsgs []string
+}
+
+// AllowanceInput defines the input for the Allowance query.
+type AllowanceInput struct {
+	Granter common.Address
+	Grantee common.Address
+}
+
+// AllowancesInput defines the input for the Allowances query.
+type AllowancesInput struct {
+	Grantee     common.Address
+	PageRequest query.PageRequest
+}
+
+// AllowancesByGranterInput defines the input for the AllowancesByGranter query.
+type AllowancesByGranterInput struct {
+	Granter     common.Address
+	PageRequest query.PageRequest
+}
+
+// Allowance represents the Solidity Allowance struct. The expiration is a unix
+// timestamp in seconds, or zero if the allowance does not expire, and an empty
+// list of allowed messages means that the allowance can pay the fees of any
+// message.
+type Allowance struct {
+	Granter     common.Address
+	Grantee     common.Address
+	SpendLimit  []cmn.Coin
+	Expiration  int64
+	AllowedMsgs []string
+}
+
+// AllowanceOutput defines the output for the Allowance query.
+type AllowanceOutput struct {
+	Allowance Allowance
+}
+
+// AllowancesOutput defines the output for the Allowances and
+// AllowancesByGranter queries.
+type AllowancesOutput struct {
+	Allowances   []Allowance
+	PageResponse query.PageResponse
+}
+
+// NewMsgGrantAllowance creates a new MsgGrantAllowance instance that grants a
+// basic allowance from the granter to the grantee. If allowed messages are
+// given, the basic allowance only pays the fees of those messages.
+func NewMsgGrantAllowance(method *abi.Method, granter common.Address, args []interface{}) (*feegrant.MsgGrantAllowance, common.Address, error) {
+	if len(args) != 4 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	var input GrantAllowanceInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, common.Address{}, fmt.Errorf("error while unpacking args to GrantAllowanceInput: %s", err)
+	}
+
+	if input.Grantee == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidGrantee, input.Grantee)
+	}
+
+	if input.Expiration < 0 {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidExpiration, input.Expiration)
+	}
+
+	// an empty spend limit must be nil for the allowance to be unlimited
+	var spendLimit sdk.Coins
+	for _, coin := range input.SpendLimit {
+		spendLimit = append(spendLimit, coin.ToSDKType())
+	}
+
+	basic := &feegrant.BasicAllowance{SpendLimit: spendLimit.Sort()}
+	if input.Expiration != 0 {
+		expiration := time.Unix(input.Expiration, 0).UTC()
+		basic.Expiration = &expiration
+	}
+
+	var allowance feegrant.FeeAllowanceI = basic
+	if len(input.AllowedMsgs) > 0 {
+		allowedMsgAllowance, err := feegrant.NewAllowedMsgAllowance(basic, input.AllowedMsgs)
+		if err != nil {
+			return nil, common.Address{}, err
+		}
+		allowance = allowedMsgAllowance
+	}
+
+	msg, err := feegrant.NewMsgGrantAllowance(allowance, granter.Bytes(), input.Grantee.Bytes())
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, input.Grantee, nil
+}
+
+// NewMsgRevokeAllowance creates a new MsgRevokeAllowance instance that revokes
+// the allowance from the granter to the grantee.
+func NewMsgRevokeAllowance(granter common.Address, args []interface{}) (*feegrant.MsgRevokeAllowance, common.Address, error) {
+	if len(args) != 1 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	grantee, ok := args[0].(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidGrantee, args[0])
+	}
+
+	msg := feegrant.NewMsgRevokeAllowance(granter.Bytes(), grantee.Bytes())
+	return &msg, grantee, nil
+}
+
+// NewAllowance creates a new Allowance from the given fee grant. Only basic
+// and periodic allowances, optionally restricted to a list of messages, are
+// supported. The spend limit and expiration of a periodic allowance are the
+// ones of its basic allowance.
+func NewAllowance(grant *feegrant.Grant) (Allowance, error) {
+	granter, err := cmn.HexAddressFromBech32String(grant.Granter)
+	if err != nil {
+		return Allowance{}, err
+	}
+
+	grantee, err := cmn.HexAddressFromBech32String(grant.Grantee)
+	if err != nil {
+		return Allowance{}, err
+	}
+
+	allowance, err := grant.GetGrant()
+	if err != nil {
+		return Allowance{}, err
+	}
+
+	allowedMsgs := []string{}
+	if allowedMsgAllowance, ok := allowance.(*feegrant.AllowedMsgAllowance); ok {
+		allowedMsgs = allowedMsgAllowance.AllowedMessages
+		if allowance, err = allowedMsgAllowance.GetAllowance(); err != nil {
+			return Allowance{}, err
+		}
+	}
+
+	var basic feegrant.BasicAllowance
+	switch a := allowance.(type) {
+	case *feegrant.BasicAllowance:
+		basic = *a
+	case *feegrant.PeriodicAllowance:
+		basic = a.Basic
+	default:
+		return Allowance{}, fmt.Errorf(ErrUnsupportedAllowance, allowance)
+	}
+
+	var expiration int64
+	if basic.Expiration != nil {
+		expiration = basic.Expiration.Unix()
+	}
+
+	return Allowance{
+		Granter:     granter,
+		Grantee:     grantee,
+		SpendLimit:  cmn.NewCoinsResponse(basic.SpendLimit),
+		Expiration:  expiration,
+		AllowedMsgs: allowedMsgs,
+	}, nil
+}
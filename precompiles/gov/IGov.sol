@@ -110,8 +110,57 @@ interface IGov {
         WeightedVoteOption[] options
     );
 
+    /// @dev SubmitProposal defines an Event emitted when a proposal is submitted.
+    /// @param proposer the address of the proposer
+    /// @param proposalId the proposal of id
+    event SubmitProposal(address indexed proposer, uint64 proposalId);
+
+    /// @dev Deposit defines an Event emitted when a deposit is made on a proposal.
+    /// @param depositor the address of the depositor
+    /// @param proposalId the proposal of id
+    /// @param amount the amount deposited
+    event Deposit(address indexed depositor, uint64 proposalId, Coin[] amount);
+
+    /// @dev CancelProposal defines an Event emitted when a proposal is canceled.
+    /// @param proposer the address of the proposer
+    /// @param proposalId the proposal of id
+    event CancelProposal(address indexed proposer, uint64 proposalId);
+
     /// TRANSACTIONS
 
+    /// @dev submitProposal defines a method to submit a proposal.
+    /// @param proposer The address of the proposer
+    /// @param jsonProposal The JSON-encoded proposal, in the same format as the
+    /// gov CLI proposal file (messages, metadata, title, summary, expedited)
+    /// @param deposit The initial deposit of the proposal
+    /// @return proposalId The id of the submitted proposal
+    function submitProposal(
+        address proposer,
+        bytes calldata jsonProposal,
+        Coin[] calldata deposit
+    ) external returns (uint64 proposalId);
+
+    /// @dev deposit defines a method to add a deposit on a specific proposal.
+    /// @param depositor The address of the depositor
+    /// @param proposalId The proposal id
+    /// @param amount The amount to deposit
+    /// @return success Whether the transaction was successful or not
+    function deposit(
+        address depositor,
+        uint64 proposalId,
+        Coin[] calldata amount
+    ) external returns (bool success);
+
+    /// @dev cancelProposal defines a method to cancel a proposal. The deposits
+    /// are refunded to the depositors, minus the proposal cancel ratio.
+    /// @param proposer The address of the proposer
+    /// @param proposalId The proposal id
+    /// @return success Whether the transaction was successful or not
+    function cancelProposal(
+        address proposer,
+        uint64 proposalId
+    ) external returns (bool success);
+
     /// @dev vote defines a method to add a vote on a specific proposal.
     /// @param voter The address of the voter
     /// @param proposalId the proposal of id
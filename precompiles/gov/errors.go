@@ -23,4 +23,14 @@ const (
 	ErrInvalidWeightedVoteOptionWeight = "invalid weighted vote option weight %s "
 	// ErrInvalidDepositor invalid depositor.
 	ErrInvalidDepositor = "invalid depositor %s "
+	// ErrInvalidProposer invalid proposer.
+	ErrInvalidProposer = "invalid proposer %s "
+	// ErrDifferentOriginProposer is raised when the origin address is not the same as the proposer address.
+	ErrDifferentOriginProposer = "tx origin address %s does not match the proposer address %s"
+	// ErrDifferentOriginDepositor is raised when the origin address is not the same as the depositor address.
+	ErrDifferentOriginDepositor = "tx origin address %s does not match the depositor address %s"
+	// ErrInvalidProposalJSON is raised when the JSON-encoded proposal cannot be decoded.
+	ErrInvalidProposalJSON = "invalid proposal JSON: %s"
+	// ErrProposalMsgNotAllowed is raised when a proposal message type is not allowed through the precompile.
+	ErrProposalMsgNotAllowed = "proposal message type %s is not allowed"
 )
@@ -14,6 +14,7 @@ import (
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
@@ -30,6 +31,10 @@ var f embed.FS
 type Precompile struct {
 	cmn.Precompile
 	govKeeper govkeeper.Keeper
+	cdc       codec.Codec
+	// allowedProposalMsgs defines the message type URLs that can be submitted
+	// in a proposal through the precompile.
+	allowedProposalMsgs map[string]bool
 }
 
 // LoadABI loads the gov ABI from the embedded abi.json file
@@ -42,6 +47,7 @@ func LoadABI() (abi.ABI, error) {
 // PrecompiledContract interface.
 func NewPrecompile(
 	govKeeper govkeeper.Keeper,
+	cdc codec.Codec,
 	authzKeeper authzkeeper.Keeper,
 ) (*Precompile, error) {
 	abi, err := LoadABI()
@@ -58,7 +64,9 @@ func NewPrecompile(
 			ApprovalExpiration:   cmn.DefaultExpirationDuration, // should be configurable in the future.
 		},
 		govKeeper: govKeeper,
+		cdc:       cdc,
 	}
+	p.SetAllowedProposalMsgs(DefaultAllowedProposalMsgs...)
 
 	// SetAddress defines the address of the gov precompiled contract.
 	p.SetAddress(common.HexToAddress(evmtypes.GovPrecompileAddress))
@@ -66,6 +74,15 @@ func NewPrecompile(
 	return p, nil
 }
 
+// SetAllowedProposalMsgs sets the message type URLs that can be submitted in a
+// proposal through the precompile, replacing the previous ones.
+func (p *Precompile) SetAllowedProposalMsgs(typeURLs ...string) {
+	p.allowedProposalMsgs = make(map[string]bool, len(typeURLs))
+	for _, typeURL := range typeURLs {
+		p.allowedProposalMsgs[typeURL] = true
+	}
+}
+
 // RequiredGas calculates the precompiled contract's base gas rate.
 func (p Precompile) RequiredGas(input []byte) uint64 {
 	// NOTE: This check avoid panicking when trying to decode the method ID
@@ -100,6 +117,12 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 		bz, err = p.Vote(ctx, evm.Origin, contract, stateDB, method, args)
 	case VoteWeightedMethod:
 		bz, err = p.VoteWeighted(ctx, evm.Origin, contract, stateDB, method, args)
+	case SubmitProposalMethod:
+		bz, err = p.SubmitProposal(ctx, evm.Origin, contract, stateDB, method, args)
+	case DepositMethod:
+		bz, err = p.Deposit(ctx, evm.Origin, contract, stateDB, method, args)
+	case CancelProposalMethod:
+		bz, err = p.CancelProposal(ctx, evm.Origin, contract, stateDB, method, args)
 
 	// gov queries
 	case GetVoteMethod:
@@ -142,7 +165,8 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 // IsTransaction checks if the given method name corresponds to a transaction or query.
 func (Precompile) IsTransaction(method *abi.Method) bool {
 	switch method.Name {
-	case VoteMethod, VoteWeightedMethod:
+	case VoteMethod, VoteWeightedMethod,
+		SubmitProposalMethod, DepositMethod, CancelProposalMethod:
 		return true
 	default:
 		return false
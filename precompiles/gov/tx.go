@@ -7,8 +7,17 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/utils"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
 
 const (
@@ -16,6 +25,12 @@ const (
 	VoteMethod = "vote"
 	// VoteWeightedMethod defines the ABI method name for the gov VoteWeighted transaction.
 	VoteWeightedMethod = "voteWeighted"
+	// SubmitProposalMethod defines the ABI method name for the gov SubmitProposal transaction.
+	SubmitProposalMethod = "submitProposal"
+	// DepositMethod defines the ABI method name for the gov Deposit transaction.
+	DepositMethod = "deposit"
+	// CancelProposalMethod defines the ABI method name for the gov CancelProposal transaction.
+	CancelProposalMethod = "cancelProposal"
 )
 
 // Vote defines a method to add a vote on a specific proposal.
@@ -83,3 +98,199 @@ func (p Precompile) VoteWeighted(
 
 	return method.Outputs.Pack(true)
 }
+
+// SubmitProposal defines a method to submit a proposal with the given
+// JSON-encoded messages and initial deposit.
+func (p *Precompile) SubmitProposal(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, proposerHexAddr, err := NewMsgSubmitProposal(method, args, p.cdc, p.allowedProposalMsgs)
+	if err != nil {
+		return nil, err
+	}
+
+	// If the contract is the proposer, we don't need an origin check
+	// Otherwise check if the origin matches the proposer address
+	isContractProposer := contract.CallerAddress == proposerHexAddr && contract.CallerAddress != origin
+	if !isContractProposer && origin != proposerHexAddr {
+		return nil, fmt.Errorf(ErrDifferentOriginProposer, origin.String(), proposerHexAddr.String())
+	}
+
+	msgSrv := govkeeper.NewMsgServerImpl(&p.govKeeper)
+	res, err := msgSrv.SubmitProposal(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if contract.CallerAddress != origin {
+		p.setDepositBalanceChangeEntries(proposerHexAddr, msg.InitialDeposit)
+	}
+
+	if err = p.EmitSubmitProposalEvent(ctx, stateDB, proposerHexAddr, res.ProposalId); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.ProposalId)
+}
+
+// Deposit defines a method to add a deposit on a specific proposal.
+func (p *Precompile) Deposit(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, depositorHexAddr, err := NewMsgDeposit(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	// If the contract is the depositor, we don't need an origin check
+	// Otherwise check if the origin matches the depositor address
+	isContractDepositor := contract.CallerAddress == depositorHexAddr && contract.CallerAddress != origin
+	if !isContractDepositor && origin != depositorHexAddr {
+		return nil, fmt.Errorf(ErrDifferentOriginDepositor, origin.String(), depositorHexAddr.String())
+	}
+
+	msgSrv := govkeeper.NewMsgServerImpl(&p.govKeeper)
+	if _, err = msgSrv.Deposit(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if contract.CallerAddress != origin {
+		p.setDepositBalanceChangeEntries(depositorHexAddr, msg.Amount)
+	}
+
+	if err = p.EmitDepositEvent(ctx, stateDB, depositorHexAddr, msg.ProposalId, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// CancelProposal defines a method to cancel a proposal. The deposits are
+// refunded to the depositors, minus the proposal cancel ratio.
+func (p *Precompile) CancelProposal(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, proposerHexAddr, err := NewMsgCancelProposal(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// If the contract is the proposer, we don't need an origin check
+	// Otherwise check if the origin matches the proposer address
+	isContractProposer := contract.CallerAddress == proposerHexAddr && contract.CallerAddress != origin
+	if !isContractProposer && origin != proposerHexAddr {
+		return nil, fmt.Errorf(ErrDifferentOriginProposer, origin.String(), proposerHexAddr.String())
+	}
+
+	// the deposits are removed on cancellation, so they need to be fetched
+	// beforehand to mirror the refunds on the EVM stateDB
+	deposits, err := p.govKeeper.GetDeposits(ctx, msg.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+	params, err := p.govKeeper.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSrv := govkeeper.NewMsgServerImpl(&p.govKeeper)
+	if _, err = msgSrv.CancelProposal(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if contract.CallerAddress != origin {
+		if err := p.setCancelBalanceChangeEntries(deposits, params.ProposalCancelRatio, params.ProposalCancelDest); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = p.EmitCancelProposalEvent(ctx, stateDB, proposerHexAddr, msg.ProposalId); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// setDepositBalanceChangeEntries sets the balance change entry of the EVM
+// coin deposited by the given depositor.
+//
+// NOTE: This ensures that the changes in the bank keeper are correctly mirrored to the EVM stateDB
+// when calling the precompile from a smart contract
+// This prevents the stateDB from overwriting the changed balance in the bank keeper when committing the EVM state.
+func (p *Precompile) setDepositBalanceChangeEntries(depositor common.Address, amount sdk.Coins) {
+	evmAmount := amount.AmountOf(evmtypes.GetEVMCoinDenom())
+	if !evmAmount.IsPositive() {
+		return
+	}
+
+	scaledAmt := evmtypes.ConvertAmountTo18DecimalsBigInt(evmAmount.BigInt())
+	p.SetBalanceChangeEntries(cmn.NewBalanceChangeEntry(depositor, scaledAmt, cmn.Sub))
+}
+
+// setCancelBalanceChangeEntries sets the balance change entries of the EVM coin
+// refunded to the depositors of a canceled proposal, and of the cancellation
+// charges sent to the destination address. It mirrors the x/gov ChargeDeposit
+// logic.
+func (p *Precompile) setCancelBalanceChangeEntries(deposits []*govv1.Deposit, cancelRatio, cancelDest string) error {
+	evmDenom := evmtypes.GetEVMCoinDenom()
+	rate, err := sdkmath.LegacyNewDecFromStr(cancelRatio)
+	if err != nil {
+		return err
+	}
+
+	var (
+		entries []cmn.BalanceChangeEntry
+		charges = sdkmath.ZeroInt()
+	)
+	for _, deposit := range deposits {
+		amount := sdk.NewCoins(deposit.Amount...).AmountOf(evmDenom)
+		if !amount.IsPositive() {
+			continue
+		}
+
+		charge := sdkmath.LegacyNewDecFromInt(amount).Mul(rate).TruncateInt()
+		charges = charges.Add(charge)
+
+		refund := amount.Sub(charge)
+		if !refund.IsPositive() {
+			continue
+		}
+
+		depositorHexAddr, err := utils.Bech32ToHexAddr(deposit.Depositor)
+		if err != nil {
+			return err
+		}
+		scaledAmt := evmtypes.ConvertAmountTo18DecimalsBigInt(refund.BigInt())
+		entries = append(entries, cmn.NewBalanceChangeEntry(depositorHexAddr, scaledAmt, cmn.Add))
+	}
+
+	// the charges are burned if there is no destination address, or go to the
+	// community pool if the destination is the distribution module
+	distributionAddress := authtypes.NewModuleAddress(distrtypes.ModuleName).String()
+	if charges.IsPositive() && cancelDest != "" && cancelDest != distributionAddress {
+		destHexAddr, err := utils.Bech32ToHexAddr(cancelDest)
+		if err != nil {
+			return err
+		}
+		scaledAmt := evmtypes.ConvertAmountTo18DecimalsBigInt(charges.BigInt())
+		entries = append(entries, cmn.NewBalanceChangeEntry(destHexAddr, scaledAmt, cmn.Add))
+	}
+
+	p.SetBalanceChangeEntries(entries...)
+	return nil
+}
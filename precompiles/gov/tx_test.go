@@ -11,9 +11,11 @@ import (
 	"github.com/cosmos/evm/precompiles/testutil"
 	utiltx "github.com/cosmos/evm/testutil/tx"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
 
 func (s *PrecompileTestSuite) TestVote() {
@@ -277,3 +279,355 @@ func (s *PrecompileTestSuite) TestVoteWeighted() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestSubmitProposal() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[gov.SubmitProposalMethod]
+	newProposerAddr := utiltx.GenerateAddress()
+	const proposalMsgFmt = `{"messages":[{"@type":"%s","from_address":"%s","to_address":"%s","amount":[{"denom":"%s","amount":"1000"}]}],"metadata":"ipfs://CID","title":"test prop","summary":"test prop","expedited":false}`
+	const disallowedProposal = `{"messages":[{"@type":"/cosmos.bank.v1beta1.MsgSetSendEnabled","authority":"%s"}],"title":"test prop","summary":"test prop"}`
+	const legacyProposal = `{"messages":[{"@type":"/cosmos.gov.v1.MsgExecLegacyContent","content":{"@type":"/cosmos.gov.v1beta1.TextProposal","title":"test prop","description":"test prop"},"authority":"%s"}],"title":"test prop","summary":"test prop"}`
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(proposalID uint64)
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func(uint64) {},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			"fail - invalid proposer address",
+			func() []interface{} {
+				return []interface{}{
+					common.Address{},
+					[]byte("{}"),
+					[]cmn.Coin{},
+				}
+			},
+			func(uint64) {},
+			200000,
+			true,
+			"invalid proposer",
+		},
+		{
+			"fail - invalid proposal JSON",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					[]byte("{"),
+					[]cmn.Coin{},
+				}
+			},
+			func(uint64) {},
+			200000,
+			true,
+			"invalid proposal JSON",
+		},
+		{
+			"fail - proposal message type not allowed",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					[]byte(fmt.Sprintf(disallowedProposal, govAcct.String())),
+					[]cmn.Coin{},
+				}
+			},
+			func(uint64) {},
+			200000,
+			true,
+			"is not allowed",
+		},
+		{
+			"fail - legacy proposal content not allowed",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					[]byte(fmt.Sprintf(legacyProposal, govAcct.String())),
+					[]cmn.Coin{},
+				}
+			},
+			func(uint64) {},
+			200000,
+			true,
+			"is not allowed",
+		},
+		{
+			"fail - using a different proposer address",
+			func() []interface{} {
+				return []interface{}{
+					newProposerAddr,
+					[]byte(fmt.Sprintf(proposalMsgFmt, sdk.MsgTypeURL(TestProposalMsgs[0]), govAcct.String(), addr.String(), s.network.GetBaseDenom())),
+					[]cmn.Coin{},
+				}
+			},
+			func(uint64) {},
+			200000,
+			true,
+			"does not match the proposer address",
+		},
+		{
+			"success - submit proposal with initial deposit",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					[]byte(fmt.Sprintf(proposalMsgFmt, sdk.MsgTypeURL(TestProposalMsgs[0]), govAcct.String(), addr.String(), s.network.GetBaseDenom())),
+					[]cmn.Coin{{Denom: s.network.GetBaseDenom(), Amount: math.NewInt(100).BigInt()}},
+				}
+			},
+			func(proposalID uint64) {
+				proposal, err := s.network.App.GovKeeper.Proposals.Get(ctx, proposalID)
+				s.Require().NoError(err)
+				s.Require().Equal(s.keyring.GetAccAddr(0).String(), proposal.Proposer)
+				s.Require().Equal("test prop", proposal.Title)
+				s.Require().Len(proposal.Messages, 1)
+				s.Require().Equal(sdk.MsgTypeURL(TestProposalMsgs[0]), proposal.Messages[0].TypeUrl)
+				s.Require().Equal(govv1.ProposalStatus_PROPOSAL_STATUS_VOTING_PERIOD, proposal.Status)
+			},
+			500000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, tc.gas)
+
+			bz, err := s.precompile.SubmitProposal(ctx, s.keyring.GetAddr(0), contract, s.network.GetStateDB(), &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				out, err := method.Outputs.Unpack(bz)
+				s.Require().NoError(err)
+				proposalID, ok := out[0].(uint64)
+				s.Require().True(ok)
+				tc.postCheck(proposalID)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestDeposit() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[gov.DepositMethod]
+	newDepositorAddr := utiltx.GenerateAddress()
+	const proposalID uint64 = 2
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			"fail - invalid depositor address",
+			func() []interface{} {
+				return []interface{}{
+					common.Address{},
+					proposalID,
+					[]cmn.Coin{},
+				}
+			},
+			func() {},
+			200000,
+			true,
+			"invalid depositor",
+		},
+		{
+			"fail - using a different depositor address",
+			func() []interface{} {
+				return []interface{}{
+					newDepositorAddr,
+					proposalID,
+					[]cmn.Coin{{Denom: s.network.GetBaseDenom(), Amount: math.NewInt(100).BigInt()}},
+				}
+			},
+			func() {},
+			200000,
+			true,
+			"does not match the depositor address",
+		},
+		{
+			"fail - proposal does not exist",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					uint64(10),
+					[]cmn.Coin{{Denom: s.network.GetBaseDenom(), Amount: math.NewInt(100).BigInt()}},
+				}
+			},
+			func() {},
+			200000,
+			true,
+			"10: unknown proposal",
+		},
+		{
+			"success - deposit on proposal",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					proposalID,
+					[]cmn.Coin{{Denom: s.network.GetBaseDenom(), Amount: math.NewInt(100).BigInt()}},
+				}
+			},
+			func() {
+				deposit, err := s.network.App.GovKeeper.Deposits.Get(ctx, collections.Join(proposalID, s.keyring.GetAccAddr(0)))
+				s.Require().NoError(err)
+				s.Require().Equal(sdk.NewCoins(sdk.NewCoin(s.network.GetBaseDenom(), math.NewInt(100))), sdk.NewCoins(deposit.Amount...))
+			},
+			200000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, tc.gas)
+
+			_, err := s.precompile.Deposit(ctx, s.keyring.GetAddr(0), contract, s.network.GetStateDB(), &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestCancelProposal() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[gov.CancelProposalMethod]
+	newProposerAddr := utiltx.GenerateAddress()
+	const proposalID uint64 = 1
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - invalid proposer address",
+			func() []interface{} {
+				return []interface{}{
+					common.Address{},
+					proposalID,
+				}
+			},
+			func() {},
+			200000,
+			true,
+			"invalid proposer",
+		},
+		{
+			"fail - using a different proposer address",
+			func() []interface{} {
+				return []interface{}{
+					newProposerAddr,
+					proposalID,
+				}
+			},
+			func() {},
+			200000,
+			true,
+			"does not match the proposer address",
+		},
+		{
+			"fail - not the proposer of the proposal",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					uint64(2),
+				}
+			},
+			func() {},
+			200000,
+			true,
+			"invalid proposer",
+		},
+		{
+			"success - cancel proposal",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					proposalID,
+				}
+			},
+			func() {
+				found, err := s.network.App.GovKeeper.Proposals.Has(ctx, proposalID)
+				s.Require().NoError(err)
+				s.Require().False(found)
+			},
+			200000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, tc.gas)
+
+			_, err := s.precompile.CancelProposal(ctx, s.keyring.GetAddr(0), contract, s.network.GetStateDB(), &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck()
+			}
+		})
+	}
+}
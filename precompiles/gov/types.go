@@ -1,6 +1,7 @@
 package gov
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
@@ -9,11 +10,45 @@ import (
 	cmn "github.com/cosmos/evm/precompiles/common"
 	"github.com/cosmos/evm/utils"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
 
+// DefaultAllowedProposalMsgs defines the message type URLs that can be
+// submitted in a proposal through the precompile by default.
+var DefaultAllowedProposalMsgs = []string{
+	sdk.MsgTypeURL(&distrtypes.MsgCommunityPoolSpend{}),
+	sdk.MsgTypeURL(&banktypes.MsgSend{}),
+}
+
+// SubmitProposalInput defines the input for the SubmitProposal transaction.
+type SubmitProposalInput struct {
+	Proposer     common.Address
+	JsonProposal []byte //nolint:revive,stylecheck
+	Deposit      []cmn.Coin
+}
+
+// DepositTxInput defines the input for the Deposit transaction.
+type DepositTxInput struct {
+	Depositor  common.Address
+	ProposalId uint64 //nolint:revive
+	Amount     []cmn.Coin
+}
+
+// jsonProposal defines the JSON-encoded proposal of the SubmitProposal
+// transaction, in the same format as the gov CLI proposal file.
+type jsonProposal struct {
+	Messages  []json.RawMessage `json:"messages,omitempty"`
+	Metadata  string            `json:"metadata"`
+	Title     string            `json:"title"`
+	Summary   string            `json:"summary"`
+	Expedited bool              `json:"expedited"`
+}
+
 // EventVote defines the event data for the Vote transaction.
 type EventVote struct {
 	Voter      common.Address
@@ -187,6 +222,119 @@ func NewMsgVoteWeighted(method *abi.Method, args []interface{}) (*govv1.MsgVoteW
 	return msg, voterAddress, options, nil
 }
 
+// NewMsgSubmitProposal creates a new MsgSubmitProposal instance from the
+// JSON-encoded proposal. Only the given allowed message types can be submitted.
+func NewMsgSubmitProposal(
+	method *abi.Method,
+	args []interface{},
+	cdc codec.Codec,
+	allowedMsgs map[string]bool,
+) (*govv1.MsgSubmitProposal, common.Address, error) {
+	if len(args) != 3 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	var input SubmitProposalInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, common.Address{}, fmt.Errorf("error while unpacking args to SubmitProposalInput: %s", err)
+	}
+
+	if input.Proposer == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidProposer, input.Proposer)
+	}
+
+	var proposal jsonProposal
+	if err := json.Unmarshal(input.JsonProposal, &proposal); err != nil {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidProposalJSON, err)
+	}
+
+	msgs := make([]sdk.Msg, len(proposal.Messages))
+	for i, rawMsg := range proposal.Messages {
+		var msg sdk.Msg
+		if err := cdc.UnmarshalInterfaceJSON(rawMsg, &msg); err != nil {
+			return nil, common.Address{}, fmt.Errorf(ErrInvalidProposalJSON, err)
+		}
+
+		if typeURL := sdk.MsgTypeURL(msg); !allowedMsgs[typeURL] {
+			return nil, common.Address{}, fmt.Errorf(ErrProposalMsgNotAllowed, typeURL)
+		}
+		msgs[i] = msg
+	}
+
+	deposit := make(sdk.Coins, len(input.Deposit))
+	for i, coin := range input.Deposit {
+		deposit[i] = coin.ToSDKType()
+	}
+
+	msg, err := govv1.NewMsgSubmitProposal(
+		msgs,
+		deposit,
+		sdk.AccAddress(input.Proposer.Bytes()).String(),
+		proposal.Metadata,
+		proposal.Title,
+		proposal.Summary,
+		proposal.Expedited,
+	)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, input.Proposer, nil
+}
+
+// NewMsgDeposit creates a new MsgDeposit instance.
+func NewMsgDeposit(method *abi.Method, args []interface{}) (*govv1.MsgDeposit, common.Address, error) {
+	if len(args) != 3 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	var input DepositTxInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, common.Address{}, fmt.Errorf("error while unpacking args to DepositTxInput: %s", err)
+	}
+
+	if input.Depositor == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidDepositor, input.Depositor)
+	}
+
+	amount := make(sdk.Coins, len(input.Amount))
+	for i, coin := range input.Amount {
+		amount[i] = coin.ToSDKType()
+	}
+
+	msg := &govv1.MsgDeposit{
+		ProposalId: input.ProposalId,
+		Depositor:  sdk.AccAddress(input.Depositor.Bytes()).String(),
+		Amount:     amount,
+	}
+
+	return msg, input.Depositor, nil
+}
+
+// NewMsgCancelProposal creates a new MsgCancelProposal instance.
+func NewMsgCancelProposal(args []interface{}) (*govv1.MsgCancelProposal, common.Address, error) {
+	if len(args) != 2 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	proposerAddress, ok := args[0].(common.Address)
+	if !ok || proposerAddress == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidProposer, args[0])
+	}
+
+	proposalID, ok := args[1].(uint64)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidProposalID, args[1])
+	}
+
+	msg := &govv1.MsgCancelProposal{
+		ProposalId: proposalID,
+		Proposer:   sdk.AccAddress(proposerAddress.Bytes()).String(),
+	}
+
+	return msg, proposerAddress, nil
+}
+
 // ParseVotesArgs parses the arguments for the Votes query.
 func ParseVotesArgs(method *abi.Method, args []interface{}) (*govv1.QueryVotesRequest, error) {
 	if len(args) != 2 {
@@ -66,6 +66,7 @@ func (s *PrecompileUnitTestSuite) SetupTest(chainID string) {
 	precompile, err := werc20.NewPrecompile(
 		tokenPair,
 		s.network.App.BankKeeper,
+		s.network.App.Erc20Keeper,
 		s.network.App.AuthzKeeper,
 		s.network.App.TransferKeeper,
 	)
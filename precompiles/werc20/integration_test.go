@@ -163,6 +163,7 @@ var _ = When("a user interact with the WEVMOS precompiled contract", func() {
 		precompile, err := werc20.NewPrecompile(
 			tokenPair,
 			is.network.App.BankKeeper,
+			is.network.App.Erc20Keeper,
 			is.network.App.AuthzKeeper,
 			is.network.App.TransferKeeper,
 		)
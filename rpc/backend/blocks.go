@@ -237,8 +237,9 @@ func (b *Backend) BlockNumberFromTendermintByHash(blockHash common.Hash) (*big.I
 }
 
 // EthMsgsFromTendermintBlock returns all real MsgEthereumTxs from a
-// Tendermint block. It also ensures consistency over the correct txs indexes
-// across RPC endpoints
+// Tendermint block, along with the synthetic txs of the EVM calls executed on
+// behalf of Cosmos accounts. It also ensures consistency over the correct txs
+// indexes across RPC endpoints
 func (b *Backend) EthMsgsFromTendermintBlock(
 	resBlock *tmrpctypes.ResultBlock,
 	blockRes *tmrpctypes.ResultBlockResults,
@@ -273,6 +274,14 @@ func (b *Backend) EthMsgsFromTendermintBlock(
 			ethMsg.Hash = ethMsg.AsTransaction().Hash().Hex()
 			result = append(result, ethMsg)
 		}
+
+		// include the synthetic txs of the EVM calls executed on behalf of Cosmos accounts
+		syntheticMsgs, err := rpctypes.SyntheticTxsFromResult(txResults[i])
+		if err != nil {
+			b.logger.Debug("failed to parse synthetic txs", "height", block.Height, "error", err.Error())
+			continue
+		}
+		result = append(result, syntheticMsgs...)
 	}
 
 	return result
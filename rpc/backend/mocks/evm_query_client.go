@@ -17,6 +17,80 @@ type EVMQueryClient struct {
 	mock.Mock
 }
 
+// AccessControlEntries provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) AccessControlEntries(ctx context.Context, in *types.QueryAccessControlEntriesRequest, opts ...grpc.CallOption) (*types.QueryAccessControlEntriesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AccessControlEntries")
+	}
+
+	var r0 *types.QueryAccessControlEntriesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryAccessControlEntriesRequest, ...grpc.CallOption) (*types.QueryAccessControlEntriesResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryAccessControlEntriesRequest, ...grpc.CallOption) *types.QueryAccessControlEntriesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryAccessControlEntriesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryAccessControlEntriesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AccessControlEntry provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) AccessControlEntry(ctx context.Context, in *types.QueryAccessControlEntryRequest, opts ...grpc.CallOption) (*types.QueryAccessControlEntryResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AccessControlEntry")
+	}
+
+	var r0 *types.QueryAccessControlEntryResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryAccessControlEntryRequest, ...grpc.CallOption) (*types.QueryAccessControlEntryResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryAccessControlEntryRequest, ...grpc.CallOption) *types.QueryAccessControlEntryResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryAccessControlEntryResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryAccessControlEntryRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Account provides a mock function with given fields: ctx, in, opts
 func (_m *EVMQueryClient) Account(ctx context.Context, in *types.QueryAccountRequest, opts ...grpc.CallOption) (*types.QueryAccountResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -239,6 +313,43 @@ func (_m *EVMQueryClient) EstimateGas(ctx context.Context, in *types.EthCallRequ
 	return r0, r1
 }
 
+// FrozenContracts provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) FrozenContracts(ctx context.Context, in *types.QueryFrozenContractsRequest, opts ...grpc.CallOption) (*types.QueryFrozenContractsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FrozenContracts")
+	}
+
+	var r0 *types.QueryFrozenContractsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryFrozenContractsRequest, ...grpc.CallOption) (*types.QueryFrozenContractsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryFrozenContractsRequest, ...grpc.CallOption) *types.QueryFrozenContractsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryFrozenContractsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryFrozenContractsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GlobalMinGasPrice provides a mock function with given fields: ctx, in, opts
 func (_m *EVMQueryClient) GlobalMinGasPrice(ctx context.Context, in *types.QueryGlobalMinGasPriceRequest, opts ...grpc.CallOption) (*types.QueryGlobalMinGasPriceResponse, error) {
 	_va := make([]interface{}, len(opts))
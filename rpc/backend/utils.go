@@ -299,3 +299,25 @@ func GetHexProofs(proof *crypto.ProofOps) []string {
 	}
 	return proofs
 }
+
+// ethMsgFromTx returns the ethereum tx at the given message index of a Cosmos
+// tx. For the Cosmos txs that aren't ethereum txs, the index refers to the
+// synthetic txs of the EVM calls executed on behalf of Cosmos accounts, which
+// are parsed from the tx result.
+func ethMsgFromTx(tx sdk.Tx, txResult *abci.ExecTxResult, msgIndex uint32) (*evmtypes.MsgEthereumTx, error) {
+	msgs := tx.GetMsgs()
+	if int(msgIndex) < len(msgs) {
+		if ethMsg, ok := msgs[msgIndex].(*evmtypes.MsgEthereumTx); ok {
+			return ethMsg, nil
+		}
+	}
+
+	syntheticMsgs, err := types.SyntheticTxsFromResult(txResult)
+	if err != nil {
+		return nil, err
+	}
+	if int(msgIndex) >= len(syntheticMsgs) {
+		return nil, fmt.Errorf("invalid ethereum tx: msg index %d out of bound", msgIndex)
+	}
+	return syntheticMsgs[msgIndex], nil
+}
@@ -5,6 +5,8 @@ import (
 	"strconv"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
@@ -60,6 +62,12 @@ type ParsedTx struct {
 	EthTxIndex int32
 	GasUsed    uint64
 	Failed     bool
+
+	// the following fields are only set for the synthetic txs of the EVM calls
+	// executed on behalf of Cosmos accounts, e.g. through MsgCallEVM.
+
+	SyntheticTx *ethtypes.Transaction
+	Sender      common.Address
 }
 
 // NewParsedTx initialize a ParsedTx
@@ -121,9 +129,11 @@ func ParseTxResult(result *abci.ExecTxResult, tx sdk.Tx) (*ParsedTxs, error) {
 		}
 	}
 
-	// some old versions miss some events, fill it with tx result
+	// some old versions miss some events, fill it with tx result.
+	// The gas used by a synthetic tx is always emitted and the Cosmos tx result
+	// also accounts for the gas consumed outside of the EVM.
 	gasUsed := uint64(result.GasUsed) // #nosec G115
-	if len(p.Txs) == 1 {
+	if len(p.Txs) == 1 && p.Txs[0].SyntheticTx == nil {
 		p.Txs[0].GasUsed = gasUsed
 	}
 
@@ -140,6 +150,36 @@ func ParseTxResult(result *abci.ExecTxResult, tx sdk.Tx) (*ParsedTxs, error) {
 	return p, nil
 }
 
+// SyntheticTxsFromResult returns the synthetic ethereum txs of the EVM calls
+// executed on behalf of Cosmos accounts within a Cosmos tx, in the order of
+// execution. The txs are parsed from the events, as the calls can be nested in
+// other messages (e.g. authz or interchain accounts). Failed Cosmos txs revert
+// all their calls and don't have any synthetic tx.
+func SyntheticTxsFromResult(result *abci.ExecTxResult) ([]*evmtypes.MsgEthereumTx, error) {
+	if result.Code != abci.CodeTypeOK {
+		return nil, nil
+	}
+
+	txs, err := ParseTxResult(result, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	msgs := make([]*evmtypes.MsgEthereumTx, 0, len(txs.Txs))
+	for _, tx := range txs.Txs {
+		if tx.SyntheticTx == nil {
+			continue
+		}
+
+		msg := &evmtypes.MsgEthereumTx{From: tx.Sender.Hex()}
+		if err := msg.FromEthereumTx(tx.SyntheticTx); err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, msg)
+	}
+	return msgs, nil
+}
+
 // ParseTxIndexerResult parse tm tx result to a format compatible with the custom tx indexer.
 func ParseTxIndexerResult(txResult *tmrpctypes.ResultTx, tx sdk.Tx, getter func(*ParsedTxs) *ParsedTx) (*types.TxResult, error) {
 	txs, err := ParseTxResult(&txResult.TxResult, tx)
@@ -249,6 +289,17 @@ func fillTxAttribute(tx *ParsedTx, key string, value string) error {
 		tx.GasUsed = gasUsed
 	case evmtypes.AttributeKeyEthereumTxFailed:
 		tx.Failed = len(value) > 0
+	case evmtypes.AttributeKeySyntheticTx:
+		bz, err := hexutil.Decode(value)
+		if err != nil {
+			return err
+		}
+		tx.SyntheticTx = new(ethtypes.Transaction)
+		if err := tx.SyntheticTx.UnmarshalBinary(bz); err != nil {
+			return err
+		}
+	case sdk.AttributeKeySender:
+		tx.Sender = common.HexToAddress(value)
 	}
 	return nil
 }
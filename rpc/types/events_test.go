@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/require"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -190,3 +192,72 @@ func TestParseTxResult(t *testing.T) {
 		})
 	}
 }
+
+func TestSyntheticTxsFromResult(t *testing.T) {
+	sender := common.HexToAddress("0x57f96e6B86CdeFdB3d412547816a82E3E0EbF9D2")
+	recipient := common.HexToAddress("0x775b87ef5D82ca211811C1a02CE0fE0CA3a455d7")
+
+	msg := ethtypes.NewMessage(
+		sender, &recipient, 3, big.NewInt(1000), 50000, big.NewInt(0), big.NewInt(0), big.NewInt(0),
+		[]byte{0x01}, ethtypes.AccessList{}, false,
+	)
+	tx := evmtypes.NewSyntheticTx(msg, 15, 10)
+	txBz, err := tx.MarshalBinary()
+	require.NoError(t, err)
+
+	events := []abci.Event{
+		{Type: "message", Attributes: []abci.EventAttribute{
+			{Key: "action", Value: "/cosmos.evm.vm.v1.MsgCallEVM"},
+			{Key: "sender", Value: "cosmos1n0p7e8h4zu0ms2ut8nr92jzkxd6z8r0n6pfz8m"},
+		}},
+		{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+			{Key: "amount", Value: "1000"},
+			{Key: "ethereumTxHash", Value: tx.Hash().Hex()},
+			{Key: "txIndex", Value: "10"},
+			{Key: "txGasUsed", Value: "30000"},
+			{Key: "recipient", Value: recipient.Hex()},
+			{Key: "sender", Value: sender.Hex()},
+			{Key: "syntheticTx", Value: hexutil.Encode(txBz)},
+		}},
+		{Type: evmtypes.EventTypeTxLog, Attributes: []abci.EventAttribute{}},
+	}
+
+	t.Run("successful tx", func(t *testing.T) {
+		result := &abci.ExecTxResult{GasUsed: 80000, Events: events}
+
+		parsed, err := ParseTxResult(result, nil)
+		require.NoError(t, err)
+		parsedTx := parsed.GetTxByHash(tx.Hash())
+		require.NotNil(t, parsedTx)
+		// the gas used by the synthetic tx isn't overridden by the Cosmos tx one
+		require.Equal(t, uint64(30000), parsedTx.GasUsed)
+		require.Equal(t, sender, parsedTx.Sender)
+		require.Equal(t, tx.Hash(), parsedTx.SyntheticTx.Hash())
+
+		msgs, err := SyntheticTxsFromResult(result)
+		require.NoError(t, err)
+		require.Len(t, msgs, 1)
+		require.Equal(t, tx.Hash().Hex(), msgs[0].Hash)
+		require.Equal(t, sender.Hex(), msgs[0].From)
+		require.True(t, evmtypes.IsSyntheticTx(msgs[0].AsTransaction()))
+		require.Equal(t, tx.Hash(), msgs[0].AsTransaction().Hash())
+	})
+
+	t.Run("failed tx", func(t *testing.T) {
+		msgs, err := SyntheticTxsFromResult(&abci.ExecTxResult{Code: 5, Events: events})
+		require.NoError(t, err)
+		require.Empty(t, msgs)
+	})
+
+	t.Run("invalid synthetic tx", func(t *testing.T) {
+		_, err := SyntheticTxsFromResult(&abci.ExecTxResult{Events: []abci.Event{
+			{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+				{Key: "amount", Value: "1000"},
+				{Key: "ethereumTxHash", Value: tx.Hash().Hex()},
+				{Key: "txIndex", Value: "10"},
+				{Key: "syntheticTx", Value: "0x01"},
+			}},
+		}})
+		require.Error(t, err)
+	})
+}
@@ -160,7 +160,17 @@ func NewTransactionFromMsg(
 	chainID *big.Int,
 ) (*RPCTransaction, error) {
 	tx := msg.AsTransaction()
-	return NewRPCTransaction(tx, blockHash, blockNumber, index, baseFee, chainID)
+	rpcTx, err := NewRPCTransaction(tx, blockHash, blockNumber, index, baseFee, chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	// the sender of a synthetic tx can't be recovered from its signature
+	if evmtypes.IsSyntheticTx(tx) {
+		rpcTx.From = common.HexToAddress(msg.From)
+		rpcTx.V = (*hexutil.Big)(new(big.Int))
+	}
+	return rpcTx, nil
 }
 
 // NewTransactionFromData returns a transaction that will serialize to the RPC
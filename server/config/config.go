@@ -54,6 +54,12 @@ const (
 	// DefaultEVMTracer is the default vm.Tracer type
 	DefaultEVMTracer = ""
 
+	// DefaultEVMFlatState is the default database backend of the EVM flat state (disabled)
+	DefaultEVMFlatState = ""
+
+	// DefaultEVMGenesisStreamDir is the default directory of the EVM genesis stream (disabled)
+	DefaultEVMGenesisStreamDir = ""
+
 	// DefaultFixRevertGasRefundHeight is the default height at which to overwrite gas refund
 	DefaultFixRevertGasRefundHeight = 0
 
@@ -102,6 +108,8 @@ const (
 
 var evmTracers = []string{"json", "markdown", "struct", "access_list"}
 
+var evmFlatStateBackends = []string{"memdb", "goleveldb"}
+
 // Config defines the server's top level configuration. It includes the default app config
 // from the SDK as well as the EVM configuration to enable the JSON-RPC APIs.
 type Config struct {
@@ -119,6 +127,12 @@ type EVMConfig struct {
 	Tracer string `mapstructure:"tracer"`
 	// MaxTxGasWanted defines the gas wanted for each eth tx returned in ante handler in check tx mode.
 	MaxTxGasWanted uint64 `mapstructure:"max-tx-gas-wanted"`
+	// FlatState defines the database backend of the flat snapshot used to serve the
+	// contract storage reads. The snapshot is disabled if empty.
+	FlatState string `mapstructure:"flat-state"`
+	// GenesisStreamDir defines the directory of the JSON-lines file used to import and
+	// export the EVM genesis accounts. The accounts are part of the genesis file if empty.
+	GenesisStreamDir string `mapstructure:"genesis-stream-dir"`
 }
 
 // JSONRPCConfig defines configuration for the EVM RPC server.
@@ -176,17 +190,23 @@ type TLSConfig struct {
 // DefaultEVMConfig returns the default EVM configuration
 func DefaultEVMConfig() *EVMConfig {
 	return &EVMConfig{
-		Tracer:         DefaultEVMTracer,
-		MaxTxGasWanted: DefaultMaxTxGasWanted,
+		Tracer:           DefaultEVMTracer,
+		MaxTxGasWanted:   DefaultMaxTxGasWanted,
+		FlatState:        DefaultEVMFlatState,
+		GenesisStreamDir: DefaultEVMGenesisStreamDir,
 	}
 }
 
-// Validate returns an error if the tracer type is invalid.
+// Validate returns an error if the tracer type or the flat state backend is invalid.
 func (c EVMConfig) Validate() error {
 	if c.Tracer != "" && !strings.StringInSlice(c.Tracer, evmTracers) {
 		return fmt.Errorf("invalid tracer type %s, available types: %v", c.Tracer, evmTracers)
 	}
 
+	if c.FlatState != "" && !strings.StringInSlice(c.FlatState, evmFlatStateBackends) {
+		return fmt.Errorf("invalid flat state backend %s, available backends: %v", c.FlatState, evmFlatStateBackends)
+	}
+
 	return nil
 }
 
@@ -16,6 +16,16 @@ tracer = "{{ .EVM.Tracer }}"
 # MaxTxGasWanted defines the gas wanted for each eth tx returned in ante handler in check tx mode.
 max-tx-gas-wanted = {{ .EVM.MaxTxGasWanted }}
 
+# FlatState defines the database backend of the flat snapshot used to serve the contract
+# storage reads without traversing the IAVL tree. The snapshot is disabled if empty.
+# Valid backends are: memdb|goleveldb
+flat-state = "{{ .EVM.FlatState }}"
+
+# GenesisStreamDir defines the directory of the JSON-lines file used to import and export
+# the EVM accounts and storage on genesis, instead of the genesis file. Relative paths are
+# resolved from the node home directory. The accounts are part of the genesis file if empty.
+genesis-stream-dir = "{{ .EVM.GenesisStreamDir }}"
+
 ###############################################################################
 ###                           JSON RPC Configuration                        ###
 ###############################################################################
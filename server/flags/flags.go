@@ -61,8 +61,10 @@ const (
 
 // EVM flags
 const (
-	EVMTracer         = "evm.tracer"
-	EVMMaxTxGasWanted = "evm.max-tx-gas-wanted"
+	EVMTracer           = "evm.tracer"
+	EVMMaxTxGasWanted   = "evm.max-tx-gas-wanted"
+	EVMFlatState        = "evm.flat-state"
+	EVMGenesisStreamDir = "evm.genesis-stream-dir"
 )
 
 // TLS flags
@@ -24,6 +24,7 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetTokenPairsCmd(),
 		GetTokenPairCmd(),
+		GetComplianceReportCmd(),
 		GetParamsCmd(),
 	)
 	return cmd
@@ -98,6 +99,39 @@ func GetTokenPairCmd() *cobra.Command {
 	return cmd
 }
 
+// GetComplianceReportCmd queries the ERC20 compliance report of a registered
+// token pair
+func GetComplianceReportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "compliance-report TOKEN",
+		Short: "Get the ERC20 compliance report of a registered token pair",
+		Long:  "Get the outcome of the ERC20 compliance checks run when registering a token pair",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryComplianceReportRequest{
+				Token: args[0],
+			}
+
+			res, err := queryClient.ComplianceReport(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetParamsCmd queries erc20 module params
 func GetParamsCmd() *cobra.Command {
 	cmd := &cobra.Command{
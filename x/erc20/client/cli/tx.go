@@ -29,6 +29,7 @@ func NewTxCmd() *cobra.Command {
 
 	txCmd.AddCommand(
 		NewConvertERC20Cmd(),
+		NewRegisterERC20WithDepositCmd(),
 	)
 	return txCmd
 }
@@ -79,3 +80,34 @@ func NewConvertERC20Cmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewRegisterERC20WithDepositCmd returns a CLI command handler for registering
+// an ERC20 token pair without governance
+func NewRegisterERC20WithDepositCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-erc20 CONTRACT_ADDRESS",
+		Short: "Register a token pair for an ERC20 contract by locking the registration deposit. Requires the permissionless registration to be enabled.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contract := args[0]
+			if err := cosmosevmtypes.ValidateAddress(contract); err != nil {
+				return fmt.Errorf("invalid ERC20 contract address %w", err)
+			}
+
+			msg := &types.MsgRegisterERC20WithDeposit{
+				Sender:       cliCtx.GetFromAddress().String(),
+				Erc20Address: contract,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
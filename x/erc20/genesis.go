@@ -3,6 +3,8 @@ package erc20
 import (
 	"fmt"
 
+	"github.com/ethereum/go-ethereum/common"
+
 	"github.com/cosmos/evm/x/erc20/keeper"
 	"github.com/cosmos/evm/x/erc20/types"
 
@@ -31,12 +33,37 @@ func InitGenesis(
 	for _, pair := range data.TokenPairs {
 		k.SetToken(ctx, pair)
 	}
+
+	for _, deposit := range data.RegistrationDeposits {
+		k.SetRegistrationDeposit(ctx, deposit)
+	}
+
+	for _, report := range data.ComplianceReports {
+		k.SetComplianceReport(ctx, report)
+	}
+
+	for _, nonce := range data.PermitNonces {
+		k.SetPermitNonce(ctx, common.HexToAddress(nonce.Erc20Address), common.HexToAddress(nonce.Owner), nonce.Nonce)
+	}
+
+	for _, authorization := range data.UsedAuthorizations {
+		k.SetTransferAuthorizationUsed(
+			ctx,
+			common.HexToAddress(authorization.Erc20Address),
+			common.HexToAddress(authorization.Authorizer),
+			authorization.GetNonceHash(),
+		)
+	}
 }
 
 // ExportGenesis export module status
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params:     k.GetParams(ctx),
-		TokenPairs: k.GetTokenPairs(ctx),
+		Params:               k.GetParams(ctx),
+		TokenPairs:           k.GetTokenPairs(ctx),
+		RegistrationDeposits: k.GetRegistrationDeposits(ctx),
+		ComplianceReports:    k.GetComplianceReports(ctx),
+		PermitNonces:         k.GetPermitNonces(ctx),
+		UsedAuthorizations:   k.GetUsedTransferAuthorizations(ctx),
 	}
 }
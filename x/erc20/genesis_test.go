@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/cometbft/cometbft/crypto/tmhash"
@@ -168,3 +169,35 @@ func (suite *GenesisTestSuite) TestErc20ExportGenesis() {
 		})
 	}
 }
+
+func (suite *GenesisTestSuite) TestErc20GenesisPermitNonces() {
+	token, owner := utiltx.GenerateAddress(), utiltx.GenerateAddress()
+	nonce := common.HexToHash("0xabcd")
+
+	suite.app.Erc20Keeper.SetPermitNonce(suite.ctx, token, owner, 5)
+	suite.app.Erc20Keeper.SetTransferAuthorizationUsed(suite.ctx, token, owner, nonce)
+
+	genesisExported := erc20.ExportGenesis(suite.ctx, suite.app.Erc20Keeper)
+	suite.Require().NoError(genesisExported.Validate())
+	suite.Require().Equal([]types.PermitNonce{types.NewPermitNonce(token, owner, 5)}, genesisExported.PermitNonces)
+	suite.Require().Equal(
+		[]types.TransferAuthorization{types.NewTransferAuthorization(token, owner, nonce)},
+		genesisExported.UsedAuthorizations,
+	)
+
+	// import the exported genesis in a new chain
+	nw := network.NewUnitTestNetwork(
+		network.WithCustomGenesis(network.CustomGenesisState{
+			types.ModuleName: genesisExported,
+		}),
+	)
+	ctx := nw.GetContext()
+
+	suite.Require().Equal(uint64(5), nw.App.Erc20Keeper.GetPermitNonce(ctx, token, owner))
+	suite.Require().True(nw.App.Erc20Keeper.IsTransferAuthorizationUsed(ctx, token, owner, nonce))
+	suite.Require().False(nw.App.Erc20Keeper.IsTransferAuthorizationUsed(ctx, token, owner, common.HexToHash("0x01")))
+
+	reExported := erc20.ExportGenesis(ctx, nw.App.Erc20Keeper)
+	suite.Require().Equal(genesisExported.PermitNonces, reExported.PermitNonces)
+	suite.Require().Equal(genesisExported.UsedAuthorizations, reExported.UsedAuthorizations)
+}
@@ -35,6 +35,8 @@ func NewIBCMiddleware(k keeper.Keeper, app porttypes.IBCModule) IBCMiddleware {
 // It receives the tokens through the default ICS20 OnRecvPacket callback logic
 // and then automatically converts the Cosmos Coin to their ERC20 token
 // representation.
+// If the packet memo defines an EVM hook, the tokens are received by the hook
+// intermediate sender, which then executes the hook call.
 // If the acknowledgement fails, this callback will default to the ibc-core
 // packet callback.
 func (im IBCMiddleware) OnRecvPacket(
@@ -42,6 +44,11 @@ func (im IBCMiddleware) OnRecvPacket(
 	packet channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) exported.Acknowledgement {
+	packet, hook, err := im.keeper.SetEVMHookReceiver(packet)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
 	ack := im.Module.OnRecvPacket(ctx, packet, relayer)
 
 	// return if the acknowledgement is an error ACK
@@ -49,7 +56,12 @@ func (im IBCMiddleware) OnRecvPacket(
 		return ack
 	}
 
-	return im.keeper.OnRecvPacket(ctx, packet, ack)
+	ack = im.keeper.OnRecvPacket(ctx, packet, ack)
+	if hook == nil || !ack.Success() {
+		return ack
+	}
+
+	return im.keeper.OnRecvPacketEVMHook(ctx, packet, *hook, ack)
 }
 
 // OnAcknowledgementPacket implements the IBCModule interface.
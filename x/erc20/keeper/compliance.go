@@ -0,0 +1,323 @@
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/cosmos/evm/contracts"
+	"github.com/cosmos/evm/x/erc20/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// maxBalancesSlot is the last storage slot looked up for the balances mapping
+// of an ERC20 contract when funding the holder of the compliance checks.
+const maxBalancesSlot = 10
+
+var (
+	// complianceProbeAmount is the amount of tokens credited to a holder
+	// without tokens to run the compliance checks. It is large enough for the
+	// fees of fee-on-transfer tokens not to round down to zero.
+	complianceProbeAmount = big.NewInt(1e18)
+	// erc20StorageLocation is the storage slot of the balances mapping of the
+	// OpenZeppelin v5 upgradeable ERC20 contracts.
+	erc20StorageLocation = common.HexToHash("0x52c63247e1f47db19d5ce0460030c497f067ca4cebf71ba98eeadabe20bace00")
+)
+
+// RunComplianceChecks simulates transfer, approve and transferFrom calls of an
+// ERC20 contract and returns the report of the checks run on their outcome. The
+// calls are executed on a cached context that is discarded afterwards.
+//
+// The holder transfers its whole balance to the erc20 module account, which
+// then approves the holder to transfer it back with transferFrom, as done when
+// converting the tokens. Fee-on-transfer, burn-on-transfer and rebasing tokens
+// fail the checks on the balances and total supply, while paused tokens and
+// tokens blacklisting any of the two accounts fail the transfers.
+//
+// A holder without tokens, such as the governance account, is funded on the
+// cached context by writing its balance in the contract storage. If its
+// balance can't be written, the checks on the balances are skipped and the
+// report is not compliant.
+//
+// Each EVM call of the checks is charged on the transaction gas meter with a
+// fixed gas limit, as the contract isn't trusted (see meteredCallEVM).
+func (k Keeper) RunComplianceChecks(ctx sdk.Context, contract, holder common.Address) types.ComplianceReport {
+	cacheCtx, _ := ctx.CacheContext()
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	escrow := types.ModuleAddress
+
+	amount := balanceOf(cacheCtx, k.meteredCallEVM, erc20, contract, holder)
+	if amount == nil || amount.Sign() == 0 {
+		amount = big.NewInt(0)
+		if k.fundHolder(cacheCtx, erc20, contract, holder, complianceProbeAmount) {
+			amount = new(big.Int).Set(complianceProbeAmount)
+		}
+	}
+	escrowBalance := balanceOf(cacheCtx, k.meteredCallEVM, erc20, contract, escrow)
+	supply := totalSupply(cacheCtx, k.meteredCallEVM, erc20, contract)
+
+	checks := make([]types.ComplianceCheck, 0, 5)
+
+	// holder -> escrow
+	res, err := k.callERC20(cacheCtx, erc20, holder, contract, "transfer", escrow, amount)
+	transferred := err == nil
+	switch {
+	case err != nil:
+		checks = append(checks, types.FailedCheck(types.ComplianceCheckTransfer, "%s", err))
+	case !hasERC20Event(res, erc20.Events["Transfer"], contract, holder, escrow, amount):
+		checks = append(checks, types.FailedCheck(types.ComplianceCheckTransfer, "missing Transfer event"))
+	case k.monitorApprovalEvent(res) != nil:
+		checks = append(checks, types.FailedCheck(types.ComplianceCheckTransfer, "unexpected Approval event"))
+	default:
+		checks = append(checks, types.PassedCheck(types.ComplianceCheckTransfer))
+	}
+
+	switch {
+	case !transferred:
+		checks = append(checks, types.SkippedCheck(types.ComplianceCheckTransferBalances, "transfer failed"))
+	case amount.Sign() == 0:
+		checks = append(checks, types.SkippedCheck(types.ComplianceCheckTransferBalances, "holder couldn't be funded"))
+	default:
+		checks = append(checks, k.checkBalances(
+			cacheCtx, erc20, types.ComplianceCheckTransferBalances, contract, holder, escrow, amount,
+			big.NewInt(0), new(big.Int).Add(safeBig(escrowBalance), amount),
+		))
+	}
+
+	if !transferred {
+		checks = append(checks, types.SkippedCheck(types.ComplianceCheckTotalSupply, "transfer failed"))
+	} else {
+		supplyAfter := totalSupply(cacheCtx, k.meteredCallEVM, erc20, contract)
+		switch {
+		case supply == nil || supplyAfter == nil:
+			checks = append(checks, types.FailedCheck(types.ComplianceCheckTotalSupply, "failed to retrieve total supply"))
+		case supply.Cmp(supplyAfter) != 0:
+			checks = append(checks, types.FailedCheck(types.ComplianceCheckTotalSupply, "total supply changed from %s to %s", supply, supplyAfter))
+		default:
+			checks = append(checks, types.PassedCheck(types.ComplianceCheckTotalSupply))
+		}
+	}
+
+	// escrow approves holder
+	approved := false
+	res, err = k.callERC20(cacheCtx, erc20, escrow, contract, "approve", holder, amount)
+	switch {
+	case err != nil:
+		checks = append(checks, types.FailedCheck(types.ComplianceCheckApprove, "%s", err))
+	case !hasERC20Event(res, erc20.Events["Approval"], contract, escrow, holder, amount):
+		checks = append(checks, types.FailedCheck(types.ComplianceCheckApprove, "missing Approval event"))
+	default:
+		allowance := k.allowance(cacheCtx, erc20, contract, escrow, holder)
+		if allowance == nil || allowance.Cmp(amount) != 0 {
+			checks = append(checks, types.FailedCheck(types.ComplianceCheckApprove, "allowance is %s, expected %s", allowance, amount))
+		} else {
+			checks = append(checks, types.PassedCheck(types.ComplianceCheckApprove))
+			approved = true
+		}
+	}
+
+	// escrow -> holder, spent by holder
+	if !transferred || !approved {
+		checks = append(checks, types.SkippedCheck(types.ComplianceCheckTransferFrom, "transfer or approve failed"))
+		return types.NewComplianceReport(contract, ctx.BlockHeight(), checks)
+	}
+
+	holderBalance := balanceOf(cacheCtx, k.meteredCallEVM, erc20, contract, holder)
+	escrowBalance = balanceOf(cacheCtx, k.meteredCallEVM, erc20, contract, escrow)
+	res, err = k.callERC20(cacheCtx, erc20, holder, contract, "transferFrom", escrow, holder, amount)
+	switch {
+	case err != nil:
+		checks = append(checks, types.FailedCheck(types.ComplianceCheckTransferFrom, "%s", err))
+	case !hasERC20Event(res, erc20.Events["Transfer"], contract, escrow, holder, amount):
+		checks = append(checks, types.FailedCheck(types.ComplianceCheckTransferFrom, "missing Transfer event"))
+	default:
+		check := k.checkBalances(
+			cacheCtx, erc20, types.ComplianceCheckTransferFrom, contract, escrow, holder, amount,
+			new(big.Int).Sub(safeBig(escrowBalance), amount), new(big.Int).Add(safeBig(holderBalance), amount),
+		)
+		if allowance := k.allowance(cacheCtx, erc20, contract, escrow, holder); check.Passed && (allowance == nil || allowance.Sign() != 0) {
+			check = types.FailedCheck(types.ComplianceCheckTransferFrom, "allowance is %s after being spent", allowance)
+		}
+		checks = append(checks, check)
+	}
+
+	return types.NewComplianceReport(contract, ctx.BlockHeight(), checks)
+}
+
+// fundHolder sets the balance of the holder to the given amount by writing it
+// in the balances mapping of the contract. As the storage layout of the
+// contract is unknown, the mapping is looked up among the first storage slots
+// and the OpenZeppelin v5 namespaced slot, keeping the first one that changes
+// the balance returned by the contract. It returns false if none does.
+func (k Keeper) fundHolder(ctx sdk.Context, erc20 abi.ABI, contract, holder common.Address, amount *big.Int) bool {
+	slots := make([]common.Hash, 0, maxBalancesSlot+2)
+	for i := int64(0); i <= maxBalancesSlot; i++ {
+		slots = append(slots, common.BigToHash(big.NewInt(i)))
+	}
+	slots = append(slots, erc20StorageLocation)
+
+	for _, slot := range slots {
+		key := crypto.Keccak256Hash(common.LeftPadBytes(holder.Bytes(), common.HashLength), slot.Bytes())
+		prev := k.evmKeeper.GetState(ctx, contract, key)
+
+		k.evmKeeper.SetState(ctx, contract, key, common.BigToHash(amount).Bytes())
+		if balance := balanceOf(ctx, k.meteredCallEVM, erc20, contract, holder); balance != nil && balance.Cmp(amount) == 0 {
+			return true
+		}
+		k.evmKeeper.SetState(ctx, contract, key, prev.Bytes())
+	}
+
+	return false
+}
+
+// checkBalances checks that the balances of the sender and the recipient
+// match the expected ones after a transfer.
+func (k Keeper) checkBalances(
+	ctx sdk.Context,
+	erc20 abi.ABI,
+	name string,
+	contract, sender, recipient common.Address,
+	amount, expSender, expRecipient *big.Int,
+) types.ComplianceCheck {
+	senderBalance := balanceOf(ctx, k.meteredCallEVM, erc20, contract, sender)
+	recipientBalance := balanceOf(ctx, k.meteredCallEVM, erc20, contract, recipient)
+
+	switch {
+	case senderBalance == nil || recipientBalance == nil:
+		return types.FailedCheck(name, "failed to retrieve balance")
+	case senderBalance.Cmp(expSender) != 0:
+		return types.FailedCheck(name, "sender balance is %s after sending %s, expected %s", senderBalance, amount, expSender)
+	case recipientBalance.Cmp(expRecipient) != 0:
+		return types.FailedCheck(name, "recipient balance is %s after receiving %s, expected %s", recipientBalance, amount, expRecipient)
+	default:
+		return types.PassedCheck(name)
+	}
+}
+
+// callERC20 calls a method of an ERC20 contract that returns a bool and fails
+// if the call reverts or doesn't return true.
+func (k Keeper) callERC20(
+	ctx sdk.Context,
+	erc20 abi.ABI,
+	from, contract common.Address,
+	method string,
+	args ...interface{},
+) (*evmtypes.MsgEthereumTxResponse, error) {
+	res, err := k.meteredCallEVM(ctx, erc20, from, contract, true, method, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	var unpackedRet types.ERC20BoolResponse
+	if err := erc20.UnpackIntoInterface(&unpackedRet, method, res.Ret); err != nil {
+		return nil, fmt.Errorf("failed to unpack %s: %w", method, err)
+	}
+
+	if !unpackedRet.Value {
+		return nil, fmt.Errorf("%s returned false", method)
+	}
+
+	return res, nil
+}
+
+// allowance queries the amount of tokens the spender can transfer on behalf of
+// the owner.
+func (k Keeper) allowance(ctx sdk.Context, erc20 abi.ABI, contract, owner, spender common.Address) *big.Int {
+	res, err := k.meteredCallEVM(ctx, erc20, types.ModuleAddress, contract, false, "allowance", owner, spender)
+	if err != nil {
+		return nil
+	}
+
+	unpacked, err := erc20.Unpack("allowance", res.Ret)
+	if err != nil || len(unpacked) == 0 {
+		return nil
+	}
+
+	allowance, ok := unpacked[0].(*big.Int)
+	if !ok {
+		return nil
+	}
+
+	return allowance
+}
+
+// hasERC20Event returns true if the logs of the call include the given
+// Transfer or Approval event of the contract, with the two indexed addresses
+// and the amount.
+func hasERC20Event(res *evmtypes.MsgEthereumTxResponse, event abi.Event, contract, from, to common.Address, amount *big.Int) bool {
+	for _, log := range res.Logs {
+		if len(log.Topics) != 3 || common.HexToAddress(log.Address) != contract {
+			continue
+		}
+
+		if common.HexToHash(log.Topics[0]) != event.ID ||
+			common.HexToHash(log.Topics[1]) != common.BytesToHash(from.Bytes()) ||
+			common.HexToHash(log.Topics[2]) != common.BytesToHash(to.Bytes()) {
+			continue
+		}
+
+		if new(big.Int).SetBytes(log.Data).Cmp(amount) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// safeBig returns zero for a nil big integer.
+func safeBig(i *big.Int) *big.Int {
+	if i == nil {
+		return big.NewInt(0)
+	}
+	return i
+}
+
+// GetComplianceReport returns the ERC20 compliance report recorded when
+// registering the token pair of the given contract.
+func (k Keeper) GetComplianceReport(ctx sdk.Context, contract common.Address) (types.ComplianceReport, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixComplianceReport)
+	bz := store.Get(contract.Bytes())
+	if len(bz) == 0 {
+		return types.ComplianceReport{}, false
+	}
+
+	var report types.ComplianceReport
+	k.cdc.MustUnmarshal(bz, &report)
+	return report, true
+}
+
+// GetComplianceReports returns all the recorded ERC20 compliance reports.
+func (k Keeper) GetComplianceReports(ctx sdk.Context) []types.ComplianceReport {
+	reports := []types.ComplianceReport{}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixComplianceReport)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var report types.ComplianceReport
+		k.cdc.MustUnmarshal(iterator.Value(), &report)
+		reports = append(reports, report)
+	}
+
+	return reports
+}
+
+// SetComplianceReport stores the ERC20 compliance report of a token pair.
+func (k Keeper) SetComplianceReport(ctx sdk.Context, report types.ComplianceReport) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixComplianceReport)
+	store.Set(report.GetERC20Contract().Bytes(), k.cdc.MustMarshal(&report))
+}
+
+// deleteComplianceReport removes the ERC20 compliance report of the given
+// contract.
+func (k Keeper) deleteComplianceReport(ctx sdk.Context, contract common.Address) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixComplianceReport)
+	store.Delete(contract.Bytes())
+}
@@ -0,0 +1,234 @@
+package keeper_test
+
+import (
+	"fmt"
+	"math/big"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/contracts"
+	"github.com/cosmos/evm/x/erc20/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func (suite *KeeperTestSuite) TestRunComplianceChecks() {
+	var (
+		ctx      sdk.Context
+		contract common.Address
+		holder   common.Address
+	)
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+
+	testCases := []struct {
+		name         string
+		malleate     func()
+		expCompliant bool
+		expFailed    []string
+		expSkipped   []string
+	}{
+		{
+			"compliant - holder with balance",
+			func() {
+				_, err := suite.network.App.EVMKeeper.CallEVM(ctx, erc20, holder, contract, true, "mint", holder, big.NewInt(1000))
+				suite.Require().NoError(err)
+			},
+			true,
+			nil,
+			nil,
+		},
+		{
+			"compliant - holder without balance is funded",
+			func() {},
+			true,
+			nil,
+			nil,
+		},
+		{
+			"compliant - governance holder is funded",
+			func() {
+				holder = common.BytesToAddress(authtypes.NewModuleAddress(govtypes.ModuleName))
+			},
+			true,
+			nil,
+			nil,
+		},
+		{
+			"not compliant - paused token",
+			func() {
+				_, err := suite.network.App.EVMKeeper.CallEVM(ctx, erc20, holder, contract, true, "pause")
+				suite.Require().NoError(err)
+			},
+			false,
+			[]string{types.ComplianceCheckTransfer},
+			[]string{types.ComplianceCheckTransferBalances, types.ComplianceCheckTotalSupply, types.ComplianceCheckTransferFrom},
+		},
+		{
+			"not compliant - token siphoning the transfers",
+			func() {
+				var err error
+				contract, err = suite.DeployContractDirectBalanceManipulation()
+				suite.Require().NoError(err)
+				ctx = suite.network.GetContext()
+			},
+			false,
+			[]string{types.ComplianceCheckTransfer, types.ComplianceCheckTransferBalances, types.ComplianceCheckTransferFrom},
+			nil,
+		},
+		{
+			"not compliant - fee-on-transfer token with a holder without balance",
+			func() {
+				var err error
+				contract, err = suite.DeployContractDirectBalanceManipulation()
+				suite.Require().NoError(err)
+				ctx = suite.network.GetContext()
+				holder = suite.keyring.GetAddr(1)
+			},
+			false,
+			[]string{types.ComplianceCheckTransfer, types.ComplianceCheckTransferBalances, types.ComplianceCheckTransferFrom},
+			nil,
+		},
+		{
+			"not compliant - token approving on transfers",
+			func() {
+				var err error
+				contract, err = suite.DeployContractMaliciousDelayed()
+				suite.Require().NoError(err)
+				ctx = suite.network.GetContext()
+			},
+			false,
+			[]string{types.ComplianceCheckTransfer},
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			var err error
+			suite.SetupTest() // reset
+
+			contract, err = suite.DeployContract(erc20Name, erc20Symbol, erc20Decimals)
+			suite.Require().NoError(err, "failed to deploy contract")
+			ctx = suite.network.GetContext()
+			holder = suite.keyring.GetAddr(0)
+
+			tc.malleate()
+
+			balance := suite.network.App.Erc20Keeper.BalanceOf(ctx, erc20, contract, holder)
+			report := suite.network.App.Erc20Keeper.RunComplianceChecks(ctx, contract, holder)
+
+			suite.Require().Equal(contract.String(), report.Erc20Address)
+			suite.Require().Equal(ctx.BlockHeight(), report.Height)
+			suite.Require().Equal(tc.expCompliant, report.Compliant, report.String())
+			suite.Require().Len(report.Checks, 5)
+			suite.Require().NoError(report.Validate())
+
+			for _, check := range report.Checks {
+				switch {
+				case slices.Contains(tc.expFailed, check.Name):
+					suite.Require().False(check.Passed, check.Name)
+					suite.Require().NotEmpty(check.Reason, check.Name)
+				case slices.Contains(tc.expSkipped, check.Name):
+					suite.Require().True(check.Skipped, check.Name)
+				default:
+					suite.Require().True(check.Passed, "%s: %s", check.Name, check.Reason)
+				}
+			}
+
+			// the simulated calls are discarded
+			suite.Require().Equal(balance, suite.network.App.Erc20Keeper.BalanceOf(ctx, erc20, contract, holder))
+			_, found := suite.network.App.Erc20Keeper.GetComplianceReport(ctx, contract)
+			suite.Require().False(found)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRegisterERC20ComplianceReport() {
+	suite.SetupTest()
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	// the governance account doesn't hold any token, but the checks on the
+	// balances still run for the tokens it registers
+	contract, err := suite.DeployContract(erc20Name, erc20Symbol, erc20Decimals)
+	suite.Require().NoError(err, "failed to deploy contract")
+	ctx := suite.network.GetContext()
+
+	_, err = suite.network.App.Erc20Keeper.RegisterERC20(ctx, &types.MsgRegisterERC20{
+		Authority:      authority,
+		Erc20Addresses: []string{contract.Hex()},
+	})
+	suite.Require().NoError(err)
+
+	report, found := suite.network.App.Erc20Keeper.GetComplianceReport(ctx, contract)
+	suite.Require().True(found)
+	suite.Require().True(report.Compliant, report.String())
+	suite.Require().Empty(report.FailedChecks())
+	pairID := suite.network.App.Erc20Keeper.GetTokenPairID(ctx, contract.Hex())
+	pair, found := suite.network.App.Erc20Keeper.GetTokenPair(ctx, pairID)
+	suite.Require().True(found)
+	suite.Require().True(pair.Enabled)
+
+	// governance registers the paused token, flagged in its report, with its
+	// conversions disabled
+	contract, err = suite.DeployContract(erc20Name, erc20Symbol, erc20Decimals)
+	suite.Require().NoError(err, "failed to deploy contract")
+	ctx = suite.network.GetContext()
+
+	_, err = suite.network.App.EVMKeeper.CallEVM(ctx, erc20, suite.keyring.GetAddr(0), contract, true, "pause")
+	suite.Require().NoError(err)
+
+	_, err = suite.network.App.Erc20Keeper.RegisterERC20(ctx, &types.MsgRegisterERC20{
+		Authority:      authority,
+		Erc20Addresses: []string{contract.Hex()},
+	})
+	suite.Require().NoError(err)
+
+	report, found = suite.network.App.Erc20Keeper.GetComplianceReport(ctx, contract)
+	suite.Require().True(found)
+	suite.Require().False(report.Compliant)
+	suite.Require().Equal([]string{
+		types.ComplianceCheckTransfer,
+		types.ComplianceCheckTransferBalances,
+		types.ComplianceCheckTotalSupply,
+		types.ComplianceCheckTransferFrom,
+	}, report.FailedChecks())
+
+	res, err := suite.network.App.Erc20Keeper.ComplianceReport(ctx, &types.QueryComplianceReportRequest{Token: contract.Hex()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(report, res.Report)
+
+	pairID = suite.network.App.Erc20Keeper.GetTokenPairID(ctx, contract.Hex())
+	pair, found = suite.network.App.Erc20Keeper.GetTokenPair(ctx, pairID)
+	suite.Require().True(found)
+	suite.Require().False(pair.Enabled)
+
+	// governance can still enable them explicitly
+	_, err = suite.network.App.Erc20Keeper.ToggleConversion(ctx, &types.MsgToggleConversion{
+		Authority: authority,
+		Token:     contract.Hex(),
+	})
+	suite.Require().NoError(err)
+	pair, _ = suite.network.App.Erc20Keeper.GetTokenPair(ctx, pairID)
+	suite.Require().True(pair.Enabled)
+
+	// the permissionless registration rejects the fee-on-transfer token even
+	// if the registrant doesn't hold any of it
+	contract, err = suite.DeployContractDirectBalanceManipulation()
+	suite.Require().NoError(err)
+	ctx = suite.network.GetContext()
+	suite.enablePermissionlessRegistration(ctx, nil)
+	suite.Require().Zero(suite.network.App.Erc20Keeper.BalanceOf(ctx, erc20, contract, suite.keyring.GetAddr(1)).Sign())
+
+	_, err = suite.network.App.Erc20Keeper.RegisterERC20WithDeposit(ctx, &types.MsgRegisterERC20WithDeposit{
+		Sender:       suite.keyring.GetAccAddr(1).String(),
+		Erc20Address: contract.Hex(),
+	})
+	suite.Require().ErrorIs(err, types.ErrInvalidERC20Contract)
+	suite.Require().False(suite.network.App.Erc20Keeper.IsERC20Registered(ctx, contract))
+	_, found = suite.network.App.Erc20Keeper.GetComplianceReport(ctx, contract)
+	suite.Require().False(found)
+}
@@ -56,10 +56,31 @@ func (k Keeper) DeployERC20Contract(
 	return contractAddr, nil
 }
 
+// evmCaller performs a smart contract method call, with the signature of the
+// EVM keeper CallEVM.
+type evmCaller func(
+	ctx sdk.Context,
+	abi abi.ABI,
+	from, contract common.Address,
+	commit bool,
+	method string,
+	args ...interface{},
+) (*evmtypes.MsgEthereumTxResponse, error)
+
 // QueryERC20 returns the data of a deployed ERC20 contract
 func (k Keeper) QueryERC20(
 	ctx sdk.Context,
 	contract common.Address,
+) (types.ERC20Data, error) {
+	return queryERC20(ctx, k.evmKeeper.CallEVM, contract)
+}
+
+// queryERC20 returns the data of a deployed ERC20 contract, queried with the
+// given caller.
+func queryERC20(
+	ctx sdk.Context,
+	call evmCaller,
+	contract common.Address,
 ) (types.ERC20Data, error) {
 	var (
 		nameRes    types.ERC20StringResponse
@@ -70,7 +91,7 @@ func (k Keeper) QueryERC20(
 	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
 
 	// Name
-	res, err := k.evmKeeper.CallEVM(ctx, erc20, types.ModuleAddress, contract, false, "name")
+	res, err := call(ctx, erc20, types.ModuleAddress, contract, false, "name")
 	if err != nil {
 		return types.ERC20Data{}, err
 	}
@@ -82,7 +103,7 @@ func (k Keeper) QueryERC20(
 	}
 
 	// Symbol
-	res, err = k.evmKeeper.CallEVM(ctx, erc20, types.ModuleAddress, contract, false, "symbol")
+	res, err = call(ctx, erc20, types.ModuleAddress, contract, false, "symbol")
 	if err != nil {
 		return types.ERC20Data{}, err
 	}
@@ -94,7 +115,7 @@ func (k Keeper) QueryERC20(
 	}
 
 	// Decimals
-	res, err = k.evmKeeper.CallEVM(ctx, erc20, types.ModuleAddress, contract, false, "decimals")
+	res, err = call(ctx, erc20, types.ModuleAddress, contract, false, "decimals")
 	if err != nil {
 		return types.ERC20Data{}, err
 	}
@@ -114,7 +135,18 @@ func (k Keeper) BalanceOf(
 	abi abi.ABI,
 	contract, account common.Address,
 ) *big.Int {
-	res, err := k.evmKeeper.CallEVM(ctx, abi, types.ModuleAddress, contract, false, "balanceOf", account)
+	return balanceOf(ctx, k.evmKeeper.CallEVM, abi, contract, account)
+}
+
+// balanceOf queries an account's balance for a given ERC20 contract with the
+// given caller.
+func balanceOf(
+	ctx sdk.Context,
+	call evmCaller,
+	abi abi.ABI,
+	contract, account common.Address,
+) *big.Int {
+	res, err := call(ctx, abi, types.ModuleAddress, contract, false, "balanceOf", account)
 	if err != nil {
 		return nil
 	}
@@ -132,6 +164,41 @@ func (k Keeper) BalanceOf(
 	return balance
 }
 
+// TotalSupply queries the total supply of a given ERC20 contract
+func (k Keeper) TotalSupply(
+	ctx sdk.Context,
+	abi abi.ABI,
+	contract common.Address,
+) *big.Int {
+	return totalSupply(ctx, k.evmKeeper.CallEVM, abi, contract)
+}
+
+// totalSupply queries the total supply of a given ERC20 contract with the
+// given caller.
+func totalSupply(
+	ctx sdk.Context,
+	call evmCaller,
+	abi abi.ABI,
+	contract common.Address,
+) *big.Int {
+	res, err := call(ctx, abi, types.ModuleAddress, contract, false, "totalSupply")
+	if err != nil {
+		return nil
+	}
+
+	unpacked, err := abi.Unpack("totalSupply", res.Ret)
+	if err != nil || len(unpacked) == 0 {
+		return nil
+	}
+
+	supply, ok := unpacked[0].(*big.Int)
+	if !ok {
+		return nil
+	}
+
+	return supply
+}
+
 // monitorApprovalEvent returns an error if the given transactions logs include
 // an unexpected `Approval` event
 func (k Keeper) monitorApprovalEvent(res *evmtypes.MsgEthereumTxResponse) error {
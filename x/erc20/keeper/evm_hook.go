@@ -0,0 +1,198 @@
+package keeper
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/contracts"
+	"github.com/cosmos/evm/ibc"
+	"github.com/cosmos/evm/utils"
+	"github.com/cosmos/evm/x/erc20/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetEVMHookReceiver checks if the memo of a received ICS20 packet defines an
+// EVM hook and, if so, returns the packet with its receiver replaced by the
+// intermediate sender of the hook, so that the transferred tokens are
+// received by the account executing the hook call. The original receiver must
+// be the hook contract.
+func (k Keeper) SetEVMHookReceiver(packet channeltypes.Packet) (channeltypes.Packet, *types.EVMHook, error) {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		// not an ICS20 packet, so there is no EVM hook to execute
+		return packet, nil, nil
+	}
+
+	hook, found, err := types.ParseEVMHook(data.Memo)
+	if err != nil || !found {
+		return packet, nil, err
+	}
+
+	receiver, err := utils.GetAccAddressFromBech32(data.Receiver)
+	if err != nil {
+		return packet, nil, errorsmod.Wrap(err, "invalid recipient")
+	}
+
+	if common.BytesToAddress(receiver) != hook.Contract {
+		return packet, nil, errorsmod.Wrapf(types.ErrInvalidEVMHook, "receiver %s must be the hook contract %s", data.Receiver, hook.Contract)
+	}
+
+	data.Receiver = types.EVMHookIntermediateSender(packet.DestinationChannel, data.Sender).String()
+	packet.Data = data.GetBytes()
+
+	return packet, &hook, nil
+}
+
+// OnRecvPacketEVMHook executes the EVM hook of a received ICS20 packet once the
+// tokens have been received by the intermediate sender and converted to their
+// ERC20 representation. The intermediate sender approves the hook contract to
+// spend the received ERC20 tokens, calls it with the hook calldata and then
+// resets the approval, so that the contract can't pull tokens from the
+// intermediate sender afterwards. As the intermediate sender has no key, the
+// tokens the contract didn't spend are then sent to the contract. The calls
+// share the gas limit of the hook, capped by the EvmHookMaxGas param, and their
+// gas is charged whether they succeed or not. An error acknowledgement is
+// returned if any of the calls fail, which reverts the whole transfer, or if the
+// received coin is the EVM coin without an enabled token pair, as the calls
+// don't transfer any value.
+//
+// CONTRACT: the packet receiver must have been set with SetEVMHookReceiver.
+func (k Keeper) OnRecvPacketEVMHook(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	hook types.EVMHook,
+	ack exported.Acknowledgement,
+) exported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	maxGas := k.GetEVMHookMaxGas(ctx)
+	if maxGas == 0 {
+		return channeltypes.NewErrorAcknowledgement(errorsmod.Wrap(types.ErrEVMHookFailed, "EVM hooks are disabled"))
+	}
+	gasLimit := hook.GetGasLimit(maxGas)
+
+	intermediateSender := types.EVMHookIntermediateSender(packet.DestinationChannel, data.Sender)
+	from := common.BytesToAddress(intermediateSender)
+
+	account := k.evmKeeper.GetAccountWithoutBalance(ctx, hook.Contract)
+	if account == nil || !account.IsContract() {
+		err := errorsmod.Wrapf(types.ErrInvalidEVMHook, "hook address %s is not a contract", hook.Contract)
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	coin := ibc.GetReceivedCoin(
+		packet.SourcePort, packet.SourceChannel,
+		packet.DestinationPort, packet.DestinationChannel,
+		data.Denom, data.Amount,
+	)
+
+	// approve the hook contract to spend the received tokens, if they have an
+	// ERC20 representation
+	pair, found := k.GetTokenPair(ctx, k.GetTokenPairID(ctx, coin.Denom))
+	approve := found && pair.Enabled
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+
+	// the hook calls don't transfer any value, so the contract can only spend
+	// the EVM coin through the approval of its token pair
+	if !approve && coin.Denom == evmtypes.GetEVMCoinDenom() {
+		err := errorsmod.Wrapf(types.ErrInvalidEVMHook, "%s has no enabled token pair to approve the hook contract", coin.Denom)
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	if approve {
+		approveData, err := erc20.Pack("approve", hook.Contract, coin.Amount.BigInt())
+		if err != nil {
+			return channeltypes.NewErrorAcknowledgement(err)
+		}
+
+		if err := k.callEVMHook(ctx, from, pair.GetERC20Contract(), approveData, &gasLimit, "evm hook approval"); err != nil {
+			return channeltypes.NewErrorAcknowledgement(errorsmod.Wrap(types.ErrEVMHookFailed, err.Error()))
+		}
+	}
+
+	if err := k.callEVMHook(ctx, from, hook.Contract, hook.Calldata, &gasLimit, "evm hook"); err != nil {
+		return channeltypes.NewErrorAcknowledgement(errorsmod.Wrap(types.ErrEVMHookFailed, err.Error()))
+	}
+
+	// reset the approval in case the contract didn't spend all the tokens
+	if approve {
+		resetData, err := erc20.Pack("approve", hook.Contract, common.Big0)
+		if err != nil {
+			return channeltypes.NewErrorAcknowledgement(err)
+		}
+
+		if err := k.callEVMHook(ctx, from, pair.GetERC20Contract(), resetData, &gasLimit, "evm hook approval reset"); err != nil {
+			return channeltypes.NewErrorAcknowledgement(errorsmod.Wrap(types.ErrEVMHookFailed, err.Error()))
+		}
+	}
+
+	// the intermediate sender has no key, so the tokens that the contract
+	// didn't spend are sent to it
+	if approve && pair.IsNativeERC20() {
+		if balance := k.BalanceOf(ctx, erc20, pair.GetERC20Contract(), from); balance != nil && balance.Sign() > 0 {
+			transferData, err := erc20.Pack("transfer", hook.Contract, balance)
+			if err != nil {
+				return channeltypes.NewErrorAcknowledgement(err)
+			}
+
+			if err := k.callEVMHook(ctx, from, pair.GetERC20Contract(), transferData, &gasLimit, "evm hook refund"); err != nil {
+				return channeltypes.NewErrorAcknowledgement(errorsmod.Wrap(types.ErrEVMHookFailed, err.Error()))
+			}
+		}
+	}
+
+	if balance := k.bankKeeper.GetBalance(ctx, intermediateSender, coin.Denom); balance.IsPositive() {
+		if err := k.bankKeeper.SendCoins(ctx, intermediateSender, hook.Contract.Bytes(), sdk.NewCoins(balance)); err != nil {
+			return channeltypes.NewErrorAcknowledgement(err)
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEVMHook,
+			sdk.NewAttribute(types.AttributeKeyContract, hook.Contract.Hex()),
+			sdk.NewAttribute(types.AttributeKeyIntermediateSender, from.Hex()),
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, coin.String()),
+			sdk.NewAttribute(types.AttributeCoinSourceChannel, packet.SourceChannel),
+		),
+	)
+
+	return ack
+}
+
+// callEVMHook calls the contract from the intermediate sender with the given
+// data, limited to the remaining gas of the hook. The gas used is charged on
+// the transaction gas meter and deducted from the remaining gas, including when
+// the call fails, in which case the remaining gas is charged if the failure
+// happened before the execution (e.g. on a revert during the gas estimation).
+func (k Keeper) callEVMHook(
+	ctx sdk.Context,
+	from, contract common.Address,
+	data []byte,
+	remainingGas *uint64,
+	descriptor string,
+) error {
+	// the gas of the execution is charged from its response, so the KV store
+	// accesses of the EVM aren't charged twice
+	evmCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	res, err := k.evmKeeper.CallEVMWithDataAndGasCap(evmCtx, from, &contract, data, true, *remainingGas)
+
+	gasUsed := *remainingGas
+	if res != nil {
+		gasUsed = min(res.GasUsed, *remainingGas)
+	}
+	ctx.GasMeter().ConsumeGas(gasUsed, descriptor)
+	*remainingGas -= gasUsed
+
+	return err
+}
@@ -0,0 +1,236 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/cosmos/evm/contracts"
+	"github.com/cosmos/evm/ibc"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	"github.com/cosmos/evm/x/erc20/types"
+	"github.com/cosmos/evm/x/vm/statedb"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// newEVMHookPacket returns an ICS20 packet sent to the given receiver with an
+// EVM hook calling the given contract.
+func newEVMHookPacket(receiver string, contract common.Address) channeltypes.Packet {
+	memo := `{"evm":{"contract":"` + contract.Hex() + `","calldata":"0x12345678"}}`
+	data := transfertypes.NewFungibleTokenPacketData("uatom", "100", "cosmos1qql8ag4cluz6r4dz28p3w00dnc9w8ueulg2gmc", receiver, memo)
+	return channeltypes.NewPacket(data.GetBytes(), 1, transfertypes.PortID, "channel-0", transfertypes.PortID, "channel-1", clienttypes.NewHeight(1, 100), 0)
+}
+
+// setHookContract sets the given runtime code on a new contract address.
+func (suite *KeeperTestSuite) setHookContract(ctx sdk.Context, code []byte) common.Address {
+	addr := utiltx.GenerateAddress()
+	codeHash := crypto.Keccak256(code)
+
+	account := statedb.NewEmptyAccount()
+	account.CodeHash = codeHash
+	suite.Require().NoError(suite.network.App.EVMKeeper.SetAccount(ctx, addr, *account))
+	suite.network.App.EVMKeeper.SetCode(ctx, codeHash, code)
+
+	return addr
+}
+
+func (suite *KeeperTestSuite) TestSetEVMHookReceiver() {
+	suite.SetupTest()
+	contract := utiltx.GenerateAddress()
+
+	// packets without EVM hooks are not modified
+	packet := newEVMHookPacket(sdk.AccAddress(contract.Bytes()).String(), contract)
+	packet.Data = transfertypes.NewFungibleTokenPacketData("uatom", "100", "cosmos1sender", "cosmos1receiver", "").GetBytes()
+	newPacket, hook, err := suite.network.App.Erc20Keeper.SetEVMHookReceiver(packet)
+	suite.Require().NoError(err)
+	suite.Require().Nil(hook)
+	suite.Require().Equal(packet, newPacket)
+
+	// the receiver must be the hook contract
+	packet = newEVMHookPacket(suite.keyring.GetAccAddr(0).String(), contract)
+	_, _, err = suite.network.App.Erc20Keeper.SetEVMHookReceiver(packet)
+	suite.Require().ErrorContains(err, "must be the hook contract")
+
+	// the receiver is replaced by the intermediate sender
+	packet = newEVMHookPacket(sdk.AccAddress(contract.Bytes()).String(), contract)
+	newPacket, hook, err = suite.network.App.Erc20Keeper.SetEVMHookReceiver(packet)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(hook)
+	suite.Require().Equal(contract, hook.Contract)
+
+	var data transfertypes.FungibleTokenPacketData
+	suite.Require().NoError(transfertypes.ModuleCdc.UnmarshalJSON(newPacket.GetData(), &data))
+	suite.Require().Equal(types.EVMHookIntermediateSender(packet.DestinationChannel, data.Sender).String(), data.Receiver)
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketEVMHook() {
+	successAck := channeltypes.NewResultAcknowledgement([]byte{1})
+
+	testCases := []struct {
+		name       string
+		contract   func(ctx sdk.Context) common.Address
+		maxGas     uint64
+		expSuccess bool
+		expGas     bool
+	}{
+		{
+			"hook address is not a contract",
+			func(_ sdk.Context) common.Address { return utiltx.GenerateAddress() },
+			types.DefaultEVMHookMaxGas,
+			false,
+			false,
+		},
+		{
+			"EVM hooks disabled",
+			func(ctx sdk.Context) common.Address {
+				return suite.setHookContract(ctx, []byte{0x00})
+			},
+			0,
+			false,
+			false,
+		},
+		{
+			"hook call reverts",
+			func(ctx sdk.Context) common.Address {
+				return suite.setHookContract(ctx, []byte{0x60, 0x00, 0x60, 0x00, 0xfd})
+			},
+			types.DefaultEVMHookMaxGas,
+			false,
+			true,
+		},
+		{
+			"hook call succeeds",
+			func(ctx sdk.Context) common.Address {
+				return suite.setHookContract(ctx, []byte{0x00})
+			},
+			types.DefaultEVMHookMaxGas,
+			true,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx := suite.network.GetContext()
+			contract := tc.contract(ctx)
+
+			params := suite.network.App.Erc20Keeper.GetParams(ctx)
+			params.EvmHookMaxGas = tc.maxGas
+			suite.Require().NoError(suite.network.App.Erc20Keeper.SetParams(ctx, params))
+
+			packet, hook, err := suite.network.App.Erc20Keeper.SetEVMHookReceiver(newEVMHookPacket(sdk.AccAddress(contract.Bytes()).String(), contract))
+			suite.Require().NoError(err)
+
+			// the intermediate sender account is created when receiving the tokens
+			var data transfertypes.FungibleTokenPacketData
+			suite.Require().NoError(transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data))
+			intermediateSender := types.EVMHookIntermediateSender(packet.DestinationChannel, data.Sender)
+			suite.network.App.AccountKeeper.SetAccount(ctx, suite.network.App.AccountKeeper.NewAccountWithAddress(ctx, intermediateSender))
+
+			// the received coins don't have a token pair, so the contract can't
+			// spend them
+			coin := ibc.GetReceivedCoin(packet.SourcePort, packet.SourceChannel, packet.DestinationPort, packet.DestinationChannel, data.Denom, data.Amount)
+			suite.Require().NoError(suite.network.App.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(coin)))
+			suite.Require().NoError(suite.network.App.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, intermediateSender, sdk.NewCoins(coin)))
+
+			ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+			ack := suite.network.App.Erc20Keeper.OnRecvPacketEVMHook(ctx, packet, *hook, successAck)
+			suite.Require().Equal(tc.expSuccess, ack.Success())
+
+			// the gas of the hook call is charged whether it succeeds or not
+			if tc.expGas {
+				suite.Require().NotZero(ctx.GasMeter().GasConsumed())
+			}
+
+			// the coins left to the intermediate sender are sent to the contract
+			if tc.expSuccess {
+				suite.Require().True(suite.network.App.BankKeeper.GetBalance(ctx, intermediateSender, coin.Denom).IsZero())
+				suite.Require().Equal(coin, suite.network.App.BankKeeper.GetBalance(ctx, contract.Bytes(), coin.Denom))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketEVMHookApproval() {
+	suite.SetupTest()
+	successAck := channeltypes.NewResultAcknowledgement([]byte{1})
+	sender := "cosmos1qql8ag4cluz6r4dz28p3w00dnc9w8ueulg2gmc"
+	amount := big.NewInt(100)
+
+	erc20Addr, err := suite.setupRegisterERC20Pair(contractMinterBurner)
+	suite.Require().NoError(err)
+
+	// the intermediate sender holds the tokens received and converted by the
+	// ICS20 middleware
+	intermediateSender := types.EVMHookIntermediateSender("channel-1", sender)
+	_, err = suite.MintERC20Token(erc20Addr, common.BytesToAddress(intermediateSender), amount)
+	suite.Require().NoError(err)
+
+	ctx := suite.network.GetContext()
+	suite.network.App.AccountKeeper.SetAccount(ctx, suite.network.App.AccountKeeper.NewAccountWithAddress(ctx, intermediateSender))
+	contract := suite.setHookContract(ctx, []byte{0x00})
+	pair, found := suite.network.App.Erc20Keeper.GetTokenPair(ctx, suite.network.App.Erc20Keeper.GetERC20Map(ctx, erc20Addr))
+	suite.Require().True(found)
+
+	// the tokens are sent back to this chain, so that the received coin is the
+	// coin of the token pair
+	denom := transfertypes.GetPrefixedDenom(transfertypes.PortID, "channel-0", pair.Denom)
+	memo := `{"evm":{"contract":"` + contract.Hex() + `","calldata":"0x12345678","gas_limit":"500000"}}`
+	data := transfertypes.NewFungibleTokenPacketData(denom, amount.String(), sender, sdk.AccAddress(contract.Bytes()).String(), memo)
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, transfertypes.PortID, "channel-0", transfertypes.PortID, "channel-1", clienttypes.NewHeight(1, 100), 0)
+
+	packet, hook, err := suite.network.App.Erc20Keeper.SetEVMHookReceiver(packet)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(500_000), hook.GasLimit)
+
+	ack := suite.network.App.Erc20Keeper.OnRecvPacketEVMHook(ctx, packet, *hook, successAck)
+	suite.Require().True(ack.Success(), string(ack.Acknowledgement()))
+
+	// the approval left unspent by the hook contract is reset
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	res, err := suite.network.App.EVMKeeper.CallEVM(ctx, erc20, types.ModuleAddress, erc20Addr, false, "allowance", common.BytesToAddress(intermediateSender), contract)
+	suite.Require().NoError(err)
+
+	allowance, err := erc20.Unpack("allowance", res.Ret)
+	suite.Require().NoError(err)
+	suite.Require().Zero(allowance[0].(*big.Int).Sign())
+
+	// and the tokens the hook contract didn't spend are sent to it
+	suite.Require().Zero(suite.network.App.Erc20Keeper.BalanceOf(ctx, erc20, erc20Addr, common.BytesToAddress(intermediateSender)).Sign())
+	suite.Require().Equal(amount, suite.network.App.Erc20Keeper.BalanceOf(ctx, erc20, erc20Addr, contract))
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketEVMHookEVMCoin() {
+	suite.SetupTest()
+	successAck := channeltypes.NewResultAcknowledgement([]byte{1})
+	ctx := suite.network.GetContext()
+	contract := suite.setHookContract(ctx, []byte{0x00})
+
+	// the EVM coin is sent back to this chain without an enabled token pair
+	evmDenom := evmtypes.GetEVMCoinDenom()
+	if pair, found := suite.network.App.Erc20Keeper.GetTokenPair(ctx, suite.network.App.Erc20Keeper.GetTokenPairID(ctx, evmDenom)); found {
+		pair.Enabled = false
+		suite.network.App.Erc20Keeper.SetTokenPair(ctx, pair)
+	}
+
+	denom := transfertypes.GetPrefixedDenom(transfertypes.PortID, "channel-0", evmDenom)
+	memo := `{"evm":{"contract":"` + contract.Hex() + `","calldata":"0x12345678"}}`
+	data := transfertypes.NewFungibleTokenPacketData(denom, "100", "cosmos1qql8ag4cluz6r4dz28p3w00dnc9w8ueulg2gmc", sdk.AccAddress(contract.Bytes()).String(), memo)
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, transfertypes.PortID, "channel-0", transfertypes.PortID, "channel-1", clienttypes.NewHeight(1, 100), 0)
+
+	packet, hook, err := suite.network.App.Erc20Keeper.SetEVMHookReceiver(packet)
+	suite.Require().NoError(err)
+
+	ack := suite.network.App.Erc20Keeper.OnRecvPacketEVMHook(ctx, packet, *hook, successAck)
+	suite.Require().False(ack.Success())
+	suite.Require().Equal(channeltypes.NewErrorAcknowledgement(types.ErrInvalidEVMHook), ack)
+}
@@ -78,6 +78,27 @@ func (k Keeper) TokenPair(c context.Context, req *types.QueryTokenPairRequest) (
 	return &types.QueryTokenPairResponse{TokenPair: pair}, nil
 }
 
+// ComplianceReport returns the ERC20 compliance report of a given registered
+// token pair
+func (k Keeper) ComplianceReport(c context.Context, req *types.QueryComplianceReportRequest) (*types.QueryComplianceReportResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	res, err := k.TokenPair(c, &types.QueryTokenPairRequest{Token: req.Token})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	report, found := k.GetComplianceReport(ctx, res.TokenPair.GetERC20Contract())
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "compliance report with token '%s'", req.Token)
+	}
+
+	return &types.QueryComplianceReportResponse{Report: report}, nil
+}
+
 // Params returns the params of the erc20 module
 func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -131,8 +131,9 @@ func (k Keeper) OnRecvPacket(
 
 	// Case 2. native ERC20 token
 	case found && pair.IsNativeERC20():
-		// Token pair is disabled -> return
-		if !pair.Enabled {
+		// Token pair is disabled -> return, unless it is delisted so that the
+		// received coins are redeemed for the ERC20 tokens
+		if !pair.Enabled && !pair.Delisted {
 			return ack
 		}
 
@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/contracts"
+	"github.com/cosmos/evm/x/erc20/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterInvariants registers the ERC20 module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "erc20-escrow", ERC20EscrowInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "precompiles", PrecompilesInvariant(k))
+}
+
+// ERC20EscrowInvariant checks that the tokens of every token pair are backed
+// by the assets escrowed on the erc20 module account:
+//   - for native ERC20 token pairs, the supply of the Cosmos coin can't exceed
+//     the ERC20 tokens escrowed on the module address.
+//   - for native coin token pairs with a deployed ERC20 contract, the ERC20
+//     total supply can't exceed the Cosmos coins escrowed on the module
+//     account. The pairs of the ERC20 precompiles are skipped, as their
+//     balances are the bank ones.
+//
+// NOTE: the escrowed balances can be larger than the supplies, since anyone
+// can transfer tokens and coins to the module address. Native ERC20 token
+// pairs whose contract has been self-destructed or doesn't answer the queries
+// are skipped, as their state is outside of the module control. The tokens
+// that don't keep the escrowed balances, such as rebasing or fee-on-transfer
+// tokens, are rejected by the compliance checks on registration.
+func ERC20EscrowInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		params := k.GetParams(ctx)
+		erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+		k.IterateTokenPairs(ctx, func(pair types.TokenPair) bool {
+			contract := pair.GetERC20Contract()
+			if k.IsAvailableERC20Precompile(&params, contract) {
+				return false
+			}
+
+			acc := k.evmKeeper.GetAccountWithoutBalance(ctx, contract)
+			if acc == nil || !acc.IsContract() {
+				return false
+			}
+
+			switch {
+			case pair.IsNativeERC20():
+				escrowed := k.BalanceOf(ctx, erc20, contract, types.ModuleAddress)
+				if escrowed == nil {
+					return false
+				}
+
+				supply := k.bankKeeper.GetSupply(ctx, pair.Denom)
+				if supply.Amount.BigInt().Cmp(escrowed) > 0 {
+					broken = true
+					msg += fmt.Sprintf(
+						"\tcoin supply %s is larger than the %s tokens escrowed for contract %s\n",
+						supply, escrowed, pair.Erc20Address,
+					)
+				}
+			case pair.IsNativeCoin():
+				supply := k.TotalSupply(ctx, erc20, contract)
+				if supply == nil {
+					broken = true
+					msg += fmt.Sprintf("\tfailed to retrieve the total supply of contract %s\n", pair.Erc20Address)
+					return false
+				}
+
+				escrowed := k.bankKeeper.GetBalance(ctx, types.ModuleAddress.Bytes(), pair.Denom)
+				if supply.Cmp(escrowed.Amount.BigInt()) > 0 {
+					broken = true
+					msg += fmt.Sprintf(
+						"\ttoken supply %s of contract %s is larger than the %s coins escrowed\n",
+						supply, pair.Erc20Address, escrowed,
+					)
+				}
+			}
+			return false
+		})
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "erc20-escrow",
+			fmt.Sprintf("token pairs not backed by escrowed assets:\n%s", msg),
+		), broken
+	}
+}
+
+// PrecompilesInvariant checks that every native and dynamic precompile
+// address in the module params corresponds to a registered native coin token
+// pair.
+func PrecompilesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		params := k.GetParams(ctx)
+		precompiles := append([]string{}, params.NativePrecompiles...)
+		precompiles = append(precompiles, params.DynamicPrecompiles...)
+
+		for _, precompile := range precompiles {
+			id := k.GetERC20Map(ctx, common.HexToAddress(precompile))
+			pair, found := k.GetTokenPair(ctx, id)
+			switch {
+			case !found:
+				broken = true
+				msg += fmt.Sprintf("\tno token pair registered for precompile %s\n", precompile)
+			case !pair.IsNativeCoin():
+				broken = true
+				msg += fmt.Sprintf("\ttoken pair for precompile %s is not a native coin pair\n", precompile)
+			}
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "precompiles",
+			fmt.Sprintf("precompiles without a native coin token pair:\n%s", msg),
+		), broken
+	}
+}
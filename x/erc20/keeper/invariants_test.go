@@ -0,0 +1,179 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	"github.com/cosmos/evm/x/erc20/keeper"
+	"github.com/cosmos/evm/x/erc20/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *KeeperTestSuite) TestERC20EscrowInvariant() {
+	amount := big.NewInt(100)
+
+	testCases := []struct {
+		name      string
+		malleate  func(contract common.Address)
+		expBroken bool
+	}{
+		{
+			"pass - no coins minted",
+			func(common.Address) {},
+			false,
+		},
+		{
+			"pass - coins backed by escrowed tokens",
+			func(contract common.Address) {
+				_, err := suite.MintERC20Token(contract, types.ModuleAddress, amount)
+				suite.Require().NoError(err)
+
+				coins := sdk.NewCoins(sdk.NewCoin(types.CreateDenom(contract.String()), sdkmath.NewIntFromBigInt(amount)))
+				err = suite.network.App.BankKeeper.MintCoins(suite.network.GetContext(), types.ModuleName, coins)
+				suite.Require().NoError(err)
+			},
+			false,
+		},
+		{
+			"fail - coins not backed by escrowed tokens",
+			func(contract common.Address) {
+				_, err := suite.MintERC20Token(contract, types.ModuleAddress, big.NewInt(1))
+				suite.Require().NoError(err)
+
+				coins := sdk.NewCoins(sdk.NewCoin(types.CreateDenom(contract.String()), sdkmath.NewIntFromBigInt(amount)))
+				err = suite.network.App.BankKeeper.MintCoins(suite.network.GetContext(), types.ModuleName, coins)
+				suite.Require().NoError(err)
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			contract, err := suite.setupRegisterERC20Pair(contractMinterBurner)
+			suite.Require().NoError(err)
+
+			tc.malleate(contract)
+
+			msg, broken := keeper.ERC20EscrowInvariant(suite.network.App.Erc20Keeper)(suite.network.GetContext())
+			suite.Require().Equal(tc.expBroken, broken, msg)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestERC20EscrowInvariantNativeCoin() {
+	denom := "acoin"
+	amount := big.NewInt(100)
+
+	testCases := []struct {
+		name      string
+		malleate  func(contract common.Address)
+		expBroken bool
+	}{
+		{
+			"pass - no tokens minted",
+			func(common.Address) {},
+			false,
+		},
+		{
+			"pass - tokens backed by escrowed coins",
+			func(contract common.Address) {
+				_, err := suite.MintERC20Token(contract, suite.keyring.GetAddr(0), amount)
+				suite.Require().NoError(err)
+
+				coins := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(amount)))
+				err = suite.network.App.BankKeeper.MintCoins(suite.network.GetContext(), types.ModuleName, coins)
+				suite.Require().NoError(err)
+			},
+			false,
+		},
+		{
+			"fail - tokens not backed by escrowed coins",
+			func(contract common.Address) {
+				_, err := suite.MintERC20Token(contract, suite.keyring.GetAddr(0), amount)
+				suite.Require().NoError(err)
+
+				coins := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.OneInt()))
+				err = suite.network.App.BankKeeper.MintCoins(suite.network.GetContext(), types.ModuleName, coins)
+				suite.Require().NoError(err)
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			// the contract is owned by an account to mint tokens without escrow
+			contract, err := suite.DeployContract(erc20Name, erc20Symbol, erc20Decimals)
+			suite.Require().NoError(err)
+			suite.Require().NoError(suite.network.NextBlock())
+
+			tc.malleate(contract)
+
+			ctx := suite.network.GetContext()
+			suite.network.App.Erc20Keeper.SetToken(ctx, types.NewTokenPair(contract, denom, types.OWNER_MODULE))
+
+			msg, broken := keeper.ERC20EscrowInvariant(suite.network.App.Erc20Keeper)(ctx)
+			suite.Require().Equal(tc.expBroken, broken, msg)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestPrecompilesInvariant() {
+	testCases := []struct {
+		name      string
+		malleate  func(ctx sdk.Context)
+		expBroken bool
+	}{
+		{
+			"pass - default precompiles",
+			func(sdk.Context) {},
+			false,
+		},
+		{
+			"fail - precompile without token pair",
+			func(ctx sdk.Context) {
+				params := suite.network.App.Erc20Keeper.GetParams(ctx)
+				params.DynamicPrecompiles = append(params.DynamicPrecompiles, utiltx.GenerateAddress().Hex())
+				err := suite.network.App.Erc20Keeper.SetParams(ctx, params)
+				suite.Require().NoError(err)
+			},
+			true,
+		},
+		{
+			"fail - precompile for a native ERC20 token pair",
+			func(ctx sdk.Context) {
+				contract := utiltx.GenerateAddress()
+				pair := types.NewTokenPair(contract, "coin", types.OWNER_EXTERNAL)
+				suite.network.App.Erc20Keeper.SetToken(ctx, pair)
+
+				params := suite.network.App.Erc20Keeper.GetParams(ctx)
+				params.DynamicPrecompiles = append(params.DynamicPrecompiles, contract.Hex())
+				err := suite.network.App.Erc20Keeper.SetParams(ctx, params)
+				suite.Require().NoError(err)
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx := suite.network.GetContext()
+
+			tc.malleate(ctx)
+
+			msg, broken := keeper.PrecompilesInvariant(suite.network.App.Erc20Keeper)(ctx)
+			suite.Require().Equal(tc.expBroken, broken, msg)
+		})
+	}
+}
@@ -3,6 +3,8 @@ package keeper
 import (
 	"context"
 	"math/big"
+	"strconv"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/hashicorp/go-metrics"
@@ -193,6 +195,7 @@ func (k Keeper) convertERC20IntoCoinsForNativeToken(
 //   - burn escrowed Coins
 //   - check if token balance increased by amount
 //   - check for unexpected `Approval` event in logs
+//   - remove the token pair if it is delisted and all its coins are redeemed
 func (k Keeper) ConvertCoinNativeERC20(
 	ctx sdk.Context,
 	pair types.TokenPair,
@@ -256,7 +259,15 @@ func (k Keeper) ConvertCoinNativeERC20(
 	}
 
 	// Check for unexpected `Approval` event in logs
-	return k.monitorApprovalEvent(res)
+	if err := k.monitorApprovalEvent(res); err != nil {
+		return err
+	}
+
+	if pair.Delisted && k.bankKeeper.GetSupply(ctx, pair.Denom).IsZero() {
+		k.DeleteTokenPair(ctx, pair)
+	}
+
+	return nil
 }
 
 // UpdateParams implements the gRPC MsgServer interface. After a successful governance vote
@@ -294,16 +305,30 @@ func (k *Keeper) RegisterERC20(goCtx context.Context, req *types.MsgRegisterERC2
 			return nil, errortypes.ErrInvalidAddress.Wrapf("invalid ERC20 contract address: %s", addr)
 		}
 
-		pair, err := k.registerERC20(ctx, common.HexToAddress(addr))
+		contract := common.HexToAddress(addr)
+		report := k.RunComplianceChecks(ctx, contract, common.BytesToAddress(k.authority))
+
+		pair, err := k.registerERC20(ctx, contract)
 		if err != nil {
 			return nil, err
 		}
+		k.SetComplianceReport(ctx, report)
+
+		// the conversions of the tokens that fail the compliance checks are
+		// disabled until governance explicitly enables them with a
+		// MsgToggleConversion
+		if !report.Compliant {
+			pair.Enabled = false
+			k.SetTokenPair(ctx, *pair)
+		}
 
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				types.EventTypeRegisterERC20,
 				sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
 				sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+				sdk.NewAttribute(types.AttributeKeyCompliant, strconv.FormatBool(report.Compliant)),
+				sdk.NewAttribute(types.AttributeKeyFailedChecks, strings.Join(report.FailedChecks(), ",")),
 			),
 		)
 	}
@@ -342,6 +367,148 @@ func (k *Keeper) ToggleConversion(goCtx context.Context, req *types.MsgToggleCon
 	return &types.MsgToggleConversionResponse{}, nil
 }
 
+// RegisterERC20WithDeposit implements the gRPC MsgServer interface.
+//
+// It registers the token pair of an ERC20 contract without governance, once
+// the contract passed the ERC20 and compliance checks, and locks the
+// registration deposit from the sender.
+func (k *Keeper) RegisterERC20WithDeposit(goCtx context.Context, req *types.MsgRegisterERC20WithDeposit) (*types.MsgRegisterERC20WithDepositResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// Check if the conversion is globally enabled
+	if !k.IsERC20Enabled(ctx) {
+		return nil, types.ErrERC20Disabled.Wrap("registration is currently disabled by governance")
+	}
+
+	if !k.IsPermissionlessRegistrationEnabled(ctx) {
+		return nil, types.ErrRegistrationDisabled
+	}
+
+	sender, err := k.accountKeeper.AddressCodec().StringToBytes(req.Sender)
+	if err != nil {
+		return nil, errortypes.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	if !common.IsHexAddress(req.Erc20Address) {
+		return nil, errortypes.ErrInvalidAddress.Wrapf("invalid ERC20 contract address: %s", req.Erc20Address)
+	}
+
+	pair, report, deposit, err := k.registerERC20WithDeposit(ctx, common.HexToAddress(req.Erc20Address), sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterERC20,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeyCompliant, strconv.FormatBool(report.Compliant)),
+			sdk.NewAttribute(types.AttributeKeyDepositor, req.Sender),
+			sdk.NewAttribute(types.AttributeKeyDeposit, deposit.String()),
+		),
+	)
+
+	return &types.MsgRegisterERC20WithDepositResponse{}, nil
+}
+
+// DelistERC20 implements the gRPC MsgServer interface.
+//
+// After a successful governance vote it removes the token pair of an ERC20
+// contract and refunds or burns the registration deposit according to the
+// outcome of the vote. If coins of the pair are still in circulation, the pair
+// is kept for them to be redeemed with RedeemCoin.
+func (k *Keeper) DelistERC20(goCtx context.Context, req *types.MsgDelistERC20) (*types.MsgDelistERC20Response, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	pair, deposit, err := k.delistERC20(ctx, req.Token, req.BurnDeposit)
+	if err != nil {
+		return nil, err
+	}
+
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+		sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+		sdk.NewAttribute(types.AttributeKeyRedeemOnly, strconv.FormatBool(pair.Delisted)),
+	}
+	if deposit != nil {
+		attrs = append(attrs,
+			sdk.NewAttribute(types.AttributeKeyDepositor, deposit.Depositor),
+			sdk.NewAttribute(types.AttributeKeyDeposit, deposit.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyDepositBurned, strconv.FormatBool(req.BurnDeposit)),
+		)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeDelistERC20, attrs...))
+
+	return &types.MsgDelistERC20Response{}, nil
+}
+
+// RedeemCoin implements the gRPC MsgServer interface.
+//
+// It converts the Cosmos coins of a token pair delisted by governance back to
+// their ERC20 tokens.
+func (k *Keeper) RedeemCoin(goCtx context.Context, req *types.MsgRedeemCoin) (*types.MsgRedeemCoinResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := k.accountKeeper.AddressCodec().StringToBytes(req.Sender)
+	if err != nil {
+		return nil, errortypes.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	if !common.IsHexAddress(req.Receiver) {
+		return nil, errortypes.ErrInvalidAddress.Wrapf("invalid receiver hex address: %s", req.Receiver)
+	}
+
+	pair, err := k.redeemCoin(ctx, req.Coin, common.HexToAddress(req.Receiver), sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRedeemCoin,
+			sdk.NewAttribute(sdk.AttributeKeySender, req.Sender),
+			sdk.NewAttribute(types.AttributeKeyReceiver, req.Receiver),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, req.Coin.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+		),
+	)
+
+	return &types.MsgRedeemCoinResponse{}, nil
+}
+
+// ReleaseRegistrationDeposit implements the gRPC MsgServer interface.
+//
+// After a successful governance vote it refunds the registration deposit of a
+// token pair to its depositor, keeping the token pair registered.
+func (k *Keeper) ReleaseRegistrationDeposit(goCtx context.Context, req *types.MsgReleaseRegistrationDeposit) (*types.MsgReleaseRegistrationDepositResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	pair, deposit, err := k.releaseRegistrationDeposit(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeReleaseDeposit,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeyDepositor, deposit.Depositor),
+			sdk.NewAttribute(types.AttributeKeyDeposit, deposit.Amount.String()),
+		),
+	)
+
+	return &types.MsgReleaseRegistrationDepositResponse{}, nil
+}
+
 // validateAuthority is a helper function to validate that the provided authority
 // is the keeper's authority address
 func (k *Keeper) validateAuthority(authority string) error {
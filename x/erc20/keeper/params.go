@@ -19,7 +19,11 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	enableErc20 := k.IsERC20Enabled(ctx)
 	dynamicPrecompiles := k.getDynamicPrecompiles(ctx)
 	nativePrecompiles := k.getNativePrecompiles(ctx)
-	return types.NewParams(enableErc20, nativePrecompiles, dynamicPrecompiles)
+	params = types.NewParams(enableErc20, nativePrecompiles, dynamicPrecompiles)
+	params.PermissionlessRegistration = k.IsPermissionlessRegistrationEnabled(ctx)
+	params.RegistrationDeposit = k.GetRegistrationDepositParam(ctx)
+	params.EvmHookMaxGas = k.GetEVMHookMaxGas(ctx)
+	return params
 }
 
 // UpdateCodeHash takes in the updated parameters and
@@ -87,6 +91,9 @@ func (k Keeper) SetParams(ctx sdk.Context, newParams types.Params) error {
 	k.setERC20Enabled(ctx, newParams.EnableErc20)
 	k.setDynamicPrecompiles(ctx, newParams.DynamicPrecompiles)
 	k.setNativePrecompiles(ctx, newParams.NativePrecompiles)
+	k.setPermissionlessRegistration(ctx, newParams.PermissionlessRegistration)
+	k.setRegistrationDepositParam(ctx, newParams.RegistrationDeposit)
+	k.setEVMHookMaxGas(ctx, newParams.EvmHookMaxGas)
 	return nil
 }
 
@@ -146,3 +153,66 @@ func (k Keeper) getNativePrecompiles(ctx sdk.Context) (nativePrecompiles []strin
 	}
 	return nativePrecompiles
 }
+
+// IsPermissionlessRegistrationEnabled returns true if any account can register
+// a token pair by locking the registration deposit
+func (k Keeper) IsPermissionlessRegistrationEnabled(ctx sdk.Context) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.ParamStoreKeyPermissionlessRegistration)
+}
+
+// setPermissionlessRegistration sets the PermissionlessRegistration param in the store
+func (k Keeper) setPermissionlessRegistration(ctx sdk.Context, enable bool) {
+	store := ctx.KVStore(k.storeKey)
+	if enable {
+		store.Set(types.ParamStoreKeyPermissionlessRegistration, isTrue)
+		return
+	}
+	store.Delete(types.ParamStoreKeyPermissionlessRegistration)
+}
+
+// GetRegistrationDepositParam returns the RegistrationDeposit param from the store
+func (k Keeper) GetRegistrationDepositParam(ctx sdk.Context) sdk.Coins {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamStoreKeyRegistrationDeposit)
+	if len(bz) == 0 {
+		return nil
+	}
+
+	// the deposit is validated before being stored
+	deposit, err := sdk.ParseCoinsNormalized(string(bz))
+	if err != nil {
+		panic(err)
+	}
+	return deposit
+}
+
+// setRegistrationDepositParam sets the RegistrationDeposit param in the store
+func (k Keeper) setRegistrationDepositParam(ctx sdk.Context, deposit sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+	if deposit.IsZero() {
+		store.Delete(types.ParamStoreKeyRegistrationDeposit)
+		return
+	}
+	store.Set(types.ParamStoreKeyRegistrationDeposit, []byte(deposit.String()))
+}
+
+// GetEVMHookMaxGas returns the EvmHookMaxGas param from the store
+func (k Keeper) GetEVMHookMaxGas(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamStoreKeyEVMHookMaxGas)
+	if len(bz) == 0 {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setEVMHookMaxGas sets the EvmHookMaxGas param in the store
+func (k Keeper) setEVMHookMaxGas(ctx sdk.Context, maxGas uint64) {
+	store := ctx.KVStore(k.storeKey)
+	if maxGas == 0 {
+		store.Delete(types.ParamStoreKeyEVMHookMaxGas)
+		return
+	}
+	store.Set(types.ParamStoreKeyEVMHookMaxGas, sdk.Uint64ToBigEndian(maxGas))
+}
@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/x/erc20/types"
+
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetPermitNonce returns the current EIP-2612 permit nonce of the owner for the
+// given ERC-20 token precompile.
+func (k Keeper) GetPermitNonce(ctx sdk.Context, token, owner common.Address) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPermitNonce)
+	bz := store.Get(append(token.Bytes(), owner.Bytes()...))
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetPermitNonce sets the EIP-2612 permit nonce of the owner for the given
+// ERC-20 token precompile.
+func (k Keeper) SetPermitNonce(ctx sdk.Context, token, owner common.Address, nonce uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPermitNonce)
+	store.Set(append(token.Bytes(), owner.Bytes()...), sdk.Uint64ToBigEndian(nonce))
+}
+
+// GetPermitNonces returns the EIP-2612 permit nonces of all the owners of the
+// ERC-20 token precompiles.
+func (k Keeper) GetPermitNonces(ctx sdk.Context) []types.PermitNonce {
+	nonces := []types.PermitNonce{}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPermitNonce)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		token := common.BytesToAddress(key[:common.AddressLength])
+		owner := common.BytesToAddress(key[common.AddressLength:])
+		nonces = append(nonces, types.NewPermitNonce(token, owner, sdk.BigEndianToUint64(iterator.Value())))
+	}
+
+	return nonces
+}
+
+// IsTransferAuthorizationUsed returns true if the EIP-3009 authorization nonce
+// of the authorizer was already used for the given ERC-20 token precompile.
+func (k Keeper) IsTransferAuthorizationUsed(ctx sdk.Context, token, authorizer common.Address, nonce common.Hash) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTransferAuthorization)
+	return store.Has(transferAuthorizationKey(token, authorizer, nonce))
+}
+
+// SetTransferAuthorizationUsed marks the EIP-3009 authorization nonce of the
+// authorizer as used for the given ERC-20 token precompile.
+func (k Keeper) SetTransferAuthorizationUsed(ctx sdk.Context, token, authorizer common.Address, nonce common.Hash) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTransferAuthorization)
+	store.Set(transferAuthorizationKey(token, authorizer, nonce), []byte{1})
+}
+
+// GetUsedTransferAuthorizations returns the EIP-3009 authorization nonces used
+// for all the ERC-20 token precompiles.
+func (k Keeper) GetUsedTransferAuthorizations(ctx sdk.Context) []types.TransferAuthorization {
+	authorizations := []types.TransferAuthorization{}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTransferAuthorization)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		token := common.BytesToAddress(key[:common.AddressLength])
+		authorizer := common.BytesToAddress(key[common.AddressLength : common.AddressLength*2])
+		nonce := common.BytesToHash(key[common.AddressLength*2:])
+		authorizations = append(authorizations, types.NewTransferAuthorization(token, authorizer, nonce))
+	}
+
+	return authorizations
+}
+
+// transferAuthorizationKey returns the store key of an EIP-3009 authorization
+// nonce, which is the concatenation of the token, authorizer and nonce bytes.
+func transferAuthorizationKey(token, authorizer common.Address, nonce common.Hash) []byte {
+	key := make([]byte, 0, common.AddressLength*2+common.HashLength)
+	key = append(key, token.Bytes()...)
+	key = append(key, authorizer.Bytes()...)
+	return append(key, nonce.Bytes()...)
+}
@@ -14,7 +14,8 @@ import (
 )
 
 // RegisterERC20 creates a Cosmos coin and registers the token pair between the
-// coin and the ERC20
+// coin and the ERC20. The ERC20 data is queried with metered calls (see
+// meteredCallEVM).
 func (k Keeper) registerERC20(
 	ctx sdk.Context,
 	contract common.Address,
@@ -26,7 +27,14 @@ func (k Keeper) registerERC20(
 		)
 	}
 
-	metadata, err := k.CreateCoinMetadata(ctx, contract)
+	erc20Data, err := queryERC20(ctx, k.meteredCallEVM, contract)
+	if err != nil {
+		return nil, errorsmod.Wrap(
+			err, "failed to create wrapped coin denom metadata for ERC20",
+		)
+	}
+
+	metadata, err := k.createCoinMetadata(ctx, contract, erc20Data)
 	if err != nil {
 		return nil, errorsmod.Wrap(
 			err, "failed to create wrapped coin denom metadata for ERC20",
@@ -44,13 +52,23 @@ func (k Keeper) CreateCoinMetadata(
 	ctx sdk.Context,
 	contract common.Address,
 ) (*banktypes.Metadata, error) {
-	strContract := contract.String()
-
 	erc20Data, err := k.QueryERC20(ctx, contract)
 	if err != nil {
 		return nil, err
 	}
 
+	return k.createCoinMetadata(ctx, contract, erc20Data)
+}
+
+// createCoinMetadata generates the metadata to represent the ERC20 token with
+// the given data.
+func (k Keeper) createCoinMetadata(
+	ctx sdk.Context,
+	contract common.Address,
+	erc20Data types.ERC20Data,
+) (*banktypes.Metadata, error) {
+	strContract := contract.String()
+
 	// Check if metadata already exists
 	_, found := k.bankKeeper.GetDenomMetaData(ctx, types.CreateDenom(strContract))
 	if found {
@@ -129,6 +147,12 @@ func (k Keeper) toggleConversion(
 		)
 	}
 
+	if pair.Delisted {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrTokenPairDelisted, "conversion of token '%s' can't be enabled", token,
+		)
+	}
+
 	pair.Enabled = !pair.Enabled
 	k.SetTokenPair(ctx, pair)
 	return pair, nil
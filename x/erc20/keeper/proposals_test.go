@@ -137,7 +137,10 @@ func (suite *KeeperTestSuite) TestRegisterERC20() {
 
 				mockEVMKeeper.On("EstimateGasInternal", mock.Anything, mock.Anything, mock.Anything).Return(&evmtypes.EstimateGasResponse{Gas: uint64(200)}, nil)
 				mockEVMKeeper.On("CallEVM", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, fmt.Errorf("forced CallEVM error"))
+				mockEVMKeeper.On("CallEVMWithDataAndGasLimit", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, fmt.Errorf("forced CallEVMWithDataAndGasLimit error"))
 				mockEVMKeeper.On("ApplyMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, fmt.Errorf("forced ApplyMessage error"))
+				mockEVMKeeper.On("GetState", mock.Anything, mock.Anything, mock.Anything).Return(common.Hash{})
+				mockEVMKeeper.On("SetState", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
 			},
 			false,
 		},
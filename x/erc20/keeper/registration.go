@@ -0,0 +1,333 @@
+package keeper
+
+import (
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/contracts"
+	"github.com/cosmos/evm/x/erc20/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// maxRegistrationDecimals is the maximum number of decimals of an ERC20
+	// token registered without governance. It matches the decimals of the EVM
+	// coin.
+	maxRegistrationDecimals = 18
+	// registrationCallGasLimit is the gas limit of each EVM call made to
+	// validate, check and register an ERC20 contract.
+	registrationCallGasLimit = 300_000
+)
+
+// GetRegistrationDeposit returns the deposit locked for the token pair of the
+// given ERC20 contract.
+func (k Keeper) GetRegistrationDeposit(ctx sdk.Context, contract common.Address) (types.RegistrationDeposit, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRegistrationDeposit)
+	bz := store.Get(contract.Bytes())
+	if len(bz) == 0 {
+		return types.RegistrationDeposit{}, false
+	}
+
+	var deposit types.RegistrationDeposit
+	k.cdc.MustUnmarshal(bz, &deposit)
+	return deposit, true
+}
+
+// GetRegistrationDeposits returns all the deposits locked for the token pairs
+// registered without governance.
+func (k Keeper) GetRegistrationDeposits(ctx sdk.Context) []types.RegistrationDeposit {
+	deposits := []types.RegistrationDeposit{}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRegistrationDeposit)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var deposit types.RegistrationDeposit
+		k.cdc.MustUnmarshal(iterator.Value(), &deposit)
+		deposits = append(deposits, deposit)
+	}
+
+	return deposits
+}
+
+// SetRegistrationDeposit stores the deposit locked for a token pair.
+func (k Keeper) SetRegistrationDeposit(ctx sdk.Context, deposit types.RegistrationDeposit) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRegistrationDeposit)
+	store.Set(deposit.GetERC20Contract().Bytes(), k.cdc.MustMarshal(&deposit))
+}
+
+// deleteRegistrationDeposit removes the deposit locked for the token pair of
+// the given ERC20 contract.
+func (k Keeper) deleteRegistrationDeposit(ctx sdk.Context, contract common.Address) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRegistrationDeposit)
+	store.Delete(contract.Bytes())
+}
+
+// registerERC20WithDeposit locks the registration deposit from the depositor
+// on the erc20 module account, validates the ERC20 contract and registers its
+// token pair. The contract is rejected if it fails any of the compliance
+// checks, which are run with the tokens of the depositor.
+//
+// The deposit is locked before running any EVM call, so that only accounts
+// able to pay it can make the contract checks run, and each of these calls is
+// charged on the transaction gas meter (see meteredCallEVM).
+func (k Keeper) registerERC20WithDeposit(
+	ctx sdk.Context,
+	contract common.Address,
+	depositor sdk.AccAddress,
+) (*types.TokenPair, types.ComplianceReport, sdk.Coins, error) {
+	amount := k.GetRegistrationDepositParam(ctx)
+	if !amount.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, depositor, types.ModuleName, amount); err != nil {
+			return nil, types.ComplianceReport{}, nil, errorsmod.Wrap(err, "failed to lock registration deposit")
+		}
+	}
+
+	if err := k.validateERC20Contract(ctx, contract); err != nil {
+		return nil, types.ComplianceReport{}, nil, err
+	}
+
+	report := k.RunComplianceChecks(ctx, contract, common.BytesToAddress(depositor))
+	if !report.Compliant {
+		return nil, types.ComplianceReport{}, nil, errorsmod.Wrapf(
+			types.ErrInvalidERC20Contract, "failed compliance checks: %s", strings.Join(report.FailedChecks(), ", "),
+		)
+	}
+
+	pair, err := k.registerERC20(ctx, contract)
+	if err != nil {
+		return nil, types.ComplianceReport{}, nil, err
+	}
+	k.SetComplianceReport(ctx, report)
+
+	if amount.IsZero() {
+		return pair, report, nil, nil
+	}
+
+	k.SetRegistrationDeposit(ctx, types.NewRegistrationDeposit(contract, depositor, amount))
+	return pair, report, amount, nil
+}
+
+// validateERC20Contract checks that the contract registered without governance
+// exposes the ERC20 metadata and views:
+//   - the address holds a contract
+//   - name and symbol are not empty and decimals don't exceed the EVM coin ones
+//   - totalSupply and balanceOf can be queried
+func (k Keeper) validateERC20Contract(ctx sdk.Context, contract common.Address) error {
+	account := k.evmKeeper.GetAccountWithoutBalance(ctx, contract)
+	if account == nil || !account.IsContract() {
+		return errorsmod.Wrapf(types.ErrInvalidERC20Contract, "%s is not a contract", contract)
+	}
+
+	erc20Data, err := queryERC20(ctx, k.meteredCallEVM, contract)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrInvalidERC20Contract, err.Error())
+	}
+
+	if strings.TrimSpace(erc20Data.Name) == "" || strings.TrimSpace(erc20Data.Symbol) == "" {
+		return errorsmod.Wrap(types.ErrInvalidERC20Contract, "name and symbol cannot be empty")
+	}
+
+	if erc20Data.Decimals > maxRegistrationDecimals {
+		return errorsmod.Wrapf(
+			types.ErrInvalidERC20Contract, "decimals %d exceed the maximum of %d", erc20Data.Decimals, maxRegistrationDecimals,
+		)
+	}
+
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	if supply := totalSupply(ctx, k.meteredCallEVM, erc20, contract); supply == nil {
+		return errorsmod.Wrap(types.ErrInvalidERC20Contract, "failed to retrieve total supply")
+	}
+
+	if balance := balanceOf(ctx, k.meteredCallEVM, erc20, contract, types.ModuleAddress); balance == nil {
+		return errorsmod.Wrap(types.ErrInvalidERC20Contract, "failed to retrieve balance")
+	}
+
+	return nil
+}
+
+// meteredCallEVM performs a smart contract method call of a contract being
+// registered, which isn't trusted yet. The call runs with the fixed gas limit
+// registrationCallGasLimit and its gas usage is consumed from the transaction
+// gas meter, including when it fails.
+func (k Keeper) meteredCallEVM(
+	ctx sdk.Context,
+	abi abi.ABI,
+	from, contract common.Address,
+	commit bool,
+	method string,
+	args ...interface{},
+) (*evmtypes.MsgEthereumTxResponse, error) {
+	data, err := abi.Pack(method, args...)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrABIPack, "failed to pack %s: %s", method, err.Error())
+	}
+
+	// the gas of the execution is charged from its response, so the KV store
+	// accesses of the EVM aren't charged twice
+	evmCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	res, err := k.evmKeeper.CallEVMWithDataAndGasLimit(evmCtx, from, &contract, data, commit, registrationCallGasLimit)
+
+	gasUsed := uint64(registrationCallGasLimit)
+	if res != nil {
+		gasUsed = res.GasUsed
+	}
+	ctx.GasMeter().ConsumeGas(gasUsed, "erc20 registration call")
+
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "contract call failed: method '%s', contract '%s'", method, contract)
+	}
+	return res, nil
+}
+
+// delistERC20 removes the token pair of an ERC20 contract with its compliance
+// report and refunds or burns its registration deposit, if any. The coin
+// metadata is kept, so that the contract can't be registered again.
+//
+// If coins of the token pair are still in circulation, the pair is kept
+// disabled and flagged as delisted instead, so that the coins can only be
+// redeemed for the ERC20 tokens. It is removed once all of them are redeemed.
+func (k Keeper) delistERC20(
+	ctx sdk.Context,
+	token string,
+	burnDeposit bool,
+) (types.TokenPair, *types.RegistrationDeposit, error) {
+	pair, err := k.getTokenPairByToken(ctx, token)
+	if err != nil {
+		return types.TokenPair{}, nil, err
+	}
+
+	if !pair.IsNativeERC20() {
+		return types.TokenPair{}, nil, errorsmod.Wrapf(
+			types.ErrTokenPairOwnedByModule, "cannot delist token pair of coin %s", pair.Denom,
+		)
+	}
+
+	if pair.Delisted {
+		return types.TokenPair{}, nil, errorsmod.Wrapf(
+			types.ErrTokenPairDelisted, "token '%s' is already delisted", token,
+		)
+	}
+
+	if supply := k.bankKeeper.GetSupply(ctx, pair.Denom); supply.IsZero() {
+		k.DeleteTokenPair(ctx, pair)
+	} else {
+		pair.Enabled = false
+		pair.Delisted = true
+		k.SetTokenPair(ctx, pair)
+	}
+
+	contract := pair.GetERC20Contract()
+	k.deleteComplianceReport(ctx, contract)
+	deposit, err := k.settleRegistrationDeposit(ctx, contract, burnDeposit)
+	if err != nil {
+		return types.TokenPair{}, nil, err
+	}
+
+	return pair, deposit, nil
+}
+
+// releaseRegistrationDeposit refunds the registration deposit of a token pair
+// to its depositor. The token pair is kept registered.
+func (k Keeper) releaseRegistrationDeposit(ctx sdk.Context, token string) (types.TokenPair, types.RegistrationDeposit, error) {
+	pair, err := k.getTokenPairByToken(ctx, token)
+	if err != nil {
+		return types.TokenPair{}, types.RegistrationDeposit{}, err
+	}
+
+	deposit, err := k.settleRegistrationDeposit(ctx, pair.GetERC20Contract(), false)
+	if err != nil {
+		return types.TokenPair{}, types.RegistrationDeposit{}, err
+	}
+
+	if deposit == nil {
+		return types.TokenPair{}, types.RegistrationDeposit{}, errorsmod.Wrapf(
+			types.ErrDepositNotFound, "token '%s' has no registration deposit", token,
+		)
+	}
+
+	return pair, *deposit, nil
+}
+
+// settleRegistrationDeposit refunds the registration deposit locked for the
+// token pair of the given ERC20 contract to its depositor, or burns it, and
+// removes it from the store. It returns nil if the pair has no deposit.
+func (k Keeper) settleRegistrationDeposit(
+	ctx sdk.Context,
+	contract common.Address,
+	burn bool,
+) (*types.RegistrationDeposit, error) {
+	deposit, found := k.GetRegistrationDeposit(ctx, contract)
+	if !found {
+		return nil, nil
+	}
+
+	if burn {
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, deposit.Amount); err != nil {
+			return nil, errorsmod.Wrap(err, "failed to burn registration deposit")
+		}
+	} else {
+		depositor := sdk.MustAccAddressFromBech32(deposit.Depositor)
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, depositor, deposit.Amount); err != nil {
+			return nil, errorsmod.Wrap(err, "failed to refund registration deposit")
+		}
+	}
+
+	k.deleteRegistrationDeposit(ctx, contract)
+	return &deposit, nil
+}
+
+// redeemCoin converts the coins of a delisted token pair back to the ERC20
+// tokens escrowed on the module account.
+func (k Keeper) redeemCoin(
+	ctx sdk.Context,
+	coin sdk.Coin,
+	receiver common.Address,
+	sender sdk.AccAddress,
+) (types.TokenPair, error) {
+	pair, err := k.getTokenPairByToken(ctx, coin.Denom)
+	if err != nil {
+		return types.TokenPair{}, err
+	}
+
+	if !pair.Delisted {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrERC20TokenPairDisabled, "only the coins of delisted token pairs can be redeemed, got '%s'", coin.Denom,
+		)
+	}
+
+	if err := k.ConvertCoinNativeERC20(ctx, pair, coin.Amount, receiver, sender); err != nil {
+		return types.TokenPair{}, err
+	}
+
+	return pair, nil
+}
+
+// getTokenPairByToken returns the token pair of the given token, which can be
+// either the hex contract address of the ERC20 or the Cosmos base denomination.
+func (k Keeper) getTokenPairByToken(ctx sdk.Context, token string) (types.TokenPair, error) {
+	id := k.GetTokenPairID(ctx, token)
+	if len(id) == 0 {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered by id", token,
+		)
+	}
+
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered", token,
+		)
+	}
+
+	return pair, nil
+}
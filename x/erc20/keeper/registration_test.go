@@ -0,0 +1,487 @@
+package keeper_test
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/contracts"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	"github.com/cosmos/evm/x/erc20/types"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// testDepositDenom is the denomination of the registration deposit used in the
+// delisting tests.
+const testDepositDenom = "adeposit"
+
+// enablePermissionlessRegistration enables the permissionless registration
+// with the given deposit.
+func (suite *KeeperTestSuite) enablePermissionlessRegistration(ctx sdk.Context, deposit sdk.Coins) {
+	params := suite.network.App.Erc20Keeper.GetParams(ctx)
+	params.PermissionlessRegistration = true
+	params.RegistrationDeposit = deposit
+	suite.Require().NoError(suite.network.App.Erc20Keeper.SetParams(ctx, params))
+}
+
+func (suite *KeeperTestSuite) TestRegisterERC20WithDeposit() {
+	var (
+		ctx      sdk.Context
+		contract common.Address
+		deposit  sdk.Coins
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"fail - permissionless registration disabled",
+			func() {
+				params := suite.network.App.Erc20Keeper.GetParams(ctx)
+				params.PermissionlessRegistration = false
+				suite.Require().NoError(suite.network.App.Erc20Keeper.SetParams(ctx, params))
+			},
+			false,
+		},
+		{
+			"fail - address is not a contract",
+			func() {
+				contract = utiltx.GenerateAddress()
+			},
+			false,
+		},
+		{
+			"fail - empty name",
+			func() {
+				var err error
+				contract, err = suite.DeployContract("", erc20Symbol, erc20Decimals)
+				suite.Require().NoError(err)
+				ctx = suite.network.GetContext()
+			},
+			false,
+		},
+		{
+			"fail - decimals exceed the maximum",
+			func() {
+				var err error
+				contract, err = suite.DeployContract(erc20Name, erc20Symbol, 19)
+				suite.Require().NoError(err)
+				ctx = suite.network.GetContext()
+			},
+			false,
+		},
+		{
+			"fail - insufficient funds for the deposit",
+			func() {
+				deposit = sdk.NewCoins(sdk.NewCoin(suite.network.GetBaseDenom(), sdkmath.NewIntWithDecimal(1, 30)))
+				suite.enablePermissionlessRegistration(ctx, deposit)
+			},
+			false,
+		},
+		{
+			"fail - already registered",
+			func() {
+				_, err := suite.network.App.Erc20Keeper.RegisterERC20(ctx, &types.MsgRegisterERC20{
+					Authority:      authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+					Erc20Addresses: []string{contract.Hex()},
+				})
+				suite.Require().NoError(err)
+			},
+			false,
+		},
+		{
+			"pass - without deposit",
+			func() {
+				deposit = nil
+				suite.enablePermissionlessRegistration(ctx, deposit)
+			},
+			true,
+		},
+		{
+			"pass - with deposit",
+			func() {},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			var err error
+			suite.SetupTest() // reset
+
+			contract, err = suite.DeployContract(erc20Name, erc20Symbol, erc20Decimals)
+			suite.Require().NoError(err, "failed to deploy contract")
+			ctx = suite.network.GetContext()
+
+			deposit = sdk.NewCoins(sdk.NewCoin(suite.network.GetBaseDenom(), sdkmath.NewInt(1000)))
+			suite.enablePermissionlessRegistration(ctx, deposit)
+
+			tc.malleate()
+
+			sender := suite.keyring.GetAccAddr(0)
+			balanceBefore := suite.network.App.BankKeeper.GetAllBalances(ctx, sender)
+
+			_, err = suite.network.App.Erc20Keeper.RegisterERC20WithDeposit(ctx, &types.MsgRegisterERC20WithDeposit{
+				Sender:       sender.String(),
+				Erc20Address: contract.Hex(),
+			})
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			suite.Require().True(suite.network.App.Erc20Keeper.IsERC20Registered(ctx, contract))
+			balanceAfter := suite.network.App.BankKeeper.GetAllBalances(ctx, sender)
+			suite.Require().Equal(balanceBefore.Sub(deposit...), balanceAfter)
+
+			registrationDeposit, found := suite.network.App.Erc20Keeper.GetRegistrationDeposit(ctx, contract)
+			if deposit.IsZero() {
+				suite.Require().False(found)
+				return
+			}
+			suite.Require().True(found)
+			suite.Require().Equal(types.NewRegistrationDeposit(contract, sender, deposit), registrationDeposit)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRegisterERC20WithDepositGas() {
+	testCases := []struct {
+		name        string
+		deposit     sdkmath.Int
+		gasLimit    uint64
+		expPass     bool
+		expOutOfGas bool
+		// expMinGas and expMaxGas bound the gas consumed by the registration
+		expMinGas uint64
+		expMaxGas uint64
+	}{
+		{
+			"fail - the deposit is locked before running the contract checks",
+			sdkmath.NewIntWithDecimal(1, 30),
+			10_000_000,
+			false,
+			false,
+			0,
+			50_000,
+		},
+		{
+			"fail - out of gas during the contract checks",
+			sdkmath.NewInt(1000),
+			150_000,
+			false,
+			true,
+			0,
+			0,
+		},
+		{
+			"pass - the gas of the contract checks is charged",
+			sdkmath.NewInt(1000),
+			10_000_000,
+			true,
+			false,
+			150_000,
+			10_000_000,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			contract, err := suite.DeployContract(erc20Name, erc20Symbol, erc20Decimals)
+			suite.Require().NoError(err, "failed to deploy contract")
+			ctx := suite.network.GetContext()
+			suite.enablePermissionlessRegistration(ctx, sdk.NewCoins(sdk.NewCoin(suite.network.GetBaseDenom(), tc.deposit)))
+
+			gasMeter := storetypes.NewGasMeter(tc.gasLimit)
+			ctx = ctx.WithGasMeter(gasMeter)
+
+			register := func() {
+				_, err = suite.network.App.Erc20Keeper.RegisterERC20WithDeposit(ctx, &types.MsgRegisterERC20WithDeposit{
+					Sender:       suite.keyring.GetAccAddr(0).String(),
+					Erc20Address: contract.Hex(),
+				})
+			}
+
+			if tc.expOutOfGas {
+				suite.Require().PanicsWithValue(storetypes.ErrorOutOfGas{Descriptor: "erc20 registration call"}, register)
+				return
+			}
+
+			register()
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+			suite.Require().GreaterOrEqual(gasMeter.GasConsumed(), tc.expMinGas)
+			suite.Require().LessOrEqual(gasMeter.GasConsumed(), tc.expMaxGas)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestDelistERC20() {
+	var (
+		ctx         sdk.Context
+		contract    common.Address
+		burnDeposit bool
+	)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	deposit := sdk.NewCoins(sdk.NewCoin(testDepositDenom, sdkmath.NewInt(1000)))
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		expPass     bool
+		expDelisted bool
+		expBalance  sdk.Coins
+	}{
+		{
+			"fail - token pair not found",
+			func() {
+				contract = utiltx.GenerateAddress()
+			},
+			false,
+			false,
+			nil,
+		},
+		{
+			"pass - coins in circulation are left redeem-only",
+			func() {
+				coins := sdk.NewCoins(sdk.NewCoin(types.CreateDenom(contract.String()), sdkmath.NewInt(1)))
+				suite.Require().NoError(suite.network.App.BankKeeper.MintCoins(ctx, types.ModuleName, coins))
+			},
+			true,
+			true,
+			deposit,
+		},
+		{
+			"pass - refund deposit",
+			func() {},
+			true,
+			false,
+			deposit,
+		},
+		{
+			"pass - burn deposit",
+			func() {
+				burnDeposit = true
+			},
+			true,
+			false,
+			sdk.NewCoins(),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			var err error
+			suite.SetupTest() // reset
+			burnDeposit = false
+
+			contract, err = suite.DeployContract(erc20Name, erc20Symbol, erc20Decimals)
+			suite.Require().NoError(err, "failed to deploy contract")
+			ctx = suite.network.GetContext()
+
+			// fund a fresh depositor so that its balance only holds the deposit
+			depositor := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+			suite.Require().NoError(suite.network.App.BankKeeper.MintCoins(ctx, types.ModuleName, deposit))
+			suite.Require().NoError(suite.network.App.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, depositor, deposit))
+
+			suite.enablePermissionlessRegistration(ctx, deposit)
+			_, err = suite.network.App.Erc20Keeper.RegisterERC20WithDeposit(ctx, &types.MsgRegisterERC20WithDeposit{
+				Sender:       depositor.String(),
+				Erc20Address: contract.Hex(),
+			})
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			_, err = suite.network.App.Erc20Keeper.DelistERC20(ctx, &types.MsgDelistERC20{
+				Authority:   authority,
+				Token:       contract.Hex(),
+				BurnDeposit: burnDeposit,
+			})
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			_, found := suite.network.App.Erc20Keeper.GetRegistrationDeposit(ctx, contract)
+			suite.Require().False(found)
+			balance := suite.network.App.BankKeeper.GetAllBalances(ctx, depositor)
+			suite.Require().True(tc.expBalance.Equal(balance), "expected %s, got %s", tc.expBalance, balance)
+
+			if tc.expDelisted {
+				// the pair is kept disabled for the coins in circulation to be redeemed
+				pair, found := suite.network.App.Erc20Keeper.GetTokenPair(ctx, suite.network.App.Erc20Keeper.GetERC20Map(ctx, contract))
+				suite.Require().True(found)
+				suite.Require().True(pair.Delisted)
+				suite.Require().False(pair.Enabled)
+
+				_, err = suite.network.App.Erc20Keeper.DelistERC20(ctx, &types.MsgDelistERC20{
+					Authority: authority,
+					Token:     contract.Hex(),
+				})
+				suite.Require().ErrorIs(err, types.ErrTokenPairDelisted)
+				return
+			}
+
+			suite.Require().False(suite.network.App.Erc20Keeper.IsERC20Registered(ctx, contract))
+
+			// the coin metadata is kept, so the contract can't be registered again
+			_, err = suite.network.App.Erc20Keeper.RegisterERC20WithDeposit(ctx, &types.MsgRegisterERC20WithDeposit{
+				Sender:       suite.keyring.GetAccAddr(0).String(),
+				Erc20Address: contract.Hex(),
+			})
+			suite.Require().Error(err)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRedeemCoin() {
+	suite.SetupTest()
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+
+	contract, err := suite.setupRegisterERC20Pair(contractMinterBurner)
+	suite.Require().NoError(err)
+	_, err = suite.MintERC20Token(contract, suite.keyring.GetAddr(0), big.NewInt(100))
+	suite.Require().NoError(err)
+
+	ctx := suite.network.GetContext()
+	sender := suite.keyring.GetAccAddr(0)
+	receiver := utiltx.GenerateAddress()
+	denom := types.CreateDenom(contract.String())
+
+	_, err = suite.network.App.Erc20Keeper.ConvertERC20(ctx, types.NewMsgConvertERC20(sdkmath.NewInt(100), sender, contract, suite.keyring.GetAddr(0)))
+	suite.Require().NoError(err)
+
+	// only the coins of delisted pairs can be redeemed
+	redeem := func(amount int64) error {
+		_, err := suite.network.App.Erc20Keeper.RedeemCoin(ctx, &types.MsgRedeemCoin{
+			Sender:   sender.String(),
+			Coin:     sdk.NewCoin(denom, sdkmath.NewInt(amount)),
+			Receiver: receiver.Hex(),
+		})
+		return err
+	}
+	suite.Require().Error(redeem(40))
+
+	_, err = suite.network.App.Erc20Keeper.DelistERC20(ctx, &types.MsgDelistERC20{
+		Authority: authority,
+		Token:     contract.Hex(),
+	})
+	suite.Require().NoError(err)
+
+	// the conversion to coins can't be enabled again
+	_, err = suite.network.App.Erc20Keeper.ConvertERC20(ctx, types.NewMsgConvertERC20(sdkmath.NewInt(1), sender, contract, suite.keyring.GetAddr(0)))
+	suite.Require().ErrorIs(err, types.ErrERC20TokenPairDisabled)
+	_, err = suite.network.App.Erc20Keeper.ToggleConversion(ctx, &types.MsgToggleConversion{
+		Authority: authority,
+		Token:     contract.Hex(),
+	})
+	suite.Require().ErrorIs(err, types.ErrTokenPairDelisted)
+
+	suite.Require().NoError(redeem(40))
+	suite.Require().True(suite.network.App.Erc20Keeper.IsERC20Registered(ctx, contract))
+	suite.Require().Equal(big.NewInt(40), suite.network.App.Erc20Keeper.BalanceOf(ctx, erc20, contract, receiver))
+
+	// the pair is removed once all its coins are redeemed
+	suite.Require().NoError(redeem(60))
+	suite.Require().False(suite.network.App.Erc20Keeper.IsERC20Registered(ctx, contract))
+	suite.Require().Equal(big.NewInt(100), suite.network.App.Erc20Keeper.BalanceOf(ctx, erc20, contract, receiver))
+	suite.Require().True(suite.network.App.BankKeeper.GetSupply(ctx, denom).IsZero())
+}
+
+func (suite *KeeperTestSuite) TestReleaseRegistrationDeposit() {
+	var (
+		ctx      sdk.Context
+		contract common.Address
+	)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	deposit := sdk.NewCoins(sdk.NewCoin(testDepositDenom, sdkmath.NewInt(1000)))
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"fail - token pair not found",
+			func() {
+				contract = utiltx.GenerateAddress()
+			},
+			types.ErrTokenPairNotFound,
+		},
+		{
+			"fail - deposit already released",
+			func() {
+				_, err := suite.network.App.Erc20Keeper.ReleaseRegistrationDeposit(ctx, &types.MsgReleaseRegistrationDeposit{
+					Authority: authority,
+					Token:     contract.Hex(),
+				})
+				suite.Require().NoError(err)
+			},
+			types.ErrDepositNotFound,
+		},
+		{
+			"pass - refund deposit",
+			func() {},
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			var err error
+			suite.SetupTest() // reset
+
+			contract, err = suite.DeployContract(erc20Name, erc20Symbol, erc20Decimals)
+			suite.Require().NoError(err, "failed to deploy contract")
+			ctx = suite.network.GetContext()
+
+			depositor := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+			suite.Require().NoError(suite.network.App.BankKeeper.MintCoins(ctx, types.ModuleName, deposit))
+			suite.Require().NoError(suite.network.App.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, depositor, deposit))
+
+			suite.enablePermissionlessRegistration(ctx, deposit)
+			_, err = suite.network.App.Erc20Keeper.RegisterERC20WithDeposit(ctx, &types.MsgRegisterERC20WithDeposit{
+				Sender:       depositor.String(),
+				Erc20Address: contract.Hex(),
+			})
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			_, err = suite.network.App.Erc20Keeper.ReleaseRegistrationDeposit(ctx, &types.MsgReleaseRegistrationDeposit{
+				Authority: authority,
+				Token:     contract.Hex(),
+			})
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			suite.Require().NoError(err)
+
+			// the deposit is refunded and the token pair is kept
+			_, found := suite.network.App.Erc20Keeper.GetRegistrationDeposit(ctx, contract)
+			suite.Require().False(found)
+			balance := suite.network.App.BankKeeper.GetAllBalances(ctx, depositor)
+			suite.Require().True(deposit.Equal(balance), "expected %s, got %s", deposit, balance)
+			suite.Require().True(suite.network.App.Erc20Keeper.IsERC20Registered(ctx, contract))
+		})
+	}
+}
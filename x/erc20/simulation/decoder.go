@@ -0,0 +1,68 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/x/erc20/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding erc20 type.
+func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixTokenPair):
+			var pairA, pairB types.TokenPair
+			cdc.MustUnmarshal(kvA.Value, &pairA)
+			cdc.MustUnmarshal(kvB.Value, &pairB)
+			return fmt.Sprintf("%v\n%v", pairA, pairB)
+
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixTokenPairByERC20),
+			bytes.Equal(kvA.Key[:1], types.KeyPrefixTokenPairByDenom):
+			return fmt.Sprintf("%x\n%x", kvA.Value, kvB.Value)
+
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixSTRv2Addresses):
+			return fmt.Sprintf("%s\n%s", common.BytesToAddress(kvA.Key[1:]), common.BytesToAddress(kvB.Key[1:]))
+
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixPermitNonce):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixTransferAuthorization):
+			return fmt.Sprintf("%s\n%s", common.BytesToHash(kvA.Key[1+2*common.AddressLength:]), common.BytesToHash(kvB.Key[1+2*common.AddressLength:]))
+
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixRegistrationDeposit):
+			var depositA, depositB types.RegistrationDeposit
+			cdc.MustUnmarshal(kvA.Value, &depositA)
+			cdc.MustUnmarshal(kvB.Value, &depositB)
+			return fmt.Sprintf("%v\n%v", depositA, depositB)
+
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixComplianceReport):
+			var reportA, reportB types.ComplianceReport
+			cdc.MustUnmarshal(kvA.Value, &reportA)
+			cdc.MustUnmarshal(kvB.Value, &reportB)
+			return fmt.Sprintf("%v\n%v", reportA, reportB)
+
+		case bytes.Equal(kvA.Key, types.ParamStoreKeyEnableErc20),
+			bytes.Equal(kvA.Key, types.ParamStoreKeyPermissionlessRegistration):
+			return fmt.Sprintf("%t\n%t", len(kvA.Value) > 0, len(kvB.Value) > 0)
+
+		case bytes.Equal(kvA.Key, types.ParamStoreKeyEVMHookMaxGas):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+
+		case bytes.Equal(kvA.Key, types.ParamStoreKeyDynamicPrecompiles),
+			bytes.Equal(kvA.Key, types.ParamStoreKeyNativePrecompiles),
+			bytes.Equal(kvA.Key, types.ParamStoreKeyRegistrationDeposit):
+			return fmt.Sprintf("%s\n%s", kvA.Value, kvB.Value)
+
+		default:
+			panic(fmt.Sprintf("invalid erc20 key %X", kvA.Key))
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/x/erc20/simulation"
+	"github.com/cosmos/evm/x/erc20/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := types.ModuleCdc
+	dec := simulation.NewDecodeStore(cdc)
+
+	pair := types.NewTokenPair(
+		types.ModuleAddress, "erc20/0x4e59b44847b379578588920ca78fbf26c0b4956c", types.OWNER_EXTERNAL,
+	)
+	precompiles := "0x4e59b44847b379578588920ca78fbf26c0b4956c"
+	token, owner := common.HexToAddress(precompiles), types.ModuleAddress
+	nonce := common.HexToHash("0x01")
+	authorizationKey := append(append(append(types.KeyPrefixTransferAuthorization, token.Bytes()...), owner.Bytes()...), nonce.Bytes()...)
+	depositAmount := sdk.NewCoins(sdk.NewInt64Coin("aevmos", 1000))
+	deposit := types.NewRegistrationDeposit(token, owner.Bytes(), depositAmount)
+	report := types.NewComplianceReport(token, 10, []types.ComplianceCheck{types.PassedCheck(types.ComplianceCheckTransfer)})
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: append(types.KeyPrefixTokenPair, pair.GetID()...), Value: cdc.MustMarshal(&pair)},
+			{Key: append(types.KeyPrefixTokenPairByDenom, []byte(pair.Denom)...), Value: pair.GetID()},
+			{Key: append(append(types.KeyPrefixPermitNonce, token.Bytes()...), owner.Bytes()...), Value: sdk.Uint64ToBigEndian(3)},
+			{Key: authorizationKey, Value: []byte{1}},
+			{Key: append(types.KeyPrefixRegistrationDeposit, token.Bytes()...), Value: cdc.MustMarshal(&deposit)},
+			{Key: append(types.KeyPrefixComplianceReport, token.Bytes()...), Value: cdc.MustMarshal(&report)},
+			{Key: types.ParamStoreKeyEnableErc20, Value: []byte{1}},
+			{Key: types.ParamStoreKeyPermissionlessRegistration, Value: []byte{1}},
+			{Key: types.ParamStoreKeyRegistrationDeposit, Value: []byte(depositAmount.String())},
+			{Key: types.ParamStoreKeyEVMHookMaxGas, Value: sdk.Uint64ToBigEndian(types.DefaultEVMHookMaxGas)},
+			{Key: types.ParamStoreKeyNativePrecompiles, Value: []byte(precompiles)},
+			{Key: []byte{0x99}, Value: []byte{0x99}}, // This test should panic
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+		panics      bool
+	}{
+		{"TokenPair", fmt.Sprintf("%v\n%v", pair, pair), false},
+		{"TokenPairByDenom", fmt.Sprintf("%x\n%x", pair.GetID(), pair.GetID()), false},
+		{"PermitNonce", "3\n3", false},
+		{"TransferAuthorization", fmt.Sprintf("%s\n%s", nonce, nonce), false},
+		{"RegistrationDeposit", fmt.Sprintf("%v\n%v", deposit, deposit), false},
+		{"ComplianceReport", fmt.Sprintf("%v\n%v", report, report), false},
+		{"EnableErc20", "true\ntrue", false},
+		{"PermissionlessRegistration", "true\ntrue", false},
+		{"RegistrationDepositParam", fmt.Sprintf("%s\n%s", depositAmount, depositAmount), false},
+		{"EVMHookMaxGas", fmt.Sprintf("%d\n%d", types.DefaultEVMHookMaxGas, types.DefaultEVMHookMaxGas), false},
+		{"NativePrecompiles", fmt.Sprintf("%s\n%s", precompiles, precompiles), false},
+		{"other", "", true},
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.panics {
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			} else {
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/evm/x/erc20/types"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// Simulation parameter constants
+const (
+	EnableErc20 = "enable_erc20"
+)
+
+// GenEnableErc20 randomized EnableErc20
+func GenEnableErc20(r *rand.Rand) bool {
+	return r.Intn(10) != 0
+}
+
+// RandomizedGenState generates a random GenesisState for the erc20 module. It starts from
+// the genesis state provided by the application, so that the app-specific token pairs
+// and precompiles are preserved.
+func RandomizedGenState(simState *module.SimulationState) {
+	erc20Genesis := types.DefaultGenesisState()
+	if bz, ok := simState.GenState[types.ModuleName]; ok {
+		simState.Cdc.MustUnmarshalJSON(bz, erc20Genesis)
+	}
+
+	var enableErc20 bool
+	simState.AppParams.GetOrGenerate(EnableErc20, &enableErc20, simState.Rand, func(r *rand.Rand) { enableErc20 = GenEnableErc20(r) })
+
+	erc20Genesis.Params.EnableErc20 = enableErc20
+
+	bz, err := json.MarshalIndent(&erc20Genesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated erc20 parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(erc20Genesis)
+}
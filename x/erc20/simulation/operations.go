@@ -0,0 +1,200 @@
+package simulation
+
+import (
+	"math/rand"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/contracts"
+	"github.com/cosmos/evm/x/erc20/keeper"
+	"github.com/cosmos/evm/x/erc20/types"
+	evmkeeper "github.com/cosmos/evm/x/vm/keeper"
+	vmsimulation "github.com/cosmos/evm/x/vm/simulation"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgConvertERC20            = "op_weight_msg_convert_erc20"
+	OpWeightMsgERC20PrecompileTransfer = "op_weight_msg_erc20_precompile_transfer"
+
+	DefaultWeightMsgConvertERC20            = 20
+	DefaultWeightMsgERC20PrecompileTransfer = 20
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams,
+	txGen client.TxConfig,
+	ak authkeeper.AccountKeeper,
+	bk bankkeeper.Keeper,
+	evmKeeper *evmkeeper.Keeper,
+	k keeper.Keeper,
+) simulation.WeightedOperations {
+	var weightMsgConvertERC20, weightMsgERC20PrecompileTransfer int
+	appParams.GetOrGenerate(OpWeightMsgConvertERC20, &weightMsgConvertERC20, nil, func(_ *rand.Rand) {
+		weightMsgConvertERC20 = DefaultWeightMsgConvertERC20
+	})
+
+	appParams.GetOrGenerate(OpWeightMsgERC20PrecompileTransfer, &weightMsgERC20PrecompileTransfer, nil, func(_ *rand.Rand) {
+		weightMsgERC20PrecompileTransfer = DefaultWeightMsgERC20PrecompileTransfer
+	})
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgConvertERC20,
+			SimulateMsgConvertERC20(txGen, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgERC20PrecompileTransfer,
+			SimulateERC20PrecompileTransfer(txGen, ak, bk, evmKeeper, k),
+		),
+	}
+}
+
+// SimulateMsgConvertERC20 simulates the conversion of the tokens of a random native ERC-20
+// token pair into Cosmos coins. The sender is a random account that holds the tokens.
+func SimulateMsgConvertERC20(
+	txGen client.TxConfig,
+	ak authkeeper.AccountKeeper,
+	bk bankkeeper.Keeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgConvertERC20{})
+		if !k.IsERC20Enabled(ctx) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "erc20 module is disabled"), nil, nil
+		}
+
+		pair, found := randomTokenPair(r, ctx, k, func(pair types.TokenPair) bool {
+			return pair.Enabled && pair.IsNativeERC20()
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no native erc20 token pair"), nil, nil
+		}
+
+		erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+		contract := pair.GetERC20Contract()
+
+		// find a random account that holds the tokens
+		for _, i := range r.Perm(len(accs)) {
+			sender := accs[i]
+			balance := k.BalanceOf(ctx, erc20, contract, common.BytesToAddress(sender.Address))
+			if balance == nil || balance.Sign() == 0 {
+				continue
+			}
+
+			receiver, _ := simtypes.RandomAcc(r, accs)
+			amount := simtypes.RandomAmount(r, sdkmath.NewIntFromBigInt(balance))
+			if amount.IsZero() {
+				amount = sdkmath.OneInt()
+			}
+
+			msg := types.NewMsgConvertERC20(amount, receiver.Address, contract, common.BytesToAddress(sender.Address))
+			txCtx := simulation.OperationInput{
+				R:             r,
+				App:           app,
+				TxGen:         txGen,
+				Cdc:           nil,
+				Msg:           msg,
+				Context:       ctx,
+				SimAccount:    sender,
+				AccountKeeper: ak,
+				Bankkeeper:    bk,
+				ModuleName:    types.ModuleName,
+			}
+
+			return simulation.GenAndDeliverTxWithRandFees(txCtx)
+		}
+
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "no account holds the tokens"), nil, nil
+	}
+}
+
+// SimulateERC20PrecompileTransfer simulates an ERC-20 transfer of the coins of a random
+// native coin token pair, which is executed by the token pair precompile.
+func SimulateERC20PrecompileTransfer(
+	txGen client.TxConfig,
+	ak authkeeper.AccountKeeper,
+	bk bankkeeper.Keeper,
+	evmKeeper *evmkeeper.Keeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{})
+		params := k.GetParams(ctx)
+
+		pair, found := randomTokenPair(r, ctx, k, func(pair types.TokenPair) bool {
+			return pair.Enabled && pair.IsNativeCoin() &&
+				(slices.Contains(params.NativePrecompiles, pair.Erc20Address) ||
+					slices.Contains(params.DynamicPrecompiles, pair.Erc20Address))
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no token pair precompile"), nil, nil
+		}
+
+		from, _ := simtypes.RandomAcc(r, accs)
+		to, _ := simtypes.RandomAcc(r, accs)
+
+		spendable := bk.SpendableCoins(ctx, from.Address).AmountOf(pair.Denom)
+		if pair.Denom == evmtypes.GetEVMCoinDenom() {
+			fee := vmsimulation.EthTxFee(ctx, evmKeeper, vmsimulation.ContractCallGasLimit)
+			spendable = spendable.Sub(sdkmath.NewIntFromBigInt(evmtypes.ConvertAmountFrom18DecimalsBigInt(fee)).AddRaw(1))
+		}
+		if !spendable.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "insufficient funds"), nil, nil
+		}
+
+		amount := simtypes.RandomAmount(r, spendable)
+		input, err := contracts.ERC20MinterBurnerDecimalsContract.ABI.Pack("transfer", common.BytesToAddress(to.Address), amount.BigInt())
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to pack call arguments"), nil, err
+		}
+
+		contract := pair.GetERC20Contract()
+		return vmsimulation.GenAndDeliverEthTx(vmsimulation.EthOperationInput{
+			App:           app,
+			TxGen:         txGen,
+			Context:       ctx,
+			AccountKeeper: ak,
+			EVMKeeper:     evmKeeper,
+			SimAccount:    from,
+			To:            &contract,
+			Input:         input,
+			GasLimit:      vmsimulation.ContractCallGasLimit,
+		})
+	}
+}
+
+// randomTokenPair returns a random token pair that satisfies the filter.
+func randomTokenPair(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, filter func(types.TokenPair) bool) (types.TokenPair, bool) {
+	var pairs []types.TokenPair
+	k.IterateTokenPairs(ctx, func(pair types.TokenPair) bool {
+		if filter(pair) {
+			pairs = append(pairs, pair)
+		}
+		return false
+	})
+
+	if len(pairs) == 0 {
+		return types.TokenPair{}, false
+	}
+	return pairs[r.Intn(len(pairs))], true
+}
@@ -0,0 +1,88 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/x/erc20/keeper"
+	"github.com/cosmos/evm/x/erc20/types"
+	evmkeeper "github.com/cosmos/evm/x/vm/keeper"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+// Simulation operation weights constants
+const (
+	DefaultWeightMsgUpdateParams  int = 50
+	DefaultWeightMsgRegisterERC20 int = 100
+
+	OpWeightMsgUpdateParams  = "op_weight_msg_update_params"
+	OpWeightMsgRegisterERC20 = "op_weight_msg_register_erc20"
+)
+
+// ProposalMsgs defines the module weighted proposals' contents
+func ProposalMsgs(evmKeeper *evmkeeper.Keeper, k keeper.Keeper) []simtypes.WeightedProposalMsg {
+	return []simtypes.WeightedProposalMsg{
+		simulation.NewWeightedProposalMsg(
+			OpWeightMsgUpdateParams,
+			DefaultWeightMsgUpdateParams,
+			SimulateMsgUpdateParams(k),
+		),
+		simulation.NewWeightedProposalMsg(
+			OpWeightMsgRegisterERC20,
+			DefaultWeightMsgRegisterERC20,
+			SimulateMsgRegisterERC20(evmKeeper, k),
+		),
+	}
+}
+
+// SimulateMsgUpdateParams returns a random MsgUpdateParams. The current parameters are
+// used as a base, so that the proposal doesn't disable the token pair precompiles.
+func SimulateMsgUpdateParams(k keeper.Keeper) simtypes.MsgSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) sdk.Msg {
+		// use the default gov module account address as authority
+		var authority sdk.AccAddress = address.Module("gov")
+
+		params := k.GetParams(ctx)
+		params.EnableErc20 = GenEnableErc20(r)
+
+		return &types.MsgUpdateParams{
+			Authority: authority.String(),
+			Params:    params,
+		}
+	}
+}
+
+// SimulateMsgRegisterERC20 returns a MsgRegisterERC20 for a deployed ERC-20 contract that
+// isn't registered yet. It returns nil if there is no such contract.
+func SimulateMsgRegisterERC20(evmKeeper *evmkeeper.Keeper, k keeper.Keeper) simtypes.MsgSimulatorFn {
+	return func(_ *rand.Rand, ctx sdk.Context, _ []simtypes.Account) sdk.Msg {
+		// use the default gov module account address as authority
+		var authority sdk.AccAddress = address.Module("gov")
+
+		var erc20Address string
+		evmKeeper.IterateContracts(ctx, func(addr common.Address, _ common.Hash) bool {
+			if k.IsERC20Registered(ctx, addr) {
+				return false
+			}
+			if _, err := k.QueryERC20(ctx, addr); err != nil {
+				return false
+			}
+			erc20Address = addr.Hex()
+			return true
+		})
+
+		if erc20Address == "" {
+			return nil
+		}
+
+		return &types.MsgRegisterERC20{
+			Authority:      authority.String(),
+			Erc20Addresses: []string{erc20Address},
+		}
+	}
+}
@@ -29,6 +29,11 @@ const (
 	updateParams     = "cosmos/evm/erc20/MsgUpdateParams"
 	registerERC20    = "cosmos/evm/erc20/MsgRegisterERC20"
 	toggleConversion = "cosmos/evm/erc20/MsgToggleConversion"
+
+	registerERC20WithDeposit = "cosmos/evm/erc20/MsgRegisterERC20WithDeposit"
+	delistERC20              = "cosmos/evm/erc20/MsgDelistERC20"
+	redeemCoin               = "cosmos/evm/erc20/MsgRedeemCoin"
+	releaseDeposit           = "cosmos/evm/erc20/MsgReleaseRegistrationDeposit"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -46,6 +51,10 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgUpdateParams{},
 		&MsgRegisterERC20{},
 		&MsgToggleConversion{},
+		&MsgRegisterERC20WithDeposit{},
+		&MsgDelistERC20{},
+		&MsgRedeemCoin{},
+		&MsgReleaseRegistrationDeposit{},
 	)
 	registry.RegisterImplementations(
 		(*govv1beta1.Content)(nil),
@@ -66,4 +75,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgConvertCoin{}, convertCoinName, nil)
 	cdc.RegisterConcrete(&MsgRegisterERC20{}, registerERC20, nil)
 	cdc.RegisterConcrete(&MsgToggleConversion{}, toggleConversion, nil)
+	cdc.RegisterConcrete(&MsgRegisterERC20WithDeposit{}, registerERC20WithDeposit, nil)
+	cdc.RegisterConcrete(&MsgDelistERC20{}, delistERC20, nil)
+	cdc.RegisterConcrete(&MsgRedeemCoin{}, redeemCoin, nil)
+	cdc.RegisterConcrete(&MsgReleaseRegistrationDeposit{}, releaseDeposit, nil)
 }
@@ -0,0 +1,111 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	cosmosevmtypes "github.com/cosmos/evm/types"
+)
+
+// ERC20 compliance checks
+const (
+	// ComplianceCheckTransfer checks that a transfer to the erc20 module
+	// account succeeds and emits the corresponding Transfer event, without any
+	// Approval event.
+	ComplianceCheckTransfer = "transfer"
+	// ComplianceCheckTransferBalances checks that a transfer moves the exact
+	// amount between the balances, which fails for fee-on-transfer tokens.
+	ComplianceCheckTransferBalances = "transfer_balances"
+	// ComplianceCheckTotalSupply checks that a transfer doesn't change the
+	// total supply, which fails for burn-on-transfer and rebasing tokens.
+	ComplianceCheckTotalSupply = "total_supply"
+	// ComplianceCheckApprove checks that an approval succeeds, emits the
+	// corresponding Approval event and sets the allowance.
+	ComplianceCheckApprove = "approve"
+	// ComplianceCheckTransferFrom checks that a transferFrom succeeds, emits
+	// the corresponding Transfer event, moves the exact amount between the
+	// balances and spends the allowance.
+	ComplianceCheckTransferFrom = "transfer_from"
+)
+
+// NewComplianceReport returns the compliance report of an ERC20 contract, which
+// is compliant if all the checks passed. Skipped checks make the report not
+// compliant, as the behavior they cover is unknown.
+func NewComplianceReport(contract common.Address, height int64, checks []ComplianceCheck) ComplianceReport {
+	compliant := true
+	for _, check := range checks {
+		if !check.Passed {
+			compliant = false
+		}
+	}
+
+	return ComplianceReport{
+		Erc20Address: contract.String(),
+		Height:       height,
+		Compliant:    compliant,
+		Checks:       checks,
+	}
+}
+
+// PassedCheck returns a check that passed.
+func PassedCheck(name string) ComplianceCheck {
+	return ComplianceCheck{Name: name, Passed: true}
+}
+
+// FailedCheck returns a check that failed for the given reason.
+func FailedCheck(name, format string, args ...interface{}) ComplianceCheck {
+	return ComplianceCheck{Name: name, Reason: fmt.Sprintf(format, args...)}
+}
+
+// SkippedCheck returns a check that couldn't be run for the given reason.
+func SkippedCheck(name, reason string) ComplianceCheck {
+	return ComplianceCheck{Name: name, Skipped: true, Reason: reason}
+}
+
+// GetERC20Contract casts the hex string address of the ERC20 to common.Address
+func (r ComplianceReport) GetERC20Contract() common.Address {
+	return common.HexToAddress(r.Erc20Address)
+}
+
+// FailedChecks returns the names of the checks that didn't pass, including the
+// skipped ones.
+func (r ComplianceReport) FailedChecks() []string {
+	var failed []string
+	for _, check := range r.Checks {
+		if !check.Passed {
+			failed = append(failed, check.Name)
+		}
+	}
+	return failed
+}
+
+// Validate performs a stateless validation of a ComplianceReport
+func (r ComplianceReport) Validate() error {
+	if err := cosmosevmtypes.ValidateAddress(r.Erc20Address); err != nil {
+		return err
+	}
+
+	if r.Height < 0 {
+		return fmt.Errorf("height cannot be negative: %d", r.Height)
+	}
+
+	seen := make(map[string]bool, len(r.Checks))
+	for _, check := range r.Checks {
+		if check.Name == "" {
+			return fmt.Errorf("check name cannot be empty")
+		}
+		if seen[check.Name] {
+			return fmt.Errorf("duplicate check %s", check.Name)
+		}
+		if check.Passed && check.Skipped {
+			return fmt.Errorf("check %s cannot be both passed and skipped", check.Name)
+		}
+		seen[check.Name] = true
+	}
+
+	if r.Compliant != (len(r.FailedChecks()) == 0) {
+		return fmt.Errorf("compliant flag doesn't match the outcome of the checks")
+	}
+	return nil
+}
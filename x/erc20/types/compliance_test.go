@@ -0,0 +1,143 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	"github.com/cosmos/evm/x/erc20/types"
+)
+
+type ComplianceTestSuite struct {
+	suite.Suite
+}
+
+func TestComplianceSuite(t *testing.T) {
+	suite.Run(t, new(ComplianceTestSuite))
+}
+
+func (suite *ComplianceTestSuite) TestNewComplianceReport() {
+	contract := utiltx.GenerateAddress()
+
+	testCases := []struct {
+		name         string
+		checks       []types.ComplianceCheck
+		expCompliant bool
+		expFailed    []string
+	}{
+		{
+			"all checks passed",
+			[]types.ComplianceCheck{
+				types.PassedCheck(types.ComplianceCheckTransfer),
+				types.PassedCheck(types.ComplianceCheckApprove),
+			},
+			true,
+			nil,
+		},
+		{
+			"skipped checks fail the report",
+			[]types.ComplianceCheck{
+				types.PassedCheck(types.ComplianceCheckTransfer),
+				types.SkippedCheck(types.ComplianceCheckTransferBalances, "holder couldn't be funded"),
+			},
+			false,
+			[]string{types.ComplianceCheckTransferBalances},
+		},
+		{
+			"failed checks",
+			[]types.ComplianceCheck{
+				types.FailedCheck(types.ComplianceCheckTransfer, "missing Transfer event"),
+				types.PassedCheck(types.ComplianceCheckApprove),
+				types.FailedCheck(types.ComplianceCheckTotalSupply, "total supply changed from %d to %d", 100, 99),
+			},
+			false,
+			[]string{types.ComplianceCheckTransfer, types.ComplianceCheckTotalSupply},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			report := types.NewComplianceReport(contract, 10, tc.checks)
+			suite.Require().Equal(contract, report.GetERC20Contract())
+			suite.Require().Equal(tc.expCompliant, report.Compliant)
+			suite.Require().Equal(tc.expFailed, report.FailedChecks())
+			suite.Require().NoError(report.Validate())
+		})
+	}
+}
+
+func (suite *ComplianceTestSuite) TestComplianceReportValidate() {
+	contract := utiltx.GenerateAddress()
+
+	testCases := []struct {
+		name     string
+		malleate func(report *types.ComplianceReport)
+		expPass  bool
+	}{
+		{
+			"valid report",
+			func(*types.ComplianceReport) {},
+			true,
+		},
+		{
+			"invalid contract address",
+			func(report *types.ComplianceReport) {
+				report.Erc20Address = "0xinvalid"
+			},
+			false,
+		},
+		{
+			"negative height",
+			func(report *types.ComplianceReport) {
+				report.Height = -1
+			},
+			false,
+		},
+		{
+			"empty check name",
+			func(report *types.ComplianceReport) {
+				report.Checks = append(report.Checks, types.PassedCheck(""))
+			},
+			false,
+		},
+		{
+			"duplicate check",
+			func(report *types.ComplianceReport) {
+				report.Checks = append(report.Checks, types.PassedCheck(types.ComplianceCheckTransfer))
+			},
+			false,
+		},
+		{
+			"check both passed and skipped",
+			func(report *types.ComplianceReport) {
+				report.Checks[0].Skipped = true
+			},
+			false,
+		},
+		{
+			"compliant flag mismatch",
+			func(report *types.ComplianceReport) {
+				report.Compliant = !report.Compliant
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			report := types.NewComplianceReport(contract, 10, []types.ComplianceCheck{
+				types.PassedCheck(types.ComplianceCheckTransfer),
+				types.SkippedCheck(types.ComplianceCheckTransferBalances, "holder couldn't be funded"),
+			})
+			tc.malleate(&report)
+
+			err := report.Validate()
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
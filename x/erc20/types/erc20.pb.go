@@ -5,7 +5,11 @@ package types
 
 import (
 	fmt "fmt"
-	types "github.com/cosmos/cosmos-sdk/x/bank/types"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	types1 "github.com/cosmos/cosmos-sdk/x/bank/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -68,6 +72,10 @@ type TokenPair struct {
 	// contract_owner is the an ENUM specifying the type of ERC20 owner (0
 	// invalid, 1 ModuleAccount, 2 external address)
 	ContractOwner Owner `protobuf:"varint,4,opt,name=contract_owner,json=contractOwner,proto3,enum=cosmos.evm.erc20.v1.Owner" json:"contract_owner,omitempty"`
+	// delisted defines if the token pair was delisted by governance while its
+	// coins were still in circulation, so that they can only be redeemed for
+	// the ERC20 tokens
+	Delisted bool `protobuf:"varint,5,opt,name=delisted,proto3" json:"delisted,omitempty"`
 }
 
 func (m *TokenPair) Reset()         { *m = TokenPair{} }
@@ -131,6 +139,357 @@ func (m *TokenPair) GetContractOwner() Owner {
 	return OWNER_UNSPECIFIED
 }
 
+func (m *TokenPair) GetDelisted() bool {
+	if m != nil {
+		return m.Delisted
+	}
+	return false
+}
+
+// PermitNonce defines the EIP-2612 permit nonce of an owner for an ERC20 token
+// precompile.
+type PermitNonce struct {
+	// erc20_address is the hex address of the ERC20 token precompile
+	Erc20Address string `protobuf:"bytes,1,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
+	// owner is the hex address of the token owner
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// nonce is the next permit nonce of the owner
+	Nonce uint64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *PermitNonce) Reset()         { *m = PermitNonce{} }
+func (m *PermitNonce) String() string { return proto.CompactTextString(m) }
+func (*PermitNonce) ProtoMessage()    {}
+func (*PermitNonce) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1164958b5b106e92, []int{1}
+}
+func (m *PermitNonce) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PermitNonce) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PermitNonce.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PermitNonce) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PermitNonce.Merge(m, src)
+}
+func (m *PermitNonce) XXX_Size() int {
+	return m.Size()
+}
+func (m *PermitNonce) XXX_DiscardUnknown() {
+	xxx_messageInfo_PermitNonce.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PermitNonce proto.InternalMessageInfo
+
+func (m *PermitNonce) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
+	}
+	return ""
+}
+
+func (m *PermitNonce) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *PermitNonce) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+// TransferAuthorization defines an EIP-3009 authorization nonce used by an
+// authorizer for an ERC20 token precompile.
+type TransferAuthorization struct {
+	// erc20_address is the hex address of the ERC20 token precompile
+	Erc20Address string `protobuf:"bytes,1,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
+	// authorizer is the hex address of the account that signed the authorization
+	Authorizer string `protobuf:"bytes,2,opt,name=authorizer,proto3" json:"authorizer,omitempty"`
+	// nonce is the hex encoded 32 bytes nonce of the authorization
+	Nonce string `protobuf:"bytes,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *TransferAuthorization) Reset()         { *m = TransferAuthorization{} }
+func (m *TransferAuthorization) String() string { return proto.CompactTextString(m) }
+func (*TransferAuthorization) ProtoMessage()    {}
+func (*TransferAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1164958b5b106e92, []int{2}
+}
+func (m *TransferAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferAuthorization.Merge(m, src)
+}
+func (m *TransferAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferAuthorization proto.InternalMessageInfo
+
+func (m *TransferAuthorization) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
+	}
+	return ""
+}
+
+func (m *TransferAuthorization) GetAuthorizer() string {
+	if m != nil {
+		return m.Authorizer
+	}
+	return ""
+}
+
+func (m *TransferAuthorization) GetNonce() string {
+	if m != nil {
+		return m.Nonce
+	}
+	return ""
+}
+
+// RegistrationDeposit defines the deposit locked by the account that registered
+// a token pair without governance.
+type RegistrationDeposit struct {
+	// erc20_address is the hex address of the registered ERC20 contract token
+	Erc20Address string `protobuf:"bytes,1,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
+	// depositor is the bech32 address of the account that locked the deposit
+	Depositor string `protobuf:"bytes,2,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	// amount is the locked deposit
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *RegistrationDeposit) Reset()         { *m = RegistrationDeposit{} }
+func (m *RegistrationDeposit) String() string { return proto.CompactTextString(m) }
+func (*RegistrationDeposit) ProtoMessage()    {}
+func (*RegistrationDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1164958b5b106e92, []int{3}
+}
+func (m *RegistrationDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegistrationDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegistrationDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RegistrationDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegistrationDeposit.Merge(m, src)
+}
+func (m *RegistrationDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegistrationDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegistrationDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegistrationDeposit proto.InternalMessageInfo
+
+func (m *RegistrationDeposit) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
+	}
+	return ""
+}
+
+func (m *RegistrationDeposit) GetDepositor() string {
+	if m != nil {
+		return m.Depositor
+	}
+	return ""
+}
+
+func (m *RegistrationDeposit) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// ComplianceCheck defines the outcome of one of the checks run on an ERC20
+// contract when registering its token pair.
+type ComplianceCheck struct {
+	// name of the check
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// passed is true if the contract behaved as an ERC20 token during the check
+	Passed bool `protobuf:"varint,2,opt,name=passed,proto3" json:"passed,omitempty"`
+	// skipped is true if the check couldn't be run, e.g. because the account
+	// used to simulate the transfers couldn't be funded
+	Skipped bool `protobuf:"varint,3,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	// reason describes why the check failed or was skipped
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *ComplianceCheck) Reset()         { *m = ComplianceCheck{} }
+func (m *ComplianceCheck) String() string { return proto.CompactTextString(m) }
+func (*ComplianceCheck) ProtoMessage()    {}
+func (*ComplianceCheck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1164958b5b106e92, []int{4}
+}
+func (m *ComplianceCheck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ComplianceCheck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ComplianceCheck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ComplianceCheck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ComplianceCheck.Merge(m, src)
+}
+func (m *ComplianceCheck) XXX_Size() int {
+	return m.Size()
+}
+func (m *ComplianceCheck) XXX_DiscardUnknown() {
+	xxx_messageInfo_ComplianceCheck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ComplianceCheck proto.InternalMessageInfo
+
+func (m *ComplianceCheck) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ComplianceCheck) GetPassed() bool {
+	if m != nil {
+		return m.Passed
+	}
+	return false
+}
+
+func (m *ComplianceCheck) GetSkipped() bool {
+	if m != nil {
+		return m.Skipped
+	}
+	return false
+}
+
+func (m *ComplianceCheck) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// ComplianceReport records the outcome of the ERC20 compliance checks run on a
+// contract when registering its token pair.
+type ComplianceReport struct {
+	// erc20_address is the hex address of the ERC20 contract token
+	Erc20Address string `protobuf:"bytes,1,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
+	// height is the block height at which the checks were run
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// compliant is true if all the checks passed, none failed or was skipped
+	Compliant bool `protobuf:"varint,3,opt,name=compliant,proto3" json:"compliant,omitempty"`
+	// checks is the list of checks run on the contract
+	Checks []ComplianceCheck `protobuf:"bytes,4,rep,name=checks,proto3" json:"checks"`
+}
+
+func (m *ComplianceReport) Reset()         { *m = ComplianceReport{} }
+func (m *ComplianceReport) String() string { return proto.CompactTextString(m) }
+func (*ComplianceReport) ProtoMessage()    {}
+func (*ComplianceReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1164958b5b106e92, []int{5}
+}
+func (m *ComplianceReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ComplianceReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ComplianceReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ComplianceReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ComplianceReport.Merge(m, src)
+}
+func (m *ComplianceReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *ComplianceReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_ComplianceReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ComplianceReport proto.InternalMessageInfo
+
+func (m *ComplianceReport) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
+	}
+	return ""
+}
+
+func (m *ComplianceReport) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ComplianceReport) GetCompliant() bool {
+	if m != nil {
+		return m.Compliant
+	}
+	return false
+}
+
+func (m *ComplianceReport) GetChecks() []ComplianceCheck {
+	if m != nil {
+		return m.Checks
+	}
+	return nil
+}
+
 // Deprecated: RegisterCoinProposal is a gov Content type to register a token
 // pair for a native Cosmos coin. We're keeping it to remove the existing
 // proposals from store. After that, remove this message.
@@ -142,14 +501,14 @@ type RegisterCoinProposal struct {
 	// description of the proposal
 	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
 	// metadata slice of the native Cosmos coins
-	Metadata []types.Metadata `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata"`
+	Metadata []types1.Metadata `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata"`
 }
 
 func (m *RegisterCoinProposal) Reset()         { *m = RegisterCoinProposal{} }
 func (m *RegisterCoinProposal) String() string { return proto.CompactTextString(m) }
 func (*RegisterCoinProposal) ProtoMessage()    {}
 func (*RegisterCoinProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1164958b5b106e92, []int{1}
+	return fileDescriptor_1164958b5b106e92, []int{6}
 }
 func (m *RegisterCoinProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -192,7 +551,7 @@ func (m *RegisterCoinProposal) GetDescription() string {
 	return ""
 }
 
-func (m *RegisterCoinProposal) GetMetadata() []types.Metadata {
+func (m *RegisterCoinProposal) GetMetadata() []types1.Metadata {
 	if m != nil {
 		return m.Metadata
 	}
@@ -204,14 +563,14 @@ func (m *RegisterCoinProposal) GetMetadata() []types.Metadata {
 // existing proposals from store. After that, remove this message.
 type ProposalMetadata struct {
 	// metadata slice of the native Cosmos coins
-	Metadata []types.Metadata `protobuf:"bytes,1,rep,name=metadata,proto3" json:"metadata"`
+	Metadata []types1.Metadata `protobuf:"bytes,1,rep,name=metadata,proto3" json:"metadata"`
 }
 
 func (m *ProposalMetadata) Reset()         { *m = ProposalMetadata{} }
 func (m *ProposalMetadata) String() string { return proto.CompactTextString(m) }
 func (*ProposalMetadata) ProtoMessage()    {}
 func (*ProposalMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1164958b5b106e92, []int{2}
+	return fileDescriptor_1164958b5b106e92, []int{7}
 }
 func (m *ProposalMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -240,7 +599,7 @@ func (m *ProposalMetadata) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ProposalMetadata proto.InternalMessageInfo
 
-func (m *ProposalMetadata) GetMetadata() []types.Metadata {
+func (m *ProposalMetadata) GetMetadata() []types1.Metadata {
 	if m != nil {
 		return m.Metadata
 	}
@@ -264,7 +623,7 @@ func (m *RegisterERC20Proposal) Reset()         { *m = RegisterERC20Proposal{} }
 func (m *RegisterERC20Proposal) String() string { return proto.CompactTextString(m) }
 func (*RegisterERC20Proposal) ProtoMessage()    {}
 func (*RegisterERC20Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1164958b5b106e92, []int{3}
+	return fileDescriptor_1164958b5b106e92, []int{8}
 }
 func (m *RegisterERC20Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -332,7 +691,7 @@ func (m *ToggleTokenConversionProposal) Reset()         { *m = ToggleTokenConver
 func (m *ToggleTokenConversionProposal) String() string { return proto.CompactTextString(m) }
 func (*ToggleTokenConversionProposal) ProtoMessage()    {}
 func (*ToggleTokenConversionProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1164958b5b106e92, []int{4}
+	return fileDescriptor_1164958b5b106e92, []int{9}
 }
 func (m *ToggleTokenConversionProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -385,6 +744,11 @@ func (m *ToggleTokenConversionProposal) GetToken() string {
 func init() {
 	proto.RegisterEnum("cosmos.evm.erc20.v1.Owner", Owner_name, Owner_value)
 	proto.RegisterType((*TokenPair)(nil), "cosmos.evm.erc20.v1.TokenPair")
+	proto.RegisterType((*PermitNonce)(nil), "cosmos.evm.erc20.v1.PermitNonce")
+	proto.RegisterType((*TransferAuthorization)(nil), "cosmos.evm.erc20.v1.TransferAuthorization")
+	proto.RegisterType((*RegistrationDeposit)(nil), "cosmos.evm.erc20.v1.RegistrationDeposit")
+	proto.RegisterType((*ComplianceCheck)(nil), "cosmos.evm.erc20.v1.ComplianceCheck")
+	proto.RegisterType((*ComplianceReport)(nil), "cosmos.evm.erc20.v1.ComplianceReport")
 	proto.RegisterType((*RegisterCoinProposal)(nil), "cosmos.evm.erc20.v1.RegisterCoinProposal")
 	proto.RegisterType((*ProposalMetadata)(nil), "cosmos.evm.erc20.v1.ProposalMetadata")
 	proto.RegisterType((*RegisterERC20Proposal)(nil), "cosmos.evm.erc20.v1.RegisterERC20Proposal")
@@ -394,39 +758,59 @@ func init() {
 func init() { proto.RegisterFile("cosmos/evm/erc20/v1/erc20.proto", fileDescriptor_1164958b5b106e92) }
 
 var fileDescriptor_1164958b5b106e92 = []byte{
-	// 506 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0x41, 0x6f, 0xd3, 0x4c,
-	0x10, 0xf5, 0x36, 0xe9, 0xf7, 0x35, 0xdb, 0x36, 0x0a, 0x4b, 0x2a, 0x59, 0x91, 0xea, 0x44, 0xa9,
-	0x84, 0x22, 0x0e, 0x76, 0x13, 0x6e, 0x48, 0x08, 0xa5, 0xa9, 0x91, 0x8a, 0xda, 0x24, 0x72, 0x53,
-	0x81, 0xb8, 0x44, 0x6b, 0x7b, 0x64, 0xac, 0xc6, 0xde, 0x68, 0x77, 0x31, 0x70, 0xe0, 0xce, 0x91,
-	0x0b, 0x77, 0x24, 0x4e, 0xfc, 0x93, 0x1e, 0x7b, 0xe4, 0x84, 0x50, 0x72, 0xe1, 0x67, 0x20, 0xef,
-	0xda, 0xa8, 0x20, 0x4e, 0xf4, 0x36, 0xef, 0xed, 0xbc, 0xd1, 0x9b, 0x99, 0x1d, 0xdc, 0x0e, 0x98,
-	0x48, 0x98, 0x70, 0x20, 0x4b, 0x1c, 0xe0, 0xc1, 0xe0, 0xd0, 0xc9, 0xfa, 0x3a, 0xb0, 0x97, 0x9c,
-	0x49, 0x46, 0xee, 0xea, 0x04, 0x1b, 0xb2, 0xc4, 0xd6, 0x7c, 0xd6, 0x6f, 0x59, 0x85, 0xca, 0xa7,
-	0xe9, 0xa5, 0x93, 0xf5, 0x7d, 0x90, 0xb4, 0xaf, 0x80, 0x16, 0xb5, 0x9a, 0x11, 0x8b, 0x98, 0x0a,
-	0x9d, 0x3c, 0xd2, 0x6c, 0xf7, 0x0b, 0xc2, 0xb5, 0x19, 0xbb, 0x84, 0x74, 0x4a, 0x63, 0x4e, 0x0e,
-	0xf0, 0xae, 0xaa, 0x37, 0xa7, 0x61, 0xc8, 0x41, 0x08, 0x13, 0x75, 0x50, 0xaf, 0xe6, 0xed, 0x28,
-	0x72, 0xa8, 0x39, 0xd2, 0xc4, 0x9b, 0x21, 0xa4, 0x2c, 0x31, 0x37, 0xd4, 0xa3, 0x06, 0xc4, 0xc4,
-	0xff, 0x43, 0x4a, 0xfd, 0x05, 0x84, 0x66, 0xa5, 0x83, 0x7a, 0x5b, 0x5e, 0x09, 0xc9, 0x10, 0xd7,
-	0x03, 0x96, 0x4a, 0x4e, 0x03, 0x39, 0x67, 0xaf, 0x53, 0xe0, 0x66, 0xb5, 0x83, 0x7a, 0xf5, 0x41,
-	0xcb, 0xfe, 0x4b, 0x1b, 0xf6, 0x24, 0xcf, 0xf0, 0x76, 0x4b, 0x85, 0x82, 0x0f, 0xab, 0x3f, 0x3e,
-	0xb5, 0x51, 0xf7, 0x23, 0xc2, 0x4d, 0x0f, 0xa2, 0x58, 0x48, 0xe0, 0x23, 0x16, 0xa7, 0x53, 0xce,
-	0x96, 0x4c, 0xd0, 0x45, 0xee, 0x48, 0xc6, 0x72, 0x01, 0x85, 0x5d, 0x0d, 0x48, 0x07, 0x6f, 0x87,
-	0x20, 0x02, 0x1e, 0x2f, 0x65, 0xcc, 0xd2, 0xc2, 0xed, 0x4d, 0x8a, 0x3c, 0xc6, 0x5b, 0x09, 0x48,
-	0x1a, 0x52, 0x49, 0xcd, 0x4a, 0xa7, 0xd2, 0xdb, 0x1e, 0xec, 0x97, 0x9e, 0xd4, 0xe0, 0x8a, 0x29,
-	0xda, 0x67, 0x45, 0xd2, 0x51, 0xf5, 0xea, 0x5b, 0xdb, 0xf0, 0x7e, 0x89, 0x94, 0x2f, 0xa3, 0x7b,
-	0x8e, 0x1b, 0xa5, 0x95, 0x32, 0xf3, 0xb7, 0xd2, 0xe8, 0x1f, 0x4a, 0x77, 0xdf, 0xe1, 0xbd, 0xb2,
-	0x57, 0xd7, 0x1b, 0x0d, 0x0e, 0x6f, 0xdd, 0xec, 0x3d, 0x5c, 0x57, 0x43, 0x2e, 0x56, 0x0b, 0x42,
-	0xb5, 0x5c, 0xf3, 0xfe, 0x60, 0x8b, 0x9e, 0x04, 0xde, 0x9f, 0xb1, 0x28, 0x5a, 0x80, 0xfa, 0x1c,
-	0x23, 0x96, 0x66, 0xc0, 0x45, 0xcc, 0x6e, 0x3f, 0xf3, 0x5c, 0x97, 0x97, 0x54, 0xbf, 0x24, 0xd7,
-	0xe5, 0x40, 0x2f, 0xf8, 0xfe, 0x53, 0xbc, 0xa9, 0xf6, 0x4d, 0xf6, 0xf0, 0x9d, 0xc9, 0xb3, 0xb1,
-	0xeb, 0xcd, 0x2f, 0xc6, 0xe7, 0x53, 0x77, 0x74, 0xf2, 0xe4, 0xc4, 0x3d, 0x6e, 0x18, 0xa4, 0x81,
-	0x77, 0x34, 0x7d, 0x36, 0x39, 0xbe, 0x38, 0x75, 0x1b, 0x88, 0x10, 0x5c, 0xd7, 0x8c, 0xfb, 0x7c,
-	0xe6, 0x7a, 0xe3, 0xe1, 0x69, 0x63, 0xa3, 0x55, 0x7d, 0xff, 0xd9, 0x32, 0x8e, 0x1e, 0x5d, 0xad,
-	0x2c, 0x74, 0xbd, 0xb2, 0xd0, 0xf7, 0x95, 0x85, 0x3e, 0xac, 0x2d, 0xe3, 0x7a, 0x6d, 0x19, 0x5f,
-	0xd7, 0x96, 0xf1, 0xe2, 0x20, 0x8a, 0xe5, 0xcb, 0x57, 0xbe, 0x1d, 0xb0, 0xc4, 0xb9, 0x71, 0x69,
-	0x6f, 0x8a, 0x5b, 0x93, 0x6f, 0x97, 0x20, 0xfc, 0xff, 0xd4, 0x79, 0x3c, 0xf8, 0x19, 0x00, 0x00,
-	0xff, 0xff, 0x3b, 0xe5, 0xad, 0x56, 0x8c, 0x03, 0x00, 0x00,
+	// 826 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x4d, 0x6f, 0x1b, 0x45,
+	0x18, 0xf6, 0x36, 0x8e, 0x89, 0xdf, 0xb4, 0xc1, 0x9d, 0x26, 0xd5, 0xd6, 0xa2, 0x1b, 0xcb, 0x45,
+	0xc8, 0xaa, 0x54, 0xbb, 0x09, 0x82, 0x03, 0x12, 0x42, 0xb1, 0x63, 0x50, 0x51, 0x9b, 0x44, 0x9b,
+	0x54, 0x20, 0x0e, 0x44, 0xe3, 0xdd, 0x97, 0xf5, 0xc8, 0xde, 0x99, 0xd5, 0xcc, 0xc4, 0x7c, 0x48,
+	0xdc, 0x39, 0x72, 0xe1, 0x8e, 0xc4, 0x05, 0x71, 0xe2, 0x80, 0xf8, 0x07, 0x48, 0x3d, 0x56, 0x9c,
+	0x38, 0x20, 0x40, 0xc9, 0x01, 0x7e, 0x06, 0x9a, 0x8f, 0xb5, 0xfb, 0x75, 0x88, 0x9a, 0x8b, 0xbd,
+	0xcf, 0xf3, 0x7e, 0xbf, 0xf3, 0xcc, 0x2e, 0x6c, 0x26, 0x42, 0xe5, 0x42, 0xf5, 0x70, 0x96, 0xf7,
+	0x50, 0x26, 0xdb, 0x77, 0x7b, 0xb3, 0x2d, 0xf7, 0xd0, 0x2d, 0xa4, 0xd0, 0x82, 0x5c, 0x73, 0x0e,
+	0x5d, 0x9c, 0xe5, 0x5d, 0xc7, 0xcf, 0xb6, 0x9a, 0x57, 0x69, 0xce, 0xb8, 0xe8, 0xd9, 0x5f, 0xe7,
+	0xd7, 0x8c, 0x7c, 0xa2, 0x11, 0xe5, 0x93, 0xde, 0x6c, 0x6b, 0x84, 0x9a, 0x6e, 0x59, 0xf0, 0x9c,
+	0x5d, 0xe1, 0xdc, 0x9e, 0x08, 0xc6, 0xbd, 0xfd, 0x86, 0xb3, 0x1f, 0x5b, 0xd4, 0xf3, 0x45, 0x9d,
+	0x69, 0x3d, 0x13, 0x99, 0x70, 0xbc, 0x79, 0x72, 0x6c, 0xfb, 0xb7, 0x00, 0xea, 0x47, 0x62, 0x82,
+	0xfc, 0x80, 0x32, 0x49, 0x6e, 0xc1, 0x15, 0xdb, 0xdd, 0x31, 0x4d, 0x53, 0x89, 0x4a, 0x85, 0x41,
+	0x2b, 0xe8, 0xd4, 0xe3, 0xcb, 0x96, 0xdc, 0x71, 0x1c, 0x59, 0x87, 0xe5, 0x14, 0xb9, 0xc8, 0xc3,
+	0x4b, 0xd6, 0xe8, 0x00, 0x09, 0xe1, 0x15, 0xe4, 0x74, 0x34, 0xc5, 0x34, 0x5c, 0x6a, 0x05, 0x9d,
+	0x95, 0xb8, 0x84, 0x64, 0x07, 0xd6, 0x12, 0xc1, 0xb5, 0xa4, 0x89, 0x3e, 0x16, 0x9f, 0x73, 0x94,
+	0x61, 0xb5, 0x15, 0x74, 0xd6, 0xb6, 0x9b, 0xdd, 0x17, 0x2c, 0xa5, 0xbb, 0x6f, 0x3c, 0xe2, 0x2b,
+	0x65, 0x84, 0x85, 0xa4, 0x09, 0x2b, 0x29, 0x4e, 0x99, 0xd2, 0x98, 0x86, 0xcb, 0x36, 0xfb, 0x1c,
+	0xbf, 0x53, 0xfd, 0xef, 0xfb, 0xcd, 0xa0, 0xfd, 0x29, 0xac, 0x1e, 0xa0, 0xcc, 0x99, 0xde, 0x13,
+	0x3c, 0xc1, 0x73, 0x0f, 0xe2, 0xfa, 0xf1, 0x83, 0x58, 0x60, 0x58, 0x6e, 0x72, 0xd8, 0x31, 0xaa,
+	0xb1, 0x03, 0x6d, 0x09, 0x1b, 0x47, 0x92, 0x72, 0xf5, 0x19, 0xca, 0x9d, 0x13, 0x3d, 0x16, 0x92,
+	0x7d, 0x45, 0x35, 0x13, 0xfc, 0x7c, 0x95, 0x22, 0x00, 0xea, 0xa3, 0xe6, 0xe5, 0x9e, 0x60, 0x9e,
+	0xae, 0x59, 0x2f, 0x6b, 0xfe, 0x19, 0xc0, 0xb5, 0x18, 0x33, 0xa6, 0xb4, 0xb4, 0xb5, 0x76, 0xb1,
+	0x10, 0x8a, 0xe9, 0xf3, 0x95, 0x7c, 0x1b, 0xea, 0xa9, 0xf3, 0x17, 0xbe, 0x62, 0x3f, 0xfc, 0xfd,
+	0x97, 0x3b, 0xeb, 0x7e, 0xe7, 0xde, 0xed, 0x50, 0x4b, 0xc6, 0xb3, 0x78, 0xe1, 0x4a, 0xc6, 0x50,
+	0xa3, 0xb9, 0x38, 0xe1, 0x3a, 0x5c, 0x6a, 0x2d, 0x75, 0x56, 0xb7, 0x6f, 0x94, 0xa7, 0x64, 0x24,
+	0xd7, 0xf5, 0x92, 0xeb, 0x0e, 0x04, 0xe3, 0xfd, 0xb7, 0x1e, 0xfd, 0xb5, 0x59, 0xf9, 0xe9, 0xef,
+	0xcd, 0x4e, 0xc6, 0xf4, 0xf8, 0x64, 0xd4, 0x4d, 0x44, 0xee, 0x25, 0xe7, 0xff, 0xee, 0xa8, 0x74,
+	0xd2, 0xd3, 0x5f, 0x16, 0xa8, 0x6c, 0x80, 0xfa, 0xf1, 0xdf, 0x9f, 0x6f, 0x07, 0xb1, 0xcf, 0xdf,
+	0x16, 0xf0, 0xea, 0x40, 0xe4, 0xc5, 0x94, 0x51, 0x9e, 0xe0, 0x60, 0x8c, 0xc9, 0x84, 0x10, 0xa8,
+	0x72, 0x9a, 0xa3, 0x1f, 0xc8, 0x3e, 0x93, 0xeb, 0x50, 0x2b, 0xa8, 0x52, 0x98, 0xda, 0x29, 0x56,
+	0x62, 0x8f, 0x8c, 0xe0, 0xd4, 0x84, 0x15, 0xc5, 0x42, 0x70, 0x1e, 0x9a, 0x08, 0x89, 0x54, 0x09,
+	0x6e, 0x85, 0x56, 0x8f, 0x3d, 0x6a, 0xff, 0x1a, 0x40, 0x63, 0x51, 0x31, 0xc6, 0x42, 0xc8, 0x73,
+	0x2e, 0xf3, 0x3a, 0xd4, 0xc6, 0xc8, 0xb2, 0xb1, 0xb6, 0x3d, 0x2c, 0xc5, 0x1e, 0x91, 0xd7, 0xa0,
+	0x9e, 0xf8, 0x84, 0xda, 0x77, 0xb1, 0x20, 0xc8, 0x07, 0x50, 0x4b, 0xcc, 0x58, 0x2a, 0xac, 0xda,
+	0x55, 0xbe, 0xfe, 0x42, 0xc1, 0x3f, 0xb3, 0x83, 0x7e, 0xdd, 0x6c, 0xd5, 0x6f, 0xca, 0x85, 0xb7,
+	0xbf, 0x0b, 0x60, 0xdd, 0x09, 0x01, 0xa5, 0x59, 0xe4, 0x81, 0x14, 0x85, 0x50, 0x74, 0x6a, 0x74,
+	0xa3, 0x99, 0x9e, 0x96, 0x0b, 0x73, 0x80, 0xb4, 0x60, 0x35, 0x45, 0x95, 0x48, 0x56, 0x18, 0xd5,
+	0x78, 0xb9, 0x3d, 0x49, 0x91, 0xf7, 0x60, 0x25, 0x47, 0x4d, 0x53, 0xaa, 0xa9, 0x3f, 0xe6, 0x9b,
+	0x8b, 0x63, 0xe6, 0x93, 0xf9, 0x31, 0x3f, 0xf0, 0x4e, 0xfd, 0xaa, 0x69, 0x2a, 0x9e, 0x07, 0xd9,
+	0x4b, 0x57, 0x69, 0x1f, 0x42, 0xa3, 0x6c, 0xa5, 0xf4, 0x7c, 0x2a, 0x75, 0xf0, 0x12, 0xa9, 0xdb,
+	0x5f, 0xc3, 0x46, 0x39, 0xeb, 0x30, 0x1e, 0x6c, 0xdf, 0xbd, 0xf0, 0xb0, 0x6f, 0xc0, 0x9a, 0x5d,
+	0xb6, 0x3f, 0x60, 0x54, 0x76, 0xe4, 0x7a, 0xfc, 0x0c, 0xeb, 0x67, 0x52, 0x70, 0xf3, 0x48, 0x64,
+	0xd9, 0x14, 0xed, 0x5b, 0x71, 0x20, 0xf8, 0x0c, 0xa5, 0x62, 0xe2, 0xe2, 0x3b, 0x37, 0x71, 0x26,
+	0x65, 0x79, 0xc7, 0x2d, 0x70, 0x6f, 0xaf, 0xdb, 0x1f, 0xc2, 0xb2, 0x7b, 0xd1, 0x6d, 0xc0, 0xd5,
+	0xfd, 0x8f, 0xf6, 0x86, 0xf1, 0xf1, 0xc3, 0xbd, 0xc3, 0x83, 0xe1, 0xe0, 0xde, 0xfb, 0xf7, 0x86,
+	0xbb, 0x8d, 0x0a, 0x69, 0xc0, 0x65, 0x47, 0x3f, 0xd8, 0xdf, 0x7d, 0x78, 0x7f, 0xd8, 0x08, 0x08,
+	0x81, 0x35, 0xc7, 0x0c, 0x3f, 0x3e, 0x1a, 0xc6, 0x7b, 0x3b, 0xf7, 0x1b, 0x97, 0x9a, 0xd5, 0x6f,
+	0x7e, 0x88, 0x2a, 0xfd, 0x77, 0x1f, 0x9d, 0x46, 0xc1, 0xe3, 0xd3, 0x28, 0xf8, 0xe7, 0x34, 0x0a,
+	0xbe, 0x3d, 0x8b, 0x2a, 0x8f, 0xcf, 0xa2, 0xca, 0x1f, 0x67, 0x51, 0xe5, 0x93, 0x5b, 0xcf, 0xdf,
+	0x53, 0xf3, 0xc1, 0xfa, 0xc2, 0x7f, 0xb2, 0xec, 0x45, 0x1d, 0xd5, 0xec, 0x77, 0xe1, 0xcd, 0xff,
+	0x07, 0x00, 0xd5, 0xd3, 0x27, 0x0c, 0xd3, 0x06, 0x00, 0x00,
 }
 
 func (this *TokenPair) Equal(that interface{}) bool {
@@ -460,6 +844,9 @@ func (this *TokenPair) Equal(that interface{}) bool {
 	if this.ContractOwner != that1.ContractOwner {
 		return false
 	}
+	if this.Delisted != that1.Delisted {
+		return false
+	}
 	return true
 }
 func (this *ToggleTokenConversionProposal) Equal(that interface{}) bool {
@@ -512,6 +899,16 @@ func (m *TokenPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Delisted {
+		i--
+		if m.Delisted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.ContractOwner != 0 {
 		i = encodeVarintErc20(dAtA, i, uint64(m.ContractOwner))
 		i--
@@ -544,7 +941,7 @@ func (m *TokenPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *RegisterCoinProposal) Marshal() (dAtA []byte, err error) {
+func (m *PermitNonce) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -554,48 +951,39 @@ func (m *RegisterCoinProposal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RegisterCoinProposal) MarshalTo(dAtA []byte) (int, error) {
+func (m *PermitNonce) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RegisterCoinProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PermitNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Metadata) > 0 {
-		for iNdEx := len(m.Metadata) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Metadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintErc20(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+	if m.Nonce != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Owner)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ProposalMetadata) Marshal() (dAtA []byte, err error) {
+func (m *TransferAuthorization) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -605,23 +993,285 @@ func (m *ProposalMetadata) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ProposalMetadata) MarshalTo(dAtA []byte) (int, error) {
+func (m *TransferAuthorization) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ProposalMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TransferAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Metadata) > 0 {
-		for iNdEx := len(m.Metadata) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Metadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
+	if len(m.Nonce) > 0 {
+		i -= len(m.Nonce)
+		copy(dAtA[i:], m.Nonce)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Nonce)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Authorizer) > 0 {
+		i -= len(m.Authorizer)
+		copy(dAtA[i:], m.Authorizer)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Authorizer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegistrationDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegistrationDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegistrationDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintErc20(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ComplianceCheck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ComplianceCheck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ComplianceCheck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Skipped {
+		i--
+		if m.Skipped {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Passed {
+		i--
+		if m.Passed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ComplianceReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ComplianceReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ComplianceReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Checks) > 0 {
+		for iNdEx := len(m.Checks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Checks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintErc20(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Compliant {
+		i--
+		if m.Compliant {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisterCoinProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterCoinProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterCoinProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Metadata) > 0 {
+		for iNdEx := len(m.Metadata) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Metadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintErc20(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposalMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Metadata) > 0 {
+		for iNdEx := len(m.Metadata) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Metadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
 				i -= size
 				i = encodeVarintErc20(dAtA, i, uint64(size))
 			}
@@ -753,64 +1403,179 @@ func (m *TokenPair) Size() (n int) {
 	if m.ContractOwner != 0 {
 		n += 1 + sovErc20(uint64(m.ContractOwner))
 	}
+	if m.Delisted {
+		n += 2
+	}
 	return n
 }
 
-func (m *RegisterCoinProposal) Size() (n int) {
+func (m *PermitNonce) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Title)
+	l = len(m.Erc20Address)
 	if l > 0 {
 		n += 1 + l + sovErc20(uint64(l))
 	}
-	l = len(m.Description)
+	l = len(m.Owner)
 	if l > 0 {
 		n += 1 + l + sovErc20(uint64(l))
 	}
-	if len(m.Metadata) > 0 {
-		for _, e := range m.Metadata {
-			l = e.Size()
-			n += 1 + l + sovErc20(uint64(l))
-		}
+	if m.Nonce != 0 {
+		n += 1 + sovErc20(uint64(m.Nonce))
 	}
 	return n
 }
 
-func (m *ProposalMetadata) Size() (n int) {
+func (m *TransferAuthorization) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Metadata) > 0 {
-		for _, e := range m.Metadata {
-			l = e.Size()
-			n += 1 + l + sovErc20(uint64(l))
-		}
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Authorizer)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Nonce)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
 	}
 	return n
 }
 
-func (m *RegisterERC20Proposal) Size() (n int) {
+func (m *RegistrationDeposit) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Title)
+	l = len(m.Erc20Address)
 	if l > 0 {
 		n += 1 + l + sovErc20(uint64(l))
 	}
-	l = len(m.Description)
+	l = len(m.Depositor)
 	if l > 0 {
 		n += 1 + l + sovErc20(uint64(l))
 	}
-	if len(m.Erc20Addresses) > 0 {
-		for _, s := range m.Erc20Addresses {
-			l = len(s)
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovErc20(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ComplianceCheck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	if m.Passed {
+		n += 2
+	}
+	if m.Skipped {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	return n
+}
+
+func (m *ComplianceReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovErc20(uint64(m.Height))
+	}
+	if m.Compliant {
+		n += 2
+	}
+	if len(m.Checks) > 0 {
+		for _, e := range m.Checks {
+			l = e.Size()
+			n += 1 + l + sovErc20(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RegisterCoinProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	if len(m.Metadata) > 0 {
+		for _, e := range m.Metadata {
+			l = e.Size()
+			n += 1 + l + sovErc20(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProposalMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Metadata) > 0 {
+		for _, e := range m.Metadata {
+			l = e.Size()
+			n += 1 + l + sovErc20(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RegisterERC20Proposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	if len(m.Erc20Addresses) > 0 {
+		for _, s := range m.Erc20Addresses {
+			l = len(s)
 			n += 1 + l + sovErc20(uint64(l))
 		}
 	}
@@ -976,6 +1741,762 @@ func (m *TokenPair) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delisted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Delisted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PermitNonce) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PermitNonce: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PermitNonce: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorizer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authorizer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nonce = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegistrationDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegistrationDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegistrationDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ComplianceCheck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ComplianceCheck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ComplianceCheck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Passed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Passed = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Skipped", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Skipped = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ComplianceReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ComplianceReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ComplianceReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compliant", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Compliant = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checks = append(m.Checks, ComplianceCheck{})
+			if err := m.Checks[len(m.Checks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipErc20(dAtA[iNdEx:])
@@ -1119,7 +2640,7 @@ func (m *RegisterCoinProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = append(m.Metadata, types.Metadata{})
+			m.Metadata = append(m.Metadata, types1.Metadata{})
 			if err := m.Metadata[len(m.Metadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -1203,7 +2724,7 @@ func (m *ProposalMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = append(m.Metadata, types.Metadata{})
+			m.Metadata = append(m.Metadata, types1.Metadata{})
 			if err := m.Metadata[len(m.Metadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
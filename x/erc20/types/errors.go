@@ -21,4 +21,10 @@ var (
 	ErrInvalidIBC               = errorsmod.Register(ModuleName, 14, "invalid IBC transaction")
 	ErrTokenPairOwnedByModule   = errorsmod.Register(ModuleName, 15, "token pair owned by module")
 	ErrNativeConversionDisabled = errorsmod.Register(ModuleName, 16, "native coins manual conversion is disabled")
+	ErrInvalidEVMHook           = errorsmod.Register(ModuleName, 17, "invalid EVM hook")
+	ErrEVMHookFailed            = errorsmod.Register(ModuleName, 18, "EVM hook failed")
+	ErrRegistrationDisabled     = errorsmod.Register(ModuleName, 19, "permissionless registration is disabled")
+	ErrInvalidERC20Contract     = errorsmod.Register(ModuleName, 20, "invalid ERC20 contract")
+	ErrTokenPairDelisted        = errorsmod.Register(ModuleName, 21, "token pair is delisted")
+	ErrDepositNotFound          = errorsmod.Register(ModuleName, 22, "registration deposit not found")
 )
@@ -12,11 +12,23 @@ const (
 	EventTypeRegisterERC20          = "register_erc20"
 	EventTypeToggleTokenConversion  = "toggle_token_conversion" // #nosec
 	EventTypeRegisterERC20Extension = "register_erc20_extension"
+	EventTypeEVMHook                = "evm_hook"
+	EventTypeDelistERC20            = "delist_erc20"
+	EventTypeRedeemCoin             = "redeem_coin"
+	EventTypeReleaseDeposit         = "release_registration_deposit"
 
-	AttributeCoinSourceChannel = "source_channel"
-	AttributeKeyCosmosCoin     = "cosmos_coin"
-	AttributeKeyERC20Token     = "erc20_token" // #nosec
-	AttributeKeyReceiver       = "receiver"
+	AttributeCoinSourceChannel     = "source_channel"
+	AttributeKeyCosmosCoin         = "cosmos_coin"
+	AttributeKeyERC20Token         = "erc20_token" // #nosec
+	AttributeKeyReceiver           = "receiver"
+	AttributeKeyContract           = "contract"
+	AttributeKeyIntermediateSender = "intermediate_sender"
+	AttributeKeyDepositor          = "depositor"
+	AttributeKeyDeposit            = "deposit"
+	AttributeKeyDepositBurned      = "deposit_burned"
+	AttributeKeyRedeemOnly         = "redeem_only"
+	AttributeKeyCompliant          = "compliant"
+	AttributeKeyFailedChecks       = "failed_checks"
 )
 
 // LogTransfer Event type for Transfer(address from, address to, uint256 value)
@@ -0,0 +1,109 @@
+package types
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// EVMHookMemoKey is the memo key of the EVM hook executed when an ICS20
+	// packet is received.
+	EVMHookMemoKey = "evm"
+	// EVMHookIntermediateSenderPrefix is the prefix used to derive the
+	// intermediate sender addresses of the EVM hooks.
+	EVMHookIntermediateSenderPrefix = "ibc-evm-hook-intermediary"
+)
+
+// EVMHook defines the EVM contract call executed with the tokens of a received
+// ICS20 packet.
+type EVMHook struct {
+	Contract common.Address
+	Calldata []byte
+	// GasLimit is the gas limit requested by the memo, or zero if it doesn't
+	// define one. It is capped by the EvmHookMaxGas param.
+	GasLimit uint64
+}
+
+// evmHookMemo defines the JSON-encoded EVM hook of a packet memo.
+type evmHookMemo struct {
+	Contract string `json:"contract"`
+	Calldata string `json:"calldata"`
+	GasLimit string `json:"gas_limit"`
+}
+
+// ParseEVMHook returns the EVM hook defined on the packet memo, or false if the
+// memo doesn't define one. The memo format is:
+//
+//	{"evm":{"contract":"0x...","calldata":"0x...","gas_limit":"200000"}}
+//
+// where gas_limit is optional.
+func ParseEVMHook(memo string) (EVMHook, bool, error) {
+	if memo == "" {
+		return EVMHook{}, false, nil
+	}
+
+	var memoFields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &memoFields); err != nil {
+		// the memo is not a JSON object so it doesn't define an EVM hook
+		return EVMHook{}, false, nil
+	}
+
+	rawHook, found := memoFields[EVMHookMemoKey]
+	if !found {
+		return EVMHook{}, false, nil
+	}
+
+	var hook evmHookMemo
+	if err := json.Unmarshal(rawHook, &hook); err != nil {
+		return EVMHook{}, false, errorsmod.Wrapf(ErrInvalidEVMHook, "invalid memo: %s", err)
+	}
+
+	if !common.IsHexAddress(hook.Contract) {
+		return EVMHook{}, false, errorsmod.Wrapf(ErrInvalidEVMHook, "invalid contract address %q", hook.Contract)
+	}
+
+	calldata, err := hexutil.Decode(hook.Calldata)
+	if err != nil {
+		return EVMHook{}, false, errorsmod.Wrapf(ErrInvalidEVMHook, "invalid calldata: %s", err)
+	}
+
+	var gasLimit uint64
+	if hook.GasLimit != "" {
+		gasLimit, err = strconv.ParseUint(hook.GasLimit, 10, 64)
+		if err != nil || gasLimit == 0 {
+			return EVMHook{}, false, errorsmod.Wrapf(ErrInvalidEVMHook, "invalid gas limit %q", hook.GasLimit)
+		}
+	}
+
+	return EVMHook{
+		Contract: common.HexToAddress(hook.Contract),
+		Calldata: calldata,
+		GasLimit: gasLimit,
+	}, true, nil
+}
+
+// GetGasLimit returns the gas limit of the hook, capped by the given maximum
+// gas.
+func (h EVMHook) GetGasLimit(maxGas uint64) uint64 {
+	if h.GasLimit == 0 || h.GasLimit > maxGas {
+		return maxGas
+	}
+	return h.GasLimit
+}
+
+// EVMHookIntermediateSender returns the address that receives the tokens of an
+// ICS20 packet with an EVM hook and executes the hook call. It is derived from
+// the destination channel and the original sender, so that senders from
+// different chains can't act on each other's behalf.
+func EVMHookIntermediateSender(channel, originalSender string) sdk.AccAddress {
+	key := []byte(channel + "/" + originalSender)
+	return sdk.AccAddress(address.Module(EVMHookIntermediateSenderPrefix, key)[:common.AddressLength])
+}
@@ -0,0 +1,73 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/x/erc20/types"
+)
+
+func TestParseEVMHook(t *testing.T) {
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+	testCases := []struct {
+		name        string
+		memo        string
+		expFound    bool
+		expHook     types.EVMHook
+		errContains string
+	}{
+		{"empty memo", "", false, types.EVMHook{}, ""},
+		{"non-JSON memo", "hello", false, types.EVMHook{}, ""},
+		{"no EVM hook", `{"forward":{}}`, false, types.EVMHook{}, ""},
+		{"invalid hook", `{"evm":"0x1"}`, false, types.EVMHook{}, "invalid memo"},
+		{"invalid contract", `{"evm":{"contract":"cosmos1","calldata":"0x"}}`, false, types.EVMHook{}, "invalid contract address"},
+		{"invalid calldata", `{"evm":{"contract":"` + contract.Hex() + `","calldata":"zz"}}`, false, types.EVMHook{}, "invalid calldata"},
+		{"invalid gas limit", `{"evm":{"contract":"` + contract.Hex() + `","calldata":"0x","gas_limit":"abc"}}`, false, types.EVMHook{}, "invalid gas limit"},
+		{"zero gas limit", `{"evm":{"contract":"` + contract.Hex() + `","calldata":"0x","gas_limit":"0"}}`, false, types.EVMHook{}, "invalid gas limit"},
+		{
+			"valid hook",
+			`{"evm":{"contract":"` + contract.Hex() + `","calldata":"0xa9059cbb"}}`,
+			true,
+			types.EVMHook{Contract: contract, Calldata: []byte{0xa9, 0x05, 0x9c, 0xbb}},
+			"",
+		},
+		{
+			"valid hook with gas limit",
+			`{"evm":{"contract":"` + contract.Hex() + `","calldata":"0xa9059cbb","gas_limit":"200000"}}`,
+			true,
+			types.EVMHook{Contract: contract, Calldata: []byte{0xa9, 0x05, 0x9c, 0xbb}, GasLimit: 200_000},
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			hook, found, err := types.ParseEVMHook(tc.memo)
+			if tc.errContains != "" {
+				require.ErrorContains(t, err, tc.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expFound, found)
+			require.Equal(t, tc.expHook, hook)
+		})
+	}
+}
+
+func TestEVMHookGetGasLimit(t *testing.T) {
+	require.Equal(t, uint64(1000), types.EVMHook{}.GetGasLimit(1000))
+	require.Equal(t, uint64(500), types.EVMHook{GasLimit: 500}.GetGasLimit(1000))
+	require.Equal(t, uint64(1000), types.EVMHook{GasLimit: 5000}.GetGasLimit(1000))
+}
+
+func TestEVMHookIntermediateSender(t *testing.T) {
+	sender := types.EVMHookIntermediateSender("channel-0", "cosmos1sender")
+	require.Len(t, sender, common.AddressLength)
+	require.Equal(t, sender, types.EVMHookIntermediateSender("channel-0", "cosmos1sender"))
+	require.NotEqual(t, sender, types.EVMHookIntermediateSender("channel-1", "cosmos1sender"))
+	require.NotEqual(t, sender, types.EVMHookIntermediateSender("channel-0", "cosmos1other"))
+}
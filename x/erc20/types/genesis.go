@@ -2,6 +2,8 @@ package types
 
 import (
 	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
 )
 
 // NewGenesisState creates a new genesis state.
@@ -57,6 +59,75 @@ func (gs GenesisState) Validate() error {
 	if err := validatePrecompiles(gs.TokenPairs, gs.Params.NativePrecompiles); err != nil {
 		return fmt.Errorf("invalid native precompiles on genesis: %w", err)
 	}
+
+	// Check if every registration deposit has a corresponding token pair
+	seenDeposit := make(map[string]bool)
+	for _, deposit := range gs.RegistrationDeposits {
+		if err := deposit.Validate(); err != nil {
+			return fmt.Errorf("invalid registration deposit on genesis: %w", err)
+		}
+
+		if seenDeposit[deposit.Erc20Address] {
+			return fmt.Errorf("registration deposit duplicated on genesis: '%s'", deposit.Erc20Address)
+		}
+
+		if !seenErc20[deposit.Erc20Address] {
+			return fmt.Errorf("registration deposit '%s' not found in token pairs", deposit.Erc20Address)
+		}
+
+		seenDeposit[deposit.Erc20Address] = true
+	}
+
+	// Check if every compliance report has a corresponding token pair
+	seenReport := make(map[string]bool)
+	for _, report := range gs.ComplianceReports {
+		if err := report.Validate(); err != nil {
+			return fmt.Errorf("invalid compliance report on genesis: %w", err)
+		}
+
+		if seenReport[report.Erc20Address] {
+			return fmt.Errorf("compliance report duplicated on genesis: '%s'", report.Erc20Address)
+		}
+
+		if !seenErc20[report.Erc20Address] {
+			return fmt.Errorf("compliance report '%s' not found in token pairs", report.Erc20Address)
+		}
+
+		seenReport[report.Erc20Address] = true
+	}
+
+	seenNonce := make(map[string]bool)
+	for _, nonce := range gs.PermitNonces {
+		if err := nonce.Validate(); err != nil {
+			return fmt.Errorf("invalid permit nonce on genesis: %w", err)
+		}
+
+		key := common.HexToAddress(nonce.Erc20Address).Hex() + common.HexToAddress(nonce.Owner).Hex()
+		if seenNonce[key] {
+			return fmt.Errorf("permit nonce duplicated on genesis: token '%s', owner '%s'", nonce.Erc20Address, nonce.Owner)
+		}
+
+		seenNonce[key] = true
+	}
+
+	seenAuthorization := make(map[string]bool)
+	for _, authorization := range gs.UsedAuthorizations {
+		if err := authorization.Validate(); err != nil {
+			return fmt.Errorf("invalid transfer authorization on genesis: %w", err)
+		}
+
+		key := common.HexToAddress(authorization.Erc20Address).Hex() +
+			common.HexToAddress(authorization.Authorizer).Hex() +
+			authorization.GetNonceHash().Hex()
+		if seenAuthorization[key] {
+			return fmt.Errorf(
+				"transfer authorization duplicated on genesis: token '%s', authorizer '%s', nonce '%s'",
+				authorization.Erc20Address, authorization.Authorizer, authorization.Nonce,
+			)
+		}
+
+		seenAuthorization[key] = true
+	}
 	return nil
 }
 
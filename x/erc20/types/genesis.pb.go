@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -30,6 +32,18 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// token_pairs is a slice of the registered token pairs at genesis
 	TokenPairs []TokenPair `protobuf:"bytes,2,rep,name=token_pairs,json=tokenPairs,proto3" json:"token_pairs"`
+	// registration_deposits is a slice of the deposits locked for the token pairs
+	// registered without governance at genesis
+	RegistrationDeposits []RegistrationDeposit `protobuf:"bytes,3,rep,name=registration_deposits,json=registrationDeposits,proto3" json:"registration_deposits"`
+	// compliance_reports is a slice of the ERC20 compliance reports of the
+	// registered token pairs at genesis
+	ComplianceReports []ComplianceReport `protobuf:"bytes,4,rep,name=compliance_reports,json=complianceReports,proto3" json:"compliance_reports"`
+	// permit_nonces is a slice of the EIP-2612 permit nonces of the ERC20 token
+	// precompiles at genesis
+	PermitNonces []PermitNonce `protobuf:"bytes,5,rep,name=permit_nonces,json=permitNonces,proto3" json:"permit_nonces"`
+	// used_authorizations is a slice of the EIP-3009 authorization nonces used
+	// for the ERC20 token precompiles at genesis
+	UsedAuthorizations []TransferAuthorization `protobuf:"bytes,6,rep,name=used_authorizations,json=usedAuthorizations,proto3" json:"used_authorizations"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -79,6 +93,34 @@ func (m *GenesisState) GetTokenPairs() []TokenPair {
 	return nil
 }
 
+func (m *GenesisState) GetRegistrationDeposits() []RegistrationDeposit {
+	if m != nil {
+		return m.RegistrationDeposits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetComplianceReports() []ComplianceReport {
+	if m != nil {
+		return m.ComplianceReports
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPermitNonces() []PermitNonce {
+	if m != nil {
+		return m.PermitNonces
+	}
+	return nil
+}
+
+func (m *GenesisState) GetUsedAuthorizations() []TransferAuthorization {
+	if m != nil {
+		return m.UsedAuthorizations
+	}
+	return nil
+}
+
 // Params defines the erc20 module params
 type Params struct {
 	// enable_erc20 is the parameter to enable the conversion of Cosmos coins <-->
@@ -91,6 +133,19 @@ type Params struct {
 	// dynamic_precompiles defines the slice of hex addresses of the
 	// active precompiles that are used to interact with Bank coins as ERC20s
 	DynamicPrecompiles []string `protobuf:"bytes,4,rep,name=dynamic_precompiles,json=dynamicPrecompiles,proto3" json:"dynamic_precompiles,omitempty"`
+	// permissionless_registration is the parameter to allow any account to
+	// register a token pair for an ERC20 contract by locking the registration
+	// deposit, without going through governance.
+	PermissionlessRegistration bool `protobuf:"varint,5,opt,name=permissionless_registration,json=permissionlessRegistration,proto3" json:"permissionless_registration,omitempty"`
+	// registration_deposit defines the deposit locked for every token pair
+	// registered without governance. It is refunded or burned when governance
+	// delists the token pair.
+	RegistrationDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=registration_deposit,json=registrationDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"registration_deposit"`
+	// evm_hook_max_gas defines the maximum gas that the EVM hook of a received
+	// ICS20 packet can use, covering the approval of the received tokens, the
+	// hook call and the reset of the approval. The gas_limit of the hook memo can
+	// only lower it. A zero value disables the EVM hooks.
+	EvmHookMaxGas uint64 `protobuf:"varint,7,opt,name=evm_hook_max_gas,json=evmHookMaxGas,proto3" json:"evm_hook_max_gas,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -147,6 +202,27 @@ func (m *Params) GetDynamicPrecompiles() []string {
 	return nil
 }
 
+func (m *Params) GetPermissionlessRegistration() bool {
+	if m != nil {
+		return m.PermissionlessRegistration
+	}
+	return false
+}
+
+func (m *Params) GetRegistrationDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RegistrationDeposit
+	}
+	return nil
+}
+
+func (m *Params) GetEvmHookMaxGas() uint64 {
+	if m != nil {
+		return m.EvmHookMaxGas
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.evm.erc20.v1.GenesisState")
 	proto.RegisterType((*Params)(nil), "cosmos.evm.erc20.v1.Params")
@@ -155,29 +231,45 @@ func init() {
 func init() { proto.RegisterFile("cosmos/evm/erc20/v1/genesis.proto", fileDescriptor_e964b7a0cc2cbbd5) }
 
 var fileDescriptor_e964b7a0cc2cbbd5 = []byte{
-	// 343 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xcf, 0x4e, 0x32, 0x31,
-	0x14, 0xc5, 0xa7, 0x0c, 0x21, 0xd0, 0x61, 0xf1, 0x51, 0xbe, 0x05, 0xc1, 0xa4, 0xfc, 0x71, 0x43,
-	0x4c, 0x6c, 0x05, 0xd7, 0xba, 0x20, 0x31, 0x26, 0xac, 0x08, 0xba, 0x72, 0x43, 0xca, 0xd8, 0x8c,
-	0x8d, 0x74, 0x3a, 0x99, 0xd6, 0x89, 0xbc, 0x85, 0x6e, 0x7d, 0x02, 0x97, 0x3e, 0x06, 0x4b, 0x96,
-	0xae, 0x8c, 0x81, 0x85, 0xaf, 0x61, 0x68, 0xc7, 0x88, 0x09, 0x9b, 0xa6, 0x39, 0xf7, 0x77, 0xcf,
-	0xb9, 0x39, 0xb0, 0x13, 0x2a, 0x2d, 0x95, 0xa6, 0x3c, 0x93, 0x94, 0xa7, 0xe1, 0xe0, 0x84, 0x66,
-	0x7d, 0x1a, 0xf1, 0x98, 0x6b, 0xa1, 0x49, 0x92, 0x2a, 0xa3, 0x50, 0xdd, 0x21, 0x84, 0x67, 0x92,
-	0x58, 0x84, 0x64, 0xfd, 0x66, 0x8d, 0x49, 0x11, 0x2b, 0x6a, 0x5f, 0xc7, 0x35, 0xff, 0x47, 0x2a,
-	0x52, 0xf6, 0x4b, 0xb7, 0xbf, 0x5c, 0x6d, 0xed, 0x0b, 0x70, 0x36, 0x16, 0xe8, 0xbe, 0x00, 0x58,
-	0xbd, 0x74, 0x81, 0x57, 0x86, 0x19, 0x8e, 0xce, 0x61, 0x29, 0x61, 0x29, 0x93, 0xba, 0x01, 0xda,
-	0xa0, 0x17, 0x0c, 0x0e, 0xc8, 0x9e, 0x03, 0xc8, 0xd8, 0x22, 0xc3, 0xca, 0xf2, 0xa3, 0xe5, 0xbd,
-	0x7e, 0xbd, 0x1d, 0x81, 0x49, 0xbe, 0x85, 0x46, 0x30, 0x30, 0xea, 0x9e, 0xc7, 0xd3, 0x84, 0x89,
-	0x54, 0x37, 0x0a, 0x6d, 0xbf, 0x17, 0x0c, 0xf0, 0x5e, 0x93, 0xeb, 0x2d, 0x37, 0x66, 0x22, 0xdd,
-	0xf5, 0x81, 0xe6, 0x47, 0xd5, 0xdd, 0x67, 0x00, 0x4b, 0x2e, 0x09, 0x75, 0x60, 0x95, 0xc7, 0x6c,
-	0x36, 0xe7, 0x53, 0xbb, 0x6e, 0x8f, 0x2b, 0x4f, 0x02, 0xa7, 0x5d, 0x6c, 0x25, 0x74, 0x0c, 0x51,
-	0xcc, 0x8c, 0xc8, 0xf8, 0x34, 0x49, 0x79, 0xa8, 0x64, 0x22, 0xe6, 0x5c, 0x37, 0xfc, 0xb6, 0xdf,
-	0xab, 0x4c, 0x6a, 0x6e, 0x32, 0xfe, 0x1d, 0x20, 0x0a, 0xeb, 0xb7, 0x8b, 0x98, 0x49, 0x11, 0xfe,
-	0xe1, 0x8b, 0x96, 0x47, 0xf9, 0x68, 0x67, 0x61, 0x54, 0x2c, 0x17, 0xfe, 0xf9, 0xc3, 0xb3, 0xe5,
-	0x1a, 0x83, 0xd5, 0x1a, 0x83, 0xcf, 0x35, 0x06, 0x4f, 0x1b, 0xec, 0xad, 0x36, 0xd8, 0x7b, 0xdf,
-	0x60, 0xef, 0xe6, 0x30, 0x12, 0xe6, 0xee, 0x61, 0x46, 0x42, 0x25, 0xe9, 0x4e, 0xed, 0x8f, 0x79,
-	0xf1, 0x66, 0x91, 0x70, 0x3d, 0x2b, 0xd9, 0xda, 0x4f, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x59,
-	0x13, 0x2d, 0x6f, 0xfa, 0x01, 0x00, 0x00,
+	// 605 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0xc1, 0x6e, 0xd3, 0x4c,
+	0x10, 0x8e, 0x9b, 0x34, 0x7f, 0xbb, 0x69, 0xa5, 0x76, 0xd3, 0x5f, 0x32, 0xad, 0xe4, 0xa6, 0x45,
+	0x88, 0xa8, 0x52, 0x6d, 0x52, 0xc4, 0x11, 0x10, 0x2d, 0xa8, 0xa8, 0x12, 0x28, 0x0a, 0x9c, 0xb8,
+	0x58, 0x1b, 0x67, 0xea, 0xac, 0x92, 0xdd, 0xb5, 0x76, 0xb6, 0x56, 0xcb, 0x0d, 0xf1, 0x02, 0xbc,
+	0x01, 0x57, 0xc4, 0x89, 0xc7, 0xe8, 0xb1, 0x47, 0x4e, 0x80, 0xda, 0x03, 0xaf, 0x81, 0xbc, 0x76,
+	0xa9, 0x43, 0x7d, 0x49, 0x56, 0xf3, 0x7d, 0xf3, 0xcd, 0xf8, 0x9b, 0x19, 0xb2, 0x15, 0x29, 0x14,
+	0x0a, 0x03, 0x48, 0x45, 0x00, 0x3a, 0xda, 0x7b, 0x10, 0xa4, 0xbd, 0x20, 0x06, 0x09, 0xc8, 0xd1,
+	0x4f, 0xb4, 0x32, 0x8a, 0xb6, 0x73, 0x8a, 0x0f, 0xa9, 0xf0, 0x2d, 0xc5, 0x4f, 0x7b, 0xeb, 0xab,
+	0x4c, 0x70, 0xa9, 0x02, 0xfb, 0x9b, 0xf3, 0xd6, 0xbd, 0x42, 0x6a, 0xc8, 0x10, 0x82, 0xb4, 0x37,
+	0x04, 0xc3, 0x7a, 0x41, 0xa4, 0xb8, 0x2c, 0xf0, 0xb5, 0x58, 0xc5, 0xca, 0x3e, 0x83, 0xec, 0x55,
+	0x44, 0x37, 0xab, 0x1a, 0xc8, 0xcb, 0x58, 0xc2, 0xf6, 0xe7, 0x06, 0x59, 0x3a, 0xcc, 0x1b, 0x7a,
+	0x63, 0x98, 0x01, 0xfa, 0x84, 0x34, 0x13, 0xa6, 0x99, 0x40, 0xd7, 0xe9, 0x38, 0xdd, 0xd6, 0xde,
+	0x86, 0x5f, 0xd1, 0xa0, 0xdf, 0xb7, 0x94, 0xfd, 0xc5, 0xf3, 0x1f, 0x9b, 0xb5, 0x2f, 0xbf, 0xbf,
+	0xed, 0x38, 0x83, 0x22, 0x8b, 0x1e, 0x91, 0x96, 0x51, 0x13, 0x90, 0x61, 0xc2, 0xb8, 0x46, 0x77,
+	0xae, 0x53, 0xef, 0xb6, 0xf6, 0xbc, 0x4a, 0x91, 0xb7, 0x19, 0xaf, 0xcf, 0xb8, 0x2e, 0xeb, 0x10,
+	0x73, 0x1d, 0x45, 0x3a, 0x26, 0xff, 0x6b, 0x88, 0x39, 0x1a, 0xcd, 0x0c, 0x57, 0x32, 0x1c, 0x41,
+	0xa2, 0x90, 0x1b, 0x74, 0xeb, 0x56, 0xb5, 0x5b, 0xa9, 0x3a, 0x28, 0x65, 0x3c, 0xcf, 0x13, 0xca,
+	0xfa, 0x6b, 0xfa, 0x36, 0x8e, 0x34, 0x24, 0x34, 0x52, 0x22, 0x99, 0x72, 0x26, 0x23, 0x08, 0x35,
+	0x24, 0x4a, 0x1b, 0x74, 0x1b, 0xb6, 0xcc, 0xbd, 0xca, 0x32, 0x07, 0x7f, 0xe9, 0x03, 0xcb, 0x2e,
+	0xd7, 0x58, 0x8d, 0xfe, 0x01, 0x91, 0xf6, 0xc9, 0x72, 0x02, 0x5a, 0x70, 0x13, 0x4a, 0x25, 0x23,
+	0x40, 0x77, 0xde, 0x6a, 0x77, 0xaa, 0xdd, 0xb5, 0xcc, 0xd7, 0x19, 0xb1, 0x2c, 0xbb, 0x94, 0xdc,
+	0xc4, 0x91, 0x1e, 0x93, 0xf6, 0x09, 0xc2, 0x28, 0x64, 0x27, 0x66, 0xac, 0x34, 0x7f, 0x6f, 0x3f,
+	0x08, 0xdd, 0xa6, 0xd5, 0xdd, 0xa9, 0x36, 0x5c, 0x33, 0x89, 0xc7, 0xa0, 0x9f, 0x95, 0x53, 0xca,
+	0x15, 0x68, 0xa6, 0x38, 0x83, 0xe2, 0xf6, 0x87, 0x3a, 0x69, 0xe6, 0xe3, 0xa6, 0x5b, 0x64, 0x09,
+	0x24, 0x1b, 0x4e, 0x21, 0xb4, 0x92, 0x76, 0x43, 0x16, 0x06, 0xad, 0x3c, 0xf6, 0x22, 0x0b, 0xd1,
+	0x5d, 0x42, 0x25, 0x33, 0x3c, 0x85, 0x30, 0xd1, 0x90, 0xd9, 0xc0, 0xa7, 0x90, 0xcf, 0x6b, 0x71,
+	0xb0, 0x9a, 0x23, 0xfd, 0x1b, 0x80, 0x06, 0xa4, 0x3d, 0x3a, 0x93, 0x4c, 0xf0, 0x68, 0x86, 0xdf,
+	0xb0, 0x7c, 0x5a, 0x40, 0xe5, 0x84, 0xa7, 0x64, 0xc3, 0xba, 0x80, 0xc8, 0x95, 0x9c, 0x02, 0x62,
+	0x58, 0x9e, 0xa7, 0x3b, 0x6f, 0x3b, 0x5a, 0x9f, 0xa5, 0x94, 0x37, 0x82, 0x7e, 0x74, 0xc8, 0x5a,
+	0xd5, 0x52, 0x15, 0xc6, 0xdd, 0xb9, 0x36, 0x2e, 0xbb, 0x33, 0xbf, 0xb8, 0x33, 0xff, 0x40, 0x71,
+	0xb9, 0xff, 0x28, 0xf3, 0xe9, 0xeb, 0xcf, 0xcd, 0x6e, 0xcc, 0xcd, 0xf8, 0x64, 0xe8, 0x47, 0x4a,
+	0x04, 0xc5, 0x79, 0xe5, 0x7f, 0xbb, 0x38, 0x9a, 0x04, 0xe6, 0x2c, 0x01, 0xb4, 0x09, 0x98, 0x7b,
+	0xda, 0xae, 0x58, 0x38, 0x7a, 0x9f, 0xac, 0x40, 0x2a, 0xc2, 0xb1, 0x52, 0x93, 0x50, 0xb0, 0xd3,
+	0x30, 0x66, 0xe8, 0xfe, 0xd7, 0x71, 0xba, 0x8d, 0xc1, 0x32, 0xa4, 0xe2, 0xa5, 0x52, 0x93, 0x57,
+	0xec, 0xf4, 0x90, 0xe1, 0x51, 0x63, 0x61, 0x6e, 0xa5, 0xbe, 0xff, 0xf8, 0xfc, 0xd2, 0x73, 0x2e,
+	0x2e, 0x3d, 0xe7, 0xd7, 0xa5, 0xe7, 0x7c, 0xba, 0xf2, 0x6a, 0x17, 0x57, 0x5e, 0xed, 0xfb, 0x95,
+	0x57, 0x7b, 0x77, 0xf7, 0x76, 0x33, 0xd9, 0xad, 0x9f, 0x16, 0xd7, 0x6e, 0xbb, 0x19, 0x36, 0xed,
+	0xad, 0x3f, 0xfc, 0x33, 0x00, 0xd9, 0x8d, 0x2b, 0x69, 0x8f, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -200,6 +292,62 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.UsedAuthorizations) > 0 {
+		for iNdEx := len(m.UsedAuthorizations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UsedAuthorizations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.PermitNonces) > 0 {
+		for iNdEx := len(m.PermitNonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PermitNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ComplianceReports) > 0 {
+		for iNdEx := len(m.ComplianceReports) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ComplianceReports[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.RegistrationDeposits) > 0 {
+		for iNdEx := len(m.RegistrationDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RegistrationDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.TokenPairs) > 0 {
 		for iNdEx := len(m.TokenPairs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -247,6 +395,35 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EvmHookMaxGas != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EvmHookMaxGas))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.RegistrationDeposit) > 0 {
+		for iNdEx := len(m.RegistrationDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RegistrationDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.PermissionlessRegistration {
+		i--
+		if m.PermissionlessRegistration {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.DynamicPrecompiles) > 0 {
 		for iNdEx := len(m.DynamicPrecompiles) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.DynamicPrecompiles[iNdEx])
@@ -303,6 +480,30 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.RegistrationDeposits) > 0 {
+		for _, e := range m.RegistrationDeposits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ComplianceReports) > 0 {
+		for _, e := range m.ComplianceReports {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PermitNonces) > 0 {
+		for _, e := range m.PermitNonces {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UsedAuthorizations) > 0 {
+		for _, e := range m.UsedAuthorizations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -327,6 +528,18 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.PermissionlessRegistration {
+		n += 2
+	}
+	if len(m.RegistrationDeposit) > 0 {
+		for _, e := range m.RegistrationDeposit {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.EvmHookMaxGas != 0 {
+		n += 1 + sovGenesis(uint64(m.EvmHookMaxGas))
+	}
 	return n
 }
 
@@ -432,6 +645,142 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationDeposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegistrationDeposits = append(m.RegistrationDeposits, RegistrationDeposit{})
+			if err := m.RegistrationDeposits[len(m.RegistrationDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComplianceReports", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ComplianceReports = append(m.ComplianceReports, ComplianceReport{})
+			if err := m.ComplianceReports[len(m.ComplianceReports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PermitNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PermitNonces = append(m.PermitNonces, PermitNonce{})
+			if err := m.PermitNonces[len(m.PermitNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedAuthorizations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UsedAuthorizations = append(m.UsedAuthorizations, TransferAuthorization{})
+			if err := m.UsedAuthorizations[len(m.UsedAuthorizations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -566,6 +915,79 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.DynamicPrecompiles = append(m.DynamicPrecompiles, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PermissionlessRegistration", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PermissionlessRegistration = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegistrationDeposit = append(m.RegistrationDeposit, types.Coin{})
+			if err := m.RegistrationDeposit[len(m.RegistrationDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmHookMaxGas", wireType)
+			}
+			m.EvmHookMaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EvmHookMaxGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,11 +3,15 @@ package types_test
 import (
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/suite"
 
 	exampleapp "github.com/cosmos/evm/evmd"
 	testconstants "github.com/cosmos/evm/testutil/constants"
+	utiltx "github.com/cosmos/evm/testutil/tx"
 	"github.com/cosmos/evm/x/erc20/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 type GenesisTestSuite struct {
@@ -23,6 +27,8 @@ func TestGenesisTestSuite(t *testing.T) {
 
 func (suite *GenesisTestSuite) TestValidateGenesis() {
 	newGen := types.NewGenesisState(types.DefaultParams(), exampleapp.ExampleTokenPairs)
+	depositor := sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String()
+	token, owner := utiltx.GenerateAddress(), utiltx.GenerateAddress()
 
 	testCases := []struct {
 		name     string
@@ -171,6 +177,181 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			expPass: false,
 		},
+		{
+			name: "valid genesis - with registration deposit",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				TokenPairs: []types.TokenPair{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:        "usdt",
+						Enabled:      true,
+					},
+				},
+				RegistrationDeposits: []types.RegistrationDeposit{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Depositor:    depositor,
+						Amount:       sdk.NewCoins(sdk.NewInt64Coin(testconstants.ExampleAttoDenom, 1000)),
+					},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - registration deposit without token pair",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				RegistrationDeposits: []types.RegistrationDeposit{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Depositor:    depositor,
+						Amount:       sdk.NewCoins(sdk.NewInt64Coin(testconstants.ExampleAttoDenom, 1000)),
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - duplicated registration deposit",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				TokenPairs: []types.TokenPair{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:        "usdt",
+						Enabled:      true,
+					},
+				},
+				RegistrationDeposits: []types.RegistrationDeposit{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Depositor:    depositor,
+					},
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Depositor:    depositor,
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - invalid depositor",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				TokenPairs: []types.TokenPair{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:        "usdt",
+						Enabled:      true,
+					},
+				},
+				RegistrationDeposits: []types.RegistrationDeposit{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Depositor:    "invalid",
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid genesis - with compliance report",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				TokenPairs: []types.TokenPair{
+					{
+						Erc20Address: "0xdAC17F958D2ee523a2206206994597C13D831ec7",
+						Denom:        "usdt",
+						Enabled:      true,
+					},
+				},
+				ComplianceReports: []types.ComplianceReport{
+					types.NewComplianceReport(common.HexToAddress("0xdac17f958d2ee523a2206206994597c13d831ec7"), 1, []types.ComplianceCheck{
+						types.FailedCheck(types.ComplianceCheckTransfer, "transfer returned false"),
+					}),
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - compliance report without token pair",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ComplianceReports: []types.ComplianceReport{
+					types.NewComplianceReport(common.HexToAddress("0xdac17f958d2ee523a2206206994597c13d831ec7"), 1, nil),
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid genesis - with permit nonces and used authorizations",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				PermitNonces: []types.PermitNonce{
+					types.NewPermitNonce(token, owner, 1),
+					types.NewPermitNonce(token, utiltx.GenerateAddress(), 3),
+				},
+				UsedAuthorizations: []types.TransferAuthorization{
+					types.NewTransferAuthorization(token, owner, common.HexToHash("0x01")),
+					types.NewTransferAuthorization(token, owner, common.HexToHash("0x02")),
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - invalid permit nonce owner",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				PermitNonces: []types.PermitNonce{
+					{Erc20Address: token.Hex(), Owner: "invalid", Nonce: 1},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - duplicated permit nonce",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				PermitNonces: []types.PermitNonce{
+					types.NewPermitNonce(token, owner, 1),
+					types.NewPermitNonce(token, owner, 2),
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - invalid authorization nonce",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				UsedAuthorizations: []types.TransferAuthorization{
+					{Erc20Address: token.Hex(), Authorizer: owner.Hex(), Nonce: "0x01"},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - invalid authorizer",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				UsedAuthorizations: []types.TransferAuthorization{
+					{Erc20Address: token.Hex(), Authorizer: "invalid", Nonce: common.HexToHash("0x01").Hex()},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - duplicated used authorization",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				UsedAuthorizations: []types.TransferAuthorization{
+					types.NewTransferAuthorization(token, owner, common.HexToHash("0x01")),
+					types.NewTransferAuthorization(token, owner, common.HexToHash("0x01")),
+				},
+			},
+			expPass: false,
+		},
 		{
 			// Voting period cant be zero
 			name:     "empty genesis",
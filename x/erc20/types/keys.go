@@ -31,6 +31,10 @@ const (
 	prefixTokenPairByERC20
 	prefixTokenPairByDenom
 	prefixSTRv2Addresses
+	prefixPermitNonce
+	prefixTransferAuthorization
+	prefixRegistrationDeposit
+	prefixComplianceReport
 )
 
 // KVStore key prefixes
@@ -39,4 +43,9 @@ var (
 	KeyPrefixTokenPairByERC20 = []byte{prefixTokenPairByERC20}
 	KeyPrefixTokenPairByDenom = []byte{prefixTokenPairByDenom}
 	KeyPrefixSTRv2Addresses   = []byte{prefixSTRv2Addresses}
+
+	KeyPrefixPermitNonce           = []byte{prefixPermitNonce}
+	KeyPrefixTransferAuthorization = []byte{prefixTransferAuthorization}
+	KeyPrefixRegistrationDeposit   = []byte{prefixRegistrationDeposit}
+	KeyPrefixComplianceReport      = []byte{prefixComplianceReport}
 )
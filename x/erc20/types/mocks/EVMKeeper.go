@@ -119,6 +119,66 @@ func (_m *EVMKeeper) CallEVMWithData(ctx types.Context, from common.Address, con
 	return r0, r1
 }
 
+// CallEVMWithDataAndGasCap provides a mock function with given fields: ctx, from, contract, data, commit, gasCap
+func (_m *EVMKeeper) CallEVMWithDataAndGasCap(ctx types.Context, from common.Address, contract *common.Address, data []byte, commit bool, gasCap uint64) (*evmtypes.MsgEthereumTxResponse, error) {
+	ret := _m.Called(ctx, from, contract, data, commit, gasCap)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CallEVMWithDataAndGasCap")
+	}
+
+	var r0 *evmtypes.MsgEthereumTxResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(types.Context, common.Address, *common.Address, []byte, bool, uint64) (*evmtypes.MsgEthereumTxResponse, error)); ok {
+		return rf(ctx, from, contract, data, commit, gasCap)
+	}
+	if rf, ok := ret.Get(0).(func(types.Context, common.Address, *common.Address, []byte, bool, uint64) *evmtypes.MsgEthereumTxResponse); ok {
+		r0 = rf(ctx, from, contract, data, commit, gasCap)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*evmtypes.MsgEthereumTxResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(types.Context, common.Address, *common.Address, []byte, bool, uint64) error); ok {
+		r1 = rf(ctx, from, contract, data, commit, gasCap)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CallEVMWithDataAndGasLimit provides a mock function with given fields: ctx, from, contract, data, commit, gasLimit
+func (_m *EVMKeeper) CallEVMWithDataAndGasLimit(ctx types.Context, from common.Address, contract *common.Address, data []byte, commit bool, gasLimit uint64) (*evmtypes.MsgEthereumTxResponse, error) {
+	ret := _m.Called(ctx, from, contract, data, commit, gasLimit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CallEVMWithDataAndGasLimit")
+	}
+
+	var r0 *evmtypes.MsgEthereumTxResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(types.Context, common.Address, *common.Address, []byte, bool, uint64) (*evmtypes.MsgEthereumTxResponse, error)); ok {
+		return rf(ctx, from, contract, data, commit, gasLimit)
+	}
+	if rf, ok := ret.Get(0).(func(types.Context, common.Address, *common.Address, []byte, bool, uint64) *evmtypes.MsgEthereumTxResponse); ok {
+		r0 = rf(ctx, from, contract, data, commit, gasLimit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*evmtypes.MsgEthereumTxResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(types.Context, common.Address, *common.Address, []byte, bool, uint64) error); ok {
+		r1 = rf(ctx, from, contract, data, commit, gasLimit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteAccount provides a mock function with given fields: ctx, addr
 func (_m *EVMKeeper) DeleteAccount(ctx types.Context, addr common.Address) error {
 	ret := _m.Called(ctx, addr)
@@ -245,6 +305,26 @@ func (_m *EVMKeeper) GetParams(ctx types.Context) evmtypes.Params {
 	return r0
 }
 
+// GetState provides a mock function with given fields: ctx, addr, key
+func (_m *EVMKeeper) GetState(ctx types.Context, addr common.Address, key common.Hash) common.Hash {
+	ret := _m.Called(ctx, addr, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetState")
+	}
+
+	var r0 common.Hash
+	if rf, ok := ret.Get(0).(func(types.Context, common.Address, common.Hash) common.Hash); ok {
+		r0 = rf(ctx, addr, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(common.Hash)
+		}
+	}
+
+	return r0
+}
+
 // IsAvailableStaticPrecompile provides a mock function with given fields: params, address
 func (_m *EVMKeeper) IsAvailableStaticPrecompile(params *evmtypes.Params, address common.Address) bool {
 	ret := _m.Called(params, address)
@@ -286,6 +366,11 @@ func (_m *EVMKeeper) SetCode(ctx types.Context, hash []byte, bytecode []byte) {
 	_m.Called(ctx, hash, bytecode)
 }
 
+// SetState provides a mock function with given fields: ctx, addr, key, value
+func (_m *EVMKeeper) SetState(ctx types.Context, addr common.Address, key common.Hash, value []byte) {
+	_m.Called(ctx, addr, key, value)
+}
+
 // NewEVMKeeper creates a new instance of EVMKeeper. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewEVMKeeper(t interface {
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"strings"
+
 	"github.com/ethereum/go-ethereum/common"
 	protov2 "google.golang.org/protobuf/proto"
 
@@ -19,10 +21,18 @@ var (
 	_ sdk.Msg              = &MsgUpdateParams{}
 	_ sdk.Msg              = &MsgRegisterERC20{}
 	_ sdk.Msg              = &MsgToggleConversion{}
+	_ sdk.Msg              = &MsgRegisterERC20WithDeposit{}
+	_ sdk.Msg              = &MsgDelistERC20{}
+	_ sdk.Msg              = &MsgRedeemCoin{}
+	_ sdk.Msg              = &MsgReleaseRegistrationDeposit{}
 	_ sdk.HasValidateBasic = &MsgConvertERC20{}
 	_ sdk.HasValidateBasic = &MsgUpdateParams{}
 	_ sdk.HasValidateBasic = &MsgRegisterERC20{}
 	_ sdk.HasValidateBasic = &MsgToggleConversion{}
+	_ sdk.HasValidateBasic = &MsgRegisterERC20WithDeposit{}
+	_ sdk.HasValidateBasic = &MsgDelistERC20{}
+	_ sdk.HasValidateBasic = &MsgRedeemCoin{}
+	_ sdk.HasValidateBasic = &MsgReleaseRegistrationDeposit{}
 )
 
 const (
@@ -105,3 +115,55 @@ func (m *MsgToggleConversion) ValidateBasic() error {
 
 	return nil
 }
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgRegisterERC20WithDeposit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	if !common.IsHexAddress(m.Erc20Address) {
+		return errortypes.ErrInvalidAddress.Wrapf("invalid ERC20 contract address: %s", m.Erc20Address)
+	}
+	return nil
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgDelistERC20) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	if strings.TrimSpace(m.Token) == "" {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "token cannot be empty")
+	}
+	return nil
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgRedeemCoin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	if !m.Coin.IsValid() || !m.Coin.IsPositive() {
+		return errorsmod.Wrapf(errortypes.ErrInvalidCoins, "invalid coin %s", m.Coin)
+	}
+
+	if !common.IsHexAddress(m.Receiver) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid receiver hex address %s", m.Receiver)
+	}
+	return nil
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgReleaseRegistrationDeposit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	if strings.TrimSpace(m.Token) == "" {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "token cannot be empty")
+	}
+	return nil
+}
@@ -166,3 +166,193 @@ func (suite *MsgsTestSuite) TestMsgUpdateValidateBasic() {
 		})
 	}
 }
+
+func (suite *MsgsTestSuite) TestMsgRegisterERC20WithDepositValidateBasic() {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgRegisterERC20WithDeposit
+		expPass bool
+	}{
+		{
+			"fail - invalid sender address",
+			&types.MsgRegisterERC20WithDeposit{
+				Sender:       "invalid",
+				Erc20Address: utiltx.GenerateAddress().String(),
+			},
+			false,
+		},
+		{
+			"fail - invalid ERC20 address",
+			&types.MsgRegisterERC20WithDeposit{
+				Sender:       sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
+				Erc20Address: "0xinvalid",
+			},
+			false,
+		},
+		{
+			"pass - valid msg",
+			&types.MsgRegisterERC20WithDeposit{
+				Sender:       sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
+				Erc20Address: utiltx.GenerateAddress().String(),
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgDelistERC20ValidateBasic() {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgDelistERC20
+		expPass bool
+	}{
+		{
+			"fail - invalid authority address",
+			&types.MsgDelistERC20{
+				Authority: "invalid",
+				Token:     utiltx.GenerateAddress().String(),
+			},
+			false,
+		},
+		{
+			"fail - empty token",
+			&types.MsgDelistERC20{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+			},
+			false,
+		},
+		{
+			"pass - valid msg",
+			&types.MsgDelistERC20{
+				Authority:   authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Token:       utiltx.GenerateAddress().String(),
+				BurnDeposit: true,
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgRedeemCoinValidateBasic() {
+	sender := sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String()
+	receiver := utiltx.GenerateAddress().String()
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgRedeemCoin
+		expPass bool
+	}{
+		{
+			"fail - invalid sender address",
+			&types.MsgRedeemCoin{
+				Sender:   "invalid",
+				Coin:     sdk.NewCoin("erc20/test", math.NewInt(100)),
+				Receiver: receiver,
+			},
+			false,
+		},
+		{
+			"fail - zero amount",
+			&types.MsgRedeemCoin{
+				Sender:   sender,
+				Coin:     sdk.NewCoin("erc20/test", math.ZeroInt()),
+				Receiver: receiver,
+			},
+			false,
+		},
+		{
+			"fail - invalid receiver address",
+			&types.MsgRedeemCoin{
+				Sender:   sender,
+				Coin:     sdk.NewCoin("erc20/test", math.NewInt(100)),
+				Receiver: "0x0000",
+			},
+			false,
+		},
+		{
+			"pass - valid msg",
+			&types.MsgRedeemCoin{
+				Sender:   sender,
+				Coin:     sdk.NewCoin("erc20/test", math.NewInt(100)),
+				Receiver: receiver,
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgReleaseRegistrationDepositValidateBasic() {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgReleaseRegistrationDeposit
+		expPass bool
+	}{
+		{
+			"fail - invalid authority address",
+			&types.MsgReleaseRegistrationDeposit{
+				Authority: "invalid",
+				Token:     utiltx.GenerateAddress().String(),
+			},
+			false,
+		},
+		{
+			"fail - empty token",
+			&types.MsgReleaseRegistrationDeposit{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+			},
+			false,
+		},
+		{
+			"pass - valid msg",
+			&types.MsgReleaseRegistrationDeposit{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Token:     utiltx.GenerateAddress().String(),
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
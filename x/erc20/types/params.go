@@ -15,8 +15,16 @@ var (
 	ParamStoreKeyEnableErc20        = []byte("EnableErc20")
 	ParamStoreKeyDynamicPrecompiles = []byte("DynamicPrecompiles")
 	ParamStoreKeyNativePrecompiles  = []byte("NativePrecompiles")
+
+	ParamStoreKeyPermissionlessRegistration = []byte("PermissionlessRegistration")
+	ParamStoreKeyRegistrationDeposit        = []byte("RegistrationDeposit")
+	ParamStoreKeyEVMHookMaxGas              = []byte("EVMHookMaxGas")
 )
 
+// DefaultEVMHookMaxGas is the default maximum gas of the EVM hook of a received
+// ICS20 packet.
+const DefaultEVMHookMaxGas uint64 = 1_000_000
+
 var (
 	// NOTE: We strongly recommend to use the canonical address for the ERC-20 representation
 	// of the chain's native denomination as defined by
@@ -47,6 +55,7 @@ func DefaultParams() Params {
 		EnableErc20:        true,
 		NativePrecompiles:  DefaultNativePrecompiles,
 		DynamicPrecompiles: DefaultDynamicPrecompiles,
+		EvmHookMaxGas:      DefaultEVMHookMaxGas,
 	}
 }
 
@@ -64,6 +73,14 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := ValidateBool(p.PermissionlessRegistration); err != nil {
+		return err
+	}
+
+	if err := p.RegistrationDeposit.Validate(); err != nil {
+		return fmt.Errorf("invalid registration deposit: %w", err)
+	}
+
 	npAddrs, err := ValidatePrecompiles(p.NativePrecompiles)
 	if err != nil {
 		return err
@@ -11,6 +11,10 @@ import (
 	exampleapp "github.com/cosmos/evm/evmd"
 	testconstants "github.com/cosmos/evm/testutil/constants"
 	"github.com/cosmos/evm/x/erc20/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 type ParamsTestSuite struct {
@@ -138,6 +142,27 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 			true,
 			"precompiles need to be sorted",
 		},
+		{
+			"valid registration deposit",
+			func() types.Params {
+				params := types.DefaultParams()
+				params.PermissionlessRegistration = true
+				params.RegistrationDeposit = sdk.NewCoins(sdk.NewInt64Coin("aatom", 1000))
+				return params
+			},
+			false,
+			"",
+		},
+		{
+			"invalid registration deposit",
+			func() types.Params {
+				params := types.DefaultParams()
+				params.RegistrationDeposit = sdk.Coins{sdk.Coin{Denom: "aatom", Amount: math.NewInt(-1)}}
+				return params
+			},
+			true,
+			"invalid registration deposit",
+		},
 	}
 
 	for _, tc := range testCases {
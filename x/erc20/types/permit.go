@@ -0,0 +1,70 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	cosmosevmtypes "github.com/cosmos/evm/types"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// NewPermitNonce returns an instance of PermitNonce
+func NewPermitNonce(token, owner common.Address, nonce uint64) PermitNonce {
+	return PermitNonce{
+		Erc20Address: token.String(),
+		Owner:        owner.String(),
+		Nonce:        nonce,
+	}
+}
+
+// Validate performs a stateless validation of a PermitNonce
+func (pn PermitNonce) Validate() error {
+	if err := cosmosevmtypes.ValidateAddress(pn.Erc20Address); err != nil {
+		return err
+	}
+
+	if err := cosmosevmtypes.ValidateAddress(pn.Owner); err != nil {
+		return errorsmod.Wrap(err, "invalid owner address")
+	}
+
+	return nil
+}
+
+// NewTransferAuthorization returns an instance of TransferAuthorization
+func NewTransferAuthorization(token, authorizer common.Address, nonce common.Hash) TransferAuthorization {
+	return TransferAuthorization{
+		Erc20Address: token.String(),
+		Authorizer:   authorizer.String(),
+		Nonce:        nonce.Hex(),
+	}
+}
+
+// GetNonceHash casts the hex string nonce of the authorization to common.Hash
+func (ta TransferAuthorization) GetNonceHash() common.Hash {
+	return common.HexToHash(ta.Nonce)
+}
+
+// Validate performs a stateless validation of a TransferAuthorization
+func (ta TransferAuthorization) Validate() error {
+	if err := cosmosevmtypes.ValidateAddress(ta.Erc20Address); err != nil {
+		return err
+	}
+
+	if err := cosmosevmtypes.ValidateAddress(ta.Authorizer); err != nil {
+		return errorsmod.Wrap(err, "invalid authorizer address")
+	}
+
+	nonce, err := hexutil.Decode(ta.Nonce)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid authorization nonce")
+	}
+
+	if len(nonce) != common.HashLength {
+		return fmt.Errorf("invalid authorization nonce length, expected %d bytes, got %d", common.HashLength, len(nonce))
+	}
+
+	return nil
+}
@@ -229,6 +229,101 @@ func (m *QueryTokenPairResponse) GetTokenPair() TokenPair {
 	return TokenPair{}
 }
 
+// QueryComplianceReportRequest is the request type for the
+// Query/ComplianceReport RPC method.
+type QueryComplianceReportRequest struct {
+	// token identifier can be either the hex contract address of the ERC20 or the
+	// Cosmos base denomination
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (m *QueryComplianceReportRequest) Reset()         { *m = QueryComplianceReportRequest{} }
+func (m *QueryComplianceReportRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryComplianceReportRequest) ProtoMessage()    {}
+func (*QueryComplianceReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1630a6677a16bf4, []int{4}
+}
+func (m *QueryComplianceReportRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryComplianceReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryComplianceReportRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryComplianceReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryComplianceReportRequest.Merge(m, src)
+}
+func (m *QueryComplianceReportRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryComplianceReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryComplianceReportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryComplianceReportRequest proto.InternalMessageInfo
+
+func (m *QueryComplianceReportRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+// QueryComplianceReportResponse is the response type for the
+// Query/ComplianceReport RPC method.
+type QueryComplianceReportResponse struct {
+	// report is the ERC20 compliance report of the token pair
+	Report ComplianceReport `protobuf:"bytes,1,opt,name=report,proto3" json:"report"`
+}
+
+func (m *QueryComplianceReportResponse) Reset()         { *m = QueryComplianceReportResponse{} }
+func (m *QueryComplianceReportResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryComplianceReportResponse) ProtoMessage()    {}
+func (*QueryComplianceReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1630a6677a16bf4, []int{5}
+}
+func (m *QueryComplianceReportResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryComplianceReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryComplianceReportResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryComplianceReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryComplianceReportResponse.Merge(m, src)
+}
+func (m *QueryComplianceReportResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryComplianceReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryComplianceReportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryComplianceReportResponse proto.InternalMessageInfo
+
+func (m *QueryComplianceReportResponse) GetReport() ComplianceReport {
+	if m != nil {
+		return m.Report
+	}
+	return ComplianceReport{}
+}
+
 // QueryParamsRequest is the request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -237,7 +332,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f1630a6677a16bf4, []int{4}
+	return fileDescriptor_f1630a6677a16bf4, []int{6}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -277,7 +372,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f1630a6677a16bf4, []int{5}
+	return fileDescriptor_f1630a6677a16bf4, []int{7}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -318,6 +413,8 @@ func init() {
 	proto.RegisterType((*QueryTokenPairsResponse)(nil), "cosmos.evm.erc20.v1.QueryTokenPairsResponse")
 	proto.RegisterType((*QueryTokenPairRequest)(nil), "cosmos.evm.erc20.v1.QueryTokenPairRequest")
 	proto.RegisterType((*QueryTokenPairResponse)(nil), "cosmos.evm.erc20.v1.QueryTokenPairResponse")
+	proto.RegisterType((*QueryComplianceReportRequest)(nil), "cosmos.evm.erc20.v1.QueryComplianceReportRequest")
+	proto.RegisterType((*QueryComplianceReportResponse)(nil), "cosmos.evm.erc20.v1.QueryComplianceReportResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.evm.erc20.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.evm.erc20.v1.QueryParamsResponse")
 }
@@ -325,40 +422,45 @@ func init() {
 func init() { proto.RegisterFile("cosmos/evm/erc20/v1/query.proto", fileDescriptor_f1630a6677a16bf4) }
 
 var fileDescriptor_f1630a6677a16bf4 = []byte{
-	// 525 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x41, 0x8b, 0xd3, 0x40,
-	0x14, 0xc7, 0x3b, 0xbb, 0x6c, 0xa1, 0xaf, 0x27, 0x67, 0xab, 0x2e, 0x5d, 0xcd, 0xd6, 0x2c, 0xb8,
-	0xa1, 0xab, 0x33, 0xdb, 0x7a, 0xd6, 0xc3, 0x1e, 0x54, 0x3c, 0xd5, 0xa2, 0x17, 0x2f, 0x3a, 0x29,
-	0x43, 0x0c, 0x9a, 0x4c, 0x36, 0x33, 0x0d, 0x2e, 0x22, 0x88, 0x9f, 0x40, 0x11, 0xfc, 0x0c, 0x9e,
-	0xc4, 0x9b, 0x5f, 0x61, 0x8f, 0x05, 0x2f, 0x9e, 0x44, 0x5a, 0xc1, 0xaf, 0x21, 0x99, 0x99, 0xa6,
-	0x8d, 0x46, 0x5a, 0x2f, 0x25, 0x79, 0xfd, 0xbf, 0xff, 0xff, 0xf7, 0xde, 0x0c, 0x81, 0xbd, 0x91,
-	0x90, 0x91, 0x90, 0x94, 0x67, 0x11, 0xe5, 0xe9, 0xa8, 0x7f, 0x44, 0xb3, 0x1e, 0x3d, 0x19, 0xf3,
-	0xf4, 0x94, 0x24, 0xa9, 0x50, 0x02, 0x6f, 0x1b, 0x01, 0xe1, 0x59, 0x44, 0xb4, 0x80, 0x64, 0xbd,
-	0xf6, 0x39, 0x16, 0x85, 0xb1, 0xa0, 0xfa, 0xd7, 0xe8, 0xda, 0x5d, 0x6b, 0xe4, 0x33, 0xc9, 0x8d,
-	0x01, 0xcd, 0x7a, 0x3e, 0x57, 0xac, 0x47, 0x13, 0x16, 0x84, 0x31, 0x53, 0xa1, 0x88, 0xad, 0xb6,
-	0x15, 0x88, 0x40, 0xe8, 0x47, 0x9a, 0x3f, 0xd9, 0xea, 0xa5, 0x40, 0x88, 0xe0, 0x39, 0xa7, 0x2c,
-	0x09, 0x29, 0x8b, 0x63, 0xa1, 0x74, 0x8b, 0xb4, 0xff, 0x56, 0x82, 0x1a, 0x20, 0x23, 0xb8, 0x52,
-	0x25, 0x08, 0x78, 0xcc, 0x65, 0x68, 0x3d, 0xdc, 0x27, 0x70, 0xe1, 0x7e, 0x4e, 0xf6, 0x40, 0x3c,
-	0xe3, 0xf1, 0x80, 0x85, 0xa9, 0x1c, 0xf2, 0x93, 0x31, 0x97, 0x0a, 0xdf, 0x06, 0x58, 0x50, 0xee,
-	0xa0, 0x0e, 0xf2, 0x9a, 0xfd, 0xab, 0xc4, 0x8e, 0x9e, 0x8f, 0x44, 0xcc, 0x4e, 0xec, 0x48, 0x64,
-	0xc0, 0x02, 0x6e, 0x7b, 0x87, 0x4b, 0x9d, 0xee, 0x27, 0x04, 0x17, 0xff, 0x8a, 0x90, 0x89, 0x88,
-	0x25, 0xc7, 0xf7, 0xa0, 0xa9, 0xf2, 0xea, 0xe3, 0x24, 0x2f, 0xef, 0xa0, 0xce, 0xa6, 0xd7, 0xec,
-	0x3b, 0xa4, 0x62, 0xbf, 0xa4, 0xe8, 0x3e, 0x6e, 0x9c, 0x7d, 0xdf, 0xab, 0x7d, 0xfc, 0xf5, 0xb9,
-	0x8b, 0x86, 0xa0, 0x0a, 0x4f, 0x7c, 0xa7, 0xc4, 0xbb, 0xa1, 0x79, 0x0f, 0x56, 0xf2, 0x1a, 0x90,
-	0x12, 0xf0, 0x75, 0x38, 0x5f, 0xe6, 0x9d, 0x6f, 0xa4, 0x05, 0x5b, 0x3a, 0x4f, 0x2f, 0xa3, 0x31,
-	0x34, 0x2f, 0xae, 0xff, 0xe7, 0x06, 0x8b, 0xe9, 0xee, 0x02, 0x2c, 0xa6, 0xb3, 0x1b, 0xfc, 0x8f,
-	0xe1, 0x1a, 0xc5, 0x70, 0x6e, 0x0b, 0xb0, 0xce, 0x18, 0xb0, 0x94, 0x45, 0xf3, 0x13, 0x72, 0x1f,
-	0xc2, 0x76, 0xa9, 0x6a, 0x63, 0x6f, 0x41, 0x3d, 0xd1, 0x15, 0x1b, 0xb9, 0x5b, 0x19, 0x69, 0x9a,
-	0x96, 0xf3, 0x6c, 0x57, 0xff, 0xcb, 0x26, 0x6c, 0x69, 0x5f, 0xfc, 0x0e, 0x01, 0x2c, 0x4e, 0x0d,
-	0x1f, 0x56, 0x1a, 0x55, 0x5f, 0x9f, 0xf6, 0xb5, 0xf5, 0xc4, 0x86, 0xd9, 0xf5, 0xde, 0x7c, 0xfd,
-	0xf9, 0x7e, 0xc3, 0xc5, 0x1d, 0x5a, 0x75, 0x65, 0x97, 0xee, 0x08, 0xfe, 0x80, 0xa0, 0x51, 0x18,
-	0xe0, 0xee, 0x1a, 0x29, 0x73, 0xa2, 0xc3, 0xb5, 0xb4, 0x16, 0xe8, 0x48, 0x03, 0x75, 0xb1, 0xb7,
-	0x0a, 0x88, 0xbe, 0xd4, 0x2f, 0xaf, 0xf0, 0x6b, 0x04, 0x75, 0xb3, 0x54, 0x7c, 0xf0, 0xef, 0xa4,
-	0xd2, 0x09, 0xb6, 0xbd, 0xd5, 0x42, 0xcb, 0xb3, 0xaf, 0x79, 0x2e, 0xe3, 0xdd, 0x4a, 0x1e, 0x73,
-	0x72, 0xc7, 0x37, 0xcf, 0xa6, 0x0e, 0x9a, 0x4c, 0x1d, 0xf4, 0x63, 0xea, 0xa0, 0xb7, 0x33, 0xa7,
-	0x36, 0x99, 0x39, 0xb5, 0x6f, 0x33, 0xa7, 0xf6, 0x68, 0x3f, 0x08, 0xd5, 0xd3, 0xb1, 0x4f, 0x46,
-	0x22, 0x5a, 0x36, 0x78, 0x61, 0x2d, 0xd4, 0x69, 0xc2, 0xa5, 0x5f, 0xd7, 0x9f, 0x84, 0x1b, 0xbf,
-	0x03, 0x00, 0x00, 0xff, 0xff, 0xc3, 0x69, 0xda, 0x20, 0x01, 0x05, 0x00, 0x00,
+	// 598 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x41, 0x6b, 0x13, 0x41,
+	0x14, 0xc7, 0x33, 0xd5, 0x06, 0xf2, 0x72, 0xd1, 0x69, 0xd4, 0x92, 0xb6, 0xdb, 0xb8, 0x45, 0x1b,
+	0x52, 0xdd, 0xe9, 0x46, 0xc1, 0x93, 0x1e, 0x2a, 0xa8, 0x78, 0x8a, 0x41, 0x2f, 0x5e, 0xea, 0x24,
+	0x0c, 0xeb, 0x62, 0x77, 0x67, 0xbb, 0x3b, 0x09, 0x16, 0x11, 0xc4, 0x4f, 0xa0, 0x08, 0x7e, 0x06,
+	0x4f, 0x22, 0x7e, 0x8a, 0x1e, 0x0b, 0x5e, 0x3c, 0x89, 0x24, 0x82, 0x17, 0x3f, 0x84, 0xec, 0xcc,
+	0x64, 0x93, 0x8d, 0x13, 0x93, 0x5e, 0xc2, 0xcc, 0xcb, 0xfb, 0xbf, 0xff, 0xef, 0xbd, 0x7d, 0xbb,
+	0xb0, 0xd9, 0xe5, 0x49, 0xc0, 0x13, 0xc2, 0xfa, 0x01, 0x61, 0x71, 0xb7, 0xb9, 0x4b, 0xfa, 0x2e,
+	0x39, 0xec, 0xb1, 0xf8, 0xc8, 0x89, 0x62, 0x2e, 0x38, 0x5e, 0x51, 0x09, 0x0e, 0xeb, 0x07, 0x8e,
+	0x4c, 0x70, 0xfa, 0x6e, 0xf5, 0x3c, 0x0d, 0xfc, 0x90, 0x13, 0xf9, 0xab, 0xf2, 0xaa, 0x0d, 0x5d,
+	0xa8, 0x43, 0x13, 0xa6, 0x0a, 0x90, 0xbe, 0xdb, 0x61, 0x82, 0xba, 0x24, 0xa2, 0x9e, 0x1f, 0x52,
+	0xe1, 0xf3, 0x50, 0xe7, 0x56, 0x3c, 0xee, 0x71, 0x79, 0x24, 0xe9, 0x49, 0x47, 0xd7, 0x3d, 0xce,
+	0xbd, 0x03, 0x46, 0x68, 0xe4, 0x13, 0x1a, 0x86, 0x5c, 0x48, 0x49, 0xa2, 0xff, 0x35, 0x82, 0xca,
+	0x83, 0x4e, 0xb8, 0x6c, 0x4a, 0xf0, 0x58, 0xc8, 0x12, 0x5f, 0xd7, 0xb0, 0x9f, 0xc1, 0xc5, 0x47,
+	0x29, 0xd9, 0x63, 0xfe, 0x82, 0x85, 0x2d, 0xea, 0xc7, 0x49, 0x9b, 0x1d, 0xf6, 0x58, 0x22, 0xf0,
+	0x3d, 0x80, 0x31, 0xe5, 0x2a, 0xaa, 0xa1, 0x7a, 0xb9, 0x79, 0xd5, 0xd1, 0xad, 0xa7, 0x2d, 0x39,
+	0x6a, 0x26, 0xba, 0x25, 0xa7, 0x45, 0x3d, 0xa6, 0xb5, 0xed, 0x09, 0xa5, 0xfd, 0x19, 0xc1, 0xa5,
+	0x7f, 0x2c, 0x92, 0x88, 0x87, 0x09, 0xc3, 0x0f, 0xa1, 0x2c, 0xd2, 0xe8, 0x7e, 0x94, 0x86, 0x57,
+	0x51, 0xed, 0x4c, 0xbd, 0xdc, 0xb4, 0x1c, 0xc3, 0x7c, 0x9d, 0x4c, 0xbd, 0x57, 0x3a, 0xfe, 0xb1,
+	0x59, 0xf8, 0xf4, 0xfb, 0x4b, 0x03, 0xb5, 0x41, 0x64, 0x35, 0xf1, 0xfd, 0x1c, 0xef, 0x92, 0xe4,
+	0xdd, 0x9e, 0xcb, 0xab, 0x40, 0x72, 0xc0, 0xd7, 0xe1, 0x42, 0x9e, 0x77, 0x34, 0x91, 0x0a, 0x2c,
+	0x4b, 0x3f, 0x39, 0x8c, 0x52, 0x5b, 0x5d, 0xec, 0xce, 0xf4, 0x04, 0xb3, 0xee, 0x1e, 0x00, 0x8c,
+	0xbb, 0xd3, 0x13, 0x3c, 0x45, 0x73, 0xa5, 0xac, 0x39, 0xfb, 0x26, 0xac, 0x4b, 0x8f, 0xbb, 0x3c,
+	0x88, 0x0e, 0x7c, 0x1a, 0x76, 0x59, 0x9b, 0x45, 0x3c, 0x16, 0xff, 0x27, 0xf3, 0x61, 0x63, 0x86,
+	0x2a, 0x03, 0x2c, 0xc6, 0x32, 0xa2, 0xe1, 0xae, 0x18, 0xe1, 0xa6, 0xe5, 0x93, 0x8c, 0x5a, 0x6f,
+	0x57, 0x00, 0x4b, 0xab, 0x16, 0x8d, 0x69, 0x30, 0x5a, 0x21, 0xfb, 0x09, 0xac, 0xe4, 0xa2, 0xda,
+	0xf6, 0x0e, 0x14, 0x23, 0x19, 0xd1, 0xb6, 0x6b, 0x46, 0x5b, 0x25, 0xca, 0x99, 0x29, 0x55, 0xf3,
+	0xcf, 0x59, 0x58, 0x96, 0x75, 0xf1, 0x7b, 0x04, 0x30, 0x5e, 0x2b, 0xbc, 0x63, 0x2c, 0x64, 0xde,
+	0xef, 0xea, 0xb5, 0xc5, 0x92, 0x15, 0xb3, 0x5d, 0x7f, 0xfb, 0xed, 0xd7, 0x87, 0x25, 0x1b, 0xd7,
+	0x88, 0xe9, 0x9d, 0x9a, 0x58, 0x62, 0xfc, 0x11, 0x41, 0x29, 0x2b, 0x80, 0x1b, 0x0b, 0xb8, 0x8c,
+	0x88, 0x76, 0x16, 0xca, 0xd5, 0x40, 0xbb, 0x12, 0xa8, 0x81, 0xeb, 0xf3, 0x80, 0xc8, 0x2b, 0x79,
+	0x79, 0x8d, 0xbf, 0x22, 0x38, 0x37, 0xfd, 0x2c, 0xb1, 0x3b, 0xdb, 0x73, 0xc6, 0xb2, 0x55, 0x9b,
+	0xa7, 0x91, 0x68, 0xda, 0x5b, 0x92, 0xd6, 0xc5, 0xc4, 0x48, 0xdb, 0xcd, 0x64, 0xfb, 0x6a, 0x9f,
+	0xc6, 0xd0, 0x6f, 0x10, 0x14, 0xd5, 0x26, 0xe0, 0xed, 0xd9, 0xbe, 0xb9, 0xb5, 0xab, 0xd6, 0xe7,
+	0x27, 0x6a, 0xac, 0x2d, 0x89, 0xb5, 0x81, 0xd7, 0x8c, 0x58, 0x6a, 0xdd, 0xf6, 0x6e, 0x1f, 0x0f,
+	0x2c, 0x74, 0x32, 0xb0, 0xd0, 0xcf, 0x81, 0x85, 0xde, 0x0d, 0xad, 0xc2, 0xc9, 0xd0, 0x2a, 0x7c,
+	0x1f, 0x5a, 0x85, 0xa7, 0x5b, 0x9e, 0x2f, 0x9e, 0xf7, 0x3a, 0x4e, 0x97, 0x07, 0x93, 0x05, 0x5e,
+	0xea, 0x12, 0xe2, 0x28, 0x62, 0x49, 0xa7, 0x28, 0x3f, 0xb4, 0x37, 0xfe, 0x0e, 0x00, 0xcf, 0xa7,
+	0x08, 0x7d, 0x57, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -377,6 +479,9 @@ type QueryClient interface {
 	TokenPairs(ctx context.Context, in *QueryTokenPairsRequest, opts ...grpc.CallOption) (*QueryTokenPairsResponse, error)
 	// TokenPair retrieves a registered token pair
 	TokenPair(ctx context.Context, in *QueryTokenPairRequest, opts ...grpc.CallOption) (*QueryTokenPairResponse, error)
+	// ComplianceReport retrieves the ERC20 compliance report recorded when
+	// registering a token pair
+	ComplianceReport(ctx context.Context, in *QueryComplianceReportRequest, opts ...grpc.CallOption) (*QueryComplianceReportResponse, error)
 	// Params retrieves the erc20 module params
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -407,6 +512,15 @@ func (c *queryClient) TokenPair(ctx context.Context, in *QueryTokenPairRequest,
 	return out, nil
 }
 
+func (c *queryClient) ComplianceReport(ctx context.Context, in *QueryComplianceReportRequest, opts ...grpc.CallOption) (*QueryComplianceReportResponse, error) {
+	out := new(QueryComplianceReportResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.erc20.v1.Query/ComplianceReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.evm.erc20.v1.Query/Params", in, out, opts...)
@@ -422,6 +536,9 @@ type QueryServer interface {
 	TokenPairs(context.Context, *QueryTokenPairsRequest) (*QueryTokenPairsResponse, error)
 	// TokenPair retrieves a registered token pair
 	TokenPair(context.Context, *QueryTokenPairRequest) (*QueryTokenPairResponse, error)
+	// ComplianceReport retrieves the ERC20 compliance report recorded when
+	// registering a token pair
+	ComplianceReport(context.Context, *QueryComplianceReportRequest) (*QueryComplianceReportResponse, error)
 	// Params retrieves the erc20 module params
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
@@ -436,6 +553,9 @@ func (*UnimplementedQueryServer) TokenPairs(ctx context.Context, req *QueryToken
 func (*UnimplementedQueryServer) TokenPair(ctx context.Context, req *QueryTokenPairRequest) (*QueryTokenPairResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TokenPair not implemented")
 }
+func (*UnimplementedQueryServer) ComplianceReport(ctx context.Context, req *QueryComplianceReportRequest) (*QueryComplianceReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ComplianceReport not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -480,6 +600,24 @@ func _Query_TokenPair_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ComplianceReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryComplianceReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ComplianceReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.erc20.v1.Query/ComplianceReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ComplianceReport(ctx, req.(*QueryComplianceReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -498,6 +636,7 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.evm.erc20.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -510,6 +649,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TokenPair",
 			Handler:    _Query_TokenPair_Handler,
 		},
+		{
+			MethodName: "ComplianceReport",
+			Handler:    _Query_ComplianceReport_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -666,6 +809,69 @@ func (m *QueryTokenPairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryComplianceReportRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryComplianceReportRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryComplianceReportRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryComplianceReportResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryComplianceReportResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryComplianceReportResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Report.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -789,6 +995,30 @@ func (m *QueryTokenPairResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryComplianceReportRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryComplianceReportResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Report.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *QueryParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1186,6 +1416,171 @@ func (m *QueryTokenPairResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryComplianceReportRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryComplianceReportRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryComplianceReportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryComplianceReportResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryComplianceReportResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryComplianceReportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Report", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Report.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
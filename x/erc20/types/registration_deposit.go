@@ -0,0 +1,38 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	cosmosevmtypes "github.com/cosmos/evm/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewRegistrationDeposit returns an instance of RegistrationDeposit
+func NewRegistrationDeposit(erc20Address common.Address, depositor sdk.AccAddress, amount sdk.Coins) RegistrationDeposit {
+	return RegistrationDeposit{
+		Erc20Address: erc20Address.String(),
+		Depositor:    depositor.String(),
+		Amount:       amount,
+	}
+}
+
+// GetERC20Contract casts the hex string address of the ERC20 to common.Address
+func (rd RegistrationDeposit) GetERC20Contract() common.Address {
+	return common.HexToAddress(rd.Erc20Address)
+}
+
+// Validate performs a stateless validation of a RegistrationDeposit
+func (rd RegistrationDeposit) Validate() error {
+	if err := cosmosevmtypes.ValidateAddress(rd.Erc20Address); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(rd.Depositor); err != nil {
+		return errorsmod.Wrap(err, "invalid depositor address")
+	}
+
+	return rd.Amount.Validate()
+}
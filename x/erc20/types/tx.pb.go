@@ -527,6 +527,403 @@ func (m *MsgToggleConversionResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgToggleConversionResponse proto.InternalMessageInfo
 
+// MsgRegisterERC20WithDeposit is the Msg/RegisterERC20WithDeposit request type
+// for registering an Erc20 contract token pair without governance.
+type MsgRegisterERC20WithDeposit struct {
+	// sender is the bech32 address of the account that locks the registration
+	// deposit
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// erc20_address is the ERC20 token contract hex address
+	Erc20Address string `protobuf:"bytes,2,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
+}
+
+func (m *MsgRegisterERC20WithDeposit) Reset()         { *m = MsgRegisterERC20WithDeposit{} }
+func (m *MsgRegisterERC20WithDeposit) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterERC20WithDeposit) ProtoMessage()    {}
+func (*MsgRegisterERC20WithDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e06c8e6992ada536, []int{10}
+}
+func (m *MsgRegisterERC20WithDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterERC20WithDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterERC20WithDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterERC20WithDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterERC20WithDeposit.Merge(m, src)
+}
+func (m *MsgRegisterERC20WithDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterERC20WithDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterERC20WithDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterERC20WithDeposit proto.InternalMessageInfo
+
+func (m *MsgRegisterERC20WithDeposit) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgRegisterERC20WithDeposit) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
+	}
+	return ""
+}
+
+// MsgRegisterERC20WithDepositResponse defines the response structure for
+// executing a MsgRegisterERC20WithDeposit message.
+type MsgRegisterERC20WithDepositResponse struct {
+}
+
+func (m *MsgRegisterERC20WithDepositResponse) Reset()         { *m = MsgRegisterERC20WithDepositResponse{} }
+func (m *MsgRegisterERC20WithDepositResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterERC20WithDepositResponse) ProtoMessage()    {}
+func (*MsgRegisterERC20WithDepositResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e06c8e6992ada536, []int{11}
+}
+func (m *MsgRegisterERC20WithDepositResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterERC20WithDepositResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterERC20WithDepositResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterERC20WithDepositResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterERC20WithDepositResponse.Merge(m, src)
+}
+func (m *MsgRegisterERC20WithDepositResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterERC20WithDepositResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterERC20WithDepositResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterERC20WithDepositResponse proto.InternalMessageInfo
+
+// MsgDelistERC20 is the Msg/DelistERC20 request type for removing an Erc20
+// contract token pair.
+type MsgDelistERC20 struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// token identifier can be either the hex contract address of the ERC20 or the
+	// Cosmos base denomination
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	// burn_deposit burns the registration deposit of the token pair instead of
+	// refunding it to the depositor
+	BurnDeposit bool `protobuf:"varint,3,opt,name=burn_deposit,json=burnDeposit,proto3" json:"burn_deposit,omitempty"`
+}
+
+func (m *MsgDelistERC20) Reset()         { *m = MsgDelistERC20{} }
+func (m *MsgDelistERC20) String() string { return proto.CompactTextString(m) }
+func (*MsgDelistERC20) ProtoMessage()    {}
+func (*MsgDelistERC20) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e06c8e6992ada536, []int{12}
+}
+func (m *MsgDelistERC20) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelistERC20) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelistERC20.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelistERC20) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelistERC20.Merge(m, src)
+}
+func (m *MsgDelistERC20) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelistERC20) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelistERC20.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelistERC20 proto.InternalMessageInfo
+
+func (m *MsgDelistERC20) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgDelistERC20) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *MsgDelistERC20) GetBurnDeposit() bool {
+	if m != nil {
+		return m.BurnDeposit
+	}
+	return false
+}
+
+// MsgDelistERC20Response defines the response structure for executing a
+// MsgDelistERC20 message.
+type MsgDelistERC20Response struct {
+}
+
+func (m *MsgDelistERC20Response) Reset()         { *m = MsgDelistERC20Response{} }
+func (m *MsgDelistERC20Response) String() string { return proto.CompactTextString(m) }
+func (*MsgDelistERC20Response) ProtoMessage()    {}
+func (*MsgDelistERC20Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e06c8e6992ada536, []int{13}
+}
+func (m *MsgDelistERC20Response) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelistERC20Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelistERC20Response.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelistERC20Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelistERC20Response.Merge(m, src)
+}
+func (m *MsgDelistERC20Response) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelistERC20Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelistERC20Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelistERC20Response proto.InternalMessageInfo
+
+// MsgRedeemCoin defines a Msg to convert the Cosmos coins of a delisted token
+// pair back to their ERC20 tokens.
+type MsgRedeemCoin struct {
+	// sender is the bech32 address of the owner of the Cosmos coins
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// coin is the Cosmos coin of a delisted token pair to redeem
+	Coin types.Coin `protobuf:"bytes,2,opt,name=coin,proto3" json:"coin"`
+	// receiver is the hex address to receive the ERC20 tokens
+	Receiver string `protobuf:"bytes,3,opt,name=receiver,proto3" json:"receiver,omitempty"`
+}
+
+func (m *MsgRedeemCoin) Reset()         { *m = MsgRedeemCoin{} }
+func (m *MsgRedeemCoin) String() string { return proto.CompactTextString(m) }
+func (*MsgRedeemCoin) ProtoMessage()    {}
+func (*MsgRedeemCoin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e06c8e6992ada536, []int{14}
+}
+func (m *MsgRedeemCoin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRedeemCoin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRedeemCoin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRedeemCoin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedeemCoin.Merge(m, src)
+}
+func (m *MsgRedeemCoin) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRedeemCoin) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedeemCoin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRedeemCoin proto.InternalMessageInfo
+
+func (m *MsgRedeemCoin) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgRedeemCoin) GetCoin() types.Coin {
+	if m != nil {
+		return m.Coin
+	}
+	return types.Coin{}
+}
+
+func (m *MsgRedeemCoin) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+// MsgRedeemCoinResponse returns no fields
+type MsgRedeemCoinResponse struct {
+}
+
+func (m *MsgRedeemCoinResponse) Reset()         { *m = MsgRedeemCoinResponse{} }
+func (m *MsgRedeemCoinResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRedeemCoinResponse) ProtoMessage()    {}
+func (*MsgRedeemCoinResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e06c8e6992ada536, []int{15}
+}
+func (m *MsgRedeemCoinResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRedeemCoinResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRedeemCoinResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRedeemCoinResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedeemCoinResponse.Merge(m, src)
+}
+func (m *MsgRedeemCoinResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRedeemCoinResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedeemCoinResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRedeemCoinResponse proto.InternalMessageInfo
+
+// MsgReleaseRegistrationDeposit is the Msg/ReleaseRegistrationDeposit request
+// type for refunding the registration deposit of a token pair.
+type MsgReleaseRegistrationDeposit struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// token identifier can be either the hex contract address of the ERC20 or the
+	// Cosmos base denomination
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (m *MsgReleaseRegistrationDeposit) Reset()         { *m = MsgReleaseRegistrationDeposit{} }
+func (m *MsgReleaseRegistrationDeposit) String() string { return proto.CompactTextString(m) }
+func (*MsgReleaseRegistrationDeposit) ProtoMessage()    {}
+func (*MsgReleaseRegistrationDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e06c8e6992ada536, []int{16}
+}
+func (m *MsgReleaseRegistrationDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReleaseRegistrationDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReleaseRegistrationDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReleaseRegistrationDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReleaseRegistrationDeposit.Merge(m, src)
+}
+func (m *MsgReleaseRegistrationDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReleaseRegistrationDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReleaseRegistrationDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReleaseRegistrationDeposit proto.InternalMessageInfo
+
+func (m *MsgReleaseRegistrationDeposit) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgReleaseRegistrationDeposit) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+// MsgReleaseRegistrationDepositResponse defines the response structure for
+// executing a MsgReleaseRegistrationDeposit message.
+type MsgReleaseRegistrationDepositResponse struct {
+}
+
+func (m *MsgReleaseRegistrationDepositResponse) Reset()         { *m = MsgReleaseRegistrationDepositResponse{} }
+func (m *MsgReleaseRegistrationDepositResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReleaseRegistrationDepositResponse) ProtoMessage()    {}
+func (*MsgReleaseRegistrationDepositResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e06c8e6992ada536, []int{17}
+}
+func (m *MsgReleaseRegistrationDepositResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReleaseRegistrationDepositResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReleaseRegistrationDepositResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReleaseRegistrationDepositResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReleaseRegistrationDepositResponse.Merge(m, src)
+}
+func (m *MsgReleaseRegistrationDepositResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReleaseRegistrationDepositResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReleaseRegistrationDepositResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReleaseRegistrationDepositResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgConvertERC20)(nil), "cosmos.evm.erc20.v1.MsgConvertERC20")
 	proto.RegisterType((*MsgConvertERC20Response)(nil), "cosmos.evm.erc20.v1.MsgConvertERC20Response")
@@ -538,58 +935,83 @@ func init() {
 	proto.RegisterType((*MsgRegisterERC20Response)(nil), "cosmos.evm.erc20.v1.MsgRegisterERC20Response")
 	proto.RegisterType((*MsgToggleConversion)(nil), "cosmos.evm.erc20.v1.MsgToggleConversion")
 	proto.RegisterType((*MsgToggleConversionResponse)(nil), "cosmos.evm.erc20.v1.MsgToggleConversionResponse")
+	proto.RegisterType((*MsgRegisterERC20WithDeposit)(nil), "cosmos.evm.erc20.v1.MsgRegisterERC20WithDeposit")
+	proto.RegisterType((*MsgRegisterERC20WithDepositResponse)(nil), "cosmos.evm.erc20.v1.MsgRegisterERC20WithDepositResponse")
+	proto.RegisterType((*MsgDelistERC20)(nil), "cosmos.evm.erc20.v1.MsgDelistERC20")
+	proto.RegisterType((*MsgDelistERC20Response)(nil), "cosmos.evm.erc20.v1.MsgDelistERC20Response")
+	proto.RegisterType((*MsgRedeemCoin)(nil), "cosmos.evm.erc20.v1.MsgRedeemCoin")
+	proto.RegisterType((*MsgRedeemCoinResponse)(nil), "cosmos.evm.erc20.v1.MsgRedeemCoinResponse")
+	proto.RegisterType((*MsgReleaseRegistrationDeposit)(nil), "cosmos.evm.erc20.v1.MsgReleaseRegistrationDeposit")
+	proto.RegisterType((*MsgReleaseRegistrationDepositResponse)(nil), "cosmos.evm.erc20.v1.MsgReleaseRegistrationDepositResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/evm/erc20/v1/tx.proto", fileDescriptor_e06c8e6992ada536) }
 
 var fileDescriptor_e06c8e6992ada536 = []byte{
-	// 732 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xc1, 0x4f, 0x13, 0x4d,
-	0x14, 0xef, 0x52, 0x68, 0xbe, 0x0e, 0x7c, 0xc0, 0xb7, 0xf0, 0x41, 0x59, 0x70, 0xd1, 0x45, 0x10,
-	0x1b, 0xd9, 0xa1, 0x25, 0x92, 0xd8, 0x44, 0x13, 0x4b, 0x3c, 0x78, 0x68, 0x62, 0x56, 0xbd, 0x78,
-	0x21, 0xd3, 0xed, 0x64, 0xd8, 0xc0, 0xce, 0x34, 0x3b, 0xc3, 0x06, 0x6e, 0x86, 0xa3, 0x27, 0x8d,
-	0x77, 0xcf, 0xc6, 0x13, 0x07, 0xff, 0x00, 0x8f, 0x1c, 0x89, 0x5e, 0x88, 0x26, 0xc4, 0x80, 0x09,
-	0xff, 0x86, 0xd9, 0x9d, 0x69, 0xe9, 0x2e, 0xdb, 0x84, 0x70, 0x69, 0x3a, 0xef, 0xfd, 0xe6, 0xbd,
-	0xdf, 0xef, 0xbd, 0xdf, 0xb4, 0x60, 0xce, 0x65, 0xdc, 0x67, 0x1c, 0xe2, 0xd0, 0x87, 0x38, 0x70,
-	0xab, 0xab, 0x30, 0xac, 0x40, 0xb1, 0x67, 0xb7, 0x03, 0x26, 0x98, 0x3e, 0x21, 0xb3, 0x36, 0x0e,
-	0x7d, 0x3b, 0xce, 0xda, 0x61, 0xc5, 0xf8, 0x0f, 0xf9, 0x1e, 0x65, 0x30, 0xfe, 0x94, 0x38, 0xc3,
-	0x54, 0x55, 0x9a, 0x88, 0x63, 0x18, 0x56, 0x9a, 0x58, 0xa0, 0x0a, 0x74, 0x99, 0x47, 0x55, 0x7e,
-	0x5a, 0xe5, 0x7d, 0x4e, 0xa2, 0xfa, 0x3e, 0x27, 0x2a, 0x31, 0x23, 0x13, 0x9b, 0xf1, 0x09, 0xaa,
-	0x6e, 0x32, 0x35, 0x49, 0x18, 0x61, 0x32, 0x1e, 0x7d, 0x53, 0xd1, 0x39, 0xc2, 0x18, 0xd9, 0xc1,
-	0x10, 0xb5, 0x3d, 0x88, 0x28, 0x65, 0x02, 0x09, 0x8f, 0xd1, 0xce, 0x9d, 0x3b, 0x59, 0x6a, 0x08,
-	0xa6, 0x98, 0x7b, 0x0a, 0x62, 0x9d, 0x68, 0x60, 0xac, 0xc1, 0xc9, 0x06, 0xa3, 0x21, 0x0e, 0xc4,
-	0x33, 0x67, 0xa3, 0xba, 0xaa, 0xdf, 0x07, 0xe3, 0x2e, 0xa3, 0x22, 0x40, 0xae, 0xd8, 0x44, 0xad,
-	0x56, 0x80, 0x39, 0x2f, 0x69, 0xb7, 0xb5, 0xe5, 0xa2, 0x33, 0xd6, 0x89, 0x3f, 0x95, 0x61, 0xbd,
-	0x06, 0x0a, 0xc8, 0x67, 0xbb, 0x54, 0x94, 0x06, 0x22, 0x40, 0xdd, 0x3a, 0x3a, 0x9d, 0xcf, 0xfd,
-	0x3c, 0x9d, 0xff, 0x5f, 0x76, 0xe6, 0xad, 0x6d, 0xdb, 0x63, 0xd0, 0x47, 0x62, 0xcb, 0x7e, 0x4e,
-	0xc5, 0xe7, 0x8b, 0xc3, 0xb2, 0xe6, 0xa8, 0x1b, 0xba, 0x01, 0xfe, 0x09, 0xb0, 0x8b, 0xbd, 0x10,
-	0x07, 0xa5, 0x7c, 0x5c, 0xbe, 0x7b, 0xd6, 0xa7, 0x40, 0x81, 0x63, 0xda, 0xc2, 0x41, 0x69, 0x30,
-	0xce, 0xa8, 0x53, 0xad, 0x7c, 0x70, 0x71, 0x58, 0x56, 0x87, 0x77, 0x17, 0x87, 0x65, 0xa3, 0x47,
-	0x61, 0x4a, 0x86, 0x35, 0x03, 0xa6, 0x53, 0x21, 0x07, 0xf3, 0x36, 0xa3, 0x1c, 0x5b, 0xfb, 0x60,
-	0xf4, 0x32, 0xb5, 0xc1, 0x3c, 0xaa, 0xaf, 0x81, 0xc1, 0x68, 0x41, 0xb1, 0xce, 0xe1, 0xea, 0x8c,
-	0xad, 0x66, 0x1f, 0x6d, 0xd0, 0x56, 0x1b, 0xb4, 0x23, 0x60, 0x7d, 0x30, 0x52, 0xe8, 0xc4, 0xe0,
-	0x84, 0x82, 0x81, 0xbe, 0x0a, 0xf2, 0xbd, 0x0a, 0xac, 0x12, 0x98, 0x4a, 0xb6, 0xee, 0x92, 0xfa,
-	0x26, 0x57, 0xf1, 0xba, 0xdd, 0x42, 0x02, 0xbf, 0x40, 0x01, 0xf2, 0xb9, 0xbe, 0x0e, 0x8a, 0x68,
-	0x57, 0x6c, 0xb1, 0xc0, 0x13, 0xfb, 0x72, 0x07, 0xf5, 0xd2, 0xf7, 0xaf, 0x2b, 0x93, 0x8a, 0x9e,
-	0x5a, 0xc3, 0x4b, 0x11, 0x78, 0x94, 0x38, 0x97, 0x50, 0xfd, 0x09, 0x28, 0xb4, 0xe3, 0x0a, 0x31,
-	0xaf, 0xe1, 0xea, 0xac, 0x9d, 0x61, 0x5d, 0x5b, 0x36, 0xa9, 0x17, 0x23, 0x49, 0x6a, 0x37, 0xf2,
-	0x56, 0xed, 0x61, 0x34, 0xe7, 0xcb, 0x7a, 0xd1, 0xa8, 0xad, 0x9e, 0x51, 0xef, 0x29, 0x3b, 0xa5,
-	0xe8, 0xaa, 0x91, 0xf7, 0x86, 0xba, 0xea, 0xbe, 0x68, 0x60, 0xbc, 0xc1, 0x89, 0x83, 0x89, 0xc7,
-	0x05, 0x0e, 0xa4, 0xd3, 0x6e, 0x2a, 0x6f, 0x09, 0x8c, 0xc6, 0x04, 0x94, 0x3b, 0x71, 0x24, 0x33,
-	0xbf, 0x5c, 0x74, 0x52, 0xd1, 0xda, 0xfa, 0x55, 0x19, 0x0b, 0xd9, 0x32, 0x12, 0xbc, 0x2c, 0x03,
-	0x94, 0xd2, 0xb1, 0xae, 0x90, 0x4f, 0x1a, 0x98, 0x68, 0x70, 0xf2, 0x8a, 0x11, 0xb2, 0x83, 0xe5,
-	0x1e, 0xb9, 0xc7, 0xe8, 0x8d, 0xb5, 0x4c, 0x82, 0x21, 0xc1, 0xb6, 0x31, 0x55, 0x0e, 0x92, 0x87,
-	0xda, 0xa3, 0xab, 0xcc, 0x97, 0xb2, 0x99, 0xa7, 0x89, 0x58, 0xb7, 0xc0, 0x6c, 0x46, 0xb8, 0xc3,
-	0xbf, 0xfa, 0x2b, 0x0f, 0xf2, 0x0d, 0x4e, 0xf4, 0x0f, 0x1a, 0x18, 0x49, 0x3c, 0xfb, 0xbb, 0x99,
-	0x1e, 0x49, 0x3d, 0x21, 0xe3, 0xc1, 0x75, 0x50, 0xdd, 0x61, 0xad, 0x1c, 0xfc, 0xf8, 0xf3, 0x71,
-	0xe0, 0x9e, 0xbe, 0x08, 0xb3, 0x7f, 0x58, 0xa1, 0x2b, 0x6f, 0x6d, 0xc6, 0x31, 0xbd, 0x09, 0x46,
-	0x12, 0xf6, 0xef, 0x4b, 0xa9, 0x17, 0xd5, 0x9f, 0x52, 0x96, 0x11, 0x75, 0x0c, 0xfe, 0x4d, 0x9a,
-	0x70, 0xb1, 0xdf, 0xf5, 0x04, 0xcc, 0x58, 0xb9, 0x16, 0xac, 0xdb, 0x86, 0x82, 0xf1, 0x2b, 0x16,
-	0x59, 0xee, 0x57, 0x22, 0x8d, 0x34, 0x56, 0xaf, 0x8b, 0xec, 0xf4, 0x33, 0x86, 0xde, 0x46, 0x0f,
-	0xb8, 0xfe, 0xf8, 0xe8, 0xcc, 0xd4, 0x8e, 0xcf, 0x4c, 0xed, 0xf7, 0x99, 0xa9, 0xbd, 0x3f, 0x37,
-	0x73, 0xc7, 0xe7, 0x66, 0xee, 0xe4, 0xdc, 0xcc, 0xbd, 0x59, 0x20, 0x9e, 0xd8, 0xda, 0x6d, 0xda,
-	0x2e, 0xf3, 0x61, 0x86, 0x93, 0xc4, 0x7e, 0x1b, 0xf3, 0x66, 0x21, 0xfe, 0x57, 0x58, 0xfb, 0x1b,
-	0x00, 0x00, 0xff, 0xff, 0xeb, 0x13, 0x81, 0xcb, 0x08, 0x07, 0x00, 0x00,
+	// 993 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0x26, 0x69, 0x68, 0x5e, 0x92, 0x36, 0x6c, 0xd3, 0xc6, 0xd9, 0xb4, 0x6e, 0xb3, 0x26,
+	0x6d, 0x30, 0xcd, 0xae, 0xe3, 0x42, 0x55, 0x56, 0xa2, 0x12, 0x4e, 0x39, 0x70, 0x88, 0x84, 0x16,
+	0x10, 0x12, 0x42, 0xb2, 0xd6, 0xf6, 0x68, 0x33, 0x6a, 0x76, 0xc6, 0xda, 0x99, 0x58, 0xcd, 0x0d,
+	0xf5, 0xc0, 0x01, 0x71, 0x00, 0x71, 0xe7, 0x8c, 0x38, 0x45, 0x55, 0x25, 0xae, 0x1c, 0xcb, 0xad,
+	0x82, 0x4b, 0xc5, 0xa1, 0x42, 0x09, 0x52, 0xfe, 0x06, 0xda, 0x99, 0xf1, 0x64, 0xbd, 0xde, 0x4d,
+	0x9c, 0xc0, 0x25, 0xca, 0xbc, 0xf7, 0xbd, 0x37, 0xdf, 0x37, 0x6f, 0xe6, 0x5b, 0xc3, 0xf5, 0x36,
+	0x65, 0x11, 0x65, 0x2e, 0xea, 0x45, 0x2e, 0x8a, 0xdb, 0xf5, 0x9a, 0xdb, 0xdb, 0x70, 0xf9, 0x13,
+	0xa7, 0x1b, 0x53, 0x4e, 0xcd, 0x2b, 0x32, 0xeb, 0xa0, 0x5e, 0xe4, 0x88, 0xac, 0xd3, 0xdb, 0xb0,
+	0xde, 0x0c, 0x22, 0x4c, 0xa8, 0x2b, 0xfe, 0x4a, 0x9c, 0x55, 0x56, 0x5d, 0x5a, 0x01, 0x43, 0x6e,
+	0x6f, 0xa3, 0x85, 0x78, 0xb0, 0xe1, 0xb6, 0x29, 0x26, 0x2a, 0xbf, 0xa8, 0xf2, 0x11, 0x0b, 0x93,
+	0xfe, 0x11, 0x0b, 0x55, 0x62, 0x49, 0x26, 0x9a, 0x62, 0xe5, 0xaa, 0xdd, 0x64, 0x6a, 0x21, 0xa4,
+	0x21, 0x95, 0xf1, 0xe4, 0x3f, 0x15, 0xbd, 0x1e, 0x52, 0x1a, 0xee, 0x20, 0x37, 0xe8, 0x62, 0x37,
+	0x20, 0x84, 0xf2, 0x80, 0x63, 0x4a, 0xfa, 0x35, 0x2b, 0x79, 0x6a, 0x42, 0x44, 0x10, 0xc3, 0x0a,
+	0x62, 0xbf, 0x32, 0xe0, 0xf2, 0x16, 0x0b, 0x37, 0x29, 0xe9, 0xa1, 0x98, 0x7f, 0xe4, 0x6f, 0xd6,
+	0x6b, 0xe6, 0xdb, 0x30, 0xdf, 0xa6, 0x84, 0xc7, 0x41, 0x9b, 0x37, 0x83, 0x4e, 0x27, 0x46, 0x8c,
+	0x95, 0x8c, 0x5b, 0xc6, 0xda, 0xb4, 0x7f, 0xb9, 0x1f, 0xff, 0x50, 0x86, 0x4d, 0x0f, 0xa6, 0x82,
+	0x88, 0xee, 0x12, 0x5e, 0x1a, 0x4f, 0x00, 0x0d, 0xfb, 0xc5, 0xeb, 0x9b, 0x63, 0x7f, 0xbd, 0xbe,
+	0x79, 0x55, 0xee, 0xcc, 0x3a, 0x8f, 0x1d, 0x4c, 0xdd, 0x28, 0xe0, 0xdb, 0xce, 0xc7, 0x84, 0xff,
+	0x7c, 0xb4, 0x5f, 0x35, 0x7c, 0x55, 0x61, 0x5a, 0x70, 0x31, 0x46, 0x6d, 0x84, 0x7b, 0x28, 0x2e,
+	0x4d, 0x88, 0xf6, 0x7a, 0x6d, 0x5e, 0x83, 0x29, 0x86, 0x48, 0x07, 0xc5, 0xa5, 0x49, 0x91, 0x51,
+	0x2b, 0xaf, 0xfa, 0xf4, 0x68, 0xbf, 0xaa, 0x16, 0xdf, 0x1e, 0xed, 0x57, 0xad, 0x94, 0xc2, 0x8c,
+	0x0c, 0x7b, 0x09, 0x16, 0x33, 0x21, 0x1f, 0xb1, 0x2e, 0x25, 0x0c, 0xd9, 0x7b, 0x70, 0xe9, 0x38,
+	0xb5, 0x49, 0x31, 0x31, 0xef, 0xc1, 0x64, 0x32, 0x20, 0xa1, 0x73, 0xa6, 0xbe, 0xe4, 0xa8, 0xb3,
+	0x4f, 0x26, 0xe8, 0xa8, 0x09, 0x3a, 0x09, 0xb0, 0x31, 0x99, 0x28, 0xf4, 0x05, 0x78, 0x40, 0xc1,
+	0x78, 0xa1, 0x82, 0x89, 0xb4, 0x02, 0xbb, 0x04, 0xd7, 0x06, 0xb7, 0xd6, 0xa4, 0x7e, 0x93, 0xa3,
+	0xf8, 0xbc, 0xdb, 0x09, 0x38, 0xfa, 0x24, 0x88, 0x83, 0x88, 0x99, 0xf7, 0x61, 0x3a, 0xd8, 0xe5,
+	0xdb, 0x34, 0xc6, 0x7c, 0x4f, 0xce, 0xa0, 0x51, 0xfa, 0xe3, 0xf9, 0xfa, 0x82, 0xa2, 0xa7, 0xc6,
+	0xf0, 0x29, 0x8f, 0x31, 0x09, 0xfd, 0x63, 0xa8, 0xf9, 0x10, 0xa6, 0xba, 0xa2, 0x83, 0xe0, 0x35,
+	0x53, 0x5f, 0x76, 0x72, 0xae, 0xae, 0x23, 0x37, 0x69, 0x4c, 0x27, 0x92, 0xd4, 0x6c, 0x64, 0x95,
+	0xf7, 0x5e, 0x72, 0xce, 0xc7, 0xfd, 0x92, 0xa3, 0xb6, 0x53, 0x47, 0xfd, 0x44, 0x5d, 0xa7, 0x0c,
+	0x5d, 0x75, 0xe4, 0xe9, 0x90, 0x56, 0xf7, 0x8b, 0x01, 0xf3, 0x5b, 0x2c, 0xf4, 0x51, 0x88, 0x19,
+	0x47, 0xb1, 0xbc, 0x69, 0xe7, 0x95, 0x77, 0x1b, 0x2e, 0x09, 0x02, 0xea, 0x76, 0xa2, 0x44, 0xe6,
+	0xc4, 0xda, 0xb4, 0x9f, 0x89, 0x7a, 0xf7, 0x87, 0x65, 0x54, 0xf2, 0x65, 0x0c, 0xf0, 0xb2, 0x2d,
+	0x28, 0x65, 0x63, 0x5a, 0xc8, 0x4f, 0x06, 0x5c, 0xd9, 0x62, 0xe1, 0x67, 0x34, 0x0c, 0x77, 0x90,
+	0x9c, 0x23, 0xc3, 0x94, 0x9c, 0x5b, 0xcb, 0x02, 0x5c, 0xe0, 0xf4, 0x31, 0x22, 0xea, 0x06, 0xc9,
+	0x85, 0xf7, 0xfe, 0x30, 0xf3, 0xdb, 0xf9, 0xcc, 0xb3, 0x44, 0xec, 0x1b, 0xb0, 0x9c, 0x13, 0xd6,
+	0xfc, 0x9f, 0x1b, 0xb0, 0x9c, 0x15, 0xf7, 0x05, 0xe6, 0xdb, 0x8f, 0x50, 0x97, 0x32, 0xcc, 0xcd,
+	0x9a, 0xbe, 0xb8, 0xa7, 0x89, 0x50, 0x38, 0xb3, 0x02, 0x73, 0x82, 0x8d, 0x36, 0x0b, 0xa9, 0x64,
+	0x56, 0x04, 0x55, 0x89, 0xf7, 0x30, 0xf3, 0x72, 0x9d, 0x11, 0xe6, 0x90, 0xa2, 0x65, 0xaf, 0x42,
+	0xe5, 0x84, 0xb4, 0x56, 0xf7, 0xab, 0x21, 0x9e, 0xf6, 0x23, 0xb4, 0x83, 0x19, 0xff, 0x6f, 0x97,
+	0x2c, 0x77, 0x30, 0xe6, 0x0a, 0xcc, 0xb6, 0x76, 0x63, 0xd2, 0xec, 0xc8, 0x8d, 0xc5, 0xeb, 0xbe,
+	0xe8, 0xcf, 0x24, 0x31, 0xc5, 0xc5, 0x7b, 0x77, 0x78, 0x76, 0x2b, 0xf9, 0x6a, 0x53, 0x34, 0x95,
+	0x31, 0xa4, 0x22, 0x5a, 0xd3, 0xef, 0x06, 0xcc, 0x09, 0xed, 0x1d, 0x84, 0x22, 0xe1, 0x56, 0x67,
+	0x9f, 0xd1, 0x03, 0xe5, 0x6f, 0xe3, 0xa7, 0xf9, 0x5b, 0xca, 0x0c, 0x86, 0x4d, 0x2e, 0x63, 0xd3,
+	0x5e, 0x2d, 0x33, 0xd4, 0x5b, 0x45, 0x43, 0xed, 0x33, 0xb7, 0x17, 0xe1, 0xea, 0x40, 0x40, 0x8b,
+	0x7c, 0x66, 0xc0, 0x0d, 0x91, 0xd9, 0x41, 0x01, 0x43, 0x72, 0xce, 0xb1, 0xf8, 0x9a, 0xf5, 0x2f,
+	0xe6, 0xff, 0xfb, 0xc0, 0x36, 0x87, 0x87, 0x54, 0x2b, 0x62, 0x5f, 0x44, 0xc9, 0xbe, 0x03, 0xab,
+	0x27, 0x02, 0xfa, 0xea, 0xea, 0xcf, 0xde, 0x80, 0x89, 0x2d, 0x16, 0x9a, 0x3f, 0x18, 0x30, 0x3b,
+	0xf0, 0xad, 0x7d, 0x2b, 0xd7, 0x98, 0x33, 0xdf, 0x2d, 0xeb, 0xee, 0x28, 0x28, 0x7d, 0x94, 0xeb,
+	0x4f, 0xff, 0xfc, 0xe7, 0xc7, 0xf1, 0x3b, 0xe6, 0xaa, 0x9b, 0xff, 0x6b, 0xc6, 0x6d, 0xcb, 0xaa,
+	0xa6, 0x88, 0x99, 0x2d, 0x98, 0x1d, 0xf8, 0xe6, 0x14, 0x52, 0x4a, 0xa3, 0xac, 0xbb, 0xa3, 0xa0,
+	0xfa, 0x94, 0x4c, 0x04, 0x73, 0x83, 0xce, 0xbf, 0x5a, 0x54, 0x3e, 0x00, 0xb3, 0xd6, 0x47, 0x82,
+	0xe9, 0x6d, 0x08, 0xcc, 0x0f, 0xf9, 0xf2, 0x5a, 0x51, 0x8b, 0x2c, 0xd2, 0xaa, 0x8d, 0x8a, 0xd4,
+	0xfb, 0x7d, 0x63, 0x40, 0xa9, 0xd8, 0x48, 0x47, 0xe2, 0x9e, 0xaa, 0xb0, 0x1e, 0x9c, 0xb5, 0x42,
+	0x13, 0x69, 0xc2, 0x4c, 0xda, 0xf2, 0x2a, 0x45, 0x8d, 0x52, 0x20, 0xeb, 0x9d, 0x11, 0x40, 0x7a,
+	0x83, 0xaf, 0x00, 0x52, 0xfe, 0x63, 0x17, 0x13, 0xed, 0x63, 0xac, 0xea, 0xe9, 0x18, 0xdd, 0xfd,
+	0x3b, 0x03, 0xac, 0x13, 0x5e, 0x7e, 0xbd, 0xb8, 0x55, 0x51, 0x8d, 0xe5, 0x9d, 0xbd, 0xa6, 0x4f,
+	0xc7, 0xba, 0xf0, 0x75, 0xe2, 0x7f, 0x8d, 0x0f, 0x5e, 0x1c, 0x94, 0x8d, 0x97, 0x07, 0x65, 0xe3,
+	0xef, 0x83, 0xb2, 0xf1, 0xfd, 0x61, 0x79, 0xec, 0xe5, 0x61, 0x79, 0xec, 0xd5, 0x61, 0x79, 0xec,
+	0xcb, 0x4a, 0x88, 0xf9, 0xf6, 0x6e, 0xcb, 0x69, 0xd3, 0xc8, 0xcd, 0x31, 0x0d, 0xbe, 0xd7, 0x45,
+	0xac, 0x35, 0x25, 0x7e, 0x61, 0xdf, 0xfb, 0x77, 0x00, 0xb5, 0x85, 0x34, 0x03, 0x54, 0x0c, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -619,6 +1041,21 @@ type MsgClient interface {
 	// token pair conversion. The authority is hard-coded to the Cosmos SDK x/gov
 	// module account
 	ToggleConversion(ctx context.Context, in *MsgToggleConversion, opts ...grpc.CallOption) (*MsgToggleConversionResponse, error)
+	// RegisterERC20WithDeposit registers a token pair for the specified erc20
+	// contract without governance, by locking the registration deposit from the
+	// sender. It requires the permissionless registration to be enabled.
+	RegisterERC20WithDeposit(ctx context.Context, in *MsgRegisterERC20WithDeposit, opts ...grpc.CallOption) (*MsgRegisterERC20WithDepositResponse, error)
+	// DelistERC20 defines a governance operation for removing the token pair of
+	// an erc20 contract and refunding or burning its registration deposit. The
+	// authority is hard-coded to the Cosmos SDK x/gov module account
+	DelistERC20(ctx context.Context, in *MsgDelistERC20, opts ...grpc.CallOption) (*MsgDelistERC20Response, error)
+	// RedeemCoin converts the Cosmos coins of a delisted token pair back to
+	// their ERC20 tokens
+	RedeemCoin(ctx context.Context, in *MsgRedeemCoin, opts ...grpc.CallOption) (*MsgRedeemCoinResponse, error)
+	// ReleaseRegistrationDeposit defines a governance operation for refunding
+	// the registration deposit of a token pair to its depositor. The authority
+	// is hard-coded to the Cosmos SDK x/gov module account
+	ReleaseRegistrationDeposit(ctx context.Context, in *MsgReleaseRegistrationDeposit, opts ...grpc.CallOption) (*MsgReleaseRegistrationDepositResponse, error)
 }
 
 type msgClient struct {
@@ -665,27 +1102,78 @@ func (c *msgClient) ToggleConversion(ctx context.Context, in *MsgToggleConversio
 	return out, nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// ConvertERC20 mints a native Cosmos coin representation of the ERC20 token
-	// contract that is registered on the token mapping.
-	ConvertERC20(context.Context, *MsgConvertERC20) (*MsgConvertERC20Response, error)
-	// UpdateParams defines a governance operation for updating the x/erc20 module
-	// parameters. The authority is hard-coded to the Cosmos SDK x/gov module
-	// account
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	// RegisterERC20 defines a governance operation for registering a token pair
-	// for the specified erc20 contract. The authority is hard-coded to the Cosmos
-	// SDK x/gov module account
-	RegisterERC20(context.Context, *MsgRegisterERC20) (*MsgRegisterERC20Response, error)
-	// ToggleConversion defines a governance operation for enabling/disablen a
-	// token pair conversion. The authority is hard-coded to the Cosmos SDK x/gov
-	// module account
-	ToggleConversion(context.Context, *MsgToggleConversion) (*MsgToggleConversionResponse, error)
+func (c *msgClient) RegisterERC20WithDeposit(ctx context.Context, in *MsgRegisterERC20WithDeposit, opts ...grpc.CallOption) (*MsgRegisterERC20WithDepositResponse, error) {
+	out := new(MsgRegisterERC20WithDepositResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.erc20.v1.Msg/RegisterERC20WithDeposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (c *msgClient) DelistERC20(ctx context.Context, in *MsgDelistERC20, opts ...grpc.CallOption) (*MsgDelistERC20Response, error) {
+	out := new(MsgDelistERC20Response)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.erc20.v1.Msg/DelistERC20", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RedeemCoin(ctx context.Context, in *MsgRedeemCoin, opts ...grpc.CallOption) (*MsgRedeemCoinResponse, error) {
+	out := new(MsgRedeemCoinResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.erc20.v1.Msg/RedeemCoin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReleaseRegistrationDeposit(ctx context.Context, in *MsgReleaseRegistrationDeposit, opts ...grpc.CallOption) (*MsgReleaseRegistrationDepositResponse, error) {
+	out := new(MsgReleaseRegistrationDepositResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.erc20.v1.Msg/ReleaseRegistrationDeposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// ConvertERC20 mints a native Cosmos coin representation of the ERC20 token
+	// contract that is registered on the token mapping.
+	ConvertERC20(context.Context, *MsgConvertERC20) (*MsgConvertERC20Response, error)
+	// UpdateParams defines a governance operation for updating the x/erc20 module
+	// parameters. The authority is hard-coded to the Cosmos SDK x/gov module
+	// account
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// RegisterERC20 defines a governance operation for registering a token pair
+	// for the specified erc20 contract. The authority is hard-coded to the Cosmos
+	// SDK x/gov module account
+	RegisterERC20(context.Context, *MsgRegisterERC20) (*MsgRegisterERC20Response, error)
+	// ToggleConversion defines a governance operation for enabling/disablen a
+	// token pair conversion. The authority is hard-coded to the Cosmos SDK x/gov
+	// module account
+	ToggleConversion(context.Context, *MsgToggleConversion) (*MsgToggleConversionResponse, error)
+	// RegisterERC20WithDeposit registers a token pair for the specified erc20
+	// contract without governance, by locking the registration deposit from the
+	// sender. It requires the permissionless registration to be enabled.
+	RegisterERC20WithDeposit(context.Context, *MsgRegisterERC20WithDeposit) (*MsgRegisterERC20WithDepositResponse, error)
+	// DelistERC20 defines a governance operation for removing the token pair of
+	// an erc20 contract and refunding or burning its registration deposit. The
+	// authority is hard-coded to the Cosmos SDK x/gov module account
+	DelistERC20(context.Context, *MsgDelistERC20) (*MsgDelistERC20Response, error)
+	// RedeemCoin converts the Cosmos coins of a delisted token pair back to
+	// their ERC20 tokens
+	RedeemCoin(context.Context, *MsgRedeemCoin) (*MsgRedeemCoinResponse, error)
+	// ReleaseRegistrationDeposit defines a governance operation for refunding
+	// the registration deposit of a token pair to its depositor. The authority
+	// is hard-coded to the Cosmos SDK x/gov module account
+	ReleaseRegistrationDeposit(context.Context, *MsgReleaseRegistrationDeposit) (*MsgReleaseRegistrationDepositResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
 func (*UnimplementedMsgServer) ConvertERC20(ctx context.Context, req *MsgConvertERC20) (*MsgConvertERC20Response, error) {
@@ -700,6 +1188,18 @@ func (*UnimplementedMsgServer) RegisterERC20(ctx context.Context, req *MsgRegist
 func (*UnimplementedMsgServer) ToggleConversion(ctx context.Context, req *MsgToggleConversion) (*MsgToggleConversionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ToggleConversion not implemented")
 }
+func (*UnimplementedMsgServer) RegisterERC20WithDeposit(ctx context.Context, req *MsgRegisterERC20WithDeposit) (*MsgRegisterERC20WithDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterERC20WithDeposit not implemented")
+}
+func (*UnimplementedMsgServer) DelistERC20(ctx context.Context, req *MsgDelistERC20) (*MsgDelistERC20Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelistERC20 not implemented")
+}
+func (*UnimplementedMsgServer) RedeemCoin(ctx context.Context, req *MsgRedeemCoin) (*MsgRedeemCoinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemCoin not implemented")
+}
+func (*UnimplementedMsgServer) ReleaseRegistrationDeposit(ctx context.Context, req *MsgReleaseRegistrationDeposit) (*MsgReleaseRegistrationDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseRegistrationDeposit not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -777,6 +1277,79 @@ func _Msg_ToggleConversion_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RegisterERC20WithDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterERC20WithDeposit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterERC20WithDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.erc20.v1.Msg/RegisterERC20WithDeposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterERC20WithDeposit(ctx, req.(*MsgRegisterERC20WithDeposit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DelistERC20_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelistERC20)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelistERC20(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.erc20.v1.Msg/DelistERC20",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelistERC20(ctx, req.(*MsgDelistERC20))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RedeemCoin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRedeemCoin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RedeemCoin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.erc20.v1.Msg/RedeemCoin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RedeemCoin(ctx, req.(*MsgRedeemCoin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReleaseRegistrationDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReleaseRegistrationDeposit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReleaseRegistrationDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.erc20.v1.Msg/ReleaseRegistrationDeposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReleaseRegistrationDeposit(ctx, req.(*MsgReleaseRegistrationDeposit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.evm.erc20.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -797,6 +1370,22 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ToggleConversion",
 			Handler:    _Msg_ToggleConversion_Handler,
 		},
+		{
+			MethodName: "RegisterERC20WithDeposit",
+			Handler:    _Msg_RegisterERC20WithDeposit_Handler,
+		},
+		{
+			MethodName: "DelistERC20",
+			Handler:    _Msg_DelistERC20_Handler,
+		},
+		{
+			MethodName: "RedeemCoin",
+			Handler:    _Msg_RedeemCoin_Handler,
+		},
+		{
+			MethodName: "ReleaseRegistrationDeposit",
+			Handler:    _Msg_ReleaseRegistrationDeposit_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evm/erc20/v1/tx.proto",
@@ -1134,104 +1723,364 @@ func (m *MsgToggleConversionResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgRegisterERC20WithDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgConvertERC20) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgRegisterERC20WithDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterERC20WithDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgConvertERC20Response) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRegisterERC20WithDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterERC20WithDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterERC20WithDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgConvertCoin) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDelistERC20) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelistERC20) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelistERC20) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Coin.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.BurnDeposit {
+		i--
+		if m.BurnDeposit {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgConvertCoinResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDelistERC20Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (m *MsgDelistERC20Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgDelistERC20Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRegisterERC20) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRedeemCoin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedeemCoin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedeemCoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedeemCoinResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedeemCoinResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedeemCoinResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReleaseRegistrationDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReleaseRegistrationDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReleaseRegistrationDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReleaseRegistrationDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReleaseRegistrationDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReleaseRegistrationDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgConvertERC20) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConvertERC20Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgConvertCoin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Coin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConvertCoinResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterERC20) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -1283,13 +2132,828 @@ func (m *MsgToggleConversionResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgRegisterERC20WithDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgRegisterERC20WithDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
-func (m *MsgConvertERC20) Unmarshal(dAtA []byte) error {
+
+func (m *MsgDelistERC20) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BurnDeposit {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgDelistERC20Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRedeemCoin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Coin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRedeemCoinResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReleaseRegistrationDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReleaseRegistrationDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgConvertERC20) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertERC20: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertERC20: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertERC20Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertERC20Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertERC20Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertCoin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertCoin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertCoin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertCoinResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertCoinResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertCoinResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterERC20) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterERC20: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterERC20: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Addresses = append(m.Erc20Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterERC20Response) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1312,81 +2976,65 @@ func (m *MsgConvertERC20) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertERC20: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterERC20Response: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertERC20: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterERC20Response: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgToggleConversion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgToggleConversion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgToggleConversion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1414,11 +3062,11 @@ func (m *MsgConvertERC20) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1446,7 +3094,7 @@ func (m *MsgConvertERC20) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Token = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1469,7 +3117,7 @@ func (m *MsgConvertERC20) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConvertERC20Response) Unmarshal(dAtA []byte) error {
+func (m *MsgToggleConversionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1492,10 +3140,10 @@ func (m *MsgConvertERC20Response) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertERC20Response: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgToggleConversionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertERC20Response: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgToggleConversionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1519,7 +3167,7 @@ func (m *MsgConvertERC20Response) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConvertCoin) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterERC20WithDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1542,48 +3190,15 @@ func (m *MsgConvertCoin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertCoin: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterERC20WithDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertCoin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterERC20WithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1611,11 +3226,11 @@ func (m *MsgConvertCoin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1643,7 +3258,7 @@ func (m *MsgConvertCoin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1666,7 +3281,7 @@ func (m *MsgConvertCoin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConvertCoinResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterERC20WithDepositResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1689,10 +3304,10 @@ func (m *MsgConvertCoinResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertCoinResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterERC20WithDepositResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertCoinResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterERC20WithDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1716,7 +3331,7 @@ func (m *MsgConvertCoinResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgDelistERC20) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1739,10 +3354,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelistERC20: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelistERC20: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1779,9 +3394,9 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1791,25 +3406,44 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Token = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnDeposit", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnDeposit = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1831,7 +3465,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDelistERC20Response) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1854,10 +3488,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelistERC20Response: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelistERC20Response: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1881,7 +3515,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterERC20) Unmarshal(dAtA []byte) error {
+func (m *MsgRedeemCoin) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1904,15 +3538,15 @@ func (m *MsgRegisterERC20) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterERC20: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRedeemCoin: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterERC20: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRedeemCoin: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1940,11 +3574,44 @@ func (m *MsgRegisterERC20) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1972,7 +3639,7 @@ func (m *MsgRegisterERC20) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Erc20Addresses = append(m.Erc20Addresses, string(dAtA[iNdEx:postIndex]))
+			m.Receiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1995,7 +3662,7 @@ func (m *MsgRegisterERC20) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterERC20Response) Unmarshal(dAtA []byte) error {
+func (m *MsgRedeemCoinResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2018,10 +3685,10 @@ func (m *MsgRegisterERC20Response) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterERC20Response: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRedeemCoinResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterERC20Response: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRedeemCoinResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2045,7 +3712,7 @@ func (m *MsgRegisterERC20Response) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgToggleConversion) Unmarshal(dAtA []byte) error {
+func (m *MsgReleaseRegistrationDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2068,10 +3735,10 @@ func (m *MsgToggleConversion) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgToggleConversion: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReleaseRegistrationDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgToggleConversion: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReleaseRegistrationDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2159,7 +3826,7 @@ func (m *MsgToggleConversion) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgToggleConversionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgReleaseRegistrationDepositResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2182,10 +3849,10 @@ func (m *MsgToggleConversionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgToggleConversionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReleaseRegistrationDepositResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgToggleConversionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReleaseRegistrationDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -0,0 +1,81 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/evm/x/feemarket/types"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// Simulation parameter constants
+const (
+	NoBaseFee                = "no_base_fee"
+	BaseFeeChangeDenominator = "base_fee_change_denominator"
+	ElasticityMultiplier     = "elasticity_multiplier"
+	BaseFee                  = "base_fee"
+)
+
+// GenNoBaseFee randomized NoBaseFee
+func GenNoBaseFee(r *rand.Rand) bool {
+	return r.Intn(2) == 0
+}
+
+// GenBaseFeeChangeDenominator randomized BaseFeeChangeDenominator
+func GenBaseFeeChangeDenominator(r *rand.Rand) uint32 {
+	return uint32(simtypes.RandIntBetween(r, 1, 17))
+}
+
+// GenElasticityMultiplier randomized ElasticityMultiplier
+func GenElasticityMultiplier(r *rand.Rand) uint32 {
+	return uint32(simtypes.RandIntBetween(r, 1, 5))
+}
+
+// GenBaseFee randomized BaseFee. The base fee is zero if it's enabled, since the Cosmos SDK
+// simulation operations pay random fees in any denomination, including zero fees, which
+// would be rejected by a non-zero base fee.
+func GenBaseFee(r *rand.Rand, noBaseFee bool) math.LegacyDec {
+	if !noBaseFee {
+		return math.LegacyZeroDec()
+	}
+	return math.LegacyNewDec(int64(r.Intn(1_000_000_000)))
+}
+
+// RandomizedGenState generates a random GenesisState for the fee market module
+func RandomizedGenState(simState *module.SimulationState) {
+	var noBaseFee bool
+	simState.AppParams.GetOrGenerate(NoBaseFee, &noBaseFee, simState.Rand, func(r *rand.Rand) { noBaseFee = GenNoBaseFee(r) })
+
+	var baseFeeChangeDenominator uint32
+	simState.AppParams.GetOrGenerate(BaseFeeChangeDenominator, &baseFeeChangeDenominator, simState.Rand, func(r *rand.Rand) { baseFeeChangeDenominator = GenBaseFeeChangeDenominator(r) })
+
+	var elasticityMultiplier uint32
+	simState.AppParams.GetOrGenerate(ElasticityMultiplier, &elasticityMultiplier, simState.Rand, func(r *rand.Rand) { elasticityMultiplier = GenElasticityMultiplier(r) })
+
+	var baseFee math.LegacyDec
+	simState.AppParams.GetOrGenerate(BaseFee, &baseFee, simState.Rand, func(r *rand.Rand) { baseFee = GenBaseFee(r, noBaseFee) })
+
+	params := types.NewParams(
+		noBaseFee,
+		baseFeeChangeDenominator,
+		elasticityMultiplier,
+		baseFee,
+		types.DefaultEnableHeight,
+		types.DefaultMinGasPrice,
+		types.DefaultMinGasMultiplier,
+	)
+
+	feemarketGenesis := types.NewGenesisState(params, 0)
+
+	bz, err := json.MarshalIndent(&feemarketGenesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated fee market parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(feemarketGenesis)
+}
@@ -0,0 +1,26 @@
+package ratelimit
+
+import (
+	"github.com/cosmos/evm/x/ibc/ratelimit/keeper"
+	"github.com/cosmos/evm/x/ibc/ratelimit/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis import module genesis
+func InitGenesis(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	data types.GenesisState,
+) {
+	for _, rateLimit := range data.RateLimits {
+		k.SetRateLimit(ctx, rateLimit)
+	}
+}
+
+// ExportGenesis export module status
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return &types.GenesisState{
+		RateLimits: k.GetRateLimits(ctx),
+	}
+}
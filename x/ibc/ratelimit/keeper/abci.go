@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"github.com/cosmos/evm/x/ibc/ratelimit/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BeginBlock starts a new window for the rate limits whose window has ended.
+func (k Keeper) BeginBlock(ctx sdk.Context) error {
+	var expired []types.RateLimit
+	k.IterateRateLimits(ctx, func(rateLimit types.RateLimit) bool {
+		if !ctx.BlockTime().Before(rateLimit.Flow.WindowEnd) {
+			expired = append(expired, rateLimit)
+		}
+		return false
+	})
+
+	for _, rateLimit := range expired {
+		k.ResetFlow(ctx, &rateLimit)
+		k.SetRateLimit(ctx, rateLimit)
+	}
+
+	return nil
+}
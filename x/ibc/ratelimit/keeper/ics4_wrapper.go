@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"github.com/cosmos/evm/x/ibc/ratelimit/types"
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ porttypes.ICS4Wrapper = Keeper{}
+
+// SendPacket implements the ICS4Wrapper interface. It adds the amount sent
+// with an ICS20 packet to the outflow of its rate limit, and rejects the
+// packet if it exceeds the quota.
+func (k Keeper) SendPacket(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	sourcePort string,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	data []byte,
+) (uint64, error) {
+	var packetData transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(data, &packetData); err != nil {
+		return 0, types.ErrInvalidPacket.Wrapf("cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
+	}
+
+	amount, ok := math.NewIntFromString(packetData.Amount)
+	if !ok {
+		return 0, types.ErrInvalidPacket.Wrapf("invalid amount %s", packetData.Amount)
+	}
+
+	rateLimit, found, err := k.CheckRateLimitAndUpdateFlow(ctx, types.PacketSend, SentDenom(packetData.Denom), sourceChannel, amount)
+	if err != nil {
+		return 0, err
+	}
+
+	sequence, err := k.ics4Wrapper.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	if err != nil {
+		return 0, err
+	}
+
+	if found {
+		k.SetPendingSendPacket(ctx, sourceChannel, sequence, rateLimit.Flow.WindowEnd)
+	}
+
+	return sequence, nil
+}
+
+// WriteAcknowledgement implements the ICS4Wrapper interface.
+func (k Keeper) WriteAcknowledgement(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet exported.PacketI,
+	ack exported.Acknowledgement,
+) error {
+	return k.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, ack)
+}
+
+// GetAppVersion implements the ICS4Wrapper interface.
+func (k Keeper) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return k.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/evm/x/ibc/ratelimit/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Keeper of the rate limit module. It tracks the flow of the ICS20 transfers
+// over the rate limited channels and rejects the transfers that exceed their
+// quota.
+type Keeper struct {
+	storeKey storetypes.StoreKey
+	cdc      codec.BinaryCodec
+	// the address capable of executing the rate limit messages. Typically, this should be the x/gov module account.
+	authority sdk.AccAddress
+
+	ics4Wrapper porttypes.ICS4Wrapper
+	bankKeeper  types.BankKeeper
+	erc20Keeper types.ERC20Keeper
+}
+
+// NewKeeper creates new instances of the rate limit Keeper
+func NewKeeper(
+	storeKey storetypes.StoreKey,
+	cdc codec.BinaryCodec,
+	authority sdk.AccAddress,
+	ics4Wrapper porttypes.ICS4Wrapper,
+	bk types.BankKeeper,
+	erc20Keeper types.ERC20Keeper,
+) Keeper {
+	// ensure gov module account is set and is not nil
+	if err := sdk.VerifyAddressFormat(authority); err != nil {
+		panic(err)
+	}
+
+	return Keeper{
+		storeKey:    storeKey,
+		cdc:         cdc,
+		authority:   authority,
+		ics4Wrapper: ics4Wrapper,
+		bankKeeper:  bk,
+		erc20Keeper: erc20Keeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
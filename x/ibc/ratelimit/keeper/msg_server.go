@@ -0,0 +1,169 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cosmos/evm/x/ibc/ratelimit/types"
+
+	sdkerrors "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+var _ types.MsgServer = Keeper{}
+
+// AddRateLimit implements the gRPC MsgServer interface. It adds a rate limit
+// to a denomination over a channel, whose first window starts with the
+// current block. The denomination can also be the contract address of an
+// ERC20 token pair.
+func (k Keeper) AddRateLimit(goCtx context.Context, req *types.MsgAddRateLimit) (*types.MsgAddRateLimitResponse, error) {
+	if err := k.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	denom := k.resolveDenom(ctx, req.Denom)
+	if _, found := k.GetRateLimit(ctx, denom, req.ChannelId); found {
+		return nil, types.ErrRateLimitAlreadyExists.Wrapf("denom %s, channel %s", denom, req.ChannelId)
+	}
+
+	path := types.Path{Denom: denom, ChannelId: req.ChannelId}
+	if err := path.Validate(); err != nil {
+		return nil, err
+	}
+
+	rateLimit := types.NewRateLimit(path, types.NewQuota(req.MaxPercentSend, req.MaxPercentRecv, req.DurationHours))
+	if err := k.startWindow(ctx, &rateLimit); err != nil {
+		return nil, err
+	}
+	k.SetRateLimit(ctx, rateLimit)
+
+	emitQuotaEvent(ctx, types.EventTypeAddRateLimit, rateLimit)
+	return &types.MsgAddRateLimitResponse{}, nil
+}
+
+// UpdateRateLimit implements the gRPC MsgServer interface. It updates the
+// quota of a rate limit and starts a new window.
+func (k Keeper) UpdateRateLimit(goCtx context.Context, req *types.MsgUpdateRateLimit) (*types.MsgUpdateRateLimitResponse, error) {
+	if err := k.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	rateLimit, err := k.getRateLimit(ctx, req.Denom, req.ChannelId)
+	if err != nil {
+		return nil, err
+	}
+
+	rateLimit.Quota = types.NewQuota(req.MaxPercentSend, req.MaxPercentRecv, req.DurationHours)
+	if err := k.startWindow(ctx, &rateLimit); err != nil {
+		return nil, err
+	}
+	k.SetRateLimit(ctx, rateLimit)
+
+	emitQuotaEvent(ctx, types.EventTypeUpdateRateLimit, rateLimit)
+	return &types.MsgUpdateRateLimitResponse{}, nil
+}
+
+// RemoveRateLimit implements the gRPC MsgServer interface. It removes a rate
+// limit, after which the transfers of the denomination over the channel are
+// no longer limited.
+func (k Keeper) RemoveRateLimit(goCtx context.Context, req *types.MsgRemoveRateLimit) (*types.MsgRemoveRateLimitResponse, error) {
+	if err := k.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	rateLimit, err := k.getRateLimit(ctx, req.Denom, req.ChannelId)
+	if err != nil {
+		return nil, err
+	}
+
+	k.DeleteRateLimit(ctx, rateLimit.Path.Denom, rateLimit.Path.ChannelId)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoveRateLimit,
+			sdk.NewAttribute(types.AttributeKeyDenom, rateLimit.Path.Denom),
+			sdk.NewAttribute(types.AttributeKeyChannel, rateLimit.Path.ChannelId),
+		),
+	)
+	return &types.MsgRemoveRateLimitResponse{}, nil
+}
+
+// ResetRateLimit implements the gRPC MsgServer interface. It clears the flow
+// of a rate limit and starts a new window, e.g. to unblock the transfers
+// after a legitimate spike.
+func (k Keeper) ResetRateLimit(goCtx context.Context, req *types.MsgResetRateLimit) (*types.MsgResetRateLimitResponse, error) {
+	if err := k.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	rateLimit, err := k.getRateLimit(ctx, req.Denom, req.ChannelId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.startWindow(ctx, &rateLimit); err != nil {
+		return nil, err
+	}
+	k.SetRateLimit(ctx, rateLimit)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeResetRateLimit,
+			sdk.NewAttribute(types.AttributeKeyDenom, rateLimit.Path.Denom),
+			sdk.NewAttribute(types.AttributeKeyChannel, rateLimit.Path.ChannelId),
+			sdk.NewAttribute(types.AttributeKeyChannelValue, rateLimit.Flow.ChannelValue.String()),
+		),
+	)
+	return &types.MsgResetRateLimitResponse{}, nil
+}
+
+// validateAuthority is a helper function to validate that the provided authority
+// is the keeper's authority address
+func (k Keeper) validateAuthority(authority string) error {
+	if k.authority.String() != authority {
+		return sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, authority)
+	}
+	return nil
+}
+
+// getRateLimit returns the rate limit of a denomination, or of the contract
+// address of an ERC20 token pair, over a channel.
+func (k Keeper) getRateLimit(ctx sdk.Context, denom, channelID string) (types.RateLimit, error) {
+	denom = k.resolveDenom(ctx, denom)
+	rateLimit, found := k.GetRateLimit(ctx, denom, channelID)
+	if !found {
+		return types.RateLimit{}, types.ErrRateLimitNotFound.Wrapf("denom %s, channel %s", denom, channelID)
+	}
+	return rateLimit, nil
+}
+
+// startWindow resets the flow of a rate limit set by governance. Unlike the
+// windows started at the beginning of a block, it fails if the channel value
+// is zero, as such a rate limit would reject all the transfers.
+func (k Keeper) startWindow(ctx sdk.Context, rateLimit *types.RateLimit) error {
+	k.ResetFlow(ctx, rateLimit)
+	if rateLimit.Flow.ChannelValue.IsZero() {
+		return types.ErrZeroChannelValue.Wrapf("denom %s", rateLimit.Path.Denom)
+	}
+	return nil
+}
+
+func emitQuotaEvent(ctx sdk.Context, eventType string, rateLimit types.RateLimit) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyDenom, rateLimit.Path.Denom),
+			sdk.NewAttribute(types.AttributeKeyChannel, rateLimit.Path.ChannelId),
+			sdk.NewAttribute(types.AttributeKeyMaxPercentSend, rateLimit.Quota.MaxPercentSend.String()),
+			sdk.NewAttribute(types.AttributeKeyMaxPercentRecv, rateLimit.Quota.MaxPercentRecv.String()),
+			sdk.NewAttribute(types.AttributeKeyDurationHours, strconv.FormatUint(rateLimit.Quota.DurationHours, 10)),
+			sdk.NewAttribute(types.AttributeKeyChannelValue, rateLimit.Flow.ChannelValue.String()),
+		),
+	)
+}
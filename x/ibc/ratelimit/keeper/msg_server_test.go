@@ -0,0 +1,137 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/evm/x/ibc/ratelimit/types"
+
+	"cosmossdk.io/math"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const channelID = "channel-0"
+
+func (suite *KeeperTestSuite) TestAddRateLimit() {
+	testCases := []struct {
+		name     string
+		malleate func() *types.MsgAddRateLimit
+		expErr   error
+	}{
+		{
+			"fail - invalid authority",
+			func() *types.MsgAddRateLimit {
+				msg := suite.addRateLimitMsg(suite.network.GetBaseDenom())
+				msg.Authority = suite.keyring.GetAccAddr(0).String()
+				return msg
+			},
+			govtypes.ErrInvalidSigner,
+		},
+		{
+			"fail - zero channel value",
+			func() *types.MsgAddRateLimit {
+				return suite.addRateLimitMsg("unknown")
+			},
+			types.ErrZeroChannelValue,
+		},
+		{
+			"fail - rate limit already exists",
+			func() *types.MsgAddRateLimit {
+				msg := suite.addRateLimitMsg(suite.network.GetBaseDenom())
+				_, err := suite.network.App.RateLimitKeeper.AddRateLimit(suite.network.GetContext(), msg)
+				suite.Require().NoError(err)
+				return msg
+			},
+			types.ErrRateLimitAlreadyExists,
+		},
+		{
+			"pass",
+			func() *types.MsgAddRateLimit {
+				return suite.addRateLimitMsg(suite.network.GetBaseDenom())
+			},
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx := suite.network.GetContext()
+			k := suite.network.App.RateLimitKeeper
+
+			msg := tc.malleate()
+			_, err := k.AddRateLimit(ctx, msg)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			suite.Require().NoError(err)
+
+			rateLimit, found := k.GetRateLimit(ctx, msg.Denom, msg.ChannelId)
+			suite.Require().True(found)
+			suite.Require().Equal(msg.MaxPercentSend, rateLimit.Quota.MaxPercentSend)
+			suite.Require().Equal(msg.MaxPercentRecv, rateLimit.Quota.MaxPercentRecv)
+			suite.Require().Equal(suite.network.App.BankKeeper.GetSupply(ctx, msg.Denom).Amount, rateLimit.Flow.ChannelValue)
+			suite.Require().Equal(ctx.BlockTime().Add(24*time.Hour), rateLimit.Flow.WindowEnd)
+			suite.Require().True(rateLimit.Flow.Inflow.IsZero())
+			suite.Require().True(rateLimit.Flow.Outflow.IsZero())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateAndResetRateLimit() {
+	ctx := suite.network.GetContext()
+	k := suite.network.App.RateLimitKeeper
+	denom := suite.network.GetBaseDenom()
+
+	_, err := k.UpdateRateLimit(ctx, &types.MsgUpdateRateLimit{
+		Authority: suite.authority, Denom: denom, ChannelId: channelID,
+		MaxPercentSend: math.NewInt(5), MaxPercentRecv: math.NewInt(5), DurationHours: 1,
+	})
+	suite.Require().ErrorIs(err, types.ErrRateLimitNotFound)
+
+	_, err = k.AddRateLimit(ctx, suite.addRateLimitMsg(denom))
+	suite.Require().NoError(err)
+
+	_, _, err = k.CheckRateLimitAndUpdateFlow(ctx, types.PacketSend, denom, channelID, math.NewInt(1))
+	suite.Require().NoError(err)
+
+	// updating the quota starts a new window
+	_, err = k.UpdateRateLimit(ctx, &types.MsgUpdateRateLimit{
+		Authority: suite.authority, Denom: denom, ChannelId: channelID,
+		MaxPercentSend: math.NewInt(5), MaxPercentRecv: math.NewInt(5), DurationHours: 1,
+	})
+	suite.Require().NoError(err)
+
+	rateLimit, found := k.GetRateLimit(ctx, denom, channelID)
+	suite.Require().True(found)
+	suite.Require().Equal(math.NewInt(5), rateLimit.Quota.MaxPercentSend)
+	suite.Require().Equal(ctx.BlockTime().Add(time.Hour), rateLimit.Flow.WindowEnd)
+	suite.Require().True(rateLimit.Flow.Outflow.IsZero())
+
+	_, _, err = k.CheckRateLimitAndUpdateFlow(ctx, types.PacketRecv, denom, channelID, math.NewInt(1))
+	suite.Require().NoError(err)
+
+	_, err = k.ResetRateLimit(ctx, &types.MsgResetRateLimit{Authority: suite.authority, Denom: denom, ChannelId: channelID})
+	suite.Require().NoError(err)
+
+	rateLimit, _ = k.GetRateLimit(ctx, denom, channelID)
+	suite.Require().True(rateLimit.Flow.Inflow.IsZero())
+
+	_, err = k.RemoveRateLimit(ctx, &types.MsgRemoveRateLimit{Authority: suite.authority, Denom: denom, ChannelId: channelID})
+	suite.Require().NoError(err)
+
+	_, found = k.GetRateLimit(ctx, denom, channelID)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) addRateLimitMsg(denom string) *types.MsgAddRateLimit {
+	return &types.MsgAddRateLimit{
+		Authority:      suite.authority,
+		Denom:          denom,
+		ChannelId:      channelID,
+		MaxPercentSend: math.NewInt(10),
+		MaxPercentRecv: math.NewInt(20),
+		DurationHours:  24,
+	}
+}
@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/contracts"
+	"github.com/cosmos/evm/x/ibc/ratelimit/types"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetRateLimit returns the rate limit of a denomination over a channel.
+func (k Keeper) GetRateLimit(ctx sdk.Context, denom, channelID string) (types.RateLimit, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRateLimit)
+	bz := store.Get(types.RateLimitKey(denom, channelID))
+	if len(bz) == 0 {
+		return types.RateLimit{}, false
+	}
+
+	var rateLimit types.RateLimit
+	k.cdc.MustUnmarshal(bz, &rateLimit)
+	return rateLimit, true
+}
+
+// SetRateLimit stores a rate limit.
+func (k Keeper) SetRateLimit(ctx sdk.Context, rateLimit types.RateLimit) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRateLimit)
+	bz := k.cdc.MustMarshal(&rateLimit)
+	store.Set(types.RateLimitKey(rateLimit.Path.Denom, rateLimit.Path.ChannelId), bz)
+}
+
+// DeleteRateLimit removes the rate limit of a denomination over a channel.
+func (k Keeper) DeleteRateLimit(ctx sdk.Context, denom, channelID string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRateLimit)
+	store.Delete(types.RateLimitKey(denom, channelID))
+}
+
+// GetRateLimits returns all the rate limits.
+func (k Keeper) GetRateLimits(ctx sdk.Context) []types.RateLimit {
+	rateLimits := []types.RateLimit{}
+	k.IterateRateLimits(ctx, func(rateLimit types.RateLimit) bool {
+		rateLimits = append(rateLimits, rateLimit)
+		return false
+	})
+	return rateLimits
+}
+
+// GetRateLimitsByChannel returns the rate limits of a channel.
+func (k Keeper) GetRateLimitsByChannel(ctx sdk.Context, channelID string) []types.RateLimit {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRateLimit)
+	iterator := storetypes.KVStorePrefixIterator(store, types.ChannelKey(channelID))
+	defer iterator.Close()
+
+	rateLimits := []types.RateLimit{}
+	for ; iterator.Valid(); iterator.Next() {
+		var rateLimit types.RateLimit
+		k.cdc.MustUnmarshal(iterator.Value(), &rateLimit)
+		rateLimits = append(rateLimits, rateLimit)
+	}
+	return rateLimits
+}
+
+// IterateRateLimits iterates over all the stored rate limits.
+func (k Keeper) IterateRateLimits(ctx sdk.Context, cb func(rateLimit types.RateLimit) (stop bool)) {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixRateLimit)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var rateLimit types.RateLimit
+		k.cdc.MustUnmarshal(iterator.Value(), &rateLimit)
+
+		if cb(rateLimit) {
+			break
+		}
+	}
+}
+
+// ResetFlow starts a new window for the rate limit, which clears its flow and
+// takes a new snapshot of the channel value.
+//
+// NOTE: all the transfers are rejected during a window whose channel value is
+// zero.
+func (k Keeper) ResetFlow(ctx sdk.Context, rateLimit *types.RateLimit) {
+	channelValue := k.GetChannelValue(ctx, rateLimit.Path.Denom)
+	rateLimit.Flow = types.NewFlow(channelValue, ctx.BlockTime().Add(rateLimit.Quota.Duration()))
+}
+
+// GetChannelValue returns the value that the quotas of a denomination apply
+// to, i.e. its total supply. For native ERC20 token pairs, the supply of the
+// Cosmos coin only accounts for the converted tokens, so the total supply of
+// the ERC20 contract is used instead.
+func (k Keeper) GetChannelValue(ctx sdk.Context, denom string) math.Int {
+	id := k.erc20Keeper.GetTokenPairID(ctx, denom)
+	if pair, found := k.erc20Keeper.GetTokenPair(ctx, id); found && pair.IsNativeERC20() {
+		erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+		supply := k.erc20Keeper.TotalSupply(ctx, erc20, pair.GetERC20Contract())
+		if supply == nil {
+			return math.ZeroInt()
+		}
+		return math.NewIntFromBigInt(supply)
+	}
+
+	return k.bankKeeper.GetSupply(ctx, denom).Amount
+}
+
+// resolveDenom returns the coin denomination of the ERC20 token pair if the
+// given denomination is the contract address of a registered pair, so that
+// the rate limits of the native ERC20 tokens can be referred to by their
+// contract address.
+func (k Keeper) resolveDenom(ctx sdk.Context, denom string) string {
+	if !common.IsHexAddress(denom) {
+		return denom
+	}
+
+	id := k.erc20Keeper.GetTokenPairID(ctx, denom)
+	if pair, found := k.erc20Keeper.GetTokenPair(ctx, id); found {
+		return pair.Denom
+	}
+	return denom
+}
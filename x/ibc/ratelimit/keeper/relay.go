@@ -0,0 +1,152 @@
+package keeper
+
+import (
+	"strings"
+	"time"
+
+	"github.com/cosmos/evm/x/ibc/ratelimit/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CheckRateLimitAndUpdateFlow adds the amount transferred to the flow of the
+// rate limit of the denomination over the channel, if any. It returns an error
+// if the transfer exceeds the quota of the rate limit.
+func (k Keeper) CheckRateLimitAndUpdateFlow(
+	ctx sdk.Context,
+	direction types.PacketDirection,
+	denom, channelID string,
+	amount math.Int,
+) (types.RateLimit, bool, error) {
+	rateLimit, found := k.GetRateLimit(ctx, denom, channelID)
+	if !found {
+		return types.RateLimit{}, false, nil
+	}
+
+	if err := rateLimit.Flow.Add(direction, amount, rateLimit.Quota); err != nil {
+		return types.RateLimit{}, false, err
+	}
+
+	k.SetRateLimit(ctx, rateLimit)
+	return rateLimit, true, nil
+}
+
+// OnRecvPacket adds the amount received to the inflow of the rate limit of
+// the received denomination, and returns an error if the packet exceeds the
+// quota.
+func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return types.ErrInvalidPacket.Wrapf("cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
+	}
+
+	amount, ok := math.NewIntFromString(data.Amount)
+	if !ok {
+		return types.ErrInvalidPacket.Wrapf("invalid amount %s", data.Amount)
+	}
+
+	denom := ReceivedDenom(packet, data.Denom)
+	_, _, err := k.CheckRateLimitAndUpdateFlow(ctx, types.PacketRecv, denom, packet.DestinationChannel, amount)
+	return err
+}
+
+// OnAcknowledgementPacket reverts the outflow of a sent packet if it has
+// been acknowledged with an error, as its tokens are refunded to the sender.
+func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, ack channeltypes.Acknowledgement) error {
+	if ack.Success() {
+		k.DeletePendingSendPacket(ctx, packet.SourceChannel, packet.Sequence)
+		return nil
+	}
+	return k.undoSendPacket(ctx, packet)
+}
+
+// OnTimeoutPacket reverts the outflow of a sent packet that timed out, as its
+// tokens are refunded to the sender.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	return k.undoSendPacket(ctx, packet)
+}
+
+// undoSendPacket removes the amount of a refunded packet from the outflow of
+// its rate limit. The outflow is only reverted if the packet was sent within
+// the current window, since the flow of the window in which it was sent has
+// already been cleared.
+func (k Keeper) undoSendPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	windowEnd, found := k.GetPendingSendPacket(ctx, packet.SourceChannel, packet.Sequence)
+	if !found {
+		return nil
+	}
+	k.DeletePendingSendPacket(ctx, packet.SourceChannel, packet.Sequence)
+
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return types.ErrInvalidPacket.Wrapf("cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
+	}
+
+	amount, ok := math.NewIntFromString(data.Amount)
+	if !ok {
+		return types.ErrInvalidPacket.Wrapf("invalid amount %s", data.Amount)
+	}
+
+	rateLimit, found := k.GetRateLimit(ctx, SentDenom(data.Denom), packet.SourceChannel)
+	if !found || !rateLimit.Flow.WindowEnd.Equal(windowEnd) {
+		return nil
+	}
+
+	rateLimit.Flow.UndoOutflow(amount)
+	k.SetRateLimit(ctx, rateLimit)
+	return nil
+}
+
+// GetPendingSendPacket returns the end of the window in which a packet whose
+// acknowledgement is pending was sent.
+func (k Keeper) GetPendingSendPacket(ctx sdk.Context, channelID string, sequence uint64) (time.Time, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingSendPacket)
+	bz := store.Get(types.PendingSendPacketKey(channelID, sequence))
+	if len(bz) == 0 {
+		return time.Time{}, false
+	}
+
+	windowEnd, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return windowEnd, true
+}
+
+// SetPendingSendPacket records a rate limited packet sent within the window
+// ending at windowEnd, until it's acknowledged or timed out.
+func (k Keeper) SetPendingSendPacket(ctx sdk.Context, channelID string, sequence uint64, windowEnd time.Time) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingSendPacket)
+	store.Set(types.PendingSendPacketKey(channelID, sequence), sdk.FormatTimeBytes(windowEnd))
+}
+
+// DeletePendingSendPacket removes the record of a sent packet.
+func (k Keeper) DeletePendingSendPacket(ctx sdk.Context, channelID string, sequence uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingSendPacket)
+	store.Delete(types.PendingSendPacketKey(channelID, sequence))
+}
+
+// SentDenom returns the denomination on this chain of the coins sent with the
+// given packet denomination, i.e. the base denomination of the native coins
+// or the ibc/ denomination of the vouchers.
+func SentDenom(packetDenom string) string {
+	return transfertypes.ParseDenomTrace(packetDenom).IBCDenom()
+}
+
+// ReceivedDenom returns the denomination on this chain of the coins received
+// with the given packet. If this chain is the source of the coins, the
+// counterparty prefix is removed from the packet denomination. Otherwise, the
+// prefix of the destination channel is added to it.
+func ReceivedDenom(packet channeltypes.Packet, packetDenom string) string {
+	if transfertypes.ReceiverChainIsSource(packet.SourcePort, packet.SourceChannel, packetDenom) {
+		voucherPrefix := transfertypes.GetDenomPrefix(packet.SourcePort, packet.SourceChannel)
+		return SentDenom(strings.TrimPrefix(packetDenom, voucherPrefix))
+	}
+
+	return SentDenom(transfertypes.GetPrefixedDenom(packet.DestinationPort, packet.DestinationChannel, packetDenom))
+}
@@ -0,0 +1,167 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/evm/x/ibc/ratelimit/keeper"
+	"github.com/cosmos/evm/x/ibc/ratelimit/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"cosmossdk.io/math"
+)
+
+func (suite *KeeperTestSuite) TestReceivedDenom() {
+	testCases := []struct {
+		name     string
+		packet   channeltypes.Packet
+		denom    string
+		expDenom string
+	}{
+		{
+			"native coin returned to this chain",
+			channeltypes.Packet{SourcePort: "transfer", SourceChannel: "channel-7", DestinationPort: "transfer", DestinationChannel: channelID},
+			"transfer/channel-7/aatom",
+			"aatom",
+		},
+		{
+			"native ERC20 token returned to this chain",
+			channeltypes.Packet{SourcePort: "transfer", SourceChannel: "channel-7", DestinationPort: "transfer", DestinationChannel: channelID},
+			"transfer/channel-7/erc20/0xdAC17F958D2ee523a2206206994597C13D831ec7",
+			"erc20/0xdAC17F958D2ee523a2206206994597C13D831ec7",
+		},
+		{
+			"voucher of the counterparty coin",
+			channeltypes.Packet{SourcePort: "transfer", SourceChannel: "channel-7", DestinationPort: "transfer", DestinationChannel: channelID},
+			"uosmo",
+			transfertypes.ParseDenomTrace("transfer/channel-0/uosmo").IBCDenom(),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.Require().Equal(tc.expDenom, keeper.ReceivedDenom(tc.packet, tc.denom))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacket() {
+	ctx := suite.network.GetContext()
+	k := suite.network.App.RateLimitKeeper
+	denom := suite.network.GetBaseDenom()
+
+	_, err := k.AddRateLimit(ctx, suite.addRateLimitMsg(denom))
+	suite.Require().NoError(err)
+
+	rateLimit, _ := k.GetRateLimit(ctx, denom, channelID)
+	threshold := rateLimit.Flow.Threshold(rateLimit.Quota.MaxPercentRecv)
+
+	packet := suite.recvPacket(denom, threshold)
+	suite.Require().NoError(k.OnRecvPacket(ctx, packet))
+
+	rateLimit, _ = k.GetRateLimit(ctx, denom, channelID)
+	suite.Require().Equal(threshold, rateLimit.Flow.Inflow)
+
+	// the quota has been used up
+	packet = suite.recvPacket(denom, math.OneInt())
+	suite.Require().ErrorIs(k.OnRecvPacket(ctx, packet), types.ErrQuotaExceeded)
+
+	// the packets of the other channels are not limited
+	packet.DestinationChannel = "channel-1"
+	suite.Require().NoError(k.OnRecvPacket(ctx, packet))
+}
+
+func (suite *KeeperTestSuite) TestUndoSendPacket() {
+	ctx := suite.network.GetContext()
+	k := suite.network.App.RateLimitKeeper
+	denom := suite.network.GetBaseDenom()
+	amount := math.NewInt(100)
+
+	_, err := k.AddRateLimit(ctx, suite.addRateLimitMsg(denom))
+	suite.Require().NoError(err)
+
+	send := func(sequence uint64) channeltypes.Packet {
+		rateLimit, found, err := k.CheckRateLimitAndUpdateFlow(ctx, types.PacketSend, denom, channelID, amount)
+		suite.Require().NoError(err)
+		suite.Require().True(found)
+		k.SetPendingSendPacket(ctx, channelID, sequence, rateLimit.Flow.WindowEnd)
+
+		data := transfertypes.NewFungibleTokenPacketData(denom, amount.String(), "sender", "receiver", "")
+		return channeltypes.Packet{
+			Sequence:      sequence,
+			SourcePort:    transfertypes.PortID,
+			SourceChannel: channelID,
+			Data:          data.GetBytes(),
+		}
+	}
+
+	outflow := func() math.Int {
+		rateLimit, _ := k.GetRateLimit(ctx, denom, channelID)
+		return rateLimit.Flow.Outflow
+	}
+
+	// a successful acknowledgement keeps the outflow
+	packet := send(1)
+	suite.Require().NoError(k.OnAcknowledgementPacket(ctx, packet, channeltypes.NewResultAcknowledgement([]byte{1})))
+	suite.Require().Equal(amount, outflow())
+	_, found := k.GetPendingSendPacket(ctx, channelID, 1)
+	suite.Require().False(found)
+
+	// an error acknowledgement reverts the outflow
+	packet = send(2)
+	suite.Require().NoError(k.OnAcknowledgementPacket(ctx, packet, channeltypes.NewErrorAcknowledgement(types.ErrInvalidPacket)))
+	suite.Require().Equal(amount, outflow())
+
+	// a timeout reverts the outflow
+	packet = send(3)
+	suite.Require().NoError(k.OnTimeoutPacket(ctx, packet))
+	suite.Require().Equal(amount, outflow())
+
+	// the outflow of the previous windows is not reverted
+	packet = send(4)
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(25 * time.Hour))
+	suite.Require().NoError(k.BeginBlock(ctx))
+	suite.Require().True(outflow().IsZero())
+	suite.Require().NoError(k.OnTimeoutPacket(ctx, packet))
+	suite.Require().True(outflow().IsZero())
+}
+
+func (suite *KeeperTestSuite) TestBeginBlock() {
+	ctx := suite.network.GetContext()
+	k := suite.network.App.RateLimitKeeper
+	denom := suite.network.GetBaseDenom()
+
+	_, err := k.AddRateLimit(ctx, suite.addRateLimitMsg(denom))
+	suite.Require().NoError(err)
+
+	_, _, err = k.CheckRateLimitAndUpdateFlow(ctx, types.PacketRecv, denom, channelID, math.NewInt(100))
+	suite.Require().NoError(err)
+
+	// the window hasn't ended yet
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(k.BeginBlock(ctx))
+	rateLimit, _ := k.GetRateLimit(ctx, denom, channelID)
+	suite.Require().Equal(math.NewInt(100), rateLimit.Flow.Inflow)
+
+	// a new window starts once the current one has ended
+	ctx = ctx.WithBlockTime(rateLimit.Flow.WindowEnd)
+	suite.Require().NoError(k.BeginBlock(ctx))
+	rateLimit, _ = k.GetRateLimit(ctx, denom, channelID)
+	suite.Require().True(rateLimit.Flow.Inflow.IsZero())
+	suite.Require().Equal(ctx.BlockTime().Add(24*time.Hour), rateLimit.Flow.WindowEnd)
+}
+
+func (suite *KeeperTestSuite) recvPacket(denom string, amount math.Int) channeltypes.Packet {
+	// the coins return to this chain, which is the source of the denomination
+	data := transfertypes.NewFungibleTokenPacketData(
+		transfertypes.GetPrefixedDenom(transfertypes.PortID, "channel-7", denom),
+		amount.String(), "sender", suite.keyring.GetAccAddr(0).String(), "",
+	)
+	return channeltypes.Packet{
+		SourcePort:         transfertypes.PortID,
+		SourceChannel:      "channel-7",
+		DestinationPort:    transfertypes.PortID,
+		DestinationChannel: channelID,
+		Data:               data.GetBytes(),
+	}
+}
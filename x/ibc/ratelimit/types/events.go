@@ -0,0 +1,16 @@
+package types
+
+// rate limit events
+const (
+	EventTypeAddRateLimit    = "add_rate_limit"
+	EventTypeUpdateRateLimit = "update_rate_limit"
+	EventTypeRemoveRateLimit = "remove_rate_limit"
+	EventTypeResetRateLimit  = "reset_rate_limit"
+
+	AttributeKeyDenom          = "denom"
+	AttributeKeyChannel        = "channel"
+	AttributeKeyMaxPercentSend = "max_percent_send"
+	AttributeKeyMaxPercentRecv = "max_percent_recv"
+	AttributeKeyDurationHours  = "duration_hours"
+	AttributeKeyChannelValue   = "channel_value"
+)
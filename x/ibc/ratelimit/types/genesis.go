@@ -0,0 +1,40 @@
+package types
+
+import (
+	"fmt"
+)
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(rateLimits []RateLimit) GenesisState {
+	return GenesisState{
+		RateLimits: rateLimits,
+	}
+}
+
+// DefaultGenesisState sets default rate limit genesis state without any rate
+// limit.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		RateLimits: []RateLimit{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seen := make(map[string]bool)
+
+	for _, rl := range gs.RateLimits {
+		if err := rl.Validate(); err != nil {
+			return fmt.Errorf("invalid rate limit for denom '%s' on channel '%s': %w", rl.Path.Denom, rl.Path.ChannelId, err)
+		}
+
+		key := string(RateLimitKey(rl.Path.Denom, rl.Path.ChannelId))
+		if seen[key] {
+			return fmt.Errorf("rate limit duplicated on genesis for denom '%s' on channel '%s'", rl.Path.Denom, rl.Path.ChannelId)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
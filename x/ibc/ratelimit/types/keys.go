@@ -0,0 +1,48 @@
+package types
+
+import (
+	"encoding/binary"
+)
+
+// constants
+const (
+	// module name
+	ModuleName = "ratelimit"
+
+	// StoreKey to be used when creating the KVStore
+	StoreKey = ModuleName
+
+	// RouterKey to be used for message routing
+	RouterKey = ModuleName
+)
+
+// prefix bytes for the rate limit persistent store
+const (
+	prefixRateLimit = iota + 1
+	prefixPendingSendPacket
+)
+
+// KVStore key prefixes
+var (
+	KeyPrefixRateLimit         = []byte{prefixRateLimit}
+	KeyPrefixPendingSendPacket = []byte{prefixPendingSendPacket}
+)
+
+// ChannelKey returns the key prefix of the rate limits of a channel. The
+// channel identifiers can't contain a slash, so the prefix of a channel isn't
+// a prefix of any other channel.
+func ChannelKey(channelID string) []byte {
+	return []byte(channelID + "/")
+}
+
+// RateLimitKey returns the key of the rate limit of a denomination over a
+// channel, relative to KeyPrefixRateLimit.
+func RateLimitKey(denom, channelID string) []byte {
+	return append(ChannelKey(channelID), denom...)
+}
+
+// PendingSendPacketKey returns the key of a packet sent over a channel whose
+// acknowledgement is pending, relative to KeyPrefixPendingSendPacket.
+func PendingSendPacketKey(channelID string, sequence uint64) []byte {
+	return binary.BigEndian.AppendUint64(ChannelKey(channelID), sequence)
+}
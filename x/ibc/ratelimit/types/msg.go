@@ -0,0 +1,86 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ sdk.Msg              = &MsgAddRateLimit{}
+	_ sdk.Msg              = &MsgUpdateRateLimit{}
+	_ sdk.Msg              = &MsgRemoveRateLimit{}
+	_ sdk.Msg              = &MsgResetRateLimit{}
+	_ sdk.HasValidateBasic = &MsgAddRateLimit{}
+	_ sdk.HasValidateBasic = &MsgUpdateRateLimit{}
+	_ sdk.HasValidateBasic = &MsgRemoveRateLimit{}
+	_ sdk.HasValidateBasic = &MsgResetRateLimit{}
+)
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgAddRateLimit) ValidateBasic() error {
+	if err := validatePath(m.Authority, m.Denom, m.ChannelId); err != nil {
+		return err
+	}
+	return NewQuota(m.MaxPercentSend, m.MaxPercentRecv, m.DurationHours).Validate()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgAddRateLimit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateRateLimit) ValidateBasic() error {
+	if err := validatePath(m.Authority, m.Denom, m.ChannelId); err != nil {
+		return err
+	}
+	return NewQuota(m.MaxPercentSend, m.MaxPercentRecv, m.DurationHours).Validate()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateRateLimit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgRemoveRateLimit) ValidateBasic() error {
+	return validatePath(m.Authority, m.Denom, m.ChannelId)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgRemoveRateLimit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgResetRateLimit) ValidateBasic() error {
+	return validatePath(m.Authority, m.Denom, m.ChannelId)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgResetRateLimit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// validatePath checks the authority address, the channel identifier and the
+// denomination of a message. Besides the coin denominations, the contract
+// address of an ERC20 token pair can be used as the denomination.
+func validatePath(authority, denom, channelID string) error {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if err := host.ChannelIdentifierValidator(channelID); err != nil {
+		return err
+	}
+
+	if common.IsHexAddress(denom) {
+		return nil
+	}
+	return sdk.ValidateDenom(denom)
+}
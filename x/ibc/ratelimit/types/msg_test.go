@@ -0,0 +1,74 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/x/ibc/ratelimit/types"
+
+	"cosmossdk.io/math"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestMsgAddRateLimitValidateBasic(t *testing.T) {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name     string
+		msg      *types.MsgAddRateLimit
+		expError bool
+	}{
+		{
+			"valid message",
+			&types.MsgAddRateLimit{Authority: authority, Denom: "aatom", ChannelId: "channel-0", MaxPercentSend: math.NewInt(10), MaxPercentRecv: math.NewInt(10), DurationHours: 24},
+			false,
+		},
+		{
+			"valid message - ERC20 contract address",
+			&types.MsgAddRateLimit{Authority: authority, Denom: "0xdAC17F958D2ee523a2206206994597C13D831ec7", ChannelId: "channel-0", MaxPercentSend: math.NewInt(10), MaxPercentRecv: math.NewInt(10), DurationHours: 24},
+			false,
+		},
+		{
+			"invalid authority",
+			&types.MsgAddRateLimit{Authority: "invalid", Denom: "aatom", ChannelId: "channel-0", MaxPercentSend: math.NewInt(10), MaxPercentRecv: math.NewInt(10), DurationHours: 24},
+			true,
+		},
+		{
+			"invalid denom",
+			&types.MsgAddRateLimit{Authority: authority, Denom: "", ChannelId: "channel-0", MaxPercentSend: math.NewInt(10), MaxPercentRecv: math.NewInt(10), DurationHours: 24},
+			true,
+		},
+		{
+			"invalid channel",
+			&types.MsgAddRateLimit{Authority: authority, Denom: "aatom", ChannelId: "", MaxPercentSend: math.NewInt(10), MaxPercentRecv: math.NewInt(10), DurationHours: 24},
+			true,
+		},
+		{
+			"invalid quota",
+			&types.MsgAddRateLimit{Authority: authority, Denom: "aatom", ChannelId: "channel-0", MaxPercentSend: math.NewInt(101), MaxPercentRecv: math.NewInt(10), DurationHours: 24},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgRemoveRateLimitValidateBasic(t *testing.T) {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	require.NoError(t, (&types.MsgRemoveRateLimit{Authority: authority, Denom: "aatom", ChannelId: "channel-0"}).ValidateBasic())
+	require.Error(t, (&types.MsgRemoveRateLimit{Authority: authority, Denom: "aatom"}).ValidateBasic())
+	require.Error(t, (&types.MsgResetRateLimit{Authority: "invalid", Denom: "aatom", ChannelId: "channel-0"}).ValidateBasic())
+}
@@ -0,0 +1,169 @@
+package types
+
+import (
+	stdmath "math"
+	"time"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PacketDirection defines the direction of an ICS20 packet relative to this
+// chain.
+type PacketDirection int
+
+const (
+	// PacketSend is the direction of the packets sent by this chain.
+	PacketSend PacketDirection = iota
+	// PacketRecv is the direction of the packets received by this chain.
+	PacketRecv
+)
+
+// String implements the fmt.Stringer interface.
+func (d PacketDirection) String() string {
+	if d == PacketSend {
+		return "send"
+	}
+	return "recv"
+}
+
+// maxPercent is the largest percentage allowed on the quotas.
+var maxPercent = math.NewInt(100)
+
+// maxDurationHours is the longest window that can be represented as a
+// time.Duration.
+const maxDurationHours = uint64(stdmath.MaxInt64 / int64(time.Hour))
+
+// NewRateLimit creates a new rate limit with an empty flow.
+func NewRateLimit(path Path, quota Quota) RateLimit {
+	return RateLimit{
+		Path:  path,
+		Quota: quota,
+		Flow:  NewFlow(math.ZeroInt(), time.Time{}),
+	}
+}
+
+// Validate performs a stateless validation of the rate limit.
+func (rl RateLimit) Validate() error {
+	if err := rl.Path.Validate(); err != nil {
+		return err
+	}
+	if err := rl.Quota.Validate(); err != nil {
+		return err
+	}
+	return rl.Flow.Validate()
+}
+
+// Validate checks that the denomination and the channel identifier are valid.
+func (p Path) Validate() error {
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return err
+	}
+	return host.ChannelIdentifierValidator(p.ChannelId)
+}
+
+// NewQuota creates a new quota.
+func NewQuota(maxPercentSend, maxPercentRecv math.Int, durationHours uint64) Quota {
+	return Quota{
+		MaxPercentSend: maxPercentSend,
+		MaxPercentRecv: maxPercentRecv,
+		DurationHours:  durationHours,
+	}
+}
+
+// Validate checks that the percentages are between 0 and 100 and that the
+// window isn't empty. A percentage of 0 halts the transfers in its direction.
+func (q Quota) Validate() error {
+	if err := validatePercent(q.MaxPercentSend); err != nil {
+		return errorsmod.Wrapf(err, "invalid max percent send")
+	}
+	if err := validatePercent(q.MaxPercentRecv); err != nil {
+		return errorsmod.Wrapf(err, "invalid max percent recv")
+	}
+	if q.DurationHours == 0 || q.DurationHours > maxDurationHours {
+		return errorsmod.Wrapf(ErrInvalidQuota, "duration hours must be between 1 and %d", maxDurationHours)
+	}
+	return nil
+}
+
+// Duration returns the length of the window.
+func (q Quota) Duration() time.Duration {
+	return time.Duration(q.DurationHours) * time.Hour //#nosec G115 -- bounded by maxDurationHours on validation
+}
+
+func validatePercent(percent math.Int) error {
+	if percent.IsNil() || percent.IsNegative() || percent.GT(maxPercent) {
+		return errorsmod.Wrapf(ErrInvalidQuota, "percent must be between 0 and 100, got %s", percent)
+	}
+	return nil
+}
+
+// NewFlow creates a new flow with no inflow nor outflow.
+func NewFlow(channelValue math.Int, windowEnd time.Time) Flow {
+	return Flow{
+		Inflow:       math.ZeroInt(),
+		Outflow:      math.ZeroInt(),
+		ChannelValue: channelValue,
+		WindowEnd:    windowEnd,
+	}
+}
+
+// Validate checks that the flow amounts are not negative.
+func (f Flow) Validate() error {
+	for _, amt := range []math.Int{f.Inflow, f.Outflow, f.ChannelValue} {
+		if amt.IsNil() || amt.IsNegative() {
+			return errorsmod.Wrapf(ErrInvalidQuota, "flow amounts cannot be nil or negative")
+		}
+	}
+	return nil
+}
+
+// Threshold returns the maximum net flow allowed by the given percentage of
+// the channel value.
+func (f Flow) Threshold(percent math.Int) math.Int {
+	return f.ChannelValue.Mul(percent).Quo(maxPercent)
+}
+
+// Add adds the amount to the flow of the given direction, and returns an
+// error if the resulting net flow exceeds the quota. The net flow of a
+// direction is the amount transferred in that direction minus the amount
+// transferred in the opposite one, so that transfers in one direction free up
+// the quota of the other.
+func (f *Flow) Add(direction PacketDirection, amount math.Int, quota Quota) error {
+	var (
+		netFlow math.Int
+		percent math.Int
+	)
+
+	if direction == PacketSend {
+		netFlow = f.Outflow.Sub(f.Inflow).Add(amount)
+		percent = quota.MaxPercentSend
+	} else {
+		netFlow = f.Inflow.Sub(f.Outflow).Add(amount)
+		percent = quota.MaxPercentRecv
+	}
+
+	if threshold := f.Threshold(percent); netFlow.GT(threshold) {
+		return errorsmod.Wrapf(
+			ErrQuotaExceeded,
+			"%s net flow %s exceeds the threshold %s (%s%% of the channel value %s)",
+			direction, netFlow, threshold, percent, f.ChannelValue,
+		)
+	}
+
+	if direction == PacketSend {
+		f.Outflow = f.Outflow.Add(amount)
+	} else {
+		f.Inflow = f.Inflow.Add(amount)
+	}
+	return nil
+}
+
+// UndoOutflow removes the amount of a failed send from the outflow.
+func (f *Flow) UndoOutflow(amount math.Int) {
+	f.Outflow = math.MaxInt(f.Outflow.Sub(amount), math.ZeroInt())
+}
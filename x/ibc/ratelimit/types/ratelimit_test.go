@@ -0,0 +1,181 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/x/ibc/ratelimit/types"
+
+	"cosmossdk.io/math"
+)
+
+func TestQuotaValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		quota    types.Quota
+		expError bool
+	}{
+		{"valid quota", types.NewQuota(math.NewInt(10), math.NewInt(20), 24), false},
+		{"valid quota - halted direction", types.NewQuota(math.ZeroInt(), math.NewInt(100), 1), false},
+		{"nil percent", types.Quota{MaxPercentRecv: math.NewInt(10), DurationHours: 24}, true},
+		{"negative percent", types.NewQuota(math.NewInt(-1), math.NewInt(10), 24), true},
+		{"percent above 100", types.NewQuota(math.NewInt(10), math.NewInt(101), 24), true},
+		{"zero duration", types.NewQuota(math.NewInt(10), math.NewInt(10), 0), true},
+		{"duration overflow", types.NewQuota(math.NewInt(10), math.NewInt(10), 1<<62), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.quota.Validate()
+			if tc.expError {
+				require.ErrorIs(t, err, types.ErrInvalidQuota)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestPathValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		path     types.Path
+		expError bool
+	}{
+		{"valid path", types.Path{Denom: "aatom", ChannelId: "channel-0"}, false},
+		{"valid path - voucher", types.Path{Denom: "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", ChannelId: "channel-0"}, false},
+		{"valid path - erc20 token pair", types.Path{Denom: "erc20/0xdAC17F958D2ee523a2206206994597C13D831ec7", ChannelId: "channel-0"}, false},
+		{"invalid denom", types.Path{Denom: "0", ChannelId: "channel-0"}, true},
+		{"invalid channel", types.Path{Denom: "aatom", ChannelId: "chan/0"}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.path.Validate()
+			if tc.expError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestFlowAdd(t *testing.T) {
+	// 10% of the channel value can be sent and 20% received
+	quota := types.NewQuota(math.NewInt(10), math.NewInt(20), 24)
+
+	testCases := []struct {
+		name       string
+		transfers  []int64
+		directions []types.PacketDirection
+		expError   bool
+		expInflow  int64
+		expOutflow int64
+	}{
+		{
+			"send within the quota",
+			[]int64{60, 40},
+			[]types.PacketDirection{types.PacketSend, types.PacketSend},
+			false, 0, 100,
+		},
+		{
+			"send above the quota",
+			[]int64{60, 41},
+			[]types.PacketDirection{types.PacketSend, types.PacketSend},
+			true, 0, 60,
+		},
+		{
+			"receive within the quota",
+			[]int64{200},
+			[]types.PacketDirection{types.PacketRecv},
+			false, 200, 0,
+		},
+		{
+			"receive above the quota",
+			[]int64{201},
+			[]types.PacketDirection{types.PacketRecv},
+			true, 0, 0,
+		},
+		{
+			"inflow frees up the send quota",
+			[]int64{100, 50, 50},
+			[]types.PacketDirection{types.PacketSend, types.PacketRecv, types.PacketSend},
+			false, 50, 150,
+		},
+		{
+			"outflow frees up the receive quota",
+			[]int64{100, 300},
+			[]types.PacketDirection{types.PacketSend, types.PacketRecv},
+			false, 300, 100,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			flow := types.NewFlow(math.NewInt(1000), time.Now())
+
+			var err error
+			for i, amt := range tc.transfers {
+				if err = flow.Add(tc.directions[i], math.NewInt(amt), quota); err != nil {
+					break
+				}
+			}
+
+			if tc.expError {
+				require.ErrorIs(t, err, types.ErrQuotaExceeded)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, math.NewInt(tc.expInflow), flow.Inflow)
+			require.Equal(t, math.NewInt(tc.expOutflow), flow.Outflow)
+		})
+	}
+}
+
+func TestFlowUndoOutflow(t *testing.T) {
+	flow := types.NewFlow(math.NewInt(1000), time.Now())
+	flow.Outflow = math.NewInt(100)
+
+	flow.UndoOutflow(math.NewInt(40))
+	require.Equal(t, math.NewInt(60), flow.Outflow)
+
+	// the outflow never becomes negative
+	flow.UndoOutflow(math.NewInt(100))
+	require.Equal(t, math.ZeroInt(), flow.Outflow)
+}
+
+func TestGenesisStateValidate(t *testing.T) {
+	rateLimit := types.NewRateLimit(
+		types.Path{Denom: "aatom", ChannelId: "channel-0"},
+		types.NewQuota(math.NewInt(10), math.NewInt(10), 24),
+	)
+	otherChannel := rateLimit
+	otherChannel.Path.ChannelId = "channel-1"
+	invalidFlow := rateLimit
+	invalidFlow.Flow.Inflow = math.NewInt(-1)
+
+	testCases := []struct {
+		name     string
+		genState types.GenesisState
+		expError bool
+	}{
+		{"default genesis", *types.DefaultGenesisState(), false},
+		{"valid genesis", types.NewGenesisState([]types.RateLimit{rateLimit, otherChannel}), false},
+		{"duplicated rate limit", types.NewGenesisState([]types.RateLimit{rateLimit, rateLimit}), true},
+		{"invalid flow", types.NewGenesisState([]types.RateLimit{invalidFlow}), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.expError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,392 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/evm/x/vm/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+const (
+	// FlagGethFormat defines the flag to select the format of the geth state file.
+	FlagGethFormat = "format"
+
+	// GethFormatGenesis is the format of the alloc field of a geth genesis.json file.
+	GethFormatGenesis = "genesis"
+	// GethFormatDump is the format of the output of the geth dump command, both
+	// in collected and iterative (line by line) mode.
+	GethFormatDump = "dump"
+)
+
+// GethAccount is the state of an account imported from geth.
+type GethAccount struct {
+	Balance *big.Int
+	Nonce   uint64
+	Code    []byte
+	Storage map[common.Hash]common.Hash
+}
+
+// ImportGethStateCmd returns a command that imports the accounts of a geth
+// genesis.json alloc or of a geth state dump into the genesis file. For every
+// account it creates the x/auth account and the x/bank balance, and for
+// accounts with code or storage the matching x/vm genesis account.
+//
+// The evmAppOptions function is called with the genesis chain ID to configure
+// the EVM coin, since the balances are converted from 18 decimals to the EVM
+// coin decimals.
+func ImportGethStateCmd(defaultNodeHome string, evmAppOptions func(string) error) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-geth-state [file]",
+		Short: "Import the accounts of a geth genesis.json alloc or state dump into genesis.json",
+		Long: `Import the accounts of a geth genesis.json alloc or of a geth state dump into genesis.json.
+The auth accounts, bank balances and EVM code and storage of every account are added to the genesis state.
+Balances are converted from 18 decimals to the EVM coin decimals, truncating any remainder.
+The accounts at precompile addresses are skipped, and the accounts at preinstall addresses must have the
+code of the preinstall they replace.
+`,
+		Example: fmt.Sprintf(`$ %[1]s genesis import-geth-state genesis.json
+$ %[1]s genesis import-geth-state state.json --format dump`, "evmd"),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			config := server.GetServerContextFromCmd(cmd).Config
+			config.SetRoot(clientCtx.HomeDir)
+
+			format, err := cmd.Flags().GetString(FlagGethFormat)
+			if err != nil {
+				return err
+			}
+
+			file, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			accounts, err := ReadGethAccounts(file, format)
+			if err != nil {
+				return fmt.Errorf("failed to read geth accounts: %w", err)
+			}
+
+			genFile := config.GenesisFile()
+			appState, appGenesis, err := genutiltypes.GenesisStateFromGenFile(genFile)
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+			}
+
+			if err := evmAppOptions(appGenesis.ChainID); err != nil {
+				return fmt.Errorf("failed to configure the EVM coin: %w", err)
+			}
+
+			skipped, err := AddGethAccounts(clientCtx.Codec, appState, accounts)
+			if err != nil {
+				return err
+			}
+
+			appStateJSON, err := json.Marshal(appState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal application genesis state: %w", err)
+			}
+
+			appGenesis.AppState = appStateJSON
+			if err := genutil.ExportGenesisFile(appGenesis, genFile); err != nil {
+				return err
+			}
+
+			for _, address := range skipped {
+				if err := clientCtx.PrintString(fmt.Sprintf("skipped account %s at a precompile address\n", address)); err != nil {
+					return err
+				}
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("imported %d accounts into %s\n", len(accounts)-len(skipped), genFile))
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(FlagGethFormat, GethFormatGenesis, fmt.Sprintf("The format of the geth state file (%s|%s)", GethFormatGenesis, GethFormatDump))
+
+	return cmd
+}
+
+// ReadGethAccounts reads the accounts from a geth genesis.json alloc or from a
+// geth state dump.
+func ReadGethAccounts(r io.Reader, format string) (map[common.Address]GethAccount, error) {
+	switch format {
+	case GethFormatGenesis:
+		return readGethGenesisAlloc(r)
+	case GethFormatDump:
+		return readGethDump(r)
+	default:
+		return nil, fmt.Errorf("invalid geth state format %q, expected %s or %s", format, GethFormatGenesis, GethFormatDump)
+	}
+}
+
+func readGethGenesisAlloc(r io.Reader) (map[common.Address]GethAccount, error) {
+	var genesis struct {
+		Alloc core.GenesisAlloc `json:"alloc"`
+	}
+	if err := json.NewDecoder(r).Decode(&genesis); err != nil {
+		return nil, err
+	}
+
+	accounts := make(map[common.Address]GethAccount, len(genesis.Alloc))
+	for address, account := range genesis.Alloc {
+		accounts[address] = GethAccount{
+			Balance: account.Balance,
+			Nonce:   account.Nonce,
+			Code:    account.Code,
+			Storage: account.Storage,
+		}
+	}
+
+	return accounts, nil
+}
+
+// readGethDump reads a geth state dump. The collected dump is a single JSON
+// object with all the accounts, while the iterative dump is a JSON object per
+// line, starting with the state root and followed by one line per account.
+func readGethDump(r io.Reader) (map[common.Address]GethAccount, error) {
+	accounts := make(map[common.Address]GethAccount)
+
+	decoder := json.NewDecoder(r)
+	for {
+		var raw json.RawMessage
+		if err := decoder.Decode(&raw); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, err
+		}
+
+		var entry struct {
+			Accounts map[common.Address]state.DumpAccount `json:"accounts"`
+			Address  *common.Address                      `json:"address"`
+			Key      string                               `json:"key"`
+		}
+		if err := json.Unmarshal(raw, &entry); err != nil {
+			return nil, err
+		}
+
+		dumpAccounts := entry.Accounts
+		switch {
+		case entry.Address != nil:
+			var account state.DumpAccount
+			if err := json.Unmarshal(raw, &account); err != nil {
+				return nil, err
+			}
+			dumpAccounts = map[common.Address]state.DumpAccount{*entry.Address: account}
+		case entry.Key != "":
+			return nil, fmt.Errorf("missing address preimage for account key %s", entry.Key)
+		}
+
+		for address, dumpAccount := range dumpAccounts {
+			account, err := gethAccountFromDump(dumpAccount)
+			if err != nil {
+				return nil, fmt.Errorf("invalid account %s: %w", address, err)
+			}
+			accounts[address] = account
+		}
+	}
+
+	return accounts, nil
+}
+
+func gethAccountFromDump(dumpAccount state.DumpAccount) (GethAccount, error) {
+	balance, ok := new(big.Int).SetString(dumpAccount.Balance, 10)
+	if !ok {
+		return GethAccount{}, fmt.Errorf("invalid balance %q", dumpAccount.Balance)
+	}
+
+	storage := make(map[common.Hash]common.Hash, len(dumpAccount.Storage))
+	for key, value := range dumpAccount.Storage {
+		storage[key] = common.HexToHash(value)
+	}
+
+	return GethAccount{
+		Balance: balance,
+		Nonce:   dumpAccount.Nonce,
+		Code:    dumpAccount.Code,
+		Storage: storage,
+	}, nil
+}
+
+// AddGethAccounts adds the given geth accounts to the x/auth, x/bank and x/vm
+// genesis states of the app state and returns the addresses of the skipped
+// accounts. The balances are added in the EVM denom of the x/vm params,
+// converted to the EVM coin decimals.
+//
+// The accounts at precompile addresses are skipped, as the precompiles take
+// precedence over any code or storage, e.g. the balances that geth allocs give
+// to the Ethereum precompiles. The x/vm preinstalls at the address of an
+// imported account are removed in favor of the account, which must have the
+// code of the preinstall so that the canonical contract can't be replaced.
+//
+// CONTRACT: the EVM coin must be configured before calling this function.
+func AddGethAccounts(cdc codec.Codec, appState map[string]json.RawMessage, accounts map[common.Address]GethAccount) ([]common.Address, error) {
+	authGenState := authtypes.GetGenesisStateFromAppState(cdc, appState)
+	accs, err := authtypes.UnpackAccounts(authGenState.Accounts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get accounts from any: %w", err)
+	}
+
+	bankGenState := banktypes.GetGenesisStateFromAppState(cdc, appState)
+
+	var evmGenState types.GenesisState
+	if err := cdc.UnmarshalJSON(appState[types.ModuleName], &evmGenState); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal evm genesis state: %w", err)
+	}
+
+	evmAccounts := make(map[common.Address]bool, len(evmGenState.Accounts))
+	for _, account := range evmGenState.Accounts {
+		evmAccounts[common.HexToAddress(account.Address)] = true
+	}
+
+	preinstallCodes := make(map[common.Address][]byte, len(evmGenState.Preinstalls))
+	for _, preinstall := range evmGenState.Preinstalls {
+		preinstallCodes[common.HexToAddress(preinstall.Address)] = common.FromHex(preinstall.Code)
+	}
+
+	// sort the addresses to get a deterministic genesis file
+	addresses := make([]common.Address, 0, len(accounts))
+	for address := range accounts {
+		addresses = append(addresses, address)
+	}
+	sort.Slice(addresses, func(i, j int) bool {
+		return bytes.Compare(addresses[i].Bytes(), addresses[j].Bytes()) < 0
+	})
+
+	var skipped []common.Address
+	denom := evmGenState.Params.EvmDenom
+	for _, address := range addresses {
+		account := accounts[address]
+		accAddr := sdk.AccAddress(address.Bytes())
+
+		if types.IsPrecompileAddress(address) {
+			skipped = append(skipped, address)
+			continue
+		}
+
+		if code, found := preinstallCodes[address]; found && !bytes.Equal(code, account.Code) {
+			return nil, fmt.Errorf("account %s doesn't have the code of the preinstall at its address", address)
+		}
+
+		if accs.Contains(accAddr) {
+			return nil, fmt.Errorf("account %s already exists in the genesis state", address)
+		}
+		accs = append(accs, authtypes.NewBaseAccount(accAddr, nil, 0, account.Nonce))
+
+		if account.Balance != nil && account.Balance.Sign() > 0 {
+			amount := sdkmath.NewIntFromBigInt(types.ConvertAmountFrom18DecimalsBigInt(account.Balance))
+			if amount.IsPositive() {
+				coins := sdk.NewCoins(sdk.NewCoin(denom, amount))
+				bankGenState.Balances = append(bankGenState.Balances, banktypes.Balance{Address: accAddr.String(), Coins: coins})
+				bankGenState.Supply = bankGenState.Supply.Add(coins...)
+			}
+		}
+
+		genAccount := newGenesisAccount(address, account)
+		if len(account.Code) == 0 && len(genAccount.Storage) == 0 {
+			continue
+		}
+
+		if evmAccounts[address] {
+			return nil, fmt.Errorf("evm account %s already exists in the genesis state", address)
+		}
+		evmGenState.Accounts = append(evmGenState.Accounts, genAccount)
+	}
+
+	// the imported accounts replace the preinstalls at the same address, e.g. the
+	// CREATE2 deployer or Multicall3 that most EVM chains already have, as they
+	// carry the state of the imported chain
+	preinstalls := make([]types.Preinstall, 0, len(evmGenState.Preinstalls))
+	for _, preinstall := range evmGenState.Preinstalls {
+		if _, found := accounts[common.HexToAddress(preinstall.Address)]; !found {
+			preinstalls = append(preinstalls, preinstall)
+		}
+	}
+	evmGenState.Preinstalls = preinstalls
+
+	if err := evmGenState.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid evm genesis state: %w", err)
+	}
+
+	accs = authtypes.SanitizeGenesisAccounts(accs)
+	genAccs, err := authtypes.PackAccounts(accs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert accounts into any's: %w", err)
+	}
+	authGenState.Accounts = genAccs
+
+	authGenStateBz, err := cdc.MarshalJSON(&authGenState)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal auth genesis state: %w", err)
+	}
+	appState[authtypes.ModuleName] = authGenStateBz
+
+	bankGenState.Balances = banktypes.SanitizeGenesisBalances(bankGenState.Balances)
+	bankGenStateBz, err := cdc.MarshalJSON(bankGenState)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal bank genesis state: %w", err)
+	}
+	appState[banktypes.ModuleName] = bankGenStateBz
+
+	evmGenStateBz, err := cdc.MarshalJSON(&evmGenState)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal evm genesis state: %w", err)
+	}
+	appState[types.ModuleName] = evmGenStateBz
+
+	return skipped, nil
+}
+
+// newGenesisAccount returns the x/vm genesis account for the given geth
+// account, with the storage sorted by key.
+func newGenesisAccount(address common.Address, account GethAccount) types.GenesisAccount {
+	keys := make([]common.Hash, 0, len(account.Storage))
+	for key := range account.Storage {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return bytes.Compare(keys[i].Bytes(), keys[j].Bytes()) < 0
+	})
+
+	storage := make(types.Storage, 0, len(keys))
+	for _, key := range keys {
+		value := account.Storage[key]
+		// geth doesn't store empty slots
+		if value == (common.Hash{}) {
+			continue
+		}
+		storage = append(storage, types.NewState(key, value))
+	}
+
+	return types.GenesisAccount{
+		Address: address.String(),
+		Code:    common.Bytes2Hex(account.Code),
+		Storage: storage,
+	}
+}
@@ -0,0 +1,225 @@
+package cli
+
+import (
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/x/vm/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestReadGethAccounts(t *testing.T) {
+	address := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	slot := common.HexToHash("0x01")
+
+	testCases := []struct {
+		name     string
+		format   string
+		input    string
+		expPass  bool
+		expected GethAccount
+	}{
+		{
+			"pass - genesis alloc",
+			GethFormatGenesis,
+			`{"config":{},"alloc":{"1000000000000000000000000000000000000001":{"balance":"0x64","nonce":"0x2","code":"0x6001","storage":{"0x01":"0x02"}}}}`,
+			true,
+			GethAccount{
+				Balance: big.NewInt(100),
+				Nonce:   2,
+				Code:    []byte{0x60, 0x01},
+				Storage: map[common.Hash]common.Hash{slot: common.HexToHash("0x02")},
+			},
+		},
+		{
+			"pass - collected dump",
+			GethFormatDump,
+			`{"root":"00","accounts":{"0x1000000000000000000000000000000000000001":{"balance":"100","nonce":2,"root":"0x00","codeHash":"0x00","code":"0x6001","storage":{"0x0000000000000000000000000000000000000000000000000000000000000001":"02"}}}}`,
+			true,
+			GethAccount{
+				Balance: big.NewInt(100),
+				Nonce:   2,
+				Code:    []byte{0x60, 0x01},
+				Storage: map[common.Hash]common.Hash{slot: common.HexToHash("0x02")},
+			},
+		},
+		{
+			"pass - iterative dump",
+			GethFormatDump,
+			`{"root":"00"}
+{"balance":"100","nonce":2,"root":"0x00","codeHash":"0x00","code":"0x6001","storage":{"0x0000000000000000000000000000000000000000000000000000000000000001":"02"},"address":"0x1000000000000000000000000000000000000001"}`,
+			true,
+			GethAccount{
+				Balance: big.NewInt(100),
+				Nonce:   2,
+				Code:    []byte{0x60, 0x01},
+				Storage: map[common.Hash]common.Hash{slot: common.HexToHash("0x02")},
+			},
+		},
+		{
+			"fail - dump account without address preimage",
+			GethFormatDump,
+			`{"balance":"100","nonce":0,"root":"0x00","codeHash":"0x00","key":"0x01"}`,
+			false,
+			GethAccount{},
+		},
+		{
+			"fail - invalid dump balance",
+			GethFormatDump,
+			`{"balance":"0x64","nonce":0,"root":"0x00","codeHash":"0x00","address":"0x1000000000000000000000000000000000000001"}`,
+			false,
+			GethAccount{},
+		},
+		{
+			"fail - invalid format",
+			"parity",
+			`{}`,
+			false,
+			GethAccount{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			accounts, err := ReadGethAccounts(strings.NewReader(tc.input), tc.format)
+			if !tc.expPass {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, accounts, 1)
+			require.Equal(t, tc.expected, accounts[address])
+		})
+	}
+}
+
+// newGethImportAppState returns the codec and an app state with the default
+// preinstalls to import geth accounts into.
+func newGethImportAppState(t *testing.T) (codec.Codec, map[string]json.RawMessage) {
+	t.Helper()
+	configurator := types.NewEVMConfigurator()
+	configurator.ResetTestConfig()
+	require.NoError(t, configurator.WithEVMCoinInfo("aatom", uint8(types.EighteenDecimals)).Configure())
+
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	authtypes.RegisterInterfaces(interfaceRegistry)
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+
+	evmGenState := types.DefaultGenesisState()
+	evmGenState.Params.EvmDenom = "aatom"
+	evmGenState.Preinstalls = types.DefaultPreinstalls
+	evmGenStateBz, err := cdc.MarshalJSON(evmGenState)
+	require.NoError(t, err)
+
+	return cdc, map[string]json.RawMessage{
+		authtypes.ModuleName: cdc.MustMarshalJSON(authtypes.DefaultGenesisState()),
+		banktypes.ModuleName: cdc.MustMarshalJSON(banktypes.DefaultGenesisState()),
+		types.ModuleName:     evmGenStateBz,
+	}
+}
+
+func TestAddGethAccountsPreinstalls(t *testing.T) {
+	cdc, appState := newGethImportAppState(t)
+
+	// the CREATE2 deployer is part of most geth allocs and dumps
+	create2 := types.DefaultPreinstalls[0]
+	create2Address := common.HexToAddress(create2.Address)
+	accounts := map[common.Address]GethAccount{
+		create2Address: {
+			Balance: big.NewInt(100),
+			Nonce:   1,
+			Code:    common.FromHex(create2.Code),
+		},
+	}
+	skipped, err := AddGethAccounts(cdc, appState, accounts)
+	require.NoError(t, err)
+	require.Empty(t, skipped)
+
+	var gotEVMGenState types.GenesisState
+	require.NoError(t, cdc.UnmarshalJSON(appState[types.ModuleName], &gotEVMGenState))
+	require.Len(t, gotEVMGenState.Preinstalls, len(types.DefaultPreinstalls)-1)
+	for _, preinstall := range gotEVMGenState.Preinstalls {
+		require.NotEqual(t, create2Address, common.HexToAddress(preinstall.Address))
+	}
+	require.Len(t, gotEVMGenState.Accounts, 1)
+	require.Equal(t, create2Address, common.HexToAddress(gotEVMGenState.Accounts[0].Address))
+	require.Equal(t, common.FromHex(create2.Code), common.FromHex(gotEVMGenState.Accounts[0].Code))
+}
+
+func TestAddGethAccountsReservedAddresses(t *testing.T) {
+	ecrecover := common.BytesToAddress([]byte{0x01})
+	staking := common.HexToAddress(types.StakingPrecompileAddress)
+	account := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	multicall3 := types.DefaultPreinstalls[1]
+
+	testCases := []struct {
+		name       string
+		accounts   map[common.Address]GethAccount
+		expPass    bool
+		expSkipped []common.Address
+	}{
+		{
+			"pass - accounts at precompile addresses are skipped",
+			map[common.Address]GethAccount{
+				// geth allocs usually give a balance to the Ethereum precompiles
+				ecrecover: {Balance: big.NewInt(1)},
+				staking:   {Balance: big.NewInt(100), Code: []byte{0x60, 0x01}},
+				account:   {Balance: big.NewInt(100)},
+			},
+			true,
+			[]common.Address{ecrecover, staking},
+		},
+		{
+			"fail - account at a preinstall address with another code",
+			map[common.Address]GethAccount{
+				common.HexToAddress(multicall3.Address): {Balance: big.NewInt(100), Code: []byte{0x60, 0x01}},
+			},
+			false,
+			nil,
+		},
+		{
+			"fail - account at a preinstall address without code",
+			map[common.Address]GethAccount{
+				common.HexToAddress(multicall3.Address): {Balance: big.NewInt(100)},
+			},
+			false,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cdc, appState := newGethImportAppState(t)
+
+			skipped, err := AddGethAccounts(cdc, appState, tc.accounts)
+			if !tc.expPass {
+				require.ErrorContains(t, err, "doesn't have the code of the preinstall")
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expSkipped, skipped)
+
+			authGenState := authtypes.GetGenesisStateFromAppState(cdc, appState)
+			accs, err := authtypes.UnpackAccounts(authGenState.Accounts)
+			require.NoError(t, err)
+			require.Len(t, accs, 1)
+			require.Equal(t, sdk.AccAddress(account.Bytes()), accs[0].GetAddress())
+
+			var gotEVMGenState types.GenesisState
+			require.NoError(t, cdc.UnmarshalJSON(appState[types.ModuleName], &gotEVMGenState))
+			require.Empty(t, gotEVMGenState.Accounts)
+		})
+	}
+}
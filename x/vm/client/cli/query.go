@@ -26,6 +26,9 @@ func GetQueryCmd() *cobra.Command {
 		GetAccountCmd(),
 		GetParamsCmd(),
 		GetConfigCmd(),
+		GetAccessControlEntriesCmd(),
+		GetAccessControlEntryCmd(),
+		GetFrozenContractsCmd(),
 	)
 	return cmd
 }
@@ -199,3 +202,121 @@ func GetConfigCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetAccessControlEntriesCmd queries the call permission rules of all contracts or of a given one
+func GetAccessControlEntriesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "access-control-entries [CONTRACT]",
+		Short: "Gets the call permission rules",
+		Long:  "Gets the call permission rules of all the contracts, or only of the given contract.",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryAccessControlEntriesRequest{
+				Pagination: pageReq,
+			}
+			if len(args) == 1 {
+				req.Contract, err = accountToHex(args[0])
+				if err != nil {
+					return err
+				}
+			}
+
+			res, err := queryClient.AccessControlEntries(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "access control entries")
+	return cmd
+}
+
+// GetAccessControlEntryCmd queries the call permission rule of a contract or contract function
+func GetAccessControlEntryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "access-control-entry CONTRACT [SELECTOR]",
+		Short: "Gets the call permission rule of a contract function",
+		Long:  "Gets the call permission rule of the contract function with the given hex selector, or the contract-wide rule if the selector is not provided.",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			contract, err := accountToHex(args[0])
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryAccessControlEntryRequest{
+				Contract: contract,
+			}
+			if len(args) == 2 {
+				req.Selector = args[1]
+			}
+
+			res, err := queryClient.AccessControlEntry(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetFrozenContractsCmd queries the contracts frozen by governance
+func GetFrozenContractsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "frozen-contracts",
+		Short: "Gets the frozen contracts",
+		Long:  "Gets the addresses of the contracts frozen by governance.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.FrozenContracts(cmd.Context(), &types.QueryFrozenContractsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "frozen contracts")
+	return cmd
+}
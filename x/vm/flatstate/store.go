@@ -0,0 +1,296 @@
+package flatstate
+
+import (
+	"encoding/binary"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	dbm "github.com/cosmos/cosmos-db"
+)
+
+var (
+	// keyHeight is the database key of the height the snapshot is synced at
+	keyHeight = []byte{0x00}
+	// prefixStorage is the database prefix of the storage values
+	prefixStorage = []byte{0x01}
+)
+
+// Entry is a contract storage slot and its value. An empty value means the
+// slot was deleted.
+type Entry struct {
+	Address common.Address
+	Key     common.Hash
+	Value   []byte
+}
+
+type slotKey struct {
+	addr common.Address
+	key  common.Hash
+}
+
+// Store is a flat snapshot of the EVM contract storage keyed by (address, slot)
+// at a committed block height. It allows to serve the storage reads without
+// traversing the IAVL tree of the module store.
+//
+// The snapshot only reflects the committed state, so the slots written during
+// the execution of a block are marked as dirty and read from the module store
+// until the block is committed. The values of the dirty slots at the end of the
+// block are then kept as pending and applied at the beginning of the next one.
+//
+// The snapshot is backed by a cosmos-db database, which can be in-memory or
+// persisted on disk (e.g. LevelDB) to avoid rebuilding it on every restart.
+type Store struct {
+	mtx sync.RWMutex
+	db  dbm.DB
+
+	// height is the block height the snapshot is synced at, -1 if not synced
+	height int64
+
+	// dirty are the slots written during the execution of the block at dirtyHeight
+	dirty       map[slotKey]struct{}
+	dirtyHeight int64
+
+	// pending are the values of the dirty slots at the end of the block at pendingHeight
+	pending       []Entry
+	pendingHeight int64
+}
+
+// NewStore returns a new flat state snapshot backed by the given database. The
+// snapshot is considered synced at the height last stored in the database, if any.
+func NewStore(db dbm.DB) (*Store, error) {
+	bz, err := db.Get(keyHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	height := int64(-1)
+	if len(bz) == 8 {
+		height = int64(binary.BigEndian.Uint64(bz)) //#nosec G115 -- height is stored from an int64
+	}
+
+	return &Store{
+		db:            db,
+		height:        height,
+		dirty:         make(map[slotKey]struct{}),
+		dirtyHeight:   -1,
+		pendingHeight: -1,
+	}, nil
+}
+
+// NewMemStore returns a new in-memory flat state snapshot, which has to be rebuilt
+// on every start.
+func NewMemStore() *Store {
+	store, err := NewStore(dbm.NewMemDB())
+	if err != nil {
+		panic(err)
+	}
+	return store
+}
+
+// Height returns the block height the snapshot is synced at, or -1 if it's not synced.
+func (s *Store) Height() int64 {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+
+	return s.height
+}
+
+// Get returns the value of the storage slot if the snapshot is synced at the given height.
+// The second return value is false if the snapshot cannot be used to serve the read.
+func (s *Store) Get(height int64, addr common.Address, key common.Hash) ([]byte, bool) {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+
+	if s.height < 0 || s.height != height {
+		return nil, false
+	}
+
+	value, err := s.db.Get(storageKey(addr, key))
+	if err != nil {
+		return nil, false
+	}
+	return value, true
+}
+
+// MarkDirty marks the storage slot as written during the execution of the block at the
+// given height. The dirty slots of the previous blocks are discarded.
+func (s *Store) MarkDirty(height int64, addr common.Address, key common.Hash) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.resetDirty(height)
+	s.dirty[slotKey{addr: addr, key: key}] = struct{}{}
+}
+
+// IsDirty returns true if the storage slot was written during the execution of the block at
+// the given height.
+func (s *Store) IsDirty(height int64, addr common.Address, key common.Hash) bool {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+
+	if s.dirtyHeight != height {
+		return false
+	}
+	_, ok := s.dirty[slotKey{addr: addr, key: key}]
+	return ok
+}
+
+// DirtySlots returns the slots written during the execution of the block at the given height.
+func (s *Store) DirtySlots(height int64) []Entry {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+
+	if s.dirtyHeight != height {
+		return nil
+	}
+
+	slots := make([]Entry, 0, len(s.dirty))
+	for slot := range s.dirty {
+		slots = append(slots, Entry{Address: slot.addr, Key: slot.key})
+	}
+	return slots
+}
+
+// SetPending sets the final values of the slots written in the block at the given height, to
+// be applied once the block is committed.
+func (s *Store) SetPending(height int64, entries []Entry) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.pending = entries
+	s.pendingHeight = height
+}
+
+// ApplyPending applies the pending values of the block at the given height if the snapshot is
+// synced at the previous one. It returns true if the snapshot is synced at the given height
+// afterwards.
+func (s *Store) ApplyPending(height int64) (bool, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.height == height {
+		return true, nil
+	}
+	if s.pendingHeight != height || s.height < 0 || s.height != height-1 {
+		return false, nil
+	}
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	for _, entry := range s.pending {
+		var err error
+		if len(entry.Value) == 0 {
+			err = batch.Delete(storageKey(entry.Address, entry.Key))
+		} else {
+			err = batch.Set(storageKey(entry.Address, entry.Key), entry.Value)
+		}
+		if err != nil {
+			return false, err
+		}
+	}
+
+	if err := s.writeHeight(batch, height); err != nil {
+		return false, err
+	}
+	s.pending = nil
+	s.pendingHeight = -1
+	return true, nil
+}
+
+// Reset replaces the snapshot content with the storage entries provided by the iterate
+// function and marks it as synced at the given height.
+func (s *Store) Reset(height int64, iterate func(cb func(entry Entry))) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	// mark as not synced while the snapshot is being rebuilt
+	s.height = -1
+	s.pending = nil
+	s.pendingHeight = -1
+
+	if err := s.db.Delete(keyHeight); err != nil {
+		return err
+	}
+	if err := s.clear(); err != nil {
+		return err
+	}
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	var err error
+	iterate(func(entry Entry) {
+		if err == nil && len(entry.Value) != 0 {
+			err = batch.Set(storageKey(entry.Address, entry.Key), entry.Value)
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.writeHeight(batch, height)
+}
+
+// Close closes the underlying database.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// resetDirty discards the dirty slots if they belong to another block height.
+func (s *Store) resetDirty(height int64) {
+	if s.dirtyHeight != height {
+		s.dirty = make(map[slotKey]struct{})
+		s.dirtyHeight = height
+	}
+}
+
+// clear deletes all the storage entries from the database.
+func (s *Store) clear() error {
+	it, err := s.db.Iterator(prefixStorage, []byte{prefixStorage[0] + 1})
+	if err != nil {
+		return err
+	}
+
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	if err := it.Close(); err != nil {
+		return err
+	}
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	for _, key := range keys {
+		if err := batch.Delete(key); err != nil {
+			return err
+		}
+	}
+	return batch.Write()
+}
+
+// writeHeight writes the batch along with the new synced height.
+func (s *Store) writeHeight(batch dbm.Batch, height int64) error {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height)) //#nosec G115 -- height is not negative
+	if err := batch.Set(keyHeight, bz); err != nil {
+		return err
+	}
+	if err := batch.Write(); err != nil {
+		return err
+	}
+
+	s.height = height
+	return nil
+}
+
+// storageKey returns the database key of the storage slot.
+func storageKey(addr common.Address, key common.Hash) []byte {
+	bz := make([]byte, 0, len(prefixStorage)+common.AddressLength+common.HashLength)
+	bz = append(bz, prefixStorage...)
+	bz = append(bz, addr.Bytes()...)
+	return append(bz, key.Bytes()...)
+}
@@ -0,0 +1,158 @@
+package flatstate_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/x/vm/flatstate"
+
+	dbm "github.com/cosmos/cosmos-db"
+)
+
+var (
+	address = common.BigToAddress(big.NewInt(101))
+	key1    = common.BigToHash(big.NewInt(1))
+	key2    = common.BigToHash(big.NewInt(2))
+	value1  = common.BigToHash(big.NewInt(11)).Bytes()
+	value2  = common.BigToHash(big.NewInt(22)).Bytes()
+)
+
+// iterateEntries returns an iterate function over the given entries.
+func iterateEntries(entries ...flatstate.Entry) func(cb func(entry flatstate.Entry)) {
+	return func(cb func(entry flatstate.Entry)) {
+		for _, entry := range entries {
+			cb(entry)
+		}
+	}
+}
+
+func TestReset(t *testing.T) {
+	store := flatstate.NewMemStore()
+	require.Equal(t, int64(-1), store.Height())
+
+	_, found := store.Get(-1, address, key1)
+	require.False(t, found, "unsynced store must not serve reads")
+
+	err := store.Reset(5, iterateEntries(
+		flatstate.Entry{Address: address, Key: key1, Value: value1},
+		flatstate.Entry{Address: address, Key: key2},
+	))
+	require.NoError(t, err)
+	require.Equal(t, int64(5), store.Height())
+
+	value, found := store.Get(5, address, key1)
+	require.True(t, found)
+	require.Equal(t, value1, value)
+
+	value, found = store.Get(5, address, key2)
+	require.True(t, found)
+	require.Nil(t, value)
+
+	_, found = store.Get(4, address, key1)
+	require.False(t, found, "reads at another height must not be served")
+
+	// a new reset discards the previous entries
+	err = store.Reset(6, iterateEntries(flatstate.Entry{Address: address, Key: key2, Value: value2}))
+	require.NoError(t, err)
+
+	value, found = store.Get(6, address, key1)
+	require.True(t, found)
+	require.Nil(t, value)
+
+	value, found = store.Get(6, address, key2)
+	require.True(t, found)
+	require.Equal(t, value2, value)
+}
+
+func TestDirtySlots(t *testing.T) {
+	store := flatstate.NewMemStore()
+
+	store.MarkDirty(3, address, key1)
+	require.True(t, store.IsDirty(3, address, key1))
+	require.False(t, store.IsDirty(3, address, key2))
+	require.False(t, store.IsDirty(4, address, key1))
+	require.Equal(t, []flatstate.Entry{{Address: address, Key: key1}}, store.DirtySlots(3))
+	require.Empty(t, store.DirtySlots(4))
+
+	// marking a slot at a new height discards the previous dirty slots
+	store.MarkDirty(4, address, key2)
+	require.False(t, store.IsDirty(4, address, key1))
+	require.True(t, store.IsDirty(4, address, key2))
+	require.Empty(t, store.DirtySlots(3))
+}
+
+func TestApplyPending(t *testing.T) {
+	testCases := []struct {
+		name          string
+		pendingHeight int64
+		applyHeight   int64
+		expSynced     bool
+		expHeight     int64
+	}{
+		{"apply the next block", 3, 3, true, 3},
+		{"already synced", 3, 2, true, 2},
+		{"no pending values for the block", 3, 4, false, 2},
+		{"pending values of a skipped block", 4, 4, false, 2},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := flatstate.NewMemStore()
+			err := store.Reset(2, iterateEntries(
+				flatstate.Entry{Address: address, Key: key1, Value: value1},
+			))
+			require.NoError(t, err)
+
+			store.SetPending(tc.pendingHeight, []flatstate.Entry{
+				{Address: address, Key: key1},
+				{Address: address, Key: key2, Value: value2},
+			})
+
+			synced, err := store.ApplyPending(tc.applyHeight)
+			require.NoError(t, err)
+			require.Equal(t, tc.expSynced, synced)
+			require.Equal(t, tc.expHeight, store.Height())
+
+			if tc.expSynced && tc.expHeight == tc.pendingHeight {
+				value, found := store.Get(tc.expHeight, address, key1)
+				require.True(t, found)
+				require.Nil(t, value)
+
+				value, found = store.Get(tc.expHeight, address, key2)
+				require.True(t, found)
+				require.Equal(t, value2, value)
+			}
+		})
+	}
+}
+
+func TestApplyPendingUnsynced(t *testing.T) {
+	store := flatstate.NewMemStore()
+	store.SetPending(0, []flatstate.Entry{{Address: address, Key: key1, Value: value1}})
+
+	synced, err := store.ApplyPending(0)
+	require.NoError(t, err)
+	require.False(t, synced)
+	require.Equal(t, int64(-1), store.Height())
+}
+
+func TestNewStorePersisted(t *testing.T) {
+	db := dbm.NewMemDB()
+
+	store, err := flatstate.NewStore(db)
+	require.NoError(t, err)
+	err = store.Reset(7, iterateEntries(flatstate.Entry{Address: address, Key: key1, Value: value1}))
+	require.NoError(t, err)
+
+	// reopening the database restores the synced height
+	store, err = flatstate.NewStore(db)
+	require.NoError(t, err)
+	require.Equal(t, int64(7), store.Height())
+
+	value, found := store.Get(7, address, key1)
+	require.True(t, found)
+	require.Equal(t, value1, value)
+}
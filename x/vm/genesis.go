@@ -32,34 +32,61 @@ func InitGenesis(
 	}
 
 	for _, account := range data.Accounts {
-		address := common.HexToAddress(account.Address)
-		accAddress := sdk.AccAddress(address.Bytes())
-
-		// check that the account is actually found in the account keeper
-		acc := accountKeeper.GetAccount(ctx, accAddress)
-		if acc == nil {
-			panic(fmt.Errorf("account not found for address %s", account.Address))
+		if err := initGenesisAccount(ctx, k, accountKeeper, account); err != nil {
+			panic(err)
 		}
+	}
 
-		code := common.Hex2Bytes(account.Code)
-		codeHash := crypto.Keccak256Hash(code).Bytes()
-
-		if !types.IsEmptyCodeHash(codeHash) {
-			k.SetCodeHash(ctx, address.Bytes(), codeHash)
-		}
+	if err := k.AddPreinstalls(ctx, data.Preinstalls); err != nil {
+		panic(fmt.Errorf("error adding preinstalls: %s", err))
+	}
 
-		if len(code) != 0 {
-			k.SetCode(ctx, codeHash, code)
-		}
+	for _, entry := range data.AccessControlEntries {
+		k.SetAccessControlEntry(ctx, entry)
+	}
 
-		for _, storage := range account.Storage {
-			k.SetState(ctx, address, common.HexToHash(storage.Key), common.HexToHash(storage.Value).Bytes())
-		}
+	for _, contract := range data.FrozenContracts {
+		k.SetContractFrozen(ctx, common.HexToAddress(contract))
 	}
 
 	return []abci.ValidatorUpdate{}
 }
 
+// initGenesisAccount sets the code and storage of the given genesis account.
+// The account must already exist in the account keeper.
+func initGenesisAccount(
+	ctx sdk.Context,
+	k *keeper.Keeper,
+	accountKeeper types.AccountKeeper,
+	account types.GenesisAccount,
+) error {
+	address := common.HexToAddress(account.Address)
+	accAddress := sdk.AccAddress(address.Bytes())
+
+	// check that the account is actually found in the account keeper
+	acc := accountKeeper.GetAccount(ctx, accAddress)
+	if acc == nil {
+		return fmt.Errorf("account not found for address %s", account.Address)
+	}
+
+	code := common.Hex2Bytes(account.Code)
+	codeHash := crypto.Keccak256Hash(code).Bytes()
+
+	if !types.IsEmptyCodeHash(codeHash) {
+		k.SetCodeHash(ctx, address.Bytes(), codeHash)
+	}
+
+	if len(code) != 0 {
+		k.SetCode(ctx, codeHash, code)
+	}
+
+	for _, storage := range account.Storage {
+		k.SetState(ctx, address, common.HexToHash(storage.Key), common.HexToHash(storage.Value).Bytes())
+	}
+
+	return nil
+}
+
 // ExportGenesis exports genesis state of the EVM module
 func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *types.GenesisState {
 	var ethGenAccounts []types.GenesisAccount
@@ -76,8 +103,7 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *types.GenesisState {
 		return false
 	})
 
-	return &types.GenesisState{
-		Accounts: ethGenAccounts,
-		Params:   k.GetParams(ctx),
-	}
+	gs := ExportGenesisWithoutAccounts(ctx, k)
+	gs.Accounts = ethGenAccounts
+	return gs
 }
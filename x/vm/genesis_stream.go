@@ -0,0 +1,203 @@
+package vm
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/x/vm/keeper"
+	"github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// GenesisStreamFile is the name of the JSON-lines file in the genesis
+	// stream directory that holds the EVM genesis accounts.
+	GenesisStreamFile = "evm_genesis_accounts.jsonl"
+
+	// GenesisStreamChunkSize is the maximum number of storage slots written on a
+	// single line of the genesis stream. The storage of larger contracts is split
+	// over several lines with the same address, where only the first one
+	// contains the contract code.
+	GenesisStreamChunkSize = 1000
+)
+
+// ExportGenesisAccounts writes the EVM genesis accounts to the given writer as
+// JSON lines, holding at most one storage chunk of a single contract in memory.
+// It returns the number of accounts written.
+func ExportGenesisAccounts(ctx sdk.Context, k *keeper.Keeper, w io.Writer) (uint64, error) {
+	encoder := json.NewEncoder(w)
+
+	var (
+		accounts uint64
+		err      error
+	)
+	k.IterateContracts(ctx, func(address common.Address, codeHash common.Hash) (stop bool) {
+		accounts++
+		genAccount := types.GenesisAccount{
+			Address: address.String(),
+			Code:    common.Bytes2Hex(k.GetCode(ctx, codeHash)),
+			Storage: make(types.Storage, 0, GenesisStreamChunkSize),
+		}
+
+		k.ForEachStorage(ctx, address, func(key, value common.Hash) bool {
+			genAccount.Storage = append(genAccount.Storage, types.NewState(key, value))
+			if len(genAccount.Storage) < GenesisStreamChunkSize {
+				return true
+			}
+
+			if err = encoder.Encode(genAccount); err != nil {
+				return false
+			}
+			genAccount.Code = ""
+			genAccount.Storage = genAccount.Storage[:0]
+			return true
+		})
+		if err != nil {
+			return true
+		}
+
+		// write the remaining storage, or the code of contracts without storage
+		if len(genAccount.Storage) != 0 || genAccount.Code != "" {
+			err = encoder.Encode(genAccount)
+		}
+		return err != nil
+	})
+
+	return accounts, err
+}
+
+// InitGenesisAccounts reads the EVM genesis accounts written by
+// ExportGenesisAccounts from the given reader and sets their code and storage.
+// It returns the number of accounts read, where the consecutive lines of an
+// account count once.
+func InitGenesisAccounts(
+	ctx sdk.Context,
+	k *keeper.Keeper,
+	accountKeeper types.AccountKeeper,
+	r io.Reader,
+) (uint64, error) {
+	var (
+		accounts uint64
+		previous string
+	)
+
+	decoder := json.NewDecoder(r)
+	for line := 1; ; line++ {
+		var account types.GenesisAccount
+		if err := decoder.Decode(&account); err != nil {
+			if errors.Is(err, io.EOF) {
+				return accounts, nil
+			}
+			return accounts, fmt.Errorf("failed to decode genesis account on line %d: %w", line, err)
+		}
+
+		if err := account.Validate(); err != nil {
+			return accounts, fmt.Errorf("invalid genesis account %s on line %d: %w", account.Address, line, err)
+		}
+
+		if err := initGenesisAccount(ctx, k, accountKeeper, account); err != nil {
+			return accounts, err
+		}
+
+		if account.Address != previous {
+			accounts++
+			previous = account.Address
+		}
+	}
+}
+
+// ExportGenesisWithoutAccounts returns the EVM genesis state without the
+// accounts, which are exported to the genesis stream file instead.
+func ExportGenesisWithoutAccounts(ctx sdk.Context, k *keeper.Keeper) *types.GenesisState {
+	return &types.GenesisState{
+		Accounts:             []types.GenesisAccount{},
+		Params:               k.GetParams(ctx),
+		AccessControlEntries: k.GetAllAccessControlEntries(ctx),
+		FrozenContracts:      k.GetFrozenContracts(ctx),
+	}
+}
+
+// ExportGenesisStream writes the EVM genesis accounts to the genesis stream file
+// in the given directory and returns the commitment to the file, to be set in
+// the EVM genesis state.
+func ExportGenesisStream(ctx sdk.Context, k *keeper.Keeper, dir string) (*types.GenesisStream, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	// write to a temporary file first, so a failed export doesn't leave a
+	// truncated stream behind
+	path := filepath.Join(dir, GenesisStreamFile)
+	file, err := os.CreateTemp(dir, GenesisStreamFile+".*")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(file.Name())
+
+	hash := sha256.New()
+	w := bufio.NewWriter(io.MultiWriter(file, hash))
+	accounts, err := ExportGenesisAccounts(ctx, k, w)
+	if err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+	if err := w.Flush(); err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+	if err := file.Close(); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(file.Name(), path); err != nil {
+		return nil, err
+	}
+
+	return &types.GenesisStream{
+		Checksum: hex.EncodeToString(hash.Sum(nil)),
+		Accounts: accounts,
+	}, nil
+}
+
+// InitGenesisStream sets the EVM genesis accounts of the genesis stream file in
+// the given directory. It fails if the file is missing or doesn't match the
+// checksum and number of accounts of the genesis state commitment, so that all
+// the nodes start from the same state.
+func InitGenesisStream(
+	ctx sdk.Context,
+	k *keeper.Keeper,
+	accountKeeper types.AccountKeeper,
+	dir string,
+	stream types.GenesisStream,
+) error {
+	path := filepath.Join(dir, GenesisStreamFile)
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open genesis stream file: %w", err)
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	accounts, err := InitGenesisAccounts(ctx, k, accountKeeper, bufio.NewReader(io.TeeReader(file, hash)))
+	if err != nil {
+		return err
+	}
+
+	if checksum := hex.EncodeToString(hash.Sum(nil)); checksum != stream.Checksum {
+		return fmt.Errorf("genesis stream file %s checksum mismatch, expected %s, got %s", path, stream.Checksum, checksum)
+	}
+	if accounts != stream.Accounts {
+		return fmt.Errorf("genesis stream file %s has %d accounts, expected %d", path, accounts, stream.Accounts)
+	}
+
+	return nil
+}
@@ -1,7 +1,10 @@
 package vm_test
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"math/big"
+	"os"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -237,7 +240,9 @@ func TestExportGenesis(t *testing.T) {
 	require.NoError(t, ts.network.NextBlock(), "failed to advance block")
 
 	genState := vm.ExportGenesis(ts.network.GetContext(), ts.network.App.EVMKeeper)
-	require.Len(t, genState.Accounts, 3, "expected 3 smart contracts in the exported genesis") // NOTE: 2 deployed above + 1 for the aatom denomination ERC-20 pair
+	// NOTE: 2 deployed above + 1 for the aatom denomination ERC-20 pair + the default preinstalls
+	expAccounts := 3 + len(types.DefaultPreinstalls)
+	require.Len(t, genState.Accounts, expAccounts, "expected %d smart contracts in the exported genesis", expAccounts)
 
 	genAddresses := make([]string, 0, len(genState.Accounts))
 	for _, acc := range genState.Accounts {
@@ -246,4 +251,95 @@ func TestExportGenesis(t *testing.T) {
 	require.Contains(t, genAddresses, contractAddr.Hex(), "expected contract 1 address in exported genesis")
 	require.Contains(t, genAddresses, contractAddr2.Hex(), "expected contract 2 address in exported genesis")
 	require.Contains(t, genAddresses, testconstants.WEVMOSContractMainnet, "expected mainnet aatom contract address in exported genesis")
+
+	entry := types.NewAccessControlEntry(contractAddr, nil, types.AccessTypeRestricted, nil)
+	require.NoError(t, ts.network.App.EVMKeeper.AddAccessControlEntry(ts.network.GetContext(), entry))
+
+	genState = vm.ExportGenesis(ts.network.GetContext(), ts.network.App.EVMKeeper)
+	require.Equal(t, []types.AccessControlEntry{entry}, genState.AccessControlEntries, "expected access control entries in exported genesis")
+
+	ts.network.App.EVMKeeper.SetContractFrozen(ts.network.GetContext(), contractAddr2)
+
+	genState = vm.ExportGenesis(ts.network.GetContext(), ts.network.App.EVMKeeper)
+	require.Equal(t, []string{contractAddr2.Hex()}, genState.FrozenContracts, "expected frozen contracts in exported genesis")
+}
+
+func TestGenesisStream(t *testing.T) {
+	ts := SetupTest()
+	ctx := ts.network.GetContext()
+	evmKeeper := ts.network.App.EVMKeeper
+
+	contractAddr, err := ts.factory.DeployContract(
+		ts.keyring.GetPrivKey(0),
+		types.EvmTxArgs{},
+		testfactory.ContractDeploymentData{
+			Contract:        contracts.ERC20MinterBurnerDecimalsContract,
+			ConstructorArgs: []interface{}{"TestToken", "TTK", uint8(18)},
+		},
+	)
+	require.NoError(t, err, "failed to deploy contract")
+	require.NoError(t, ts.network.NextBlock(), "failed to advance block")
+
+	// add enough storage slots to split the contract storage over several lines
+	ctx = ts.network.GetContext()
+	for i := 0; i < vm.GenesisStreamChunkSize+1; i++ {
+		key := common.BigToHash(big.NewInt(int64(i + 1000)))
+		evmKeeper.SetState(ctx, contractAddr, key, common.BigToHash(big.NewInt(int64(i+1))).Bytes())
+	}
+
+	dir := t.TempDir()
+	stream, err := vm.ExportGenesisStream(ctx, evmKeeper, dir)
+	require.NoError(t, err, "failed to export genesis stream")
+	require.NoError(t, stream.Validate())
+
+	expGenState := vm.ExportGenesis(ctx, evmKeeper)
+	require.Equal(t, uint64(len(expGenState.Accounts)), stream.Accounts)
+
+	genState := vm.ExportGenesisWithoutAccounts(ctx, evmKeeper)
+	require.Empty(t, genState.Accounts, "expected no accounts in the streamed genesis state")
+	require.Equal(t, evmKeeper.GetParams(ctx), genState.Params)
+
+	// import the stream in a new network with the contract accounts only
+	setupImport := func() (*GenesisTestSuite, sdk.Context) {
+		newTs := SetupTest()
+		newCtx := newTs.network.GetContext()
+		for _, account := range expGenState.Accounts {
+			accAddr := sdk.AccAddress(common.HexToAddress(account.Address).Bytes())
+			if newTs.network.App.AccountKeeper.GetAccount(newCtx, accAddr) == nil {
+				acc := newTs.network.App.AccountKeeper.NewAccountWithAddress(newCtx, accAddr)
+				newTs.network.App.AccountKeeper.SetAccount(newCtx, acc)
+			}
+		}
+		return newTs, newCtx
+	}
+
+	newTs, newCtx := setupImport()
+	err = vm.InitGenesisStream(newCtx, newTs.network.App.EVMKeeper, newTs.network.App.AccountKeeper, dir, *stream)
+	require.NoError(t, err, "failed to import genesis stream")
+
+	expStorage := evmKeeper.GetAccountStorage(ctx, contractAddr)
+	require.Equal(t, expStorage, newTs.network.App.EVMKeeper.GetAccountStorage(newCtx, contractAddr), "storage mismatch")
+	require.Equal(t,
+		evmKeeper.GetCodeHash(ctx, contractAddr),
+		newTs.network.App.EVMKeeper.GetCodeHash(newCtx, contractAddr),
+		"code hash mismatch",
+	)
+
+	// a missing stream file fails the import
+	newTs, newCtx = setupImport()
+	err = vm.InitGenesisStream(newCtx, newTs.network.App.EVMKeeper, newTs.network.App.AccountKeeper, t.TempDir(), *stream)
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	// a stream file that doesn't match the commitment fails the import
+	newTs, newCtx = setupImport()
+	badStream := *stream
+	badStream.Checksum = hex.EncodeToString(make([]byte, sha256.Size))
+	err = vm.InitGenesisStream(newCtx, newTs.network.App.EVMKeeper, newTs.network.App.AccountKeeper, dir, badStream)
+	require.ErrorContains(t, err, "checksum mismatch")
+
+	newTs, newCtx = setupImport()
+	badStream = *stream
+	badStream.Accounts++
+	err = vm.InitGenesisStream(newCtx, newTs.network.App.EVMKeeper, newTs.network.App.AccountKeeper, dir, badStream)
+	require.ErrorContains(t, err, "accounts, expected")
 }
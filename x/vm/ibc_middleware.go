@@ -0,0 +1,84 @@
+package vm
+
+import (
+	"github.com/cosmos/evm/ibc"
+	"github.com/cosmos/evm/x/vm/keeper"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ porttypes.IBCModule = &IBCMiddleware{}
+
+// IBCMiddleware implements the ICS26 callbacks for the EVM callbacks
+// middleware. It calls the EVM contracts defined in the ICS20 packet memos on
+// the packet lifecycle events, following the ADR-008 callbacks format.
+type IBCMiddleware struct {
+	*ibc.Module
+	keeper         *keeper.Keeper
+	maxCallbackGas uint64
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware given the keeper, the underlying
+// application and the maximum amount of gas that a callback can consume.
+func NewIBCMiddleware(k *keeper.Keeper, app porttypes.IBCModule, maxCallbackGas uint64) IBCMiddleware {
+	return IBCMiddleware{
+		Module:         ibc.NewModule(app),
+		keeper:         k,
+		maxCallbackGas: maxCallbackGas,
+	}
+}
+
+// OnRecvPacket implements the IBCModule interface.
+// It receives the packet through the underlying application and then executes
+// the destination callback. If the callback fails, an error acknowledgement is
+// returned.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) exported.Acknowledgement {
+	ack := im.Module.OnRecvPacket(ctx, packet, relayer)
+
+	// return if the acknowledgement is an error ACK
+	if !ack.Success() {
+		return ack
+	}
+
+	return im.keeper.OnRecvPacketCallback(ctx, packet, ack, im.maxCallbackGas)
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface.
+// It acknowledges the packet through the underlying application and then
+// executes the source callback.
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.Module.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	im.keeper.OnAcknowledgementPacketCallback(ctx, packet, acknowledgement, im.maxCallbackGas)
+	return nil
+}
+
+// OnTimeoutPacket implements the IBCModule interface.
+// It times out the packet through the underlying application and then executes
+// the source callback.
+func (im IBCMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.Module.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+
+	im.keeper.OnTimeoutPacketCallback(ctx, packet, im.maxCallbackGas)
+	return nil
+}
@@ -10,7 +10,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// BeginBlock emits a base fee event which will be adjusted to the evm decimals
+// BeginBlock emits a base fee event which will be adjusted to the evm decimals and
+// syncs the flat state snapshot, if any, with the state committed on the previous block.
 func (k *Keeper) BeginBlock(ctx sdk.Context) error {
 	logger := ctx.Logger().With("begin_block", "evm")
 
@@ -31,18 +32,24 @@ func (k *Keeper) BeginBlock(ctx sdk.Context) error {
 			),
 		})
 	}
+
+	if err := k.beginFlatStateBlock(ctx); err != nil {
+		logger.Error("error when syncing flat state", "error", err.Error())
+	}
 	return nil
 }
 
 // EndBlock also retrieves the bloom filter value from the transient store and commits it to the
 // KVStore. The EVM end block logic doesn't update the validator set, thus it returns
-// an empty slice.
+// an empty slice. The storage slots written in the block are kept to update the flat
+// state snapshot, if any, once the block is committed.
 func (k *Keeper) EndBlock(ctx sdk.Context) error {
 	// Gas costs are handled within msg handler so costs should be ignored
 	infCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
 
 	bloom := ethtypes.BytesToBloom(k.GetBlockBloomTransient(infCtx).Bytes())
 	k.EmitBlockBloomEvent(infCtx, bloom)
+	k.endFlatStateBlock(infCtx)
 
 	return nil
 }
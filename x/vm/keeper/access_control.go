@@ -0,0 +1,181 @@
+package keeper
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GetAccessControlEntry returns the call permission rule of the contract function with the
+// given selector, or the contract-wide rule if the selector is nil.
+func (k Keeper) GetAccessControlEntry(ctx sdk.Context, contract common.Address, selector []byte) (types.AccessControlEntry, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.AccessControlEntryKey(contract, selector))
+	if len(bz) == 0 {
+		return types.AccessControlEntry{}, false
+	}
+
+	var entry types.AccessControlEntry
+	k.cdc.MustUnmarshal(bz, &entry)
+	return entry, true
+}
+
+// SetAccessControlEntry stores the call permission rule, normalizing its addresses and
+// selector. It assumes the rule was validated.
+func (k Keeper) SetAccessControlEntry(ctx sdk.Context, entry types.AccessControlEntry) {
+	addresses := make([]string, len(entry.AccessControlList))
+	for i, address := range entry.AccessControlList {
+		addresses[i] = common.HexToAddress(address).Hex()
+	}
+
+	contract := entry.GetContractAddress()
+	selector := entry.GetSelectorBytes()
+	entry = types.NewAccessControlEntry(contract, selector, entry.AccessType, addresses)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.AccessControlEntryKey(contract, selector), k.cdc.MustMarshal(&entry))
+}
+
+// DeleteAccessControlEntry removes the call permission rule of the contract function with
+// the given selector, or the contract-wide rule if the selector is nil.
+func (k Keeper) DeleteAccessControlEntry(ctx sdk.Context, contract common.Address, selector []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.AccessControlEntryKey(contract, selector))
+}
+
+// IterateAccessControlEntries iterates over all the call permission rules and performs a
+// callback function.
+func (k Keeper) IterateAccessControlEntries(ctx sdk.Context, cb func(entry types.AccessControlEntry) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAccessControlEntry)
+	iterator := store.Iterator(nil, nil)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.AccessControlEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &entry)
+
+		if cb(entry) {
+			break
+		}
+	}
+}
+
+// GetAllAccessControlEntries returns all the call permission rules.
+func (k Keeper) GetAllAccessControlEntries(ctx sdk.Context) []types.AccessControlEntry {
+	entries := []types.AccessControlEntry{}
+	k.IterateAccessControlEntries(ctx, func(entry types.AccessControlEntry) bool {
+		entries = append(entries, entry)
+		return false
+	})
+	return entries
+}
+
+// AddAccessControlEntry creates the given call permission rule. If there is already a rule
+// for the same contract and selector, its access type is updated and the addresses are added
+// to its access control list.
+func (k Keeper) AddAccessControlEntry(ctx sdk.Context, entry types.AccessControlEntry) error {
+	if err := entry.Validate(); err != nil {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+	}
+
+	contract := entry.GetContractAddress()
+	selector := entry.GetSelectorBytes()
+
+	newEntry := entry
+	if existing, found := k.GetAccessControlEntry(ctx, contract, selector); found {
+		newEntry = existing
+		newEntry.AccessType = entry.AccessType
+		for _, address := range entry.AccessControlList {
+			if !slices.Contains(existing.AccessControlList, common.HexToAddress(address).Hex()) {
+				newEntry.AccessControlList = append(newEntry.AccessControlList, address)
+			}
+		}
+	}
+
+	k.SetAccessControlEntry(ctx, newEntry)
+	k.emitAccessControlEntryEvent(ctx, types.EventTypeAddAccessControlEntry, entry)
+	return nil
+}
+
+// RemoveAccessControlEntry removes the addresses of the given rule from the access control
+// list of the stored one, or the whole stored rule if no addresses are given. It returns an
+// error if the rule or any of the addresses doesn't exist.
+func (k Keeper) RemoveAccessControlEntry(ctx sdk.Context, entry types.AccessControlEntry) error {
+	if err := entry.ValidateKey(); err != nil {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+	}
+
+	contract := entry.GetContractAddress()
+	selector := entry.GetSelectorBytes()
+
+	existing, found := k.GetAccessControlEntry(ctx, contract, selector)
+	if !found {
+		return errorsmod.Wrapf(types.ErrAccessControlEntryNotFound, "contract %s, selector %q", contract, entry.Selector)
+	}
+
+	if len(entry.AccessControlList) == 0 {
+		k.DeleteAccessControlEntry(ctx, contract, selector)
+		k.emitAccessControlEntryEvent(ctx, types.EventTypeRemoveAccessControlEntry, existing)
+		return nil
+	}
+
+	for _, address := range entry.AccessControlList {
+		i := slices.Index(existing.AccessControlList, common.HexToAddress(address).Hex())
+		if i == -1 {
+			return errorsmod.Wrapf(types.ErrAccessControlEntryNotFound, "address %s not found in the entry of contract %s, selector %q", address, contract, entry.Selector)
+		}
+		existing.AccessControlList = slices.Delete(existing.AccessControlList, i, i+1)
+	}
+
+	k.SetAccessControlEntry(ctx, existing)
+	k.emitAccessControlEntryEvent(ctx, types.EventTypeRemoveAccessControlEntry, entry)
+	return nil
+}
+
+// GetAccessControlEntryFn returns the function used by the permission policy to look up the
+// call permission rules. The rules can't be modified during the EVM execution, so the lookups
+// are cached and don't consume gas.
+func (k Keeper) GetAccessControlEntryFn(ctx sdk.Context) types.AccessControlEntryGetter {
+	store := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()).KVStore(k.storeKey)
+	cache := make(map[string]*types.AccessControlEntry)
+
+	return func(contract common.Address, selector []byte) (types.AccessControlEntry, bool) {
+		key := types.AccessControlEntryKey(contract, selector)
+		entry, cached := cache[string(key)]
+		if !cached {
+			if bz := store.Get(key); len(bz) != 0 {
+				entry = new(types.AccessControlEntry)
+				k.cdc.MustUnmarshal(bz, entry)
+			}
+			cache[string(key)] = entry
+		}
+
+		if entry == nil {
+			return types.AccessControlEntry{}, false
+		}
+		return *entry, true
+	}
+}
+
+// emitAccessControlEntryEvent emits an event of the given type for the call permission rule.
+func (k Keeper) emitAccessControlEntryEvent(ctx sdk.Context, eventType string, entry types.AccessControlEntry) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyContractAddress, entry.GetContractAddress().Hex()),
+			sdk.NewAttribute(types.AttributeKeySelector, entry.Selector),
+			sdk.NewAttribute(types.AttributeKeyAccessType, entry.AccessType.String()),
+			sdk.NewAttribute(types.AttributeKeyAddresses, strings.Join(entry.AccessControlList, ",")),
+		),
+	)
+}
@@ -0,0 +1,143 @@
+package keeper_test
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/cosmos/evm/contracts"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	"github.com/cosmos/evm/x/vm/types"
+)
+
+func (suite *KeeperTestSuite) TestAddRemoveAccessControlEntries() {
+	suite.SetupTest()
+	ctx := suite.network.GetContext()
+	k := suite.network.App.EVMKeeper
+
+	contract := utiltx.GenerateAddress()
+	selector := []byte{0xa9, 0x05, 0x9c, 0xbb}
+	addr0, addr1 := suite.keyring.GetAddr(0), suite.keyring.GetAddr(1)
+
+	// entries are created with normalized addresses and merged on update
+	entry := types.NewAccessControlEntry(contract, selector, types.AccessTypePermissioned, []string{addr0.Hex()})
+	entry.AccessControlList[0] = common.Bytes2Hex(addr0.Bytes())
+	suite.Require().NoError(k.AddAccessControlEntry(ctx, entry))
+	suite.Require().NoError(k.AddAccessControlEntry(ctx, types.NewAccessControlEntry(
+		contract, selector, types.AccessTypePermissionless, []string{addr0.Hex(), addr1.Hex()},
+	)))
+
+	stored, found := k.GetAccessControlEntry(ctx, contract, selector)
+	suite.Require().True(found)
+	suite.Require().Equal(types.NewAccessControlEntry(
+		contract, selector, types.AccessTypePermissionless, []string{addr0.Hex(), addr1.Hex()},
+	), stored)
+
+	_, found = k.GetAccessControlEntry(ctx, contract, nil)
+	suite.Require().False(found, "expected no contract-wide entry")
+
+	// invalid entries are rejected
+	err := k.AddAccessControlEntry(ctx, types.AccessControlEntry{Contract: contract.Hex(), Selector: "0x01"})
+	suite.Require().Error(err)
+
+	// addresses are removed from the entry
+	suite.Require().NoError(k.RemoveAccessControlEntry(ctx, types.NewAccessControlEntry(contract, selector, 0, []string{addr0.Hex()})))
+	stored, found = k.GetAccessControlEntry(ctx, contract, selector)
+	suite.Require().True(found)
+	suite.Require().Equal([]string{addr1.Hex()}, stored.AccessControlList)
+
+	err = k.RemoveAccessControlEntry(ctx, types.NewAccessControlEntry(contract, selector, 0, []string{addr0.Hex()}))
+	suite.Require().ErrorIs(err, types.ErrAccessControlEntryNotFound)
+
+	// the whole entry is removed if no addresses are given
+	suite.Require().NoError(k.RemoveAccessControlEntry(ctx, types.NewAccessControlEntry(contract, selector, 0, nil)))
+	_, found = k.GetAccessControlEntry(ctx, contract, selector)
+	suite.Require().False(found)
+
+	err = k.RemoveAccessControlEntry(ctx, types.NewAccessControlEntry(contract, selector, 0, nil))
+	suite.Require().ErrorIs(err, types.ErrAccessControlEntryNotFound)
+}
+
+func (suite *KeeperTestSuite) TestAccessControlEntriesQuery() {
+	suite.SetupTest()
+	ctx := suite.network.GetContext()
+	k := suite.network.App.EVMKeeper
+
+	contract1, contract2 := utiltx.GenerateAddress(), utiltx.GenerateAddress()
+	entries := []types.AccessControlEntry{
+		types.NewAccessControlEntry(contract1, nil, types.AccessTypeRestricted, nil),
+		types.NewAccessControlEntry(contract1, []byte{0x01, 0x02, 0x03, 0x04}, types.AccessTypeRestricted, nil),
+		types.NewAccessControlEntry(contract2, nil, types.AccessTypeRestricted, nil),
+	}
+	for _, entry := range entries {
+		suite.Require().NoError(k.AddAccessControlEntry(ctx, entry))
+	}
+
+	res, err := k.AccessControlEntries(ctx, &types.QueryAccessControlEntriesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Entries, 3)
+
+	res, err = k.AccessControlEntries(ctx, &types.QueryAccessControlEntriesRequest{Contract: contract1.Hex()})
+	suite.Require().NoError(err)
+	suite.Require().ElementsMatch(entries[:2], res.Entries)
+
+	entryRes, err := k.AccessControlEntry(ctx, &types.QueryAccessControlEntryRequest{Contract: contract1.Hex(), Selector: "0x01020304"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(entries[1], entryRes.Entry)
+
+	_, err = k.AccessControlEntry(ctx, &types.QueryAccessControlEntryRequest{Contract: contract2.Hex(), Selector: "0x01020304"})
+	suite.Require().Error(err)
+
+	_, err = k.AccessControlEntry(ctx, &types.QueryAccessControlEntryRequest{Contract: contract2.Hex(), Selector: "0x01"})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestAccessControlEntriesEnforcement() {
+	suite.SetupTest()
+	ctx := suite.network.GetContext()
+	k := suite.network.App.EVMKeeper
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	addr0, addr1 := suite.keyring.GetAddr(0), suite.keyring.GetAddr(1)
+
+	// deploy a contract that is not a precompile
+	nonce := k.GetNonce(ctx, erc20types.ModuleAddress)
+	ctorArgs, err := erc20.Pack("", "test", "test", uint8(18))
+	suite.Require().NoError(err)
+	_, err = k.CallEVMWithData(ctx, erc20types.ModuleAddress, nil, append(contracts.ERC20MinterBurnerDecimalsContract.Bin, ctorArgs...), true)
+	suite.Require().NoError(err)
+	contract := crypto.CreateAddress(erc20types.ModuleAddress, nonce)
+
+	balanceOf, err := erc20.Pack("balanceOf", addr0)
+	suite.Require().NoError(err)
+	totalSupply, err := erc20.Pack("totalSupply")
+	suite.Require().NoError(err)
+
+	// only the listed address can call the restricted function
+	suite.Require().NoError(k.AddAccessControlEntry(ctx, types.NewAccessControlEntry(
+		contract, balanceOf[:types.SelectorLength], types.AccessTypePermissioned, []string{addr0.Hex()},
+	)))
+
+	_, err = k.CallEVMWithData(ctx, addr0, &contract, balanceOf, false)
+	suite.Require().NoError(err)
+	_, err = k.CallEVMWithData(ctx, addr1, &contract, balanceOf, false)
+	suite.Require().ErrorContains(err, "does not have permission to call function")
+	_, err = k.CallEVMWithData(ctx, addr1, &contract, totalSupply, false)
+	suite.Require().NoError(err)
+
+	// the contract-wide entry applies to all the functions
+	suite.Require().NoError(k.AddAccessControlEntry(ctx, types.NewAccessControlEntry(
+		contract, nil, types.AccessTypeRestricted, nil,
+	)))
+
+	_, err = k.CallEVMWithData(ctx, addr0, &contract, balanceOf, false)
+	suite.Require().ErrorContains(err, "does not have permission to perform a call")
+	_, err = k.CallEVMWithData(ctx, addr1, &contract, totalSupply, false)
+	suite.Require().ErrorContains(err, "does not have permission to perform a call")
+
+	// the calls are allowed again once the entries are removed
+	suite.Require().NoError(k.RemoveAccessControlEntry(ctx, types.NewAccessControlEntry(contract, nil, 0, nil)))
+	suite.Require().NoError(k.RemoveAccessControlEntry(ctx, types.NewAccessControlEntry(contract, balanceOf[:types.SelectorLength], 0, nil)))
+
+	_, err = k.CallEVMWithData(ctx, addr1, &contract, balanceOf, false)
+	suite.Require().NoError(err)
+}
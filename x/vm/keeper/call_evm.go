@@ -1,18 +1,27 @@
 package keeper
 
 import (
+	"bytes"
+	"encoding/hex"
 	"encoding/json"
 	"math/big"
+	"strconv"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	cmttypes "github.com/cometbft/cometbft/types"
 
 	"github.com/cosmos/evm/server/config"
+	cosmosevmtypes "github.com/cosmos/evm/types"
 	"github.com/cosmos/evm/x/vm/types"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
@@ -50,12 +59,21 @@ func (k Keeper) CallEVMWithData(
 	data []byte,
 	commit bool,
 ) (*types.MsgEthereumTxResponse, error) {
-	nonce, err := k.accountKeeper.GetSequence(ctx, from.Bytes())
-	if err != nil {
-		return nil, err
-	}
+	return k.CallEVMWithDataAndGasCap(ctx, from, contract, data, commit, config.DefaultGasCap)
+}
 
-	gasCap := config.DefaultGasCap
+// CallEVMWithDataAndGasCap performs a smart contract method call using contract
+// data, failing if the call requires more gas than the given gas cap. If the
+// execution fails in the EVM, the response is returned along with the error so
+// that the caller can charge the gas used.
+func (k Keeper) CallEVMWithDataAndGasCap(
+	ctx sdk.Context,
+	from common.Address,
+	contract *common.Address,
+	data []byte,
+	commit bool,
+	gasCap uint64,
+) (*types.MsgEthereumTxResponse, error) {
 	if commit {
 		args, err := json.Marshal(types.TransactionArgs{
 			From: &from,
@@ -68,7 +86,7 @@ func (k Keeper) CallEVMWithData(
 
 		gasRes, err := k.EstimateGasInternal(ctx, &types.EthCallRequest{
 			Args:   args,
-			GasCap: config.DefaultGasCap,
+			GasCap: gasCap,
 		}, types.Internal)
 		if err != nil {
 			return nil, err
@@ -76,12 +94,32 @@ func (k Keeper) CallEVMWithData(
 		gasCap = gasRes.Gas
 	}
 
+	return k.CallEVMWithDataAndGasLimit(ctx, from, contract, data, commit, gasCap)
+}
+
+// CallEVMWithDataAndGasLimit performs a smart contract method call using
+// contract data with the given gas limit, without estimating the gas of the
+// call first. If the execution fails in the EVM, the response is returned along
+// with the error so that the caller can charge the gas used.
+func (k Keeper) CallEVMWithDataAndGasLimit(
+	ctx sdk.Context,
+	from common.Address,
+	contract *common.Address,
+	data []byte,
+	commit bool,
+	gasLimit uint64,
+) (*types.MsgEthereumTxResponse, error) {
+	nonce, err := k.accountKeeper.GetSequence(ctx, from.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
 	msg := ethtypes.NewMessage(
 		from,
 		contract,
 		nonce,
 		big.NewInt(0), // amount
-		gasCap,        // gasLimit
+		gasLimit,      // gasLimit
 		big.NewInt(0), // gasFeeCap
 		big.NewInt(0), // gasTipCap
 		big.NewInt(0), // gasPrice
@@ -96,8 +134,255 @@ func (k Keeper) CallEVMWithData(
 	}
 
 	if res.Failed() {
-		return nil, errorsmod.Wrap(types.ErrVMExecution, res.VmError)
+		return res, errorsmod.Wrap(types.ErrVMExecution, res.VmError)
 	}
 
 	return res, nil
 }
+
+// CallEVMFromCosmos executes an EVM call or contract creation on behalf of a
+// Cosmos account, e.g. an account signing with a Cosmos key or an interchain
+// account. The message is executed from the EVM address derived from the
+// sender's address (see types.CosmosSenderEVMAddress) and its gas usage is
+// consumed from the transaction gas meter, as it isn't deducted by the EVM ante
+// handlers. A failed execution returns an error so that all the state changes
+// of the message are reverted.
+//
+// When the EVM address is derived from a longer sender address, e.g. the one of
+// an interchain account, the value is transferred from the sender to the EVM
+// address before the execution and the EVM address balance is swept back to the
+// sender after it, so that the sender's native balance is the one used and
+// received by the EVM.
+// The gas limit can't exceed the block gas limit and is capped to the gas
+// remaining in the transaction gas meter, as the EVM runs with an infinite one.
+//
+// As for the Ethereum transactions, a contract creation on behalf of a signer of
+// the transaction takes the nonce the signer had before the ante handler
+// incremented its sequence, so that its sequence is incremented only once by the
+// transaction. Senders that didn't sign the transaction, e.g. interchain accounts
+// or authz granters, use their current nonce, which the contract creation
+// increments.
+//
+// The execution is recorded as a synthetic Ethereum transaction (see
+// types.NewSyntheticTx): it takes the next transaction index of the block, its
+// logs are added to the block bloom and its events follow the format of the
+// EthereumTx ones, so that it's indexed by the JSON-RPC server.
+func (k *Keeper) CallEVMFromCosmos(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	contract *common.Address,
+	data []byte,
+	value *big.Int,
+	gasLimit uint64,
+) (*types.MsgEthereumTxResponse, common.Address, error) {
+	// as for the Ethereum transactions, only the gas used by the EVM is charged,
+	// which already covers the store accesses of the execution, so the message
+	// is processed with an infinite gas meter to not charge them twice
+	gasMeter := ctx.GasMeter()
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+	if blockGasLimit := cosmosevmtypes.BlockGasLimit(ctx); blockGasLimit > 0 && gasLimit > blockGasLimit {
+		return nil, common.Address{}, errorsmod.Wrapf(types.ErrInvalidGasLimit, "gas limit %d exceeds the block gas limit %d", gasLimit, blockGasLimit)
+	}
+
+	gasLimit = min(gasLimit, gasMeter.GasRemaining())
+
+	cfg, err := k.EVMConfig(ctx, sdk.ConsAddress(ctx.BlockHeader().ProposerAddress))
+	if err != nil {
+		return nil, common.Address{}, errorsmod.Wrap(err, "failed to load evm config")
+	}
+
+	from := types.CosmosSenderEVMAddress(sender)
+	isDerived := !bytes.Equal(from.Bytes(), sender)
+	if value == nil {
+		value = big.NewInt(0)
+	}
+
+	if isDerived {
+		if err := k.sendEVMCoin(ctx, sender, from.Bytes(), value); err != nil {
+			return nil, common.Address{}, errorsmod.Wrap(err, "failed to fund the sender EVM address")
+		}
+	}
+
+	nonce, err := k.cosmosSenderNonce(ctx, sender, from, contract == nil)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	msg := ethtypes.NewMessage(
+		from,
+		contract,
+		nonce,
+		value,
+		gasLimit,
+		big.NewInt(0), // gasFeeCap
+		big.NewInt(0), // gasTipCap
+		big.NewInt(0), // gasPrice
+		data,
+		ethtypes.AccessList{}, // AccessList
+		false,                 // isFake
+	)
+
+	tx := types.NewSyntheticTx(msg, ctx.BlockHeight(), k.GetTxIndexTransient(ctx))
+	txConfig := k.TxConfig(ctx, tx.Hash())
+
+	res, err := k.ApplyMessageWithConfig(ctx, msg, types.NewNoOpTracer(), true, cfg, txConfig)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	gasMeter.ConsumeGas(res.GasUsed, "evm execution")
+
+	if res.Failed() {
+		return nil, common.Address{}, errorsmod.Wrap(types.ErrVMExecution, res.VmError)
+	}
+
+	if isDerived {
+		if err := k.sendEVMCoin(ctx, from.Bytes(), sender, k.GetBalance(ctx, from)); err != nil {
+			return nil, common.Address{}, errorsmod.Wrap(err, "failed to return the sender EVM address balance")
+		}
+	}
+
+	logs := types.LogsToEthereum(res.Logs)
+
+	var contractAddr common.Address
+	if contract == nil {
+		contractAddr = crypto.CreateAddress(from, msg.Nonce())
+	}
+
+	receipt := &ethtypes.Receipt{
+		Type:              tx.Type(),
+		Status:            ethtypes.ReceiptStatusSuccessful,
+		CumulativeGasUsed: res.GasUsed,
+		Bloom:             ethtypes.BytesToBloom(ethtypes.LogsBloom(logs)),
+		Logs:              logs,
+		TxHash:            txConfig.TxHash,
+		ContractAddress:   contractAddr,
+		GasUsed:           res.GasUsed,
+		BlockHash:         txConfig.BlockHash,
+		BlockNumber:       big.NewInt(ctx.BlockHeight()),
+		TransactionIndex:  txConfig.TxIndex,
+	}
+
+	if err := k.PostTxProcessing(ctx, msg, receipt); err != nil {
+		return nil, common.Address{}, errorsmod.Wrap(types.ErrPostTxProcessing, err.Error())
+	}
+
+	// the post-processing can alter the logs
+	res.Logs = types.NewLogsFromEth(receipt.Logs)
+	logs = receipt.Logs
+
+	if len(logs) > 0 {
+		bloom := k.GetBlockBloomTransient(ctx)
+		bloom.Or(bloom, big.NewInt(0).SetBytes(ethtypes.LogsBloom(logs)))
+		k.SetBlockBloomTransient(ctx, bloom)
+		k.SetLogSizeTransient(ctx, uint64(txConfig.LogIndex)+uint64(len(logs)))
+	}
+
+	k.SetTxIndexTransient(ctx, uint64(txConfig.TxIndex)+1)
+
+	if err := emitSyntheticTxEvents(ctx, tx, from, txConfig.TxIndex, res); err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return res, contractAddr, nil
+}
+
+// cosmosSenderNonce returns the nonce of an EVM message executed on behalf of a
+// Cosmos account. If the sender signed the transaction and the nonce recorded by
+// the ante handler is still unused, it returns it and, for a contract creation,
+// marks it as used. A second contract creation of the same signer is rejected, as
+// it would increment the signer sequence beyond the one of the next transaction.
+func (k *Keeper) cosmosSenderNonce(ctx sdk.Context, sender sdk.AccAddress, from common.Address, contractCreation bool) (uint64, error) {
+	nonce := k.GetNonce(ctx, from)
+	if !bytes.Equal(from.Bytes(), sender) {
+		return nonce, nil
+	}
+
+	signerNonce, found := k.GetSignerNonceTransient(ctx, sender)
+	switch {
+	case !found:
+		return nonce, nil
+	case signerNonce+1 == nonce:
+		if contractCreation {
+			k.SetSignerNonceTransient(ctx, sender, nonce)
+		}
+		return signerNonce, nil
+	case contractCreation:
+		return 0, errorsmod.Wrapf(
+			errortypes.ErrWrongSequence,
+			"nonce %d of signer %s already used by a contract creation of the transaction", signerNonce, from,
+		)
+	default:
+		return nonce, nil
+	}
+}
+
+// sendEVMCoin sends the given amount of the EVM coin, in 18 decimals, between
+// two accounts. The transfer goes through the module account, as the bank
+// wrapper only converts the amounts of the module account transfers.
+func (k *Keeper) sendEVMCoin(ctx sdk.Context, from, to sdk.AccAddress, amount *big.Int) error {
+	if amount.Sign() == 0 {
+		return nil
+	}
+
+	coins := sdk.Coins{sdk.NewCoin(types.GetEVMCoinDenom(), sdkmath.NewIntFromBigInt(amount))}
+	if err := k.bankWrapper.SendCoinsFromAccountToModule(ctx, from, types.ModuleName, coins); err != nil {
+		return err
+	}
+	return k.bankWrapper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, to, coins)
+}
+
+// emitSyntheticTxEvents emits the ethereum_tx and tx_log events of a synthetic
+// transaction. On top of the attributes emitted for the EthereumTx messages, the
+// ethereum_tx event includes the encoded synthetic transaction and its sender,
+// as the sender can't be recovered from the transaction signature.
+func emitSyntheticTxEvents(
+	ctx sdk.Context,
+	tx *ethtypes.Transaction,
+	from common.Address,
+	txIndex uint,
+	res *types.MsgEthereumTxResponse,
+) error {
+	txBz, err := tx.MarshalBinary()
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to encode synthetic tx")
+	}
+
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyAmount, tx.Value().String()),
+		sdk.NewAttribute(types.AttributeKeyEthereumTxHash, res.Hash),
+		sdk.NewAttribute(types.AttributeKeyTxIndex, strconv.FormatUint(uint64(txIndex), 10)),
+		sdk.NewAttribute(types.AttributeKeyTxGasUsed, strconv.FormatUint(res.GasUsed, 10)),
+	}
+
+	if len(ctx.TxBytes()) > 0 {
+		hash := cmttypes.Tx(ctx.TxBytes()).Hash()
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyTxHash, hex.EncodeToString(hash)))
+	}
+
+	if to := tx.To(); to != nil {
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyRecipient, to.Hex()))
+	}
+
+	attrs = append(attrs,
+		sdk.NewAttribute(sdk.AttributeKeySender, from.Hex()),
+		sdk.NewAttribute(types.AttributeKeySyntheticTx, hexutil.Encode(txBz)),
+	)
+
+	txLogAttrs := make([]sdk.Attribute, len(res.Logs))
+	for i, log := range res.Logs {
+		value, err := json.Marshal(log)
+		if err != nil {
+			return errorsmod.Wrap(err, "failed to encode log")
+		}
+		txLogAttrs[i] = sdk.NewAttribute(types.AttributeKeyTxLog, string(value))
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(types.EventTypeEthereumTx, attrs...),
+		sdk.NewEvent(types.EventTypeTxLog, txLogAttrs...),
+	})
+
+	return nil
+}
@@ -0,0 +1,193 @@
+package keeper
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/x/vm/flatstate"
+	"github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// flatStateQueryKey is the context key of the flat state view used by queries.
+type flatStateQueryKey struct{}
+
+// flatStateQuery tracks the storage slots written while serving a query, which
+// cannot be read from the flat state anymore.
+type flatStateQuery struct {
+	dirty map[common.Address]map[common.Hash]struct{}
+}
+
+// SetFlatState sets the flat state snapshot used to serve the contract storage reads.
+// NOTE: it returns the keeper pointer to allow chaining and panics if the flat state
+// was already set
+func (k *Keeper) SetFlatState(fs *flatstate.Store) *Keeper {
+	if k.flatState != nil {
+		panic("cannot set evm flat state twice")
+	}
+
+	k.flatState = fs
+	return k
+}
+
+// InitFlatState rebuilds the flat state snapshot at the context block height, which must
+// be the latest committed one, unless it's already synced at that height. It's meant to be
+// called on startup and is a no-op if the flat state is not set.
+//
+// NOTE: nothing is committed before the first block, which includes the genesis state, so
+// the snapshot is built when the first block begins.
+func (k *Keeper) InitFlatState(ctx sdk.Context) error {
+	if k.flatState == nil || ctx.BlockHeight() == 0 || k.flatState.Height() == ctx.BlockHeight() {
+		return nil
+	}
+	return k.RebuildFlatState(ctx)
+}
+
+// RebuildFlatState rebuilds the flat state snapshot from the contract storage of the
+// module store at the context block height. It's a no-op if the flat state is not set.
+func (k *Keeper) RebuildFlatState(ctx sdk.Context) error {
+	if k.flatState == nil {
+		return nil
+	}
+
+	// the rebuild is node specific so it must not consume any gas
+	store := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()).KVStore(k.storeKey)
+	err := k.flatState.Reset(ctx.BlockHeight(), func(cb func(entry flatstate.Entry)) {
+		iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixStorage)
+		defer iterator.Close()
+
+		for ; iterator.Valid(); iterator.Next() {
+			key := iterator.Key()[len(types.KeyPrefixStorage):]
+			cb(flatstate.Entry{
+				Address: common.BytesToAddress(key[:common.AddressLength]),
+				Key:     common.BytesToHash(key[common.AddressLength:]),
+				Value:   iterator.Value(),
+			})
+		}
+	})
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to rebuild evm flat state")
+	}
+
+	k.Logger(ctx).Info("rebuilt evm flat state", "height", ctx.BlockHeight())
+	return nil
+}
+
+// WithFlatStateQuery returns a query context that reads the contract storage from the
+// flat state when it's synced at the context block height.
+func (k *Keeper) WithFlatStateQuery(ctx sdk.Context) sdk.Context {
+	if k.flatState == nil {
+		return ctx
+	}
+
+	return ctx.WithValue(flatStateQueryKey{}, &flatStateQuery{
+		dirty: make(map[common.Address]map[common.Hash]struct{}),
+	})
+}
+
+// beginFlatStateBlock applies the changes of the previous block to the flat state and
+// rebuilds it if it cannot be synced with the current state.
+func (k *Keeper) beginFlatStateBlock(ctx sdk.Context) error {
+	if k.flatState == nil {
+		return nil
+	}
+
+	synced, err := k.flatState.ApplyPending(ctx.BlockHeight() - 1)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to update evm flat state")
+	}
+	if synced {
+		return nil
+	}
+
+	// NOTE: the state at the beginning of the block only contains the genesis state or
+	// the committed state of the previous block.
+	return k.RebuildFlatState(ctx.WithBlockHeight(ctx.BlockHeight() - 1))
+}
+
+// endFlatStateBlock stores the final values of the storage slots written during the block,
+// to be applied to the flat state once the block is committed.
+func (k *Keeper) endFlatStateBlock(ctx sdk.Context) {
+	if k.flatState == nil {
+		return
+	}
+
+	// the reads are node specific so they must not consume any gas
+	store := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()).KVStore(k.storeKey)
+
+	entries := k.flatState.DirtySlots(ctx.BlockHeight())
+	for i, entry := range entries {
+		entries[i].Value = store.Get(types.StateKey(entry.Address, entry.Key.Bytes()))
+	}
+	k.flatState.SetPending(ctx.BlockHeight(), entries)
+}
+
+// getFlatState returns the value of the storage slot from the flat state. The second
+// return value is false if the slot cannot be read from the flat state, in which case it
+// must be read from the module store.
+//
+// The gas consumed is the same as for a read on the module store.
+func (k *Keeper) getFlatState(ctx sdk.Context, addr common.Address, key common.Hash) ([]byte, bool) {
+	if k.flatState == nil {
+		return nil, false
+	}
+
+	var (
+		value []byte
+		found bool
+	)
+	if ctx.ExecMode() == sdk.ExecModeFinalize {
+		if k.flatState.IsDirty(ctx.BlockHeight(), addr, key) {
+			return nil, false
+		}
+		value, found = k.flatState.Get(ctx.BlockHeight()-1, addr, key)
+	} else if query, ok := ctx.Value(flatStateQueryKey{}).(*flatStateQuery); ok {
+		if _, dirty := query.dirty[addr][key]; dirty {
+			return nil, false
+		}
+		value, found = k.flatState.Get(ctx.BlockHeight(), addr, key)
+	}
+	if !found {
+		return nil, false
+	}
+
+	gasConfig := ctx.KVGasConfig()
+	keyLen := len(types.AddressStoragePrefix(addr)) + len(key)
+	ctx.GasMeter().ConsumeGas(gasConfig.ReadCostFlat, storetypes.GasReadCostFlatDesc)
+	ctx.GasMeter().ConsumeGas(gasConfig.ReadCostPerByte*storetypes.Gas(keyLen), storetypes.GasReadPerByteDesc)
+	ctx.GasMeter().ConsumeGas(gasConfig.ReadCostPerByte*storetypes.Gas(len(value)), storetypes.GasReadPerByteDesc)
+
+	return value, true
+}
+
+// markFlatStateDirty marks the storage slot as written, so that it's read from the module
+// store until the flat state is synced again.
+func (k *Keeper) markFlatStateDirty(ctx sdk.Context, addr common.Address, key common.Hash) {
+	if k.flatState == nil {
+		return
+	}
+
+	if ctx.ExecMode() == sdk.ExecModeFinalize {
+		k.flatState.MarkDirty(ctx.BlockHeight(), addr, key)
+	} else if query, ok := ctx.Value(flatStateQueryKey{}).(*flatStateQuery); ok {
+		if query.dirty[addr] == nil {
+			query.dirty[addr] = make(map[common.Hash]struct{})
+		}
+		query.dirty[addr][key] = struct{}{}
+	}
+}
+
+// getStateFromStore returns the value of the storage slot, reading it from the flat state
+// when possible.
+func (k *Keeper) getStateFromStore(ctx sdk.Context, addr common.Address, key common.Hash) []byte {
+	if value, found := k.getFlatState(ctx, addr, key); found {
+		return value
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressStoragePrefix(addr))
+	return store.Get(key.Bytes())
+}
@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	"github.com/cosmos/evm/x/vm/flatstate"
+	"github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *KeeperTestSuite) TestFlatState() {
+	var (
+		addr   = utiltx.GenerateAddress()
+		key1   = common.BytesToHash([]byte("key1"))
+		key2   = common.BytesToHash([]byte("key2"))
+		value1 = common.BytesToHash([]byte("value1")).Bytes()
+		value2 = common.BytesToHash([]byte("value2")).Bytes()
+		value3 = common.BytesToHash([]byte("value3")).Bytes()
+	)
+
+	suite.SetupTest()
+	k := suite.network.App.EVMKeeper
+	ctx := suite.network.GetContext()
+	height := ctx.BlockHeight()
+
+	k.SetState(ctx, addr, key1, value1)
+	k.SetState(ctx, addr, key2, value1)
+
+	// the snapshot is synced with the state at the beginning of the block
+	k.SetFlatState(flatstate.NewMemStore())
+	suite.Require().NoError(k.RebuildFlatState(ctx.WithBlockHeight(height - 1)))
+
+	// update the module store without going through the keeper, so the reads
+	// served by the snapshot return the previous value
+	store := prefix.NewStore(ctx.KVStore(suite.network.App.GetKey(types.StoreKey)), types.AddressStoragePrefix(addr))
+	store.Set(key1.Bytes(), value2)
+
+	finalizeCtx := ctx.WithExecMode(sdk.ExecModeFinalize)
+	suite.Require().Equal(common.BytesToHash(value1), k.GetState(finalizeCtx, addr, key1), "expected snapshot read")
+	suite.Require().Equal(common.BytesToHash(value2), k.GetState(ctx, addr, key1), "expected module store read")
+
+	// the reads from the snapshot consume the same gas as the module store ones
+	flatCtx := finalizeCtx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	storeCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	k.GetState(flatCtx, addr, key2)
+	k.GetState(storeCtx, addr, key2)
+	suite.Require().Equal(storeCtx.GasMeter().GasConsumed(), flatCtx.GasMeter().GasConsumed())
+
+	// the slots written during the block are read from the module store
+	k.SetState(finalizeCtx, addr, key1, value3)
+	suite.Require().Equal(common.BytesToHash(value3), k.GetState(finalizeCtx, addr, key1))
+
+	// queries read the snapshot synced at their height, except for the slots they wrote
+	queryCtx := k.WithFlatStateQuery(ctx.WithBlockHeight(height - 1))
+	suite.Require().Equal(common.BytesToHash(value1), k.GetState(queryCtx, addr, key2))
+	store.Set(key2.Bytes(), value2)
+	suite.Require().Equal(common.BytesToHash(value1), k.GetState(queryCtx, addr, key2))
+	k.SetState(queryCtx, addr, key2, value3)
+	suite.Require().Equal(common.BytesToHash(value3), k.GetState(queryCtx, addr, key2))
+
+	// the values written during the block are applied at the beginning of the next one
+	suite.Require().NoError(k.EndBlock(finalizeCtx))
+	suite.Require().NoError(k.BeginBlock(finalizeCtx.WithBlockHeight(height + 1)))
+
+	nextCtx := finalizeCtx.WithBlockHeight(height + 1)
+	suite.Require().Equal(common.BytesToHash(value3), k.GetState(nextCtx, addr, key1))
+}
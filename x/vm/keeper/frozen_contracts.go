@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// IsContractFrozen returns true if the contract is frozen by governance.
+func (k Keeper) IsContractFrozen(ctx sdk.Context, contract common.Address) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.FrozenContractKey(contract))
+}
+
+// SetContractFrozen freezes the contract, so that all the calls to it revert.
+func (k Keeper) SetContractFrozen(ctx sdk.Context, contract common.Address) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.FrozenContractKey(contract), []byte{1})
+}
+
+// DeleteContractFrozen unfreezes the contract.
+func (k Keeper) DeleteContractFrozen(ctx sdk.Context, contract common.Address) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.FrozenContractKey(contract))
+}
+
+// checkFreezeAuthority returns an error if the authority is neither the governance
+// account nor the emergency authority set in the params.
+func (k Keeper) checkFreezeAuthority(ctx sdk.Context, authority string) error {
+	if k.authority.String() == authority {
+		return nil
+	}
+
+	if emergencyAuthority := k.GetParams(ctx).EmergencyAuthority; emergencyAuthority != "" && emergencyAuthority == authority {
+		return nil
+	}
+
+	return errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority, expected %s or the emergency authority, got %s", k.authority.String(), authority)
+}
+
+// IterateFrozenContracts iterates over all the frozen contracts and performs a callback
+// function.
+func (k Keeper) IterateFrozenContracts(ctx sdk.Context, cb func(contract common.Address) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFrozenContract)
+	iterator := store.Iterator(nil, nil)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(common.BytesToAddress(iterator.Key())) {
+			break
+		}
+	}
+}
+
+// GetFrozenContracts returns the hex addresses of all the frozen contracts.
+func (k Keeper) GetFrozenContracts(ctx sdk.Context) []string {
+	contracts := []string{}
+	k.IterateFrozenContracts(ctx, func(contract common.Address) bool {
+		contracts = append(contracts, contract.Hex())
+		return false
+	})
+	return contracts
+}
+
+// GetFrozenContractsCallHook returns a CallHook that reverts the calls to the frozen
+// contracts. The contracts can't be frozen during the EVM execution, so the lookups don't
+// consume gas.
+func (k Keeper) GetFrozenContractsCallHook(ctx sdk.Context) types.CallHook {
+	store := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()).KVStore(k.storeKey)
+
+	return func(_ *vm.EVM, _ common.Address, recipient common.Address) error {
+		if store.Has(types.FrozenContractKey(recipient)) {
+			return errorsmod.Wrapf(types.ErrContractFrozen, "contract %s", recipient)
+		}
+		return nil
+	}
+}
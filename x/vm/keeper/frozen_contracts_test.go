@@ -0,0 +1,52 @@
+package keeper_test
+
+import (
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/cosmos/evm/contracts"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	"github.com/cosmos/evm/x/vm/types"
+)
+
+func (suite *KeeperTestSuite) TestFrozenContracts() {
+	suite.SetupTest()
+	ctx := suite.network.GetContext()
+	k := suite.network.App.EVMKeeper
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	sender := suite.keyring.GetAddr(0)
+
+	nonce := k.GetNonce(ctx, erc20types.ModuleAddress)
+	ctorArgs, err := erc20.Pack("", "test", "test", uint8(18))
+	suite.Require().NoError(err)
+	_, err = k.CallEVMWithData(ctx, erc20types.ModuleAddress, nil, append(contracts.ERC20MinterBurnerDecimalsContract.Bin, ctorArgs...), true)
+	suite.Require().NoError(err)
+	contract := crypto.CreateAddress(erc20types.ModuleAddress, nonce)
+
+	totalSupply, err := erc20.Pack("totalSupply")
+	suite.Require().NoError(err)
+
+	_, err = k.CallEVMWithData(ctx, sender, &contract, totalSupply, false)
+	suite.Require().NoError(err)
+
+	// the calls to a frozen contract revert
+	k.SetContractFrozen(ctx, contract)
+	suite.Require().True(k.IsContractFrozen(ctx, contract))
+
+	_, err = k.CallEVMWithData(ctx, sender, &contract, totalSupply, false)
+	suite.Require().ErrorContains(err, types.ErrContractFrozen.Error())
+
+	res, err := k.FrozenContracts(ctx, &types.QueryFrozenContractsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{contract.Hex()}, res.Contracts)
+
+	// the calls are allowed again once the contract is unfrozen
+	k.DeleteContractFrozen(ctx, contract)
+	suite.Require().False(k.IsContractFrozen(ctx, contract))
+
+	_, err = k.CallEVMWithData(ctx, sender, &contract, totalSupply, false)
+	suite.Require().NoError(err)
+
+	res, err = k.FrozenContracts(ctx, &types.QueryFrozenContractsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Contracts)
+}
@@ -27,8 +27,10 @@ import (
 	"github.com/cosmos/evm/x/vm/types"
 
 	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -166,7 +168,7 @@ func (k Keeper) Storage(c context.Context, req *types.QueryStorageRequest) (*typ
 		)
 	}
 
-	ctx := sdk.UnwrapSDKContext(c)
+	ctx := k.WithFlatStateQuery(sdk.UnwrapSDKContext(c))
 
 	address := common.HexToAddress(req.Address)
 	key := common.HexToHash(req.Key)
@@ -223,7 +225,7 @@ func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (*types.Ms
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
-	ctx := sdk.UnwrapSDKContext(c)
+	ctx := k.WithFlatStateQuery(sdk.UnwrapSDKContext(c))
 
 	var args types.TransactionArgs
 	err := json.Unmarshal(req.Args, &args)
@@ -271,7 +273,7 @@ func (k Keeper) EstimateGasInternal(c context.Context, req *types.EthCallRequest
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
-	ctx := sdk.UnwrapSDKContext(c)
+	ctx := k.WithFlatStateQuery(sdk.UnwrapSDKContext(c))
 
 	if req.GasCap < ethparams.TxGas {
 		return nil, status.Errorf(codes.InvalidArgument, "gas cap cannot be lower than %d", ethparams.TxGas)
@@ -722,3 +724,94 @@ func (k Keeper) Config(_ context.Context, _ *types.QueryConfigRequest) (*types.Q
 
 	return &types.QueryConfigResponse{Config: config}, nil
 }
+
+// AccessControlEntries implements the Query/AccessControlEntries gRPC method. It returns the
+// call permission rules of all the contracts, or only of the given one.
+func (k Keeper) AccessControlEntries(c context.Context, req *types.QueryAccessControlEntriesRequest) (*types.QueryAccessControlEntriesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	keyPrefix := types.KeyPrefixAccessControlEntry
+	if req.Contract != "" {
+		if err := cosmosevmtypes.ValidateAddress(req.Contract); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		keyPrefix = types.AccessControlEntriesPrefix(common.HexToAddress(req.Contract))
+	}
+
+	var entries []types.AccessControlEntry
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var entry types.AccessControlEntry
+		if err := k.cdc.Unmarshal(value, &entry); err != nil {
+			return err
+		}
+		entries = append(entries, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAccessControlEntriesResponse{
+		Entries:    entries,
+		Pagination: pageRes,
+	}, nil
+}
+
+// AccessControlEntry implements the Query/AccessControlEntry gRPC method. It returns the call
+// permission rule of the contract function with the given selector, or the contract-wide
+// rule if the selector is empty.
+func (k Keeper) AccessControlEntry(c context.Context, req *types.QueryAccessControlEntryRequest) (*types.QueryAccessControlEntryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := cosmosevmtypes.ValidateAddress(req.Contract); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	selector, err := types.ParseSelector(req.Selector)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	entry, found := k.GetAccessControlEntry(ctx, common.HexToAddress(req.Contract), selector)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "access control entry not found for contract %s and selector %q", req.Contract, req.Selector)
+	}
+
+	return &types.QueryAccessControlEntryResponse{Entry: entry}, nil
+}
+
+// FrozenContracts implements the Query/FrozenContracts gRPC method. It returns the contracts
+// frozen by governance.
+func (k Keeper) FrozenContracts(c context.Context, req *types.QueryFrozenContractsRequest) (*types.QueryFrozenContractsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var contracts []string
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFrozenContract)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		contracts = append(contracts, common.BytesToAddress(key).Hex())
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryFrozenContractsResponse{
+		Contracts:  contracts,
+		Pagination: pageRes,
+	}, nil
+}
@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	"github.com/cosmos/evm/x/vm/keeper"
+	"github.com/cosmos/evm/x/vm/keeper/testdata"
+	"github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// LogRecordHook records all the logs
+type LogRecordHook struct {
+	Logs []*ethtypes.Log
+}
+
+func (dh *LogRecordHook) PostTxProcessing(_ sdk.Context, _ core.Message, receipt *ethtypes.Receipt) error {
+	dh.Logs = receipt.Logs
+	return nil
+}
+
+// FailureHook always fail
+type FailureHook struct{}
+
+func (dh FailureHook) PostTxProcessing(_ sdk.Context, _ core.Message, _ *ethtypes.Receipt) error {
+	return errors.New("post tx processing failed")
+}
+
+func (suite *KeeperTestSuite) TestEvmHooks() {
+	testCases := []struct {
+		msg       string
+		setupHook func() types.EvmHooks
+		expFunc   func(hook types.EvmHooks, res *types.MsgEthereumTxResponse, balance *big.Int)
+	}{
+		{
+			"log collect hook",
+			func() types.EvmHooks {
+				return &LogRecordHook{}
+			},
+			func(hook types.EvmHooks, res *types.MsgEthereumTxResponse, balance *big.Int) {
+				suite.Require().False(res.Failed())
+				suite.Require().Len(res.Logs, 1)
+				suite.Require().Len(hook.(*LogRecordHook).Logs, 1)
+				suite.Require().Equal(int64(100), balance.Int64())
+			},
+		},
+		{
+			"always fail hook",
+			func() types.EvmHooks {
+				return &FailureHook{}
+			},
+			func(_ types.EvmHooks, res *types.MsgEthereumTxResponse, balance *big.Int) {
+				suite.Require().True(res.Failed())
+				suite.Require().Equal(types.ErrPostTxProcessing.Error(), res.VmError)
+				suite.Require().Empty(res.Logs)
+				suite.Require().Zero(balance.Sign(), "expected the transfer to be reverted")
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest()
+
+			ctx := suite.network.GetContext()
+			sender := suite.keyring.GetAddr(0)
+			recipient := utiltx.GenerateAddress()
+			contractAddr := suite.DeployTestContract(suite.T(), ctx, sender, big.NewInt(1000))
+
+			hook := tc.setupHook()
+			suite.network.App.EVMKeeper.SetHooks(keeper.NewMultiEvmHooks(hook))
+
+			erc20Contract, err := testdata.LoadERC20Contract()
+			suite.Require().NoError(err)
+			transferData, err := erc20Contract.ABI.Pack("transfer", recipient, big.NewInt(100))
+			suite.Require().NoError(err)
+
+			chainID := types.GetEthChainConfig().ChainID
+			msg := types.NewTx(&types.EvmTxArgs{
+				ChainID:  chainID,
+				Nonce:    suite.network.App.EVMKeeper.GetNonce(ctx, sender),
+				To:       &contractAddr,
+				GasLimit: 100_000,
+				Input:    transferData,
+			})
+			msg.From = sender.Hex()
+			err = msg.Sign(ethtypes.LatestSignerForChainID(chainID), utiltx.NewSigner(suite.keyring.GetPrivKey(0)))
+			suite.Require().NoError(err)
+
+			res, err := suite.network.App.EVMKeeper.ApplyTransaction(ctx, msg.AsTransaction())
+			suite.Require().NoError(err)
+
+			balanceRes, err := suite.network.App.EVMKeeper.CallEVM(ctx, erc20Contract.ABI, sender, contractAddr, false, "balanceOf", recipient)
+			suite.Require().NoError(err)
+			balance := new(big.Int).SetBytes(balanceRes.Ret)
+
+			tc.expFunc(hook, res, balance)
+		})
+	}
+}
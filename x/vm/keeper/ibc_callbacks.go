@@ -0,0 +1,222 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/contracts"
+	"github.com/cosmos/evm/utils"
+	"github.com/cosmos/evm/x/vm/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// OnRecvPacketCallback executes the destination callback defined in the memo of
+// a received ICS20 packet. If the callback fails, an error acknowledgement is
+// returned so that the transfer is reverted and the tokens are refunded on the
+// source chain.
+func (k *Keeper) OnRecvPacketCallback(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	ack exported.Acknowledgement,
+	maxCallbackGas uint64,
+) exported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		// not an ICS20 packet, so there is no callback to execute
+		return ack
+	}
+
+	callback, found, err := types.GetCallbackData(data.Memo, types.DestinationCallbackKey, maxCallbackGas)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	if !found {
+		return ack
+	}
+
+	input, err := contracts.IBCCallbacksContract.ABI.Pack(
+		"onPacketReceive",
+		packet.DestinationChannel,
+		packet.DestinationPort,
+		packet.Sequence,
+		packet.Data,
+	)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(errorsmod.Wrap(types.ErrABIPack, err.Error()))
+	}
+
+	if err := k.executeCallback(ctx, types.CallbackTypeReceivePacket, packet.DestinationChannel, packet.Sequence, callback, input); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	return ack
+}
+
+// OnAcknowledgementPacketCallback executes the source callback defined in the
+// memo of an acknowledged ICS20 packet. A failed callback doesn't revert the
+// acknowledgement, as that would prevent the refund of the transferred tokens.
+func (k *Keeper) OnAcknowledgementPacketCallback(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	maxCallbackGas uint64,
+) {
+	callback, found := k.getSourceCallback(ctx, packet, types.CallbackTypeAcknowledgementPacket, maxCallbackGas)
+	if !found {
+		return
+	}
+
+	input, err := contracts.IBCCallbacksContract.ABI.Pack(
+		"onPacketAcknowledgement",
+		packet.SourceChannel,
+		packet.SourcePort,
+		packet.Sequence,
+		packet.Data,
+		acknowledgement,
+	)
+	if err != nil {
+		k.emitCallbackEvent(ctx, types.CallbackTypeAcknowledgementPacket, packet.SourceChannel, packet.Sequence, callback, errorsmod.Wrap(types.ErrABIPack, err.Error()))
+		return
+	}
+
+	// the error is already emitted on the callback event
+	_ = k.executeCallback(ctx, types.CallbackTypeAcknowledgementPacket, packet.SourceChannel, packet.Sequence, callback, input)
+}
+
+// OnTimeoutPacketCallback executes the source callback defined in the memo of
+// a timed out ICS20 packet. A failed callback doesn't revert the timeout, as
+// that would prevent the refund of the transferred tokens.
+func (k *Keeper) OnTimeoutPacketCallback(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	maxCallbackGas uint64,
+) {
+	callback, found := k.getSourceCallback(ctx, packet, types.CallbackTypeTimeoutPacket, maxCallbackGas)
+	if !found {
+		return
+	}
+
+	input, err := contracts.IBCCallbacksContract.ABI.Pack(
+		"onPacketTimeout",
+		packet.SourceChannel,
+		packet.SourcePort,
+		packet.Sequence,
+		packet.Data,
+	)
+	if err != nil {
+		k.emitCallbackEvent(ctx, types.CallbackTypeTimeoutPacket, packet.SourceChannel, packet.Sequence, callback, errorsmod.Wrap(types.ErrABIPack, err.Error()))
+		return
+	}
+
+	// the error is already emitted on the callback event
+	_ = k.executeCallback(ctx, types.CallbackTypeTimeoutPacket, packet.SourceChannel, packet.Sequence, callback, input)
+}
+
+// getSourceCallback returns the source callback defined in the memo of the
+// given ICS20 packet. The callback is only returned if the contract to call is
+// the sender of the packet, so that contracts can't be called on behalf of
+// other accounts. Invalid callbacks are reported through a failed callback
+// event.
+func (k *Keeper) getSourceCallback(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	callbackType string,
+	maxCallbackGas uint64,
+) (types.CallbackData, bool) {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return types.CallbackData{}, false
+	}
+
+	callback, found, err := types.GetCallbackData(data.Memo, types.SourceCallbackKey, maxCallbackGas)
+	if err != nil {
+		k.emitCallbackEvent(ctx, callbackType, packet.SourceChannel, packet.Sequence, callback, err)
+		return types.CallbackData{}, false
+	}
+	if !found {
+		return types.CallbackData{}, false
+	}
+
+	sender, err := utils.GetAccAddressFromBech32(data.Sender)
+	if err != nil || common.BytesToAddress(sender) != callback.ContractAddress {
+		err = errorsmod.Wrapf(types.ErrInvalidCallback, "callback contract %s is not the packet sender %s", callback.ContractAddress, data.Sender)
+		k.emitCallbackEvent(ctx, callbackType, packet.SourceChannel, packet.Sequence, callback, err)
+		return types.CallbackData{}, false
+	}
+
+	return callback, true
+}
+
+// executeCallback calls the callback contract with the given input on a cached
+// context, limiting the execution to the callback gas limit. The state changes
+// are only committed if the call succeeds, while the gas used is consumed in
+// both cases.
+func (k *Keeper) executeCallback(
+	ctx sdk.Context,
+	callbackType, channel string,
+	sequence uint64,
+	callback types.CallbackData,
+	input []byte,
+) (err error) {
+	defer func() {
+		k.emitCallbackEvent(ctx, callbackType, channel, sequence, callback, err)
+	}()
+
+	if !k.IsContract(ctx, callback.ContractAddress) {
+		return errorsmod.Wrapf(types.ErrInvalidCallback, "callback address %s is not a contract", callback.ContractAddress)
+	}
+
+	cacheCtx, writeFn := ctx.CacheContext()
+	res, err := k.CallEVMWithDataAndGasCap(cacheCtx, types.CallbackSenderAddress, &callback.ContractAddress, input, true, callback.GasLimit)
+	if err != nil {
+		// a failed callback is charged too, with the whole gas limit if it
+		// failed before its execution (e.g. on a revert during the estimation)
+		gasUsed := callback.GasLimit
+		if res != nil {
+			gasUsed = res.GasUsed
+		}
+		ctx.GasMeter().ConsumeGas(gasUsed, "ibc callback")
+
+		return errorsmod.Wrap(types.ErrCallbackFailed, err.Error())
+	}
+
+	ctx.GasMeter().ConsumeGas(res.GasUsed, "ibc callback")
+	writeFn()
+
+	return nil
+}
+
+// emitCallbackEvent emits the result of a packet callback execution.
+func (k *Keeper) emitCallbackEvent(
+	ctx sdk.Context,
+	callbackType, channel string,
+	sequence uint64,
+	callback types.CallbackData,
+	err error,
+) {
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyCallbackType, callbackType),
+		sdk.NewAttribute(types.AttributeKeyContractAddress, callback.ContractAddress.Hex()),
+		sdk.NewAttribute(types.AttributeKeyCallbackGas, strconv.FormatUint(callback.GasLimit, 10)),
+		sdk.NewAttribute(types.AttributeKeyPacketChannel, channel),
+		sdk.NewAttribute(types.AttributeKeyPacketSequence, strconv.FormatUint(sequence, 10)),
+	}
+
+	if err != nil {
+		attrs = append(attrs,
+			sdk.NewAttribute(types.AttributeKeyCallbackResult, types.AttributeValueCallbackFailure),
+			sdk.NewAttribute(types.AttributeKeyCallbackError, err.Error()),
+		)
+	} else {
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyCallbackResult, types.AttributeValueCallbackSuccess))
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeIBCCallback, attrs...))
+}
@@ -0,0 +1,202 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	"github.com/cosmos/evm/x/vm/statedb"
+	"github.com/cosmos/evm/x/vm/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	// stopCode is the runtime code of a contract that stops on any call
+	stopCode = []byte{0x00}
+	// revertCode is the runtime code of a contract that reverts on any call
+	revertCode = []byte{0x60, 0x00, 0x60, 0x00, 0xfd}
+)
+
+// setCallbackContract sets the given runtime code on a new contract address.
+func (suite *KeeperTestSuite) setCallbackContract(ctx sdk.Context, code []byte) common.Address {
+	addr := utiltx.GenerateAddress()
+	codeHash := crypto.Keccak256(code)
+
+	account := statedb.NewEmptyAccount()
+	account.CodeHash = codeHash
+	suite.Require().NoError(suite.network.App.EVMKeeper.SetAccount(ctx, addr, *account))
+	suite.network.App.EVMKeeper.SetCode(ctx, codeHash, code)
+
+	return addr
+}
+
+// newCallbackPacket returns an ICS20 packet with the given sender and memo.
+func newCallbackPacket(sender, memo string) channeltypes.Packet {
+	data := transfertypes.NewFungibleTokenPacketData("aatom", "100", sender, "cosmos1receiver", memo)
+	return channeltypes.NewPacket(data.GetBytes(), 1, transfertypes.PortID, "channel-0", transfertypes.PortID, "channel-1", clienttypes.NewHeight(1, 100), 0)
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketCallback() {
+	successAck := channeltypes.NewResultAcknowledgement([]byte{1})
+
+	testCases := []struct {
+		name       string
+		memo       func(ctx sdk.Context) string
+		expSuccess bool
+	}{
+		{
+			"no callback",
+			func(_ sdk.Context) string { return "" },
+			true,
+		},
+		{
+			"invalid callback",
+			func(_ sdk.Context) string { return `{"dest_callback":{"address":"invalid"}}` },
+			false,
+		},
+		{
+			"callback address is not a contract",
+			func(_ sdk.Context) string {
+				return `{"dest_callback":{"address":"` + utiltx.GenerateAddress().Hex() + `"}}`
+			},
+			false,
+		},
+		{
+			"callback reverts",
+			func(ctx sdk.Context) string {
+				return `{"dest_callback":{"address":"` + suite.setCallbackContract(ctx, revertCode).Hex() + `"}}`
+			},
+			false,
+		},
+		{
+			"callback succeeds",
+			func(ctx sdk.Context) string {
+				return `{"dest_callback":{"address":"` + suite.setCallbackContract(ctx, stopCode).Hex() + `","gas_limit":"100000"}}`
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx := suite.network.GetContext()
+
+			packet := newCallbackPacket(suite.keyring.GetAccAddr(0).String(), tc.memo(ctx))
+			ack := suite.network.App.EVMKeeper.OnRecvPacketCallback(ctx, packet, successAck, types.DefaultMaxCallbackGas)
+			suite.Require().Equal(tc.expSuccess, ack.Success())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketCallbackGas() {
+	const gasLimit = 100_000
+	successAck := channeltypes.NewResultAcknowledgement([]byte{1})
+
+	testCases := []struct {
+		name       string
+		code       []byte
+		expSuccess bool
+	}{
+		{"callback reverts", revertCode, false},
+		{"callback succeeds", stopCode, true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx := suite.network.GetContext()
+
+			contract := suite.setCallbackContract(ctx, tc.code)
+			memo := fmt.Sprintf(`{"dest_callback":{"address":"%s","gas_limit":"%d"}}`, contract.Hex(), gasLimit)
+			packet := newCallbackPacket(suite.keyring.GetAccAddr(0).String(), memo)
+
+			ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+			ack := suite.network.App.EVMKeeper.OnRecvPacketCallback(ctx, packet, successAck, types.DefaultMaxCallbackGas)
+			suite.Require().Equal(tc.expSuccess, ack.Success())
+
+			// the gas of the callback is charged whether it succeeds or not
+			suite.Require().NotZero(ctx.GasMeter().GasConsumed())
+			if !tc.expSuccess {
+				suite.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed(), uint64(gasLimit))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnAcknowledgementPacketCallback() {
+	testCases := []struct {
+		name      string
+		sender    func(contract common.Address) string
+		code      []byte
+		expResult string
+	}{
+		{
+			"sender is not the callback contract",
+			func(_ common.Address) string { return suite.keyring.GetAccAddr(0).String() },
+			stopCode,
+			types.AttributeValueCallbackFailure,
+		},
+		{
+			"callback reverts",
+			func(contract common.Address) string { return sdk.AccAddress(contract.Bytes()).String() },
+			revertCode,
+			types.AttributeValueCallbackFailure,
+		},
+		{
+			"callback succeeds",
+			func(contract common.Address) string { return sdk.AccAddress(contract.Bytes()).String() },
+			stopCode,
+			types.AttributeValueCallbackSuccess,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx := suite.network.GetContext().WithEventManager(sdk.NewEventManager())
+
+			contract := suite.setCallbackContract(ctx, tc.code)
+			packet := newCallbackPacket(tc.sender(contract), `{"src_callback":{"address":"`+contract.Hex()+`"}}`)
+			suite.network.App.EVMKeeper.OnAcknowledgementPacketCallback(ctx, packet, []byte(`{"result":"AQ=="}`), types.DefaultMaxCallbackGas)
+
+			suite.requireCallbackResult(ctx, tc.expResult)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnTimeoutPacketCallback() {
+	suite.SetupTest()
+	ctx := suite.network.GetContext().WithEventManager(sdk.NewEventManager())
+
+	contract := suite.setCallbackContract(ctx, stopCode)
+	packet := newCallbackPacket(sdk.AccAddress(contract.Bytes()).String(), `{"src_callback":{"address":"`+contract.Hex()+`"}}`)
+	suite.network.App.EVMKeeper.OnTimeoutPacketCallback(ctx, packet, types.DefaultMaxCallbackGas)
+
+	suite.requireCallbackResult(ctx, types.AttributeValueCallbackSuccess)
+}
+
+// requireCallbackResult checks that an IBC callback event with the given result
+// was emitted.
+func (suite *KeeperTestSuite) requireCallbackResult(ctx sdk.Context, result string) {
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeIBCCallback {
+			continue
+		}
+
+		attr, found := event.GetAttribute(types.AttributeKeyCallbackResult)
+		suite.Require().True(found)
+		suite.Require().Equal(result, attr.Value)
+		return
+	}
+
+	suite.Fail("IBC callback event not found")
+}
@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterInvariants registers the EVM module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
+	ir.RegisterRoute(types.ModuleName, "contract-code", ContractCodeInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "module-account", ModuleAccountInvariant(k))
+}
+
+// ContractCodeInvariant checks that every code hash in use by a contract has
+// its code stored and that the code reference counts match the number of
+// contracts using each code hash.
+func ContractCodeInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		counts := make(map[common.Hash]uint64)
+		k.IterateContracts(ctx, func(addr common.Address, codeHash common.Hash) bool {
+			if counts[codeHash] == 0 && len(k.GetCode(ctx, codeHash)) == 0 {
+				broken = true
+				msg += fmt.Sprintf("\tcode not found for contract %s with code hash %s\n", addr.Hex(), codeHash.Hex())
+			}
+			counts[codeHash]++
+			return false
+		})
+
+		k.IterateContracts(ctx, func(_ common.Address, codeHash common.Hash) bool {
+			expected, found := counts[codeHash]
+			if !found {
+				return false
+			}
+			// only check each code hash once
+			delete(counts, codeHash)
+
+			if refCount := k.GetCodeRefCount(ctx, codeHash); refCount != expected {
+				broken = true
+				msg += fmt.Sprintf("\tcode hash %s has reference count %d, expected %d\n", codeHash.Hex(), refCount, expected)
+			}
+			return false
+		})
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "contract-code",
+			fmt.Sprintf("contract code references broken:\n%s", msg),
+		), broken
+	}
+}
+
+// ModuleAccountInvariant checks that the EVM module account holds no funds.
+// The module account only mints and burns the EVM coin on behalf of the EVM
+// state transitions, so any balance left on it is a sign of broken accounting.
+// The EVM can't transfer funds to it (see GetModuleAccountCallHook) and the
+// bank module blocks the sends to module accounts.
+func ModuleAccountInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+		balances := k.bankWrapper.GetAllBalances(ctx, moduleAddr)
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "module-account",
+			fmt.Sprintf("\tmodule account %s balance: %s, expected none\n", moduleAddr, balances),
+		), !balances.IsZero()
+	}
+}
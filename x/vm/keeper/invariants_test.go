@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	"github.com/cosmos/evm/x/vm/keeper"
+	"github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func (suite *KeeperTestSuite) TestContractCodeInvariant() {
+	code := []byte("code")
+	codeHash := crypto.Keccak256Hash(code)
+
+	testCases := []struct {
+		name      string
+		malleate  func(ctx sdk.Context)
+		expBroken bool
+	}{
+		{
+			"pass - code stored for every contract",
+			func(ctx sdk.Context) {
+				suite.network.App.EVMKeeper.SetCode(ctx, codeHash.Bytes(), code)
+				suite.network.App.EVMKeeper.SetCodeHash(ctx, utiltx.GenerateAddress().Bytes(), codeHash.Bytes())
+				suite.network.App.EVMKeeper.SetCodeHash(ctx, utiltx.GenerateAddress().Bytes(), codeHash.Bytes())
+			},
+			false,
+		},
+		{
+			"fail - contract code missing",
+			func(ctx sdk.Context) {
+				suite.network.App.EVMKeeper.SetCodeHash(ctx, utiltx.GenerateAddress().Bytes(), codeHash.Bytes())
+			},
+			true,
+		},
+		{
+			"fail - reference count mismatch",
+			func(ctx sdk.Context) {
+				suite.network.App.EVMKeeper.SetCode(ctx, codeHash.Bytes(), code)
+				suite.network.App.EVMKeeper.SetCodeHash(ctx, utiltx.GenerateAddress().Bytes(), codeHash.Bytes())
+
+				store := prefix.NewStore(ctx.KVStore(suite.network.App.GetKey(types.StoreKey)), types.KeyPrefixCodeHash)
+				store.Set(utiltx.GenerateAddress().Bytes(), codeHash.Bytes())
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx := suite.network.GetContext()
+
+			tc.malleate(ctx)
+
+			msg, broken := keeper.ContractCodeInvariant(suite.network.App.EVMKeeper)(ctx)
+			suite.Require().Equal(tc.expBroken, broken, msg)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestModuleAccountInvariant() {
+	testCases := []struct {
+		name      string
+		malleate  func(ctx sdk.Context)
+		expBroken bool
+	}{
+		{
+			"pass - no funds on the module account",
+			func(sdk.Context) {},
+			false,
+		},
+		{
+			"fail - funds on the module account",
+			func(ctx sdk.Context) {
+				coins := sdk.NewCoins(sdk.NewInt64Coin(types.GetEVMCoinDenom(), 100))
+				err := suite.network.App.BankKeeper.MintCoins(ctx, types.ModuleName, coins)
+				suite.Require().NoError(err)
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx := suite.network.GetContext()
+
+			tc.malleate(ctx)
+
+			msg, broken := keeper.ModuleAccountInvariant(suite.network.App.EVMKeeper)(ctx)
+			suite.Require().Equal(tc.expBroken, broken, msg)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestModuleAccountInvariantValueTransfer() {
+	suite.SetupTest()
+	moduleAddr := common.BytesToAddress(authtypes.NewModuleAddress(types.ModuleName))
+
+	// the EVM can't send funds to the module account
+	_, err := suite.factory.ExecuteEthTx(suite.keyring.GetPrivKey(0), types.EvmTxArgs{
+		To:       &moduleAddr,
+		Amount:   big.NewInt(100),
+		GasLimit: 100_000,
+		GasPrice: big.NewInt(1),
+	})
+	suite.Require().ErrorContains(err, types.ErrModuleAccountRecipient.Error())
+	suite.Require().NoError(suite.network.NextBlock())
+
+	// nor credit it, e.g. as the beneficiary of a SELFDESTRUCT
+	ctx := suite.network.GetContext()
+	err = suite.network.App.EVMKeeper.SetBalance(ctx, moduleAddr, big.NewInt(100))
+	suite.Require().ErrorIs(err, types.ErrModuleAccountRecipient)
+
+	balance := suite.network.App.EVMKeeper.GetBalance(ctx, moduleAddr)
+	suite.Require().Zero(balance.Sign())
+
+	for _, invariant := range []sdk.Invariant{
+		keeper.ModuleAccountInvariant(suite.network.App.EVMKeeper),
+		keeper.ContractCodeInvariant(suite.network.App.EVMKeeper),
+	} {
+		msg, broken := invariant(ctx)
+		suite.Require().False(broken, msg)
+	}
+}
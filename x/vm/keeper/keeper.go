@@ -9,6 +9,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/params"
 
+	"github.com/cosmos/evm/x/vm/flatstate"
 	"github.com/cosmos/evm/x/vm/statedb"
 	"github.com/cosmos/evm/x/vm/types"
 	"github.com/cosmos/evm/x/vm/wrappers"
@@ -58,6 +59,12 @@ type Keeper struct {
 	// Tracer used to collect execution traces from the EVM transaction execution
 	tracer string
 
+	// EVM Hooks for tx post-processing
+	hooks types.EvmHooks
+
+	// flatState is an optional flat snapshot of the contract storage used to serve the storage reads
+	flatState *flatstate.Store
+
 	// Legacy subspace
 	ss paramstypes.Subspace
 
@@ -129,6 +136,26 @@ func (k Keeper) EmitBlockBloomEvent(ctx sdk.Context, bloom ethtypes.Bloom) {
 	)
 }
 
+// SetHooks sets the hooks for the EVM module
+// NOTE: it returns the keeper pointer to allow chaining and panics if the
+// hooks were already set
+func (k *Keeper) SetHooks(eh types.EvmHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set evm hooks twice")
+	}
+
+	k.hooks = eh
+	return k
+}
+
+// PostTxProcessing delegates the call to the hooks. If no hook has been registered, this function returns with a `nil` error
+func (k *Keeper) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
+	if k.hooks == nil {
+		return nil
+	}
+	return k.hooks.PostTxProcessing(ctx, msg, receipt)
+}
+
 // GetAuthority returns the x/evm module authority address
 func (k Keeper) GetAuthority() sdk.AccAddress {
 	return k.authority
@@ -311,6 +338,42 @@ func (k Keeper) SetTransientGasUsed(ctx sdk.Context, gasUsed uint64) {
 	store.Set(types.KeyPrefixTransientGasUsed, bz)
 }
 
+// SetSignerNonceTransient sets the nonce of a signer of the current cosmos tx, i.e.
+// the nonce of the signer that hasn't been used yet by the transaction. It's set by
+// the ante handler to the signer sequence before it's incremented.
+func (k Keeper) SetSignerNonceTransient(ctx sdk.Context, signer sdk.AccAddress, nonce uint64) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientSignerNonce)
+	store.Set(signer, sdk.Uint64ToBigEndian(nonce))
+}
+
+// GetSignerNonceTransient returns the nonce of a signer of the current cosmos tx and
+// whether the given account is a signer of the tx.
+func (k Keeper) GetSignerNonceTransient(ctx sdk.Context, signer sdk.AccAddress) (uint64, bool) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientSignerNonce)
+	bz := store.Get(signer)
+	if bz == nil {
+		return 0, false
+	}
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// ResetSignerNoncesTransient removes the signer nonces of the previous cosmos tx.
+// The transient store is only reset at the end of the block, so it's called by the
+// ante handler at the start of each tx.
+func (k Keeper) ResetSignerNoncesTransient(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientSignerNonce)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
 // AddTransientGasUsed accumulate gas used by each eth msgs included in current cosmos tx.
 func (k Keeper) AddTransientGasUsed(ctx sdk.Context, gasUsed uint64) (uint64, error) {
 	result := k.GetTransientGasUsed(ctx) + gasUsed
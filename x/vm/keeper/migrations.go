@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/x/vm/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper *Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper *Keeper) Migrator {
+	return Migrator{
+		keeper: keeper,
+	}
+}
+
+// Migrate8to9 migrates the store from consensus version 8 to 9. It rebuilds
+// the code reference counts from the contract code hashes and prunes all code
+// that is not referenced by any account.
+func (m Migrator) Migrate8to9(ctx sdk.Context) error {
+	m.keeper.RebuildCodeRefCounts(ctx)
+	return nil
+}
+
+// RebuildCodeRefCounts recomputes the reference count of every code hash from
+// the contracts stored in the EVM module and deletes the code entries that are
+// no longer referenced.
+func (k *Keeper) RebuildCodeRefCounts(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	// clear the existing reference counts
+	deleteKeysWithPrefix(store, types.KeyPrefixCodeRefCount)
+
+	// NOTE: keep track of the code hashes in iteration order so that the
+	// writes are deterministic.
+	var codeHashes []common.Hash
+	counts := make(map[common.Hash]uint64)
+	k.IterateContracts(ctx, func(_ common.Address, codeHash common.Hash) bool {
+		if counts[codeHash] == 0 {
+			codeHashes = append(codeHashes, codeHash)
+		}
+		counts[codeHash]++
+		return false
+	})
+
+	for _, codeHash := range codeHashes {
+		k.setCodeRefCount(ctx, codeHash.Bytes(), counts[codeHash])
+	}
+
+	var orphans [][]byte
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixCode)
+	for ; iterator.Valid(); iterator.Next() {
+		codeHash := iterator.Key()[len(types.KeyPrefixCode):]
+		if counts[common.BytesToHash(codeHash)] == 0 {
+			orphans = append(orphans, codeHash)
+		}
+	}
+	iterator.Close()
+
+	for _, codeHash := range orphans {
+		k.DeleteCode(ctx, codeHash)
+	}
+
+	k.Logger(ctx).Info(
+		"rebuilt code reference counts",
+		"code-hashes", len(codeHashes),
+		"pruned", len(orphans),
+	)
+}
+
+// deleteKeysWithPrefix removes all the entries of the store under the given prefix.
+func deleteKeysWithPrefix(store storetypes.KVStore, prefix []byte) {
+	iterator := storetypes.KVStorePrefixIterator(store, prefix)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
@@ -0,0 +1,41 @@
+package keeper_test
+
+import (
+	"github.com/ethereum/go-ethereum/crypto"
+
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	"github.com/cosmos/evm/x/vm/keeper"
+	"github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/store/prefix"
+)
+
+func (suite *KeeperTestSuite) TestMigrate8to9() {
+	suite.SetupTest()
+	ctx := suite.network.GetContext()
+	evmKeeper := suite.network.App.EVMKeeper
+	store := ctx.KVStore(suite.network.App.GetKey(types.StoreKey))
+
+	code := []byte("shared code")
+	codeHash := crypto.Keccak256Hash(code)
+	orphanCode := []byte("orphan code")
+	orphanCodeHash := crypto.Keccak256Hash(orphanCode)
+
+	// simulate the v8 layout, where code hashes are set without reference counts
+	codeHashStore := prefix.NewStore(store, types.KeyPrefixCodeHash)
+	codeHashStore.Set(utiltx.GenerateAddress().Bytes(), codeHash.Bytes())
+	codeHashStore.Set(utiltx.GenerateAddress().Bytes(), codeHash.Bytes())
+	evmKeeper.SetCode(ctx, codeHash.Bytes(), code)
+	evmKeeper.SetCode(ctx, orphanCodeHash.Bytes(), orphanCode)
+
+	m := keeper.NewMigrator(evmKeeper)
+	suite.Require().NoError(m.Migrate8to9(ctx))
+
+	suite.Require().Equal(uint64(2), evmKeeper.GetCodeRefCount(ctx, codeHash))
+	suite.Require().Equal(code, evmKeeper.GetCode(ctx, codeHash))
+	suite.Require().Equal(uint64(0), evmKeeper.GetCodeRefCount(ctx, orphanCodeHash))
+	suite.Require().Nil(evmKeeper.GetCode(ctx, orphanCodeHash), "expected orphaned code to be pruned")
+
+	_, broken := keeper.ContractCodeInvariant(evmKeeper)(ctx)
+	suite.Require().False(broken)
+}
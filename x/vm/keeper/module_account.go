@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// GetModuleAccountCallHook returns a CallHook that reverts the calls to the EVM
+// module account. The module account only mints and burns the EVM coin on
+// behalf of the state transitions, so it must not receive funds from the EVM
+// (see ModuleAccountInvariant). As it holds no code, calling it is only useful
+// to transfer value.
+func (k Keeper) GetModuleAccountCallHook() types.CallHook {
+	moduleAddr := common.BytesToAddress(k.accountKeeper.GetModuleAddress(types.ModuleName))
+
+	return func(_ *vm.EVM, _ common.Address, recipient common.Address) error {
+		if recipient == moduleAddr {
+			return errorsmod.Wrapf(types.ErrModuleAccountRecipient, "cannot call %s", recipient)
+		}
+		return nil
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/hashicorp/go-metrics"
 
 	cmttypes "github.com/cometbft/cometbft/types"
@@ -146,3 +147,164 @@ func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// RegisterPreinstalls implements the gRPC MsgServer interface. When a RegisterPreinstalls
+// proposal passes, it deploys the preinstalled contracts at their fixed addresses. The
+// registration can only be performed if the requested authority is the Cosmos SDK
+// governance module account.
+func (k *Keeper) RegisterPreinstalls(goCtx context.Context, req *types.MsgRegisterPreinstalls) (*types.MsgRegisterPreinstallsResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority, expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.AddPreinstalls(ctx, req.Preinstalls); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterPreinstallsResponse{}, nil
+}
+
+// AddAccessControlEntries implements the gRPC MsgServer interface. When an
+// AddAccessControlEntries proposal passes, it creates or updates the call permission rules
+// of the given contracts and functions. The update can only be performed if the requested
+// authority is the Cosmos SDK governance module account.
+func (k *Keeper) AddAccessControlEntries(goCtx context.Context, req *types.MsgAddAccessControlEntries) (*types.MsgAddAccessControlEntriesResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority, expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	for _, entry := range req.Entries {
+		if err := k.AddAccessControlEntry(ctx, entry); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgAddAccessControlEntriesResponse{}, nil
+}
+
+// RemoveAccessControlEntries implements the gRPC MsgServer interface. When a
+// RemoveAccessControlEntries proposal passes, it removes the given call permission rules or
+// addresses from their access control lists. The update can only be performed if the
+// requested authority is the Cosmos SDK governance module account.
+func (k *Keeper) RemoveAccessControlEntries(goCtx context.Context, req *types.MsgRemoveAccessControlEntries) (*types.MsgRemoveAccessControlEntriesResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority, expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	for _, entry := range req.Entries {
+		if err := k.RemoveAccessControlEntry(ctx, entry); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgRemoveAccessControlEntriesResponse{}, nil
+}
+
+// FreezeContract implements the gRPC MsgServer interface. When a FreezeContract proposal
+// passes, all the calls to the contract revert until it's unfrozen. The freeze can only be
+// performed if the requested authority is the Cosmos SDK governance module account or the
+// emergency authority set in the params, which doesn't need to wait for a proposal.
+func (k *Keeper) FreezeContract(goCtx context.Context, req *types.MsgFreezeContract) (*types.MsgFreezeContractResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.checkFreezeAuthority(ctx, req.Authority); err != nil {
+		return nil, err
+	}
+
+	contract := common.HexToAddress(req.Contract)
+	if k.IsContractFrozen(ctx, contract) {
+		return nil, errorsmod.Wrapf(types.ErrContractFrozen, "contract %s", contract)
+	}
+
+	k.SetContractFrozen(ctx, contract)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFreezeContract,
+			sdk.NewAttribute(types.AttributeKeyContractAddress, contract.Hex()),
+		),
+	)
+
+	return &types.MsgFreezeContractResponse{}, nil
+}
+
+// UnfreezeContract implements the gRPC MsgServer interface. When an UnfreezeContract
+// proposal passes, the calls to the frozen contract are allowed again. The unfreeze can only
+// be performed if the requested authority is the Cosmos SDK governance module account or the
+// emergency authority set in the params.
+func (k *Keeper) UnfreezeContract(goCtx context.Context, req *types.MsgUnfreezeContract) (*types.MsgUnfreezeContractResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.checkFreezeAuthority(ctx, req.Authority); err != nil {
+		return nil, err
+	}
+
+	contract := common.HexToAddress(req.Contract)
+	if !k.IsContractFrozen(ctx, contract) {
+		return nil, errorsmod.Wrapf(types.ErrContractNotFrozen, "contract %s", contract)
+	}
+
+	k.DeleteContractFrozen(ctx, contract)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUnfreezeContract,
+			sdk.NewAttribute(types.AttributeKeyContractAddress, contract.Hex()),
+		),
+	)
+
+	return &types.MsgUnfreezeContractResponse{}, nil
+}
+
+// CallContract implements the gRPC MsgServer interface. It calls a contract on
+// behalf of the signer, using the EVM address derived from its address. This
+// allows accounts that can't sign Ethereum transactions, such as multisigs or
+// interchain accounts, to interact with contracts. The value is always paid
+// from the signer's own balance, including when its EVM address is derived
+// from a longer address like the one of an interchain account.
+func (k *Keeper) CallContract(goCtx context.Context, req *types.MsgCallEVM) (*types.MsgCallEVMResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	to := common.HexToAddress(req.To)
+	res, _, err := k.CallEVMFromCosmos(ctx, sender, &to, req.Data, req.Value.BigInt(), req.GasLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCallEVMResponse{
+		Ret:     res.Ret,
+		GasUsed: res.GasUsed,
+		Hash:    res.Hash,
+	}, nil
+}
+
+// DeployContract implements the gRPC MsgServer interface. It deploys a contract
+// on behalf of the signer, using the EVM address derived from its address, so
+// the contract address depends on the derived address and its nonce. When the
+// signer signed the transaction, the nonce is the sequence the transaction was
+// signed with.
+func (k *Keeper) DeployContract(goCtx context.Context, req *types.MsgDeployContract) (*types.MsgDeployContractResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	res, contract, err := k.CallEVMFromCosmos(ctx, sender, nil, req.Data, req.Value.BigInt(), req.GasLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDeployContractResponse{
+		ContractAddress: contract.Hex(),
+		GasUsed:         res.GasUsed,
+		Hash:            res.Hash,
+	}, nil
+}
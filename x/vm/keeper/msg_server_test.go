@@ -1,16 +1,37 @@
 package keeper_test
 
 import (
+	"errors"
 	"math/big"
+	"strconv"
+	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	commonfactory "github.com/cosmos/evm/testutil/integration/common/factory"
 	"github.com/cosmos/evm/testutil/integration/os/utils"
+	utiltx "github.com/cosmos/evm/testutil/tx"
 	"github.com/cosmos/evm/x/vm/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
+// stopInitCode is the init code of a contract that copies and returns the stop
+// runtime code
+var stopInitCode = []byte{0x60, 0x01, 0x60, 0x0c, 0x60, 0x00, 0x39, 0x60, 0x01, 0x60, 0x00, 0xf3, 0x00}
+
 func (suite *KeeperTestSuite) TestEthereumTx() {
 	suite.enableFeemarket = true
 	defer func() { suite.enableFeemarket = false }()
@@ -121,3 +142,651 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 		suite.Require().NoError(err)
 	}
 }
+
+func (suite *KeeperTestSuite) TestRegisterPreinstalls() {
+	suite.SetupTest()
+	testCases := []struct {
+		name        string
+		getMsg      func() *types.MsgRegisterPreinstalls
+		expectedErr error
+	}{
+		{
+			name: "fail - invalid authority",
+			getMsg: func() *types.MsgRegisterPreinstalls {
+				return &types.MsgRegisterPreinstalls{Authority: "foobar"}
+			},
+			expectedErr: govtypes.ErrInvalidSigner,
+		},
+		{
+			name: "fail - another contract deployed at the preinstall address",
+			getMsg: func() *types.MsgRegisterPreinstalls {
+				return &types.MsgRegisterPreinstalls{
+					Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+					Preinstalls: []types.Preinstall{
+						{Name: "Test", Address: types.DefaultPreinstalls[0].Address, Code: "0x6080604052"},
+					},
+				}
+			},
+			expectedErr: types.ErrInvalidPreinstall,
+		},
+		{
+			name: "pass - valid RegisterPreinstalls msg",
+			getMsg: func() *types.MsgRegisterPreinstalls {
+				return &types.MsgRegisterPreinstalls{
+					Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+					Preinstalls: []types.Preinstall{
+						{Name: "Test", Address: utiltx.GenerateAddress().Hex(), Code: "0x6080604052"},
+					},
+				}
+			},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			msg := tc.getMsg()
+			_, err := suite.network.App.EVMKeeper.RegisterPreinstalls(suite.network.GetContext(), msg)
+			if tc.expectedErr != nil {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), tc.expectedErr.Error())
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+
+		err := suite.network.NextBlock()
+		suite.Require().NoError(err)
+	}
+}
+
+func (suite *KeeperTestSuite) TestAddRemoveAccessControlEntriesMsgs() {
+	suite.SetupTest()
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	entry := types.NewAccessControlEntry(utiltx.GenerateAddress(), nil, types.AccessTypeRestricted, nil)
+
+	testCases := []struct {
+		name        string
+		execMsg     func() error
+		expectedErr error
+	}{
+		{
+			name: "fail - add with invalid authority",
+			execMsg: func() error {
+				_, err := suite.network.App.EVMKeeper.AddAccessControlEntries(suite.network.GetContext(), &types.MsgAddAccessControlEntries{Authority: "foobar"})
+				return err
+			},
+			expectedErr: govtypes.ErrInvalidSigner,
+		},
+		{
+			name: "fail - remove missing entry",
+			execMsg: func() error {
+				_, err := suite.network.App.EVMKeeper.RemoveAccessControlEntries(suite.network.GetContext(), &types.MsgRemoveAccessControlEntries{
+					Authority: authority,
+					Entries:   []types.AccessControlEntry{entry},
+				})
+				return err
+			},
+			expectedErr: types.ErrAccessControlEntryNotFound,
+		},
+		{
+			name: "fail - remove with invalid authority",
+			execMsg: func() error {
+				_, err := suite.network.App.EVMKeeper.RemoveAccessControlEntries(suite.network.GetContext(), &types.MsgRemoveAccessControlEntries{Authority: "foobar"})
+				return err
+			},
+			expectedErr: govtypes.ErrInvalidSigner,
+		},
+		{
+			name: "pass - add and remove entry",
+			execMsg: func() error {
+				ctx := suite.network.GetContext()
+				_, err := suite.network.App.EVMKeeper.AddAccessControlEntries(ctx, &types.MsgAddAccessControlEntries{
+					Authority: authority,
+					Entries:   []types.AccessControlEntry{entry},
+				})
+				if err != nil {
+					return err
+				}
+				_, err = suite.network.App.EVMKeeper.RemoveAccessControlEntries(ctx, &types.MsgRemoveAccessControlEntries{
+					Authority: authority,
+					Entries:   []types.AccessControlEntry{entry},
+				})
+				return err
+			},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.execMsg()
+			if tc.expectedErr != nil {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), tc.expectedErr.Error())
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+
+		err := suite.network.NextBlock()
+		suite.Require().NoError(err)
+	}
+}
+
+func (suite *KeeperTestSuite) TestFreezeUnfreezeContract() {
+	suite.SetupTest()
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	contract := utiltx.GenerateAddress().Hex()
+
+	setEmergencyAuthority := func(ctx sdktypes.Context) error {
+		params := suite.network.App.EVMKeeper.GetParams(ctx)
+		params.EmergencyAuthority = suite.keyring.GetAccAddr(0).String()
+		return suite.network.App.EVMKeeper.SetParams(ctx, params)
+	}
+
+	testCases := []struct {
+		name        string
+		execMsg     func() error
+		expectedErr error
+	}{
+		{
+			name: "fail - freeze with invalid authority",
+			execMsg: func() error {
+				_, err := suite.network.App.EVMKeeper.FreezeContract(suite.network.GetContext(), &types.MsgFreezeContract{Authority: "foobar", Contract: contract})
+				return err
+			},
+			expectedErr: govtypes.ErrInvalidSigner,
+		},
+		{
+			name: "fail - unfreeze with invalid authority",
+			execMsg: func() error {
+				_, err := suite.network.App.EVMKeeper.UnfreezeContract(suite.network.GetContext(), &types.MsgUnfreezeContract{Authority: "foobar", Contract: contract})
+				return err
+			},
+			expectedErr: govtypes.ErrInvalidSigner,
+		},
+		{
+			name: "fail - unfreeze contract not frozen",
+			execMsg: func() error {
+				_, err := suite.network.App.EVMKeeper.UnfreezeContract(suite.network.GetContext(), &types.MsgUnfreezeContract{Authority: authority, Contract: contract})
+				return err
+			},
+			expectedErr: types.ErrContractNotFrozen,
+		},
+		{
+			name: "fail - freeze contract twice",
+			execMsg: func() error {
+				ctx := suite.network.GetContext()
+				msg := &types.MsgFreezeContract{Authority: authority, Contract: contract}
+				if _, err := suite.network.App.EVMKeeper.FreezeContract(ctx, msg); err != nil {
+					return err
+				}
+				_, err := suite.network.App.EVMKeeper.FreezeContract(ctx, msg)
+				return err
+			},
+			expectedErr: types.ErrContractFrozen,
+		},
+		{
+			name: "pass - freeze and unfreeze contract",
+			execMsg: func() error {
+				ctx := suite.network.GetContext()
+				_, err := suite.network.App.EVMKeeper.FreezeContract(ctx, &types.MsgFreezeContract{Authority: authority, Contract: contract})
+				if err != nil {
+					return err
+				}
+				_, err = suite.network.App.EVMKeeper.UnfreezeContract(ctx, &types.MsgUnfreezeContract{Authority: authority, Contract: contract})
+				return err
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "fail - freeze with an account other than the emergency authority",
+			execMsg: func() error {
+				ctx := suite.network.GetContext()
+				if err := setEmergencyAuthority(ctx); err != nil {
+					return err
+				}
+				_, err := suite.network.App.EVMKeeper.FreezeContract(ctx, &types.MsgFreezeContract{Authority: suite.keyring.GetAccAddr(1).String(), Contract: utiltx.GenerateAddress().Hex()})
+				return err
+			},
+			expectedErr: govtypes.ErrInvalidSigner,
+		},
+		{
+			name: "pass - freeze and unfreeze contract with the emergency authority",
+			execMsg: func() error {
+				ctx := suite.network.GetContext()
+				if err := setEmergencyAuthority(ctx); err != nil {
+					return err
+				}
+				emergencyAuthority := suite.keyring.GetAccAddr(0).String()
+				frozen := utiltx.GenerateAddress().Hex()
+				_, err := suite.network.App.EVMKeeper.FreezeContract(ctx, &types.MsgFreezeContract{Authority: emergencyAuthority, Contract: frozen})
+				if err != nil {
+					return err
+				}
+				_, err = suite.network.App.EVMKeeper.UnfreezeContract(ctx, &types.MsgUnfreezeContract{Authority: emergencyAuthority, Contract: frozen})
+				return err
+			},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.execMsg()
+			if tc.expectedErr != nil {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), tc.expectedErr.Error())
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+
+		err := suite.network.NextBlock()
+		suite.Require().NoError(err)
+	}
+}
+
+func (suite *KeeperTestSuite) TestCallContract() {
+	suite.SetupTest()
+	sender := suite.keyring.GetAccAddr(0)
+
+	testCases := []struct {
+		name        string
+		getMsg      func() *types.MsgCallEVM
+		expectedErr error
+	}{
+		{
+			"fail - invalid sender",
+			func() *types.MsgCallEVM {
+				return &types.MsgCallEVM{Sender: "foobar", To: utiltx.GenerateAddress().Hex(), GasLimit: 100_000}
+			},
+			errors.New("invalid sender address"),
+		},
+		{
+			"fail - contract reverts",
+			func() *types.MsgCallEVM {
+				contract := suite.setCallbackContract(suite.network.GetContext(), revertCode)
+				return &types.MsgCallEVM{Sender: sender.String(), To: contract.Hex(), GasLimit: 100_000}
+			},
+			types.ErrVMExecution,
+		},
+		{
+			"fail - insufficient gas",
+			func() *types.MsgCallEVM {
+				contract := suite.setCallbackContract(suite.network.GetContext(), stopCode)
+				return &types.MsgCallEVM{Sender: sender.String(), To: contract.Hex(), GasLimit: 10}
+			},
+			core.ErrIntrinsicGas,
+		},
+		{
+			"pass - call contract",
+			func() *types.MsgCallEVM {
+				contract := suite.setCallbackContract(suite.network.GetContext(), stopCode)
+				return &types.MsgCallEVM{Sender: sender.String(), To: contract.Hex(), Data: []byte{0x01}, GasLimit: 100_000}
+			},
+			nil,
+		},
+		{
+			"pass - transfer value",
+			func() *types.MsgCallEVM {
+				return &types.MsgCallEVM{
+					Sender:   sender.String(),
+					To:       suite.keyring.GetAddr(1).Hex(),
+					Value:    sdkmath.NewInt(1e18),
+					GasLimit: 100_000,
+				}
+			},
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			msg := tc.getMsg()
+			res, err := suite.network.App.EVMKeeper.CallContract(suite.network.GetContext(), msg)
+			if tc.expectedErr != nil {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expectedErr.Error())
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().NotZero(res.GasUsed)
+			}
+		})
+
+		err := suite.network.NextBlock()
+		suite.Require().NoError(err)
+	}
+}
+
+func (suite *KeeperTestSuite) TestCallContractGas() {
+	suite.SetupTest()
+	sender := suite.keyring.GetAccAddr(0)
+
+	ctx := suite.network.GetContext()
+	contract := suite.setCallbackContract(ctx, stopCode)
+
+	// only the gas used by the EVM is charged to the transaction
+	ctx = ctx.WithGasMeter(storetypes.NewGasMeter(1_000_000))
+	res, err := suite.network.App.EVMKeeper.CallContract(ctx, &types.MsgCallEVM{
+		Sender:   sender.String(),
+		To:       contract.Hex(),
+		GasLimit: 100_000,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(res.GasUsed, ctx.GasMeter().GasConsumed())
+
+	ctx = ctx.WithGasMeter(storetypes.NewGasMeter(1_000_000))
+	deployRes, err := suite.network.App.EVMKeeper.DeployContract(ctx, &types.MsgDeployContract{
+		Sender:   sender.String(),
+		Data:     stopCode,
+		GasLimit: 100_000,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(deployRes.GasUsed, ctx.GasMeter().GasConsumed())
+}
+
+func (suite *KeeperTestSuite) TestCallContractGasLimit() {
+	suite.SetupTest()
+	sender := suite.keyring.GetAccAddr(0)
+
+	ctx := suite.network.GetContext()
+	// the contract loops until it runs out of gas
+	contract := suite.setCallbackContract(ctx, []byte{0x5b, 0x60, 0x00, 0x56})
+
+	// the gas limit is capped to the gas remaining in the transaction
+	ctx = ctx.WithGasMeter(storetypes.NewGasMeter(50_000))
+	suite.Require().NotPanics(func() {
+		_, err := suite.network.App.EVMKeeper.CallContract(ctx, &types.MsgCallEVM{
+			Sender:   sender.String(),
+			To:       contract.Hex(),
+			GasLimit: 1_000_000,
+		})
+		suite.Require().ErrorContains(err, "out of gas")
+	})
+	suite.Require().Equal(uint64(50_000), ctx.GasMeter().GasConsumed())
+
+	// the gas limit can't exceed the block gas limit
+	ctx = ctx.
+		WithGasMeter(storetypes.NewGasMeter(1_000_000)).
+		WithBlockGasMeter(storetypes.NewGasMeter(100_000))
+	_, err := suite.network.App.EVMKeeper.CallContract(ctx, &types.MsgCallEVM{
+		Sender:   sender.String(),
+		To:       contract.Hex(),
+		GasLimit: 200_000,
+	})
+	suite.Require().ErrorIs(err, types.ErrInvalidGasLimit)
+	suite.Require().Zero(ctx.GasMeter().GasConsumed())
+}
+
+func (suite *KeeperTestSuite) TestCallContractSyntheticTx() {
+	suite.SetupTest()
+	sender := suite.keyring.GetAccAddr(0)
+	from := suite.keyring.GetAddr(0)
+
+	ctx := suite.network.GetContext()
+	contract := suite.setCallbackContract(ctx, stopCode)
+	txIndex := suite.network.App.EVMKeeper.GetTxIndexTransient(ctx)
+
+	ctx = ctx.WithEventManager(sdktypes.NewEventManager())
+	res, err := suite.network.App.EVMKeeper.CallContract(ctx, &types.MsgCallEVM{
+		Sender:   sender.String(),
+		To:       contract.Hex(),
+		GasLimit: 100_000,
+	})
+	suite.Require().NoError(err)
+
+	// the call takes the next tx index of the block
+	suite.Require().Equal(txIndex+1, suite.network.App.EVMKeeper.GetTxIndexTransient(ctx))
+
+	var attrs map[string]string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeEthereumTx {
+			continue
+		}
+		attrs = make(map[string]string)
+		for _, attr := range event.Attributes {
+			attrs[attr.Key] = attr.Value
+		}
+	}
+	suite.Require().NotNil(attrs, "ethereum_tx event not emitted")
+	suite.Require().Equal(res.Hash, attrs[types.AttributeKeyEthereumTxHash])
+	suite.Require().Equal(strconv.FormatUint(txIndex, 10), attrs[types.AttributeKeyTxIndex])
+	suite.Require().Equal(from.Hex(), attrs[sdktypes.AttributeKeySender])
+
+	txBz, err := hexutil.Decode(attrs[types.AttributeKeySyntheticTx])
+	suite.Require().NoError(err)
+	tx := new(ethtypes.Transaction)
+	suite.Require().NoError(tx.UnmarshalBinary(txBz))
+	suite.Require().True(types.IsSyntheticTx(tx))
+	suite.Require().Equal(res.Hash, tx.Hash().Hex())
+	suite.Require().Equal(&contract, tx.To())
+}
+
+func (suite *KeeperTestSuite) TestCallContractWithAuthorization() {
+	suite.SetupTest()
+	granter := suite.keyring.GetAccAddr(0)
+	grantee := suite.keyring.GetAccAddr(1)
+
+	ctx := suite.network.GetContext()
+	contract := suite.setCallbackContract(ctx, stopCode)
+	otherContract := suite.setCallbackContract(ctx, stopCode)
+
+	authorization := types.NewContractCallAuthorization(
+		[]types.AllowedContractCall{{Contract: contract.Hex()}},
+		sdkmath.NewInt(100),
+	)
+	expiration := ctx.BlockTime().Add(time.Hour)
+	err := suite.network.App.AuthzKeeper.SaveGrant(ctx, grantee, granter, authorization, &expiration)
+	suite.Require().NoError(err)
+
+	callMsg := func(to common.Address, value int64) []sdktypes.Msg {
+		return []sdktypes.Msg{&types.MsgCallEVM{
+			Sender:   granter.String(),
+			To:       to.Hex(),
+			Value:    sdkmath.NewInt(value),
+			GasLimit: 100_000,
+		}}
+	}
+
+	// the grantee calls the allowed contract on behalf of the granter
+	_, err = suite.network.App.AuthzKeeper.DispatchActions(ctx, grantee, callMsg(contract, 60))
+	suite.Require().NoError(err)
+	suite.Require().Equal(big.NewInt(60), suite.network.App.EVMKeeper.GetBalance(ctx, contract))
+
+	updated, _ := suite.network.App.AuthzKeeper.GetAuthorization(ctx, grantee, granter, authorization.MsgTypeURL())
+	suite.Require().Equal(sdkmath.NewInt(40), updated.(*types.ContractCallAuthorization).SpendLimit)
+
+	// the calls exceeding the spend limit or to other contracts are rejected
+	_, err = suite.network.App.AuthzKeeper.DispatchActions(ctx, grantee, callMsg(contract, 50))
+	suite.Require().ErrorContains(err, "spend limit")
+	_, err = suite.network.App.AuthzKeeper.DispatchActions(ctx, grantee, callMsg(otherContract, 0))
+	suite.Require().ErrorContains(err, "cannot call contract")
+
+	// the grant can't be used once expired
+	ctx = ctx.WithBlockTime(expiration.Add(time.Second))
+	_, err = suite.network.App.AuthzKeeper.DispatchActions(ctx, grantee, callMsg(contract, 0))
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestDeployContract() {
+	suite.SetupTest()
+	sender := suite.keyring.GetAccAddr(0)
+	from := suite.keyring.GetAddr(0)
+
+	ctx := suite.network.GetContext()
+	nonce := suite.network.App.EVMKeeper.GetNonce(ctx, from)
+
+	res, err := suite.network.App.EVMKeeper.DeployContract(ctx, &types.MsgDeployContract{
+		Sender:   sender.String(),
+		Data:     stopInitCode,
+		GasLimit: 100_000,
+	})
+	suite.Require().NoError(err)
+
+	contract := common.HexToAddress(res.ContractAddress)
+	suite.Require().Equal(crypto.CreateAddress(from, nonce), contract)
+	suite.Require().True(suite.network.App.EVMKeeper.IsContract(ctx, contract))
+	suite.Require().Equal(nonce+1, suite.network.App.EVMKeeper.GetNonce(ctx, from))
+
+	// the contract creation fails if the init code reverts
+	_, err = suite.network.App.EVMKeeper.DeployContract(ctx, &types.MsgDeployContract{
+		Sender:   sender.String(),
+		Data:     revertCode,
+		GasLimit: 100_000,
+	})
+	suite.Require().ErrorContains(err, types.ErrVMExecution.Error())
+}
+
+func (suite *KeeperTestSuite) TestDeployContractSequence() {
+	suite.SetupTest()
+	priv := suite.keyring.GetPrivKey(0)
+	sender := suite.keyring.GetAccAddr(0)
+	from := suite.keyring.GetAddr(0)
+
+	deployMsg := &types.MsgDeployContract{
+		Sender:   sender.String(),
+		Data:     stopInitCode,
+		GasLimit: 100_000,
+	}
+
+	// the transactions are delivered through the ante handler, which increments
+	// the sequence, and each one is signed with the sequence left by the previous one
+	for i := 0; i < 2; i++ {
+		sequence, err := suite.network.App.AccountKeeper.GetSequence(suite.network.GetContext(), sender)
+		suite.Require().NoError(err)
+
+		res, err := suite.factory.CommitCosmosTx(priv, commonfactory.CosmosTxArgs{Msgs: []sdktypes.Msg{deployMsg}})
+		suite.Require().NoError(err)
+		suite.Require().True(res.IsOK(), "tx failed: %s", res.Log)
+
+		// the contract is created with the sequence the tx was signed with, which
+		// is incremented only once
+		ctx := suite.network.GetContext()
+		suite.Require().True(suite.network.App.EVMKeeper.IsContract(ctx, crypto.CreateAddress(from, sequence)))
+		newSequence, err := suite.network.App.AccountKeeper.GetSequence(ctx, sender)
+		suite.Require().NoError(err)
+		suite.Require().Equal(sequence+1, newSequence)
+	}
+
+	// a second contract creation of the signer in the same tx is rejected
+	gas := uint64(1_000_000)
+	res, err := suite.factory.CommitCosmosTx(priv, commonfactory.CosmosTxArgs{
+		Msgs: []sdktypes.Msg{deployMsg, deployMsg},
+		Gas:  &gas,
+	})
+	suite.Require().NoError(err)
+	suite.Require().False(res.IsOK())
+	suite.Require().Contains(res.Log, "already used by a contract creation")
+}
+
+func (suite *KeeperTestSuite) TestDeployContractSequenceSameBlock() {
+	suite.SetupTest()
+	priv := suite.keyring.GetPrivKey(0)
+	sender := suite.keyring.GetAccAddr(0)
+	from := suite.keyring.GetAddr(0)
+	granteePriv := suite.keyring.GetPrivKey(1)
+	grantee := suite.keyring.GetAccAddr(1)
+
+	deployMsg := &types.MsgDeployContract{
+		Sender:   sender.String(),
+		Data:     stopInitCode,
+		GasLimit: 100_000,
+	}
+
+	// the grantee can deploy contracts on behalf of the sender without its signature
+	grantMsg, err := authz.NewMsgGrant(sender, grantee, authz.NewGenericAuthorization(sdktypes.MsgTypeURL(deployMsg)), nil)
+	suite.Require().NoError(err)
+	res, err := suite.factory.CommitCosmosTx(priv, commonfactory.CosmosTxArgs{Msgs: []sdktypes.Msg{grantMsg}})
+	suite.Require().NoError(err)
+	suite.Require().True(res.IsOK(), "tx failed: %s", res.Log)
+
+	sequence, err := suite.network.App.AccountKeeper.GetSequence(suite.network.GetContext(), sender)
+	suite.Require().NoError(err)
+
+	// the signer nonce recorded for the first tx must not be found by the second
+	// one, which the sender doesn't sign
+	gas := uint64(1_000_000)
+	encodeTx := func(priv cryptotypes.PrivKey, msg sdktypes.Msg) []byte {
+		tx, err := suite.factory.BuildCosmosTx(priv, commonfactory.CosmosTxArgs{Msgs: []sdktypes.Msg{msg}, Gas: &gas})
+		suite.Require().NoError(err)
+		bz, err := suite.factory.EncodeTx(tx)
+		suite.Require().NoError(err)
+		return bz
+	}
+	execMsg := authz.NewMsgExec(grantee, []sdktypes.Msg{deployMsg})
+
+	blockRes, err := suite.network.NextBlockWithTxs(encodeTx(priv, deployMsg), encodeTx(granteePriv, &execMsg))
+	suite.Require().NoError(err)
+	suite.Require().Len(blockRes.TxResults, 2)
+	for _, txRes := range blockRes.TxResults {
+		suite.Require().True(txRes.IsOK(), "tx failed: %s", txRes.Log)
+	}
+
+	// the first contract takes the nonce of the tx and the second one the
+	// following nonce of the sender
+	ctx := suite.network.GetContext()
+	suite.Require().True(suite.network.App.EVMKeeper.IsContract(ctx, crypto.CreateAddress(from, sequence)))
+	suite.Require().True(suite.network.App.EVMKeeper.IsContract(ctx, crypto.CreateAddress(from, sequence+1)))
+}
+
+func (suite *KeeperTestSuite) TestCallContractFromInterchainAccount() {
+	suite.SetupTest()
+	ctx := suite.network.GetContext()
+	denom := types.GetEVMCoinDenom()
+
+	ica := icatypes.GenerateAddress(ctx, "connection-0", icatypes.ControllerPortPrefix+"owner")
+	suite.Require().Len(ica, 32)
+
+	// the interchain account isn't mapped to its truncated address
+	from := types.CosmosSenderEVMAddress(ica)
+	suite.Require().NotEqual(common.BytesToAddress(ica), from)
+
+	funds := suite.network.App.BankKeeper.GetBalance(ctx, suite.keyring.GetAccAddr(0), denom)
+	funds.Amount = funds.Amount.QuoRaw(2)
+	err := suite.network.App.BankKeeper.SendCoins(ctx, suite.keyring.GetAccAddr(0), ica, sdktypes.NewCoins(funds))
+	suite.Require().NoError(err)
+	icaBalance := func() *big.Int {
+		return types.MustConvertEvmCoinTo18Decimals(suite.network.App.BankKeeper.GetBalance(ctx, ica, denom)).Amount.BigInt()
+	}
+	initialBalance := icaBalance()
+
+	// the value is paid from the interchain account balance
+	value := big.NewInt(1e18)
+	recipient := utiltx.GenerateAddress()
+	_, err = suite.network.App.EVMKeeper.CallContract(ctx, &types.MsgCallEVM{
+		Sender:   ica.String(),
+		To:       recipient.Hex(),
+		Value:    sdkmath.NewIntFromBigInt(value),
+		GasLimit: 100_000,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(value, suite.network.App.EVMKeeper.GetBalance(ctx, recipient))
+	suite.Require().Equal(new(big.Int).Sub(initialBalance, value), icaBalance())
+	suite.Require().Zero(suite.network.App.EVMKeeper.GetBalance(ctx, from).Sign())
+
+	// the funds received by the derived address are swept to the interchain account
+	received := sdktypes.NewCoins(sdktypes.NewCoin(denom, funds.Amount.QuoRaw(2)))
+	err = suite.network.App.BankKeeper.SendCoins(ctx, suite.keyring.GetAccAddr(0), from.Bytes(), received)
+	suite.Require().NoError(err)
+	receivedBalance := suite.network.App.EVMKeeper.GetBalance(ctx, from)
+	_, err = suite.network.App.EVMKeeper.CallContract(ctx, &types.MsgCallEVM{
+		Sender:   ica.String(),
+		To:       suite.setCallbackContract(ctx, stopCode).Hex(),
+		GasLimit: 100_000,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Zero(suite.network.App.EVMKeeper.GetBalance(ctx, from).Sign())
+	suite.Require().Equal(new(big.Int).Add(new(big.Int).Sub(initialBalance, value), receivedBalance), icaBalance())
+
+	// the contracts are deployed from the derived address
+	res, err := suite.network.App.EVMKeeper.DeployContract(ctx, &types.MsgDeployContract{
+		Sender:   ica.String(),
+		Data:     stopInitCode,
+		GasLimit: 100_000,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(crypto.CreateAddress(from, 0).Hex(), res.ContractAddress)
+	suite.Require().Equal(uint64(1), suite.network.App.EVMKeeper.GetNonce(ctx, from))
+}
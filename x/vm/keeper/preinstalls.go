@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AddPreinstalls deploys the given preinstalled contracts at their fixed addresses, creating
+// the corresponding accounts. A contract with the code hash of the preinstall at its address
+// is the canonical deployment, e.g. one registered by an earlier proposal, and is kept as is.
+// It returns an error if any of the addresses is a precompile or is already used by another
+// account or contract.
+func (k *Keeper) AddPreinstalls(ctx sdk.Context, preinstalls []types.Preinstall) error {
+	for _, preinstall := range preinstalls {
+		if err := preinstall.Validate(); err != nil {
+			return errorsmod.Wrap(types.ErrInvalidPreinstall, err.Error())
+		}
+
+		address := common.HexToAddress(preinstall.Address)
+		isPrecompile, err := k.isPrecompileAddress(ctx, address)
+		if err != nil {
+			return err
+		}
+		if isPrecompile {
+			return errorsmod.Wrapf(types.ErrInvalidPreinstall, "preinstall %s collides with a precompile", address)
+		}
+
+		// the code at the address is checked before the account, as the canonical
+		// deployment has an account too
+		codeHash := preinstall.CodeHash()
+		switch existingCodeHash := k.GetCodeHash(ctx, address); {
+		case existingCodeHash == codeHash:
+			continue
+		case !types.IsEmptyCodeHash(existingCodeHash.Bytes()):
+			return errorsmod.Wrapf(
+				types.ErrInvalidPreinstall,
+				"preinstall %s collides with an existing contract with code hash %s", address, existingCodeHash,
+			)
+		}
+
+		accAddress := sdk.AccAddress(address.Bytes())
+		if k.accountKeeper.GetAccount(ctx, accAddress) != nil {
+			return errorsmod.Wrapf(types.ErrInvalidPreinstall, "preinstall %s collides with an existing account", address)
+		}
+
+		code := common.FromHex(preinstall.Code)
+
+		account := k.accountKeeper.NewAccountWithAddress(ctx, accAddress)
+		k.accountKeeper.SetAccount(ctx, account)
+		k.SetCodeHash(ctx, address.Bytes(), codeHash.Bytes())
+		k.SetCode(ctx, codeHash.Bytes(), code)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRegisterPreinstall,
+				sdk.NewAttribute(types.AttributeKeyPreinstallName, preinstall.Name),
+				sdk.NewAttribute(types.AttributeKeyContractAddress, address.Hex()),
+				sdk.NewAttribute(types.AttributeKeyCodeHash, codeHash.Hex()),
+			),
+		)
+	}
+
+	return nil
+}
+
+// isPrecompileAddress returns true if the address is the one of an Ethereum precompile,
+// an available static precompile (active or not) or an ERC-20 precompile.
+func (k *Keeper) isPrecompileAddress(ctx sdk.Context, address common.Address) (bool, error) {
+	if _, found := k.precompiles[address]; found || slices.Contains(vm.PrecompiledAddressesBerlin, address) {
+		return true, nil
+	}
+
+	params := k.GetParams(ctx)
+	if slices.Contains(params.ActiveStaticPrecompiles, address.Hex()) {
+		return true, nil
+	}
+
+	_, found, err := k.erc20Keeper.GetERC20PrecompileInstance(ctx, address)
+	return found, err
+}
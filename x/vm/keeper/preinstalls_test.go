@@ -0,0 +1,169 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	testconstants "github.com/cosmos/evm/testutil/constants"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	"github.com/cosmos/evm/x/vm/types"
+)
+
+func (suite *KeeperTestSuite) TestAddPreinstalls() {
+	code := "0x6080604052"
+	newAddress := utiltx.GenerateAddress()
+
+	testCases := []struct {
+		name        string
+		preinstalls func() []types.Preinstall
+		expErr      bool
+		errContains string
+	}{
+		{
+			"pass - new contract",
+			func() []types.Preinstall {
+				return []types.Preinstall{{Name: "Test", Address: newAddress.Hex(), Code: code}}
+			},
+			false,
+			"",
+		},
+		{
+			"fail - invalid preinstall",
+			func() []types.Preinstall {
+				return []types.Preinstall{{Name: "Test", Address: newAddress.Hex(), Code: "0x"}}
+			},
+			true,
+			"code for",
+		},
+		{
+			"fail - existing account",
+			func() []types.Preinstall {
+				return []types.Preinstall{{Name: "Test", Address: suite.keyring.GetAddr(0).Hex(), Code: code}}
+			},
+			true,
+			"collides with an existing account",
+		},
+		{
+			"fail - static precompile",
+			func() []types.Preinstall {
+				return []types.Preinstall{{Name: "Test", Address: types.StakingPrecompileAddress, Code: code}}
+			},
+			true,
+			"precompile",
+		},
+		{
+			"fail - erc20 precompile",
+			func() []types.Preinstall {
+				return []types.Preinstall{{Name: "Test", Address: testconstants.WEVMOSContractMainnet, Code: code}}
+			},
+			true,
+			"collides with a precompile",
+		},
+		{
+			"pass - default preinstall already deployed",
+			func() []types.Preinstall {
+				return types.DefaultPreinstalls[:1]
+			},
+			false,
+			"",
+		},
+		{
+			"fail - another contract at the preinstall address",
+			func() []types.Preinstall {
+				return []types.Preinstall{{Name: "Test", Address: types.DefaultPreinstalls[0].Address, Code: code}}
+			},
+			true,
+			"collides with an existing contract with code hash",
+		},
+		{
+			"fail - funded account at the preinstall address",
+			func() []types.Preinstall {
+				coins := sdk.NewCoins(sdk.NewInt64Coin(suite.network.GetBaseDenom(), 1))
+				err := suite.network.App.BankKeeper.SendCoins(suite.network.GetContext(), suite.keyring.GetAccAddr(0), newAddress.Bytes(), coins)
+				suite.Require().NoError(err)
+				return []types.Preinstall{{Name: "Test", Address: newAddress.Hex(), Code: code}}
+			},
+			true,
+			"collides with an existing account",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx := suite.network.GetContext()
+			k := suite.network.App.EVMKeeper
+
+			preinstalls := tc.preinstalls()
+			err := k.AddPreinstalls(ctx, preinstalls)
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().ErrorIs(err, types.ErrInvalidPreinstall)
+				suite.Require().Contains(err.Error(), tc.errContains)
+				return
+			}
+
+			suite.Require().NoError(err)
+			for _, preinstall := range preinstalls {
+				address := common.HexToAddress(preinstall.Address)
+				expCode := common.FromHex(preinstall.Code)
+
+				acc := suite.network.App.AccountKeeper.GetAccount(ctx, address.Bytes())
+				suite.Require().NotNil(acc)
+
+				codeHash := k.GetCodeHash(ctx, address)
+				suite.Require().Equal(crypto.Keccak256Hash(expCode), codeHash)
+				suite.Require().Equal(expCode, k.GetCode(ctx, codeHash))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestDefaultPreinstalls() {
+	suite.SetupTest()
+	ctx := suite.network.GetContext()
+	k := suite.network.App.EVMKeeper
+
+	for _, preinstall := range types.DefaultPreinstalls {
+		address := common.HexToAddress(preinstall.Address)
+		suite.Require().Equal(common.FromHex(preinstall.Code), k.GetCode(ctx, k.GetCodeHash(ctx, address)), preinstall.Name)
+	}
+
+	// deploy a contract through the CREATE2 deployer, with init code returning the 0x01 runtime code
+	deployer := common.HexToAddress(types.DefaultPreinstalls[0].Address)
+	salt := common.BigToHash(common.Big1)
+	initCode := common.FromHex("0x600160005360016000f3")
+
+	res, err := k.CallEVMWithData(ctx, suite.keyring.GetAddr(0), &deployer, append(salt.Bytes(), initCode...), true)
+	suite.Require().NoError(err)
+	suite.Require().False(res.Failed(), res.VmError)
+
+	expAddress := crypto.CreateAddress2(deployer, salt, crypto.Keccak256(initCode))
+	suite.Require().Equal(expAddress, common.BytesToAddress(res.Ret))
+	suite.Require().Equal([]byte{0x01}, k.GetCode(ctx, k.GetCodeHash(ctx, expAddress)))
+
+	// Multicall3 returns the current block number
+	multicall3 := common.HexToAddress(types.DefaultPreinstalls[1].Address)
+	res, err = k.CallEVMWithData(ctx, suite.keyring.GetAddr(0), &multicall3, crypto.Keccak256([]byte("getBlockNumber()"))[:4], false)
+	suite.Require().NoError(err)
+	suite.Require().False(res.Failed(), res.VmError)
+	suite.Require().Equal(common.BigToHash(big.NewInt(ctx.BlockHeight())).Bytes(), res.Ret)
+
+	// Permit2 computes its EIP-712 domain separator for the chain ID
+	permit2 := common.HexToAddress(types.DefaultPreinstalls[2].Address)
+	res, err = k.CallEVMWithData(ctx, suite.keyring.GetAddr(0), &permit2, crypto.Keccak256([]byte("DOMAIN_SEPARATOR()"))[:4], false)
+	suite.Require().NoError(err)
+	suite.Require().False(res.Failed(), res.VmError)
+
+	expDomainSeparator := crypto.Keccak256(
+		crypto.Keccak256([]byte("EIP712Domain(string name,uint256 chainId,address verifyingContract)")),
+		crypto.Keccak256([]byte("Permit2")),
+		common.BigToHash(types.GetEthChainConfig().ChainID).Bytes(),
+		common.BytesToHash(permit2.Bytes()).Bytes(),
+	)
+	suite.Require().Equal(expDomainSeparator, res.Ret)
+}
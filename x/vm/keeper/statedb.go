@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"errors"
 	"math/big"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/cosmos/evm/x/vm/statedb"
 	"github.com/cosmos/evm/x/vm/types"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 
@@ -34,9 +36,7 @@ func (k *Keeper) GetAccount(ctx sdk.Context, addr common.Address) *statedb.Accou
 
 // GetState loads contract state from database.
 func (k *Keeper) GetState(ctx sdk.Context, addr common.Address, key common.Hash) common.Hash {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressStoragePrefix(addr))
-
-	value := store.Get(key.Bytes())
+	value := k.getStateFromStore(ctx, addr, key)
 	if len(value) == 0 {
 		return common.Hash{}
 	}
@@ -46,9 +46,7 @@ func (k *Keeper) GetState(ctx sdk.Context, addr common.Address, key common.Hash)
 
 // GetFastState loads contract state from database.
 func (k *Keeper) GetFastState(ctx sdk.Context, addr common.Address, key common.Hash) []byte {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressStoragePrefix(addr))
-
-	return store.Get(key.Bytes())
+	return k.getStateFromStore(ctx, addr, key)
 }
 
 // GetCodeHash loads the code hash from the database for the given contract address.
@@ -115,6 +113,11 @@ func (k *Keeper) SetBalance(ctx sdk.Context, addr common.Address, amount *big.In
 	delta := new(big.Int).Sub(amount, coin.Amount.BigInt())
 	switch delta.Sign() {
 	case 1:
+		// the module account can't receive funds from the EVM, which the call hooks
+		// don't catch for the beneficiaries of SELFDESTRUCT
+		if cosmosAddr.Equals(k.accountKeeper.GetModuleAddress(types.ModuleName)) {
+			return errorsmod.Wrapf(types.ErrModuleAccountRecipient, "cannot credit %s", addr)
+		}
 		// mint
 		if err := k.bankWrapper.MintAmountToAccount(ctx, cosmosAddr, delta); err != nil {
 			return err
@@ -167,6 +170,7 @@ func (k *Keeper) SetAccount(ctx sdk.Context, addr common.Address, account stated
 func (k *Keeper) SetState(ctx sdk.Context, addr common.Address, key common.Hash, value []byte) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressStoragePrefix(addr))
 	store.Set(key.Bytes(), value)
+	k.markFlatStateDirty(ctx, addr, key)
 
 	k.Logger(ctx).Debug(
 		"state updated",
@@ -180,6 +184,7 @@ func (k *Keeper) SetState(ctx sdk.Context, addr common.Address, key common.Hash,
 func (k *Keeper) DeleteState(ctx sdk.Context, addr common.Address, key common.Hash) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressStoragePrefix(addr))
 	store.Delete(key.Bytes())
+	k.markFlatStateDirty(ctx, addr, key)
 
 	k.Logger(ctx).Debug(
 		"state deleted",
@@ -188,10 +193,21 @@ func (k *Keeper) DeleteState(ctx sdk.Context, addr common.Address, key common.Ha
 	)
 }
 
-// SetCodeHash sets the code hash for the given contract address.
+// SetCodeHash sets the code hash for the given contract address. The reference
+// count of the new code hash is incremented and the one of the previous code
+// hash (if any) is decremented, pruning its code once it is no longer used.
 func (k *Keeper) SetCodeHash(ctx sdk.Context, addrBytes, hashBytes []byte) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCodeHash)
+	prevHash := store.Get(addrBytes)
+	if bytes.Equal(prevHash, hashBytes) {
+		return
+	}
+
 	store.Set(addrBytes, hashBytes)
+	k.incrementCodeRefCount(ctx, hashBytes)
+	if len(prevHash) != 0 {
+		k.decrementCodeRefCount(ctx, prevHash)
+	}
 
 	k.Logger(ctx).Debug(
 		"code hash updated",
@@ -200,10 +216,17 @@ func (k *Keeper) SetCodeHash(ctx sdk.Context, addrBytes, hashBytes []byte) {
 	)
 }
 
-// DeleteCodeHash deletes the code hash for the given contract address from the store.
+// DeleteCodeHash deletes the code hash for the given contract address from the store
+// and releases its reference to the code.
 func (k *Keeper) DeleteCodeHash(ctx sdk.Context, addr common.Address) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCodeHash)
+	prevHash := store.Get(addr.Bytes())
+	if len(prevHash) == 0 {
+		return
+	}
+
 	store.Delete(addr.Bytes())
+	k.decrementCodeRefCount(ctx, prevHash)
 
 	k.Logger(ctx).Debug(
 		"code hash deleted",
@@ -211,6 +234,48 @@ func (k *Keeper) DeleteCodeHash(ctx sdk.Context, addr common.Address) {
 	)
 }
 
+// GetCodeRefCount returns the number of accounts that reference the given code hash.
+func (k Keeper) GetCodeRefCount(ctx sdk.Context, codeHash common.Hash) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCodeRefCount)
+	bz := store.Get(codeHash.Bytes())
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setCodeRefCount sets the reference count for the given code hash. A zero count
+// removes the entry from the store.
+func (k Keeper) setCodeRefCount(ctx sdk.Context, codeHash []byte, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCodeRefCount)
+	if count == 0 {
+		store.Delete(codeHash)
+		return
+	}
+
+	store.Set(codeHash, sdk.Uint64ToBigEndian(count))
+}
+
+// incrementCodeRefCount increases the reference count of the given code hash by one.
+func (k Keeper) incrementCodeRefCount(ctx sdk.Context, codeHash []byte) {
+	count := k.GetCodeRefCount(ctx, common.BytesToHash(codeHash))
+	k.setCodeRefCount(ctx, codeHash, count+1)
+}
+
+// decrementCodeRefCount decreases the reference count of the given code hash by one.
+// The code is deleted from the store once no account references it anymore.
+func (k *Keeper) decrementCodeRefCount(ctx sdk.Context, codeHash []byte) {
+	count := k.GetCodeRefCount(ctx, common.BytesToHash(codeHash))
+	if count > 1 {
+		k.setCodeRefCount(ctx, codeHash, count-1)
+		return
+	}
+
+	k.setCodeRefCount(ctx, codeHash, 0)
+	k.DeleteCode(ctx, codeHash)
+}
+
 // SetCode sets the given contract code bytes for the corresponding code hash bytes key
 // in the code store.
 func (k *Keeper) SetCode(ctx sdk.Context, codeHash, code []byte) {
@@ -237,9 +302,8 @@ func (k *Keeper) DeleteCode(ctx sdk.Context, codeHash []byte) {
 
 // DeleteAccount handles contract's suicide call:
 // - clear balance
-// - remove code
 // - remove states
-// - remove the code hash
+// - remove the code hash (and the code if no other account references it)
 // - remove auth account
 func (k *Keeper) DeleteAccount(ctx sdk.Context, addr common.Address) error {
 	cosmosAddr := sdk.AccAddress(addr.Bytes())
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"fmt"
 	"math/big"
 	"testing"
 
@@ -10,6 +11,9 @@ import (
 	"github.com/stretchr/testify/require"
 
 	utiltx "github.com/cosmos/evm/testutil/tx"
+	"github.com/cosmos/evm/x/vm/flatstate"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func BenchmarkCreateAccountNew(b *testing.B) {
@@ -190,3 +194,44 @@ func BenchmarkSuicide(b *testing.B) {
 		vmdb.Suicide(addr)
 	}
 }
+
+// newBenchmarkSuite returns a KeeperTestSuite set up to be used in benchmarks.
+func newBenchmarkSuite(b *testing.B) *KeeperTestSuite {
+	b.Helper()
+	suite := &KeeperTestSuite{enableLondonHF: true}
+	require.NoError(b, suite.setupNetwork())
+	return suite
+}
+
+func BenchmarkGetState(b *testing.B) {
+	for _, flatState := range []bool{false, true} {
+		b.Run(fmt.Sprintf("flat-state=%t", flatState), func(b *testing.B) {
+			suite := newBenchmarkSuite(b)
+			k := suite.network.App.EVMKeeper
+			addr := utiltx.GenerateAddress()
+
+			// write the storage slots on the committed state
+			keys := make([]common.Hash, 10_000)
+			ctx := suite.network.App.NewUncachedContext(false, suite.network.GetContext().BlockHeader())
+			for i := range keys {
+				keys[i] = common.BigToHash(big.NewInt(int64(i)))
+				k.SetState(ctx, addr, keys[i], crypto.Keccak256(keys[i].Bytes()))
+			}
+			require.NoError(b, suite.network.NextBlock())
+
+			ctx = suite.network.App.NewUncachedContext(false, suite.network.GetContext().BlockHeader()).
+				WithExecMode(sdk.ExecModeFinalize)
+			if flatState {
+				k.SetFlatState(flatstate.NewMemStore())
+				require.NoError(b, k.RebuildFlatState(ctx.WithBlockHeight(ctx.BlockHeight()-1)))
+			}
+
+			b.ResetTimer()
+			b.ReportAllocs()
+
+			for i := 0; i < b.N; i++ {
+				k.GetState(ctx, addr, keys[i%len(keys)])
+			}
+		})
+	}
+}
@@ -370,6 +370,69 @@ func (suite *KeeperTestSuite) TestKeeperSetOrDeleteCode() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestCodeRefCount() {
+	suite.SetupTest()
+	ctx := suite.network.GetContext()
+	keeper := suite.network.App.EVMKeeper
+
+	code := []byte("shared code")
+	codeHash := crypto.Keccak256Hash(code)
+	firstAddr := utiltx.GenerateAddress()
+	secondAddr := utiltx.GenerateAddress()
+
+	keeper.SetCode(ctx, codeHash.Bytes(), code)
+	keeper.SetCodeHash(ctx, firstAddr.Bytes(), codeHash.Bytes())
+	keeper.SetCodeHash(ctx, secondAddr.Bytes(), codeHash.Bytes())
+	suite.Require().Equal(uint64(2), keeper.GetCodeRefCount(ctx, codeHash))
+
+	// setting the same code hash again must not change the reference count
+	keeper.SetCodeHash(ctx, firstAddr.Bytes(), codeHash.Bytes())
+	suite.Require().Equal(uint64(2), keeper.GetCodeRefCount(ctx, codeHash))
+
+	// removing one reference keeps the code for the other account
+	keeper.DeleteCodeHash(ctx, firstAddr)
+	suite.Require().Equal(uint64(1), keeper.GetCodeRefCount(ctx, codeHash))
+	suite.Require().Equal(code, keeper.GetCode(ctx, codeHash))
+
+	// replacing the last reference prunes the code
+	otherCode := []byte("other code")
+	otherCodeHash := crypto.Keccak256Hash(otherCode)
+	keeper.SetCode(ctx, otherCodeHash.Bytes(), otherCode)
+	keeper.SetCodeHash(ctx, secondAddr.Bytes(), otherCodeHash.Bytes())
+	suite.Require().Equal(uint64(0), keeper.GetCodeRefCount(ctx, codeHash))
+	suite.Require().Nil(keeper.GetCode(ctx, codeHash))
+	suite.Require().Equal(uint64(1), keeper.GetCodeRefCount(ctx, otherCodeHash))
+	suite.Require().Equal(otherCode, keeper.GetCode(ctx, otherCodeHash))
+}
+
+func (suite *KeeperTestSuite) TestSuicideSharedCode() {
+	suite.SetupTest()
+
+	code := []byte("code")
+	codeHash := crypto.Keccak256Hash(code)
+	firstAddr := utiltx.GenerateAddress()
+	secondAddr := utiltx.GenerateAddress()
+
+	db := suite.network.GetStateDB()
+	db.SetCode(firstAddr, code)
+	db.SetCode(secondAddr, code)
+	suite.Require().NoError(db.Commit())
+
+	// self-destructing one contract must not remove the code of its clone
+	db = suite.network.GetStateDB()
+	suite.Require().True(db.Suicide(firstAddr))
+	suite.Require().NoError(db.Commit())
+
+	db = suite.network.GetStateDB()
+	suite.Require().Equal(code, db.GetCode(secondAddr))
+	suite.Require().Equal(uint64(1), suite.network.App.EVMKeeper.GetCodeRefCount(suite.network.GetContext(), codeHash))
+
+	// self-destructing the last contract removes the code
+	suite.Require().True(db.Suicide(secondAddr))
+	suite.Require().NoError(db.Commit())
+	suite.Require().Nil(suite.network.App.EVMKeeper.GetCode(suite.network.GetContext(), codeHash))
+}
+
 func TestIterateContracts(t *testing.T) {
 	keyring := testkeyring.New(1)
 	network := network.NewUnitTestNetwork(
@@ -407,9 +470,15 @@ func TestIterateContracts(t *testing.T) {
 
 	network.App.EVMKeeper.IterateContracts(network.GetContext(), func(addr common.Address, codeHash common.Hash) bool {
 		// NOTE: we only care about the 2 contracts deployed above, not the ERC20 native precompile for the aatom denomination
+		// or the default preinstalls
 		if bytes.Equal(addr.Bytes(), common.HexToAddress(testconstants.WEVMOSContractMainnet).Bytes()) {
 			return false
 		}
+		for _, preinstall := range types.DefaultPreinstalls {
+			if addr == common.HexToAddress(preinstall.Address) {
+				return false
+			}
+		}
 
 		foundAddrs = append(foundAddrs, addr)
 		foundHashes = append(foundHashes, codeHash)
@@ -13,6 +13,7 @@ import (
 
 	"github.com/cosmos/evm/x/vm/client/cli"
 	"github.com/cosmos/evm/x/vm/keeper"
+	"github.com/cosmos/evm/x/vm/simulation"
 	"github.com/cosmos/evm/x/vm/types"
 
 	"cosmossdk.io/core/appmodule"
@@ -26,13 +27,16 @@ import (
 )
 
 // consensusVersion defines the current x/evm module consensus version.
-const consensusVersion = 8
+const consensusVersion = 9
 
 var (
 	_ module.AppModule      = AppModule{}
 	_ module.AppModuleBasic = AppModuleBasic{}
 	_ module.HasABCIGenesis = AppModule{}
 
+	_ module.AppModuleSimulation = AppModule{}
+	_ module.HasProposalMsgs     = AppModule{}
+
 	_ appmodule.HasBeginBlocker = AppModule{}
 	_ appmodule.HasEndBlocker   = AppModule{}
 )
@@ -106,6 +110,10 @@ type AppModule struct {
 	ak     types.AccountKeeper
 	// legacySubspace is used solely for migration of x/params managed parameters
 	legacySubspace types.Subspace
+	// genesisStreamDir is the directory of the genesis stream file used to
+	// import and export the EVM genesis accounts. The accounts are part of the
+	// genesis JSON if empty.
+	genesisStreamDir string
 }
 
 // NewAppModule creates a new AppModule object
@@ -118,14 +126,24 @@ func NewAppModule(k *keeper.Keeper, ak types.AccountKeeper, ss types.Subspace) A
 	}
 }
 
+// WithGenesisStreamDir returns a copy of the AppModule that imports and exports
+// the EVM genesis accounts through the genesis stream file in the given
+// directory, instead of the genesis JSON.
+//
+// NOTE: the file is only imported if the genesis state commits to it.
+func (am AppModule) WithGenesisStreamDir(dir string) AppModule {
+	am.genesisStreamDir = dir
+	return am
+}
+
 // Name returns the evm module's name.
 func (AppModule) Name() string {
 	return types.ModuleName
 }
 
-// RegisterInvariants interface for registering invariants. Performs a no-op
-// as the evm module doesn't expose invariants.
-func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {
+// RegisterInvariants registers the evm module invariants.
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
 }
 
 // RegisterServices registers a GRPC query service to respond to the
@@ -133,6 +151,11 @@ func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), am.keeper)
 	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 8, m.Migrate8to9); err != nil {
+		panic(fmt.Sprintf("failed to migrate %s from version 8 to 9: %v", types.ModuleName, err))
+	}
 }
 
 // BeginBlock returns the begin blocker for the evm module.
@@ -154,27 +177,50 @@ func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.
 	var genesisState types.GenesisState
 	cdc.MustUnmarshalJSON(data, &genesisState)
 	InitGenesis(ctx, am.keeper, am.ak, genesisState)
+
+	if genesisState.GenesisStream != nil {
+		if am.genesisStreamDir == "" {
+			panic(fmt.Errorf("the genesis accounts are streamed, but no genesis stream directory is set"))
+		}
+		if err := InitGenesisStream(ctx, am.keeper, am.ak, am.genesisStreamDir, *genesisState.GenesisStream); err != nil {
+			panic(fmt.Errorf("error initializing genesis stream accounts: %w", err))
+		}
+	}
 	return []abci.ValidatorUpdate{}
 }
 
 // ExportGenesis returns the exported genesis state as raw bytes for the evm
-// module.
+// module. If a genesis stream directory is set, the accounts are left out of
+// the genesis state, since the export command writes them to the genesis
+// stream file with ExportGenesisStream.
 func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
-	gs := ExportGenesis(ctx, am.keeper)
+	if am.genesisStreamDir == "" {
+		gs := ExportGenesis(ctx, am.keeper)
+		return cdc.MustMarshalJSON(gs)
+	}
+
+	gs := ExportGenesisWithoutAccounts(ctx, am.keeper)
 	return cdc.MustMarshalJSON(gs)
 }
 
 // RegisterStoreDecoder registers a decoder for evm module's types
-func (am AppModule) RegisterStoreDecoder(_ simtypes.StoreDecoderRegistry) {
+func (am AppModule) RegisterStoreDecoder(sdr simtypes.StoreDecoderRegistry) {
+	sdr[types.StoreKey] = simulation.NewDecodeStore(types.ModuleCdc)
 }
 
 // GenerateGenesisState creates a randomized GenState of the evm module.
-func (AppModule) GenerateGenesisState(_ *module.SimulationState) {
+func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
+	simulation.RandomizedGenState(simState)
+}
+
+// ProposalMsgs returns msgs used for governance proposals for simulations.
+func (am AppModule) ProposalMsgs(_ module.SimulationState) []simtypes.WeightedProposalMsg {
+	return simulation.ProposalMsgs(am.keeper)
 }
 
 // WeightedOperations returns the all the evm module operations with their respective weights.
-func (am AppModule) WeightedOperations(_ module.SimulationState) []simtypes.WeightedOperation {
-	return nil
+func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	return simulation.WeightedOperations(simState.AppParams, simState.TxConfig, am.ak, am.keeper)
 }
 
 // IsAppModule implements the appmodule.AppModule interface.
@@ -0,0 +1,34 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// RandomAccounts generates n random accounts with eth_secp256k1 keys, so that the
+// simulation accounts can sign both the Cosmos and the Ethereum transactions. It
+// implements the simtypes.RandomAccountFn signature.
+func RandomAccounts(r *rand.Rand, n int) []simtypes.Account {
+	accs := make([]simtypes.Account, n)
+
+	for i := 0; i < n; i++ {
+		// don't need that much entropy for simulation
+		privkeySeed := make([]byte, 15)
+		r.Read(privkeySeed)
+
+		privKey := &ethsecp256k1.PrivKey{Key: secp256k1.GenPrivKeyFromSecret(privkeySeed).Bytes()}
+		accs[i].PrivKey = privKey
+		accs[i].PubKey = privKey.PubKey()
+		accs[i].Address = sdk.AccAddress(accs[i].PubKey.Address())
+
+		accs[i].ConsKey = ed25519.GenPrivKeyFromSecret(privkeySeed)
+	}
+
+	return accs
+}
@@ -0,0 +1,50 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/x/vm/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding evm type.
+func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixCode):
+			return fmt.Sprintf("%x\n%x", kvA.Value, kvB.Value)
+
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixStorage),
+			bytes.Equal(kvA.Key[:1], types.KeyPrefixCodeHash):
+			return fmt.Sprintf("%s\n%s", common.BytesToHash(kvA.Value), common.BytesToHash(kvB.Value))
+
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixParams):
+			var paramsA, paramsB types.Params
+			cdc.MustUnmarshal(kvA.Value, &paramsA)
+			cdc.MustUnmarshal(kvB.Value, &paramsB)
+			return fmt.Sprintf("%v\n%v", paramsA, paramsB)
+
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixCodeRefCount):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixAccessControlEntry):
+			var entryA, entryB types.AccessControlEntry
+			cdc.MustUnmarshal(kvA.Value, &entryA)
+			cdc.MustUnmarshal(kvB.Value, &entryB)
+			return fmt.Sprintf("%v\n%v", entryA, entryB)
+
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixFrozenContract):
+			return fmt.Sprintf("%s\n%s", common.BytesToAddress(kvA.Key[1:]), common.BytesToAddress(kvB.Key[1:]))
+
+		default:
+			panic(fmt.Sprintf("invalid evm key prefix %X", kvA.Key[:1]))
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/x/vm/simulation"
+	"github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := types.ModuleCdc
+	dec := simulation.NewDecodeStore(cdc)
+
+	contract := common.HexToAddress("0x4e59b44847b379578588920ca78fbf26c0b4956c")
+	codeHash := common.HexToHash("0x01")
+	params := types.DefaultParams()
+	entry := types.NewAccessControlEntry(contract, nil, types.AccessTypeRestricted, nil)
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: append(types.KeyPrefixCode, codeHash.Bytes()...), Value: []byte{0x60, 0x80}},
+			{Key: types.StateKey(contract, common.HexToHash("0x02").Bytes()), Value: codeHash.Bytes()},
+			{Key: types.KeyPrefixParams, Value: cdc.MustMarshal(&params)},
+			{Key: append(types.KeyPrefixCodeRefCount, codeHash.Bytes()...), Value: sdk.Uint64ToBigEndian(2)},
+			{Key: types.AccessControlEntryKey(contract, nil), Value: cdc.MustMarshal(&entry)},
+			{Key: types.FrozenContractKey(contract), Value: []byte{1}},
+			{Key: []byte{0x99}, Value: []byte{0x99}}, // This test should panic
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+		panics      bool
+	}{
+		{"Code", "6080\n6080", false},
+		{"Storage", fmt.Sprintf("%s\n%s", codeHash, codeHash), false},
+		{"Params", fmt.Sprintf("%v\n%v", params, params), false},
+		{"CodeRefCount", "2\n2", false},
+		{"AccessControlEntry", fmt.Sprintf("%v\n%v", entry, entry), false},
+		{"FrozenContract", fmt.Sprintf("%s\n%s", contract, contract), false},
+		{"other", "", true},
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.panics {
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			} else {
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/evm/x/vm/types"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// Simulation parameter constants
+const (
+	AllowUnprotectedTxs = "allow_unprotected_txs"
+)
+
+// GenAllowUnprotectedTxs randomized AllowUnprotectedTxs
+func GenAllowUnprotectedTxs(r *rand.Rand) bool {
+	return r.Intn(2) == 0
+}
+
+// RandomizedGenState generates a random GenesisState for the evm module. It starts from
+// the genesis state provided by the application, so that the app-specific settings
+// (e.g. the active precompiles and the preinstalled contracts) are preserved.
+func RandomizedGenState(simState *module.SimulationState) {
+	evmGenesis := types.DefaultGenesisState()
+	if bz, ok := simState.GenState[types.ModuleName]; ok {
+		simState.Cdc.MustUnmarshalJSON(bz, evmGenesis)
+	}
+
+	var allowUnprotectedTxs bool
+	simState.AppParams.GetOrGenerate(AllowUnprotectedTxs, &allowUnprotectedTxs, simState.Rand, func(r *rand.Rand) { allowUnprotectedTxs = GenAllowUnprotectedTxs(r) })
+
+	evmGenesis.Params.AllowUnprotectedTxs = allowUnprotectedTxs
+
+	bz, err := json.MarshalIndent(&evmGenesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated evm parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(evmGenesis)
+}
@@ -0,0 +1,324 @@
+package simulation
+
+import (
+	"math/big"
+	"math/rand"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/cosmos/evm/contracts"
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/x/vm/keeper"
+	"github.com/cosmos/evm/x/vm/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgEthSimpleTransfer = "op_weight_msg_eth_simple_transfer"
+	OpWeightMsgEthCreateContract = "op_weight_msg_eth_create_contract"
+
+	DefaultWeightMsgEthSimpleTransfer = 50
+	DefaultWeightMsgEthCreateContract = 10
+)
+
+// Gas limits of the simulated Ethereum transactions. They are fixed instead of estimated,
+// so that the operations don't depend on the gas estimation logic.
+const (
+	SimpleTransferGasLimit uint64 = 21_000
+	ContractCallGasLimit   uint64 = 200_000
+	ContractDeployGasLimit uint64 = 5_000_000
+)
+
+// EthOperationInput holds all the values needed to generate an Ethereum transaction and
+// deliver it.
+type EthOperationInput struct {
+	App           *baseapp.BaseApp
+	TxGen         client.TxConfig
+	Context       sdk.Context
+	AccountKeeper types.AccountKeeper
+	EVMKeeper     *keeper.Keeper
+	SimAccount    simtypes.Account
+	To            *common.Address
+	Amount        *big.Int
+	Input         []byte
+	GasLimit      uint64
+}
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams,
+	txGen client.TxConfig,
+	ak types.AccountKeeper,
+	k *keeper.Keeper,
+) simulation.WeightedOperations {
+	var weightMsgEthSimpleTransfer, weightMsgEthCreateContract int
+	appParams.GetOrGenerate(OpWeightMsgEthSimpleTransfer, &weightMsgEthSimpleTransfer, nil, func(_ *rand.Rand) {
+		weightMsgEthSimpleTransfer = DefaultWeightMsgEthSimpleTransfer
+	})
+
+	appParams.GetOrGenerate(OpWeightMsgEthCreateContract, &weightMsgEthCreateContract, nil, func(_ *rand.Rand) {
+		weightMsgEthCreateContract = DefaultWeightMsgEthCreateContract
+	})
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgEthSimpleTransfer,
+			SimulateEthSimpleTransfer(txGen, ak, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgEthCreateContract,
+			SimulateEthCreateContract(txGen, ak, k),
+		),
+	}
+}
+
+// SimulateEthSimpleTransfer simulates an Ethereum value transfer between two random
+// accounts.
+func SimulateEthSimpleTransfer(txGen client.TxConfig, ak types.AccountKeeper, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		from, _ := simtypes.RandomAcc(r, accs)
+		to, _ := simtypes.RandomAcc(r, accs)
+		recipient := common.BytesToAddress(to.Address)
+
+		spendable := SpendableBalance(ctx, ak, k, from)
+		spendable.Sub(spendable, EthTxFee(ctx, k, SimpleTransferGasLimit))
+		if spendable.Sign() <= 0 {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgEthereumTx{}), "insufficient funds"), nil, nil
+		}
+
+		return GenAndDeliverEthTx(EthOperationInput{
+			App:           app,
+			TxGen:         txGen,
+			Context:       ctx,
+			AccountKeeper: ak,
+			EVMKeeper:     k,
+			SimAccount:    from,
+			To:            &recipient,
+			Amount:        simtypes.RandomAmount(r, sdkmath.NewIntFromBigInt(spendable)).BigInt(),
+			GasLimit:      SimpleTransferGasLimit,
+		})
+	}
+}
+
+// SimulateEthCreateContract simulates the deployment of an ERC-20 contract by a random
+// account. The deployment schedules future operations that mint tokens to the deployer
+// and transfer part of them to another random account.
+func SimulateEthCreateContract(txGen client.TxConfig, ak types.AccountKeeper, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgEthereumTx{})
+		deployer, _ := simtypes.RandomAcc(r, accs)
+		deployerAddr := common.BytesToAddress(deployer.Address)
+
+		name := simtypes.RandStringOfLength(r, 10)
+		ctorArgs, err := contracts.ERC20MinterBurnerDecimalsContract.ABI.Pack("", name, name[:3], uint8(18))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to pack constructor arguments"), nil, err
+		}
+
+		// copy the bytecode to avoid appending to the shared contract binary
+		input := append(append([]byte{}, contracts.ERC20MinterBurnerDecimalsContract.Bin...), ctorArgs...)
+		contract := crypto.CreateAddress(deployerAddr, k.GetNonce(ctx, deployerAddr))
+		opMsg, _, err := GenAndDeliverEthTx(EthOperationInput{
+			App:           app,
+			TxGen:         txGen,
+			Context:       ctx,
+			AccountKeeper: ak,
+			EVMKeeper:     k,
+			SimAccount:    deployer,
+			Input:         input,
+			GasLimit:      ContractDeployGasLimit,
+		})
+		if err != nil || !opMsg.OK {
+			return opMsg, nil, err
+		}
+
+		futureOps := []simtypes.FutureOperation{
+			{
+				BlockHeight: int(ctx.BlockHeight()) + 1,
+				Op:          SimulateERC20Call(txGen, ak, k, deployer, contract, "mint", deployerAddr),
+			},
+			{
+				BlockHeight: int(ctx.BlockHeight()) + 2,
+				Op:          SimulateERC20Call(txGen, ak, k, deployer, contract, "transfer", common.Address{}),
+			},
+		}
+
+		return opMsg, futureOps, nil
+	}
+}
+
+// SimulateERC20Call simulates a call of the ERC-20 contract deployed by a simulation
+// account. The mint and transfer amounts are random, and a zero recipient is replaced
+// by a random account.
+func SimulateERC20Call(
+	txGen client.TxConfig,
+	ak types.AccountKeeper,
+	k *keeper.Keeper,
+	sender simtypes.Account,
+	contract common.Address,
+	method string,
+	recipient common.Address,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgEthereumTx{})
+		if acc := k.GetAccount(ctx, contract); acc == nil || !acc.IsContract() {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "contract not deployed"), nil, nil
+		}
+
+		if (recipient == common.Address{}) {
+			to, _ := simtypes.RandomAcc(r, accs)
+			recipient = common.BytesToAddress(to.Address)
+		}
+
+		amount := big.NewInt(r.Int63n(1e18) + 1)
+		if method == "transfer" {
+			balance, err := erc20BalanceOf(ctx, k, contract, common.BytesToAddress(sender.Address))
+			if err != nil || balance.Sign() == 0 {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, "no token balance"), nil, nil
+			}
+			amount = simtypes.RandomAmount(r, sdkmath.NewIntFromBigInt(balance)).BigInt()
+		}
+
+		input, err := contracts.ERC20MinterBurnerDecimalsContract.ABI.Pack(method, recipient, amount)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to pack call arguments"), nil, err
+		}
+
+		return GenAndDeliverEthTx(EthOperationInput{
+			App:           app,
+			TxGen:         txGen,
+			Context:       ctx,
+			AccountKeeper: ak,
+			EVMKeeper:     k,
+			SimAccount:    sender,
+			To:            &contract,
+			Input:         input,
+			GasLimit:      ContractCallGasLimit,
+		})
+	}
+}
+
+// GenAndDeliverEthTx signs an Ethereum transaction with the simulation account, wraps it
+// into a Cosmos transaction and delivers it. The gas price is the current base fee. It
+// returns a no-op message if the account can't sign Ethereum transactions or can't pay
+// for the transaction.
+func GenAndDeliverEthTx(txCtx EthOperationInput) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	ctx, k := txCtx.Context, txCtx.EVMKeeper
+	msgType := sdk.MsgTypeURL(&types.MsgEthereumTx{})
+
+	privKey, ok := txCtx.SimAccount.PrivKey.(*ethsecp256k1.PrivKey)
+	if !ok {
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "account key is not eth_secp256k1"), nil, nil
+	}
+
+	amount := txCtx.Amount
+	if amount == nil {
+		amount = big.NewInt(0)
+	}
+
+	cost := new(big.Int).Add(amount, EthTxFee(ctx, k, txCtx.GasLimit))
+	if SpendableBalance(ctx, txCtx.AccountKeeper, k, txCtx.SimAccount).Cmp(cost) < 0 {
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "insufficient funds"), nil, nil
+	}
+
+	from := common.BytesToAddress(txCtx.SimAccount.Address)
+	chainID := types.GetEthChainConfig().ChainID
+	msg := types.NewTx(&types.EvmTxArgs{
+		ChainID:  chainID,
+		Nonce:    k.GetNonce(ctx, from),
+		To:       txCtx.To,
+		Amount:   amount,
+		GasLimit: txCtx.GasLimit,
+		GasPrice: ethTxGasPrice(ctx, k),
+		Input:    txCtx.Input,
+	})
+
+	key, err := privKey.ToECDSA()
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to convert private key"), nil, err
+	}
+
+	signedTx, err := ethtypes.SignTx(msg.AsTransaction(), ethtypes.LatestSignerForChainID(chainID), key)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to sign transaction"), nil, err
+	}
+
+	if err := msg.FromEthereumTx(signedTx); err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to build message"), nil, err
+	}
+
+	tx, err := msg.BuildTx(txCtx.TxGen.NewTxBuilder(), types.GetEVMCoinDenom())
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to build transaction"), nil, err
+	}
+
+	if _, _, err := txCtx.App.SimDeliver(txCtx.TxGen.TxEncoder(), tx); err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to deliver tx"), nil, err
+	}
+
+	return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+}
+
+// SpendableBalance returns the balance of the EVM denomination that the simulation
+// account can spend in an Ethereum transaction. The vesting accounts are skipped, since
+// their balance can be locked.
+func SpendableBalance(ctx sdk.Context, ak types.AccountKeeper, k *keeper.Keeper, acc simtypes.Account) *big.Int {
+	if _, ok := ak.GetAccount(ctx, acc.Address).(vestingexported.VestingAccount); ok {
+		return big.NewInt(0)
+	}
+	return k.GetBalance(ctx, common.BytesToAddress(acc.Address))
+}
+
+// EthTxFee returns the maximum fee of an Ethereum transaction with the given gas limit.
+func EthTxFee(ctx sdk.Context, k *keeper.Keeper, gasLimit uint64) *big.Int {
+	return new(big.Int).Mul(ethTxGasPrice(ctx, k), new(big.Int).SetUint64(gasLimit))
+}
+
+// ethTxGasPrice returns the gas price of the simulated Ethereum transactions.
+func ethTxGasPrice(ctx sdk.Context, k *keeper.Keeper) *big.Int {
+	baseFee := k.GetBaseFee(ctx)
+	if baseFee == nil {
+		return big.NewInt(0)
+	}
+	return baseFee
+}
+
+// erc20BalanceOf returns the token balance of the account.
+func erc20BalanceOf(ctx sdk.Context, k *keeper.Keeper, contract, account common.Address) (*big.Int, error) {
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	res, err := k.CallEVM(ctx, erc20, account, contract, false, "balanceOf", account)
+	if err != nil {
+		return nil, err
+	}
+
+	unpacked, err := erc20.Unpack("balanceOf", res.Ret)
+	if err != nil || len(unpacked) == 0 {
+		return nil, err
+	}
+
+	balance, ok := unpacked[0].(*big.Int)
+	if !ok {
+		return big.NewInt(0), nil
+	}
+	return balance, nil
+}
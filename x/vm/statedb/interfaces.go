@@ -30,7 +30,6 @@ type Keeper interface {
 	SetAccount(ctx sdk.Context, addr common.Address, account Account) error
 	DeleteState(ctx sdk.Context, addr common.Address, key common.Hash)
 	SetState(ctx sdk.Context, addr common.Address, key common.Hash, value []byte)
-	DeleteCode(ctx sdk.Context, codeHash []byte)
 	SetCode(ctx sdk.Context, codeHash []byte, code []byte)
 	DeleteAccount(ctx sdk.Context, addr common.Address) error
 }
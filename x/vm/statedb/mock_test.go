@@ -94,10 +94,6 @@ func (k MockKeeper) SetCode(_ sdk.Context, codeHash []byte, code []byte) {
 	k.codes[common.BytesToHash(codeHash)] = code
 }
 
-func (k MockKeeper) DeleteCode(_ sdk.Context, codeHash []byte) {
-	delete(k.codes, common.BytesToHash(codeHash))
-}
-
 func (k MockKeeper) DeleteAccount(_ sdk.Context, addr common.Address) error {
 	if addr == errAddress {
 		return errors.New("mock db error")
@@ -532,12 +532,10 @@ func (s *StateDB) commitWithCtx(ctx sdk.Context) error {
 				return errorsmod.Wrapf(err, "failed to delete account %s", obj.Address())
 			}
 		} else {
-			if obj.code != nil && obj.dirtyCode {
-				if len(obj.code) == 0 {
-					s.keeper.DeleteCode(ctx, obj.CodeHash())
-				} else {
-					s.keeper.SetCode(ctx, obj.CodeHash(), obj.code)
-				}
+			// NOTE: code is shared by every account with the same code hash, so it is
+			// never deleted here. The keeper prunes it once it's no longer referenced.
+			if len(obj.code) != 0 && obj.dirtyCode {
+				s.keeper.SetCode(ctx, obj.CodeHash(), obj.code)
 			}
 			if err := s.keeper.SetAccount(ctx, obj.Address(), obj.account); err != nil {
 				return errorsmod.Wrap(err, "failed to set account")
@@ -0,0 +1,134 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/cosmos/evm/types"
+)
+
+// SelectorLength is the length in bytes of a contract function selector.
+const SelectorLength = 4
+
+// AccessControlEntryGetter returns the call permission rule of the contract function with
+// the given selector, or the contract-wide rule if the selector is nil. The second return
+// value is false if there is no such rule.
+type AccessControlEntryGetter func(contract common.Address, selector []byte) (AccessControlEntry, bool)
+
+// ParseSelector returns the bytes of the given hex function selector, or nil if it's empty.
+func ParseSelector(selector string) ([]byte, error) {
+	if selector == "" {
+		return nil, nil
+	}
+
+	bz, err := hexutil.Decode(selector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid function selector %s: %w", selector, err)
+	}
+	if len(bz) != SelectorLength {
+		return nil, fmt.Errorf("invalid function selector %s: expected %d bytes, got %d", selector, SelectorLength, len(bz))
+	}
+	return bz, nil
+}
+
+// NewAccessControlEntry returns a new call permission rule for the contract function with the
+// given selector, or for the whole contract if the selector is nil.
+func NewAccessControlEntry(contract common.Address, selector []byte, accessType AccessType, addresses []string) AccessControlEntry {
+	entry := AccessControlEntry{
+		Contract:          contract.Hex(),
+		AccessType:        accessType,
+		AccessControlList: addresses,
+	}
+	if len(selector) != 0 {
+		entry.Selector = hexutil.Encode(selector)
+	}
+	return entry
+}
+
+// ValidateKey validates the contract address and the function selector of the rule.
+func (e AccessControlEntry) ValidateKey() error {
+	if err := types.ValidateNonZeroAddress(e.Contract); err != nil {
+		return fmt.Errorf("invalid access control entry contract %s: %w", e.Contract, err)
+	}
+
+	_, err := ParseSelector(e.Selector)
+	return err
+}
+
+// Validate performs a stateless validation of the rule fields.
+func (e AccessControlEntry) Validate() error {
+	if err := e.ValidateKey(); err != nil {
+		return err
+	}
+	if err := validateAccessType(e.AccessType); err != nil {
+		return err
+	}
+	if err := validateAllowlistAddresses(e.AccessControlList); err != nil {
+		return err
+	}
+
+	seenAddresses := make(map[common.Address]bool, len(e.AccessControlList))
+	for _, address := range e.AccessControlList {
+		addr := common.HexToAddress(address)
+		if seenAddresses[addr] {
+			return fmt.Errorf("duplicated address %s in access control entry", address)
+		}
+		seenAddresses[addr] = true
+	}
+	return nil
+}
+
+// GetContractAddress returns the address of the contract the rule applies to.
+func (e AccessControlEntry) GetContractAddress() common.Address {
+	return common.HexToAddress(e.Contract)
+}
+
+// GetSelectorBytes returns the function selector the rule applies to, or nil for a
+// contract-wide rule. It assumes the rule was validated.
+func (e AccessControlEntry) GetSelectorBytes() []byte {
+	selector, _ := ParseSelector(e.Selector)
+	return selector
+}
+
+// IsAllowed returns true if the signer or the caller are allowed to perform the call
+// according to the rule.
+func (e AccessControlEntry) IsAllowed(signer, caller common.Address) bool {
+	switch e.AccessType {
+	case AccessTypePermissionless:
+		return !e.hasAddress(signer) && !e.hasAddress(caller)
+	case AccessTypePermissioned:
+		return e.hasAddress(signer) || e.hasAddress(caller)
+	default:
+		return false
+	}
+}
+
+// hasAddress returns true if the address is within the access control list of the rule.
+func (e AccessControlEntry) hasAddress(address common.Address) bool {
+	for _, addr := range e.AccessControlList {
+		if common.HexToAddress(addr) == address {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateAccessControlEntries validates each rule and checks that there are no duplicated
+// rules for the same contract and selector.
+func ValidateAccessControlEntries(entries []AccessControlEntry) error {
+	seenKeys := make(map[string]bool, len(entries))
+	for _, entry := range entries {
+		if err := entry.Validate(); err != nil {
+			return err
+		}
+
+		key := string(AccessControlEntryKey(entry.GetContractAddress(), entry.GetSelectorBytes()))
+		if seenKeys[key] {
+			return fmt.Errorf("duplicated access control entry for contract %s and selector %q", entry.Contract, entry.Selector)
+		}
+		seenKeys[key] = true
+	}
+	return nil
+}
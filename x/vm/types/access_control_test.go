@@ -0,0 +1,143 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testContract = "0x4e59b44847b379578588920ca78fbf26c0b4956c"
+	testAddress1 = "0x1000000000000000000000000000000000000001"
+	testAddress2 = "0x2000000000000000000000000000000000000002"
+)
+
+func TestParseSelector(t *testing.T) {
+	testCases := []struct {
+		name     string
+		selector string
+		expBytes []byte
+		expError bool
+	}{
+		{"empty selector", "", nil, false},
+		{"valid selector", "0xa9059cbb", []byte{0xa9, 0x05, 0x9c, 0xbb}, false},
+		{"without 0x prefix", "a9059cbb", nil, true},
+		{"too short", "0xa9059c", nil, true},
+		{"too long", "0xa9059cbb00", nil, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			bz, err := ParseSelector(tc.selector)
+			if tc.expError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expBytes, bz)
+		})
+	}
+}
+
+func TestAccessControlEntryValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		entry    AccessControlEntry
+		expError bool
+	}{
+		{
+			"valid contract-wide entry",
+			AccessControlEntry{Contract: testContract, AccessType: AccessTypeRestricted},
+			false,
+		},
+		{
+			"valid function entry",
+			AccessControlEntry{Contract: testContract, Selector: "0xa9059cbb", AccessType: AccessTypePermissioned, AccessControlList: []string{testAddress1}},
+			false,
+		},
+		{
+			"zero contract address",
+			AccessControlEntry{Contract: common.Address{}.Hex(), AccessType: AccessTypeRestricted},
+			true,
+		},
+		{
+			"invalid selector",
+			AccessControlEntry{Contract: testContract, Selector: "0x01", AccessType: AccessTypeRestricted},
+			true,
+		},
+		{
+			"invalid access type",
+			AccessControlEntry{Contract: testContract, AccessType: AccessType(-1)},
+			true,
+		},
+		{
+			"invalid address",
+			AccessControlEntry{Contract: testContract, AccessType: AccessTypePermissioned, AccessControlList: []string{"0x123"}},
+			true,
+		},
+		{
+			"duplicated address",
+			AccessControlEntry{Contract: testContract, AccessType: AccessTypePermissioned, AccessControlList: []string{testAddress1, testAddress1}},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.entry.Validate()
+			if tc.expError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAccessControlEntryIsAllowed(t *testing.T) {
+	address1 := common.HexToAddress(testAddress1)
+	address2 := common.HexToAddress(testAddress2)
+	other := common.HexToAddress(testContract)
+
+	testCases := []struct {
+		name       string
+		accessType AccessType
+		signer     common.Address
+		caller     common.Address
+		expAllowed bool
+	}{
+		{"permissionless - not listed", AccessTypePermissionless, other, other, true},
+		{"permissionless - listed signer", AccessTypePermissionless, address1, other, false},
+		{"permissionless - listed caller", AccessTypePermissionless, other, address2, false},
+		{"permissioned - not listed", AccessTypePermissioned, other, other, false},
+		{"permissioned - listed signer", AccessTypePermissioned, address1, other, true},
+		{"permissioned - listed caller", AccessTypePermissioned, other, address2, true},
+		{"restricted - listed", AccessTypeRestricted, address1, address2, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			entry := AccessControlEntry{
+				Contract:          testContract,
+				AccessType:        tc.accessType,
+				AccessControlList: []string{testAddress1, testAddress2},
+			}
+			require.Equal(t, tc.expAllowed, entry.IsAllowed(tc.signer, tc.caller))
+		})
+	}
+}
+
+func TestValidateAccessControlEntries(t *testing.T) {
+	contractEntry := AccessControlEntry{Contract: testContract, AccessType: AccessTypeRestricted}
+	functionEntry := AccessControlEntry{Contract: testContract, Selector: "0xa9059cbb", AccessType: AccessTypeRestricted}
+
+	require.NoError(t, ValidateAccessControlEntries(nil))
+	require.NoError(t, ValidateAccessControlEntries([]AccessControlEntry{contractEntry, functionEntry}))
+
+	// the same rule with a differently formatted key is a duplicate
+	duplicate := functionEntry
+	duplicate.Contract = common.HexToAddress(testContract).Hex()
+	duplicate.Selector = "0xA9059CBB"
+	require.Error(t, ValidateAccessControlEntries([]AccessControlEntry{functionEntry, duplicate}))
+}
@@ -0,0 +1,153 @@
+package types
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// gasCostPerIteration is the gas consumed for every allowed contract call
+// checked when accepting a call.
+const gasCostPerIteration = uint64(10)
+
+var _ authz.Authorization = &ContractCallAuthorization{}
+
+// NewContractCallAuthorization creates a new ContractCallAuthorization object.
+func NewContractCallAuthorization(allowedCalls []AllowedContractCall, spendLimit sdkmath.Int) *ContractCallAuthorization {
+	return &ContractCallAuthorization{
+		AllowedCalls: allowedCalls,
+		SpendLimit:   spendLimit,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a ContractCallAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgCallEVM{})
+}
+
+// Accept implements Authorization.Accept. It accepts the calls to the allowed
+// contracts and functions whose value doesn't exceed the spend limit, and
+// decreases the spend limit by the value of the call. The calls without
+// value are still accepted once the spend limit is exhausted.
+func (a ContractCallAuthorization) Accept(ctx context.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	mCall, ok := msg.(*MsgCallEVM)
+	if !ok {
+		return authz.AcceptResponse{}, errortypes.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	value := sdkmath.ZeroInt()
+	if !mCall.Value.IsNil() {
+		value = mCall.Value
+	}
+
+	limitLeft := a.SpendLimit.Sub(value)
+	if limitLeft.IsNegative() {
+		return authz.AcceptResponse{}, errortypes.ErrInsufficientFunds.Wrapf(
+			"requested value %s is more than the spend limit %s", value, a.SpendLimit,
+		)
+	}
+
+	if !a.isCallAllowed(sdk.UnwrapSDKContext(ctx), common.HexToAddress(mCall.To), mCall.Data) {
+		return authz.AcceptResponse{}, errortypes.ErrUnauthorized.Wrapf("cannot call contract %s with the given calldata", mCall.To)
+	}
+
+	if value.IsZero() {
+		return authz.AcceptResponse{Accept: true}, nil
+	}
+
+	return authz.AcceptResponse{
+		Accept:  true,
+		Updated: NewContractCallAuthorization(a.AllowedCalls, limitLeft),
+	}, nil
+}
+
+// isCallAllowed returns true if the calldata calls one of the allowed
+// functions of the contract.
+func (a ContractCallAuthorization) isCallAllowed(ctx sdk.Context, contract common.Address, data []byte) bool {
+	for _, allowed := range a.AllowedCalls {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "contract call authorization")
+		if common.HexToAddress(allowed.Contract) != contract {
+			continue
+		}
+
+		if len(allowed.Selectors) == 0 {
+			return true
+		}
+
+		// the calls to the fallback or receive functions don't have any selector
+		if len(data) < SelectorLength {
+			return false
+		}
+
+		for _, selector := range allowed.Selectors {
+			bz, _ := ParseSelector(selector)
+			if bytes.Equal(bz, data[:SelectorLength]) {
+				return true
+			}
+		}
+		return false
+	}
+	return false
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a ContractCallAuthorization) ValidateBasic() error {
+	if len(a.AllowedCalls) == 0 {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "allowed calls cannot be empty")
+	}
+
+	if a.SpendLimit.IsNil() || a.SpendLimit.IsNegative() {
+		return errorsmod.Wrap(ErrInvalidAmount, "spend limit cannot be nil or negative")
+	}
+
+	found := make(map[common.Address]bool, len(a.AllowedCalls))
+	for _, allowed := range a.AllowedCalls {
+		if err := allowed.Validate(); err != nil {
+			return err
+		}
+
+		contract := common.HexToAddress(allowed.Contract)
+		if found[contract] {
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "duplicate contract %s", allowed.Contract)
+		}
+		found[contract] = true
+	}
+
+	return nil
+}
+
+// Validate validates the contract address and the function selectors.
+func (c AllowedContractCall) Validate() error {
+	if err := types.ValidateAddress(c.Contract); err != nil {
+		return errorsmod.Wrap(err, "invalid contract address")
+	}
+
+	found := make(map[string]bool, len(c.Selectors))
+	for _, selector := range c.Selectors {
+		bz, err := ParseSelector(selector)
+		if err != nil {
+			return errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+		}
+		if bz == nil {
+			return errorsmod.Wrap(errortypes.ErrInvalidRequest, "function selector cannot be empty")
+		}
+
+		key := string(bz)
+		if found[key] {
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "duplicate function selector %s", selector)
+		}
+		found[key] = true
+	}
+
+	return nil
+}
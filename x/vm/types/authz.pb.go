@@ -0,0 +1,628 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/evm/vm/v1/authz.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ContractCallAuthorization allows the grantee to call contracts on behalf of
+// the granter through MsgCallEVM, e.g. to give a session key to a dApp. The
+// calls are restricted to the allowed contracts and functions, and the value
+// transferred with them can't exceed the spend limit. The authorization expires
+// with the authz grant that holds it.
+type ContractCallAuthorization struct {
+	// allowed_calls is the list of contracts, and optionally of their functions,
+	// that the grantee can call.
+	AllowedCalls []AllowedContractCall `protobuf:"bytes,1,rep,name=allowed_calls,json=allowedCalls,proto3" json:"allowed_calls"`
+	// spend_limit is the maximum amount of the EVM denomination that the grantee
+	// can transfer with its calls. It's decreased by the value of every call.
+	SpendLimit cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=spend_limit,json=spendLimit,proto3,customtype=cosmossdk.io/math.Int" json:"spend_limit"`
+}
+
+func (m *ContractCallAuthorization) Reset()         { *m = ContractCallAuthorization{} }
+func (m *ContractCallAuthorization) String() string { return proto.CompactTextString(m) }
+func (*ContractCallAuthorization) ProtoMessage()    {}
+func (*ContractCallAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_717543495e65fc1e, []int{0}
+}
+func (m *ContractCallAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractCallAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractCallAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractCallAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractCallAuthorization.Merge(m, src)
+}
+func (m *ContractCallAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractCallAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractCallAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractCallAuthorization proto.InternalMessageInfo
+
+func (m *ContractCallAuthorization) GetAllowedCalls() []AllowedContractCall {
+	if m != nil {
+		return m.AllowedCalls
+	}
+	return nil
+}
+
+// AllowedContractCall defines a contract, and optionally some of its functions,
+// that can be called with a ContractCallAuthorization.
+type AllowedContractCall struct {
+	// contract is the ethereum hex address of the contract.
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// selectors is the list of hex encoded 4-byte selectors of the functions that
+	// can be called. If empty, any function of the contract can be called.
+	Selectors []string `protobuf:"bytes,2,rep,name=selectors,proto3" json:"selectors,omitempty"`
+}
+
+func (m *AllowedContractCall) Reset()         { *m = AllowedContractCall{} }
+func (m *AllowedContractCall) String() string { return proto.CompactTextString(m) }
+func (*AllowedContractCall) ProtoMessage()    {}
+func (*AllowedContractCall) Descriptor() ([]byte, []int) {
+	return fileDescriptor_717543495e65fc1e, []int{1}
+}
+func (m *AllowedContractCall) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllowedContractCall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllowedContractCall.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AllowedContractCall) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllowedContractCall.Merge(m, src)
+}
+func (m *AllowedContractCall) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllowedContractCall) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllowedContractCall.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllowedContractCall proto.InternalMessageInfo
+
+func (m *AllowedContractCall) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *AllowedContractCall) GetSelectors() []string {
+	if m != nil {
+		return m.Selectors
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ContractCallAuthorization)(nil), "cosmos.evm.vm.v1.ContractCallAuthorization")
+	proto.RegisterType((*AllowedContractCall)(nil), "cosmos.evm.vm.v1.AllowedContractCall")
+}
+
+func init() { proto.RegisterFile("cosmos/evm/vm/v1/authz.proto", fileDescriptor_717543495e65fc1e) }
+
+var fileDescriptor_717543495e65fc1e = []byte{
+	// 366 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x51, 0xc1, 0x4a, 0xeb, 0x40,
+	0x14, 0x4d, 0x5a, 0x78, 0xbc, 0x4c, 0xdf, 0x83, 0xf7, 0xa2, 0x42, 0x5a, 0x6a, 0x1a, 0x0a, 0x42,
+	0x2d, 0x38, 0x21, 0xba, 0xeb, 0x42, 0x68, 0xbb, 0x12, 0x04, 0x21, 0xe8, 0xc6, 0x4d, 0x99, 0xa6,
+	0x43, 0x13, 0x9c, 0xc9, 0x94, 0xcc, 0xb4, 0x6a, 0xbf, 0x40, 0x5c, 0xf9, 0x19, 0x2e, 0xbb, 0xf0,
+	0x23, 0x8a, 0xab, 0x2e, 0xc5, 0x45, 0x91, 0x76, 0xd1, 0xdf, 0x90, 0xc9, 0x04, 0xad, 0x45, 0xe1,
+	0x12, 0x72, 0xcf, 0x3d, 0xf7, 0x70, 0xee, 0x19, 0x50, 0x0e, 0x18, 0xa7, 0x8c, 0xbb, 0x78, 0x44,
+	0x5d, 0x59, 0x9e, 0x8b, 0x86, 0x22, 0x1c, 0xc3, 0x41, 0xc2, 0x04, 0x33, 0xff, 0xa9, 0x29, 0xc4,
+	0x23, 0x0a, 0x65, 0x79, 0xa5, 0xff, 0x88, 0x46, 0x31, 0x73, 0xd3, 0xaf, 0x22, 0x95, 0x8a, 0x8a,
+	0xd4, 0x49, 0x3b, 0x37, 0xdb, 0x50, 0xa3, 0xed, 0x3e, 0xeb, 0x33, 0x85, 0xcb, 0x3f, 0x85, 0x56,
+	0xef, 0x72, 0xa0, 0xd8, 0x66, 0xb1, 0x48, 0x50, 0x20, 0xda, 0x88, 0x90, 0xe6, 0x50, 0x84, 0x2c,
+	0x89, 0xc6, 0x48, 0x44, 0x2c, 0x36, 0x2f, 0xc0, 0x5f, 0x44, 0x08, 0xbb, 0xc6, 0xbd, 0x4e, 0x80,
+	0x08, 0xe1, 0x96, 0xee, 0xe4, 0x6b, 0x85, 0xc3, 0x3d, 0xb8, 0xe9, 0x05, 0x36, 0x15, 0x6d, 0x5d,
+	0xaa, 0x65, 0x4c, 0xe7, 0x15, 0xed, 0x71, 0x35, 0xa9, 0xeb, 0xfe, 0x9f, 0x4c, 0x46, 0xe2, 0xdc,
+	0x3c, 0x06, 0x05, 0x3e, 0xc0, 0x71, 0xaf, 0x43, 0x22, 0x1a, 0x09, 0x2b, 0xe7, 0xe8, 0x35, 0xa3,
+	0xb5, 0x2b, 0xd9, 0xaf, 0xf3, 0xca, 0x8e, 0xd2, 0xe6, 0xbd, 0x2b, 0x18, 0x31, 0x97, 0x22, 0x11,
+	0xc2, 0x93, 0x58, 0xf8, 0x20, 0xdd, 0x38, 0x95, 0x0b, 0x8d, 0xf3, 0xe7, 0xa7, 0x83, 0x6a, 0x66,
+	0x41, 0x45, 0x34, 0xf2, 0xba, 0x58, 0x20, 0x0f, 0x7e, 0xb1, 0x7f, 0xbf, 0x9a, 0xd4, 0xf7, 0xd7,
+	0x32, 0xbd, 0x91, 0xa9, 0xfe, 0x78, 0x6c, 0xf5, 0x0c, 0x6c, 0x7d, 0x73, 0x85, 0x59, 0x02, 0xbf,
+	0x83, 0xac, 0xb7, 0x74, 0xe9, 0xd4, 0xff, 0xe8, 0xcd, 0x32, 0x30, 0x38, 0x26, 0x38, 0x10, 0x2c,
+	0xe1, 0x56, 0xce, 0xc9, 0xd7, 0x0c, 0xff, 0x13, 0x68, 0x35, 0xa6, 0x0b, 0x5b, 0x9f, 0x2d, 0x6c,
+	0xfd, 0x6d, 0x61, 0xeb, 0x0f, 0x4b, 0x5b, 0x9b, 0x2d, 0x6d, 0xed, 0x65, 0x69, 0x6b, 0x97, 0x4e,
+	0x3f, 0x12, 0xe1, 0xb0, 0x0b, 0x03, 0x46, 0xdd, 0x4d, 0x83, 0xe2, 0x76, 0x80, 0x79, 0xf7, 0x57,
+	0xfa, 0x3c, 0x47, 0xef, 0x03, 0x00, 0x8f, 0xbe, 0xf1, 0x00, 0x14, 0x02, 0x00, 0x00,
+}
+
+func (m *ContractCallAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractCallAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractCallAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SpendLimit.Size()
+		i -= size
+		if _, err := m.SpendLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuthz(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.AllowedCalls) > 0 {
+		for iNdEx := len(m.AllowedCalls) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllowedCalls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AllowedContractCall) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllowedContractCall) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllowedContractCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Selectors) > 0 {
+		for iNdEx := len(m.Selectors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Selectors[iNdEx])
+			copy(dAtA[i:], m.Selectors[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.Selectors[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthz(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ContractCallAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedCalls) > 0 {
+		for _, e := range m.AllowedCalls {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	l = m.SpendLimit.Size()
+	n += 1 + l + sovAuthz(uint64(l))
+	return n
+}
+
+func (m *AllowedContractCall) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if len(m.Selectors) > 0 {
+		for _, s := range m.Selectors {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthz(x uint64) (n int) {
+	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ContractCallAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractCallAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractCallAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedCalls", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedCalls = append(m.AllowedCalls, AllowedContractCall{})
+			if err := m.AllowedCalls[len(m.AllowedCalls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpendLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllowedContractCall) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllowedContractCall: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllowedContractCall: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selectors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Selectors = append(m.Selectors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuthz(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuthz
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuthz
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuthz
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuthz        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuthz          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuthz = fmt.Errorf("proto: unexpected end of group")
+)
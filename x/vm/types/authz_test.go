@@ -0,0 +1,179 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestContractCallAuthorizationValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name     string
+		auth     *ContractCallAuthorization
+		expError bool
+	}{
+		{
+			"valid authorization",
+			NewContractCallAuthorization([]AllowedContractCall{
+				{Contract: testContract, Selectors: []string{"0xa9059cbb", "0x095ea7b3"}},
+				{Contract: testAddress1},
+			}, sdkmath.NewInt(100)),
+			false,
+		},
+		{
+			"valid authorization without spend limit",
+			NewContractCallAuthorization([]AllowedContractCall{{Contract: testContract}}, sdkmath.ZeroInt()),
+			false,
+		},
+		{
+			"no allowed calls",
+			NewContractCallAuthorization(nil, sdkmath.NewInt(100)),
+			true,
+		},
+		{
+			"nil spend limit",
+			&ContractCallAuthorization{AllowedCalls: []AllowedContractCall{{Contract: testContract}}},
+			true,
+		},
+		{
+			"negative spend limit",
+			NewContractCallAuthorization([]AllowedContractCall{{Contract: testContract}}, sdkmath.NewInt(-1)),
+			true,
+		},
+		{
+			"invalid contract address",
+			NewContractCallAuthorization([]AllowedContractCall{{Contract: "foobar"}}, sdkmath.ZeroInt()),
+			true,
+		},
+		{
+			"duplicate contract",
+			NewContractCallAuthorization([]AllowedContractCall{{Contract: testContract}, {Contract: testContract}}, sdkmath.ZeroInt()),
+			true,
+		},
+		{
+			"invalid selector",
+			NewContractCallAuthorization([]AllowedContractCall{{Contract: testContract, Selectors: []string{"0xa9059c"}}}, sdkmath.ZeroInt()),
+			true,
+		},
+		{
+			"empty selector",
+			NewContractCallAuthorization([]AllowedContractCall{{Contract: testContract, Selectors: []string{""}}}, sdkmath.ZeroInt()),
+			true,
+		},
+		{
+			"duplicate selector",
+			NewContractCallAuthorization([]AllowedContractCall{{Contract: testContract, Selectors: []string{"0xa9059cbb", "0xa9059cbb"}}}, sdkmath.ZeroInt()),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.auth.ValidateBasic()
+			if tc.expError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestContractCallAuthorizationAccept(t *testing.T) {
+	ctx := sdk.Context{}.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	transfer := []byte{0xa9, 0x05, 0x9c, 0xbb, 0x01}
+	approve := []byte{0x09, 0x5e, 0xa7, 0xb3, 0x01}
+
+	auth := NewContractCallAuthorization([]AllowedContractCall{
+		{Contract: testContract, Selectors: []string{"0xa9059cbb"}},
+		{Contract: testAddress1},
+	}, sdkmath.NewInt(100))
+
+	testCases := []struct {
+		name       string
+		msg        sdk.Msg
+		expError   bool
+		expUpdated *ContractCallAuthorization
+	}{
+		{
+			"fail - type mismatch",
+			&banktypes.MsgSend{},
+			true,
+			nil,
+		},
+		{
+			"fail - contract not allowed",
+			&MsgCallEVM{To: testAddress2, Data: transfer},
+			true,
+			nil,
+		},
+		{
+			"fail - function not allowed",
+			&MsgCallEVM{To: testContract, Data: approve},
+			true,
+			nil,
+		},
+		{
+			"fail - fallback function not allowed",
+			&MsgCallEVM{To: testContract, Data: []byte{0xa9}},
+			true,
+			nil,
+		},
+		{
+			"fail - value exceeds spend limit",
+			&MsgCallEVM{To: testContract, Data: transfer, Value: sdkmath.NewInt(101)},
+			true,
+			nil,
+		},
+		{
+			"pass - allowed function without value",
+			&MsgCallEVM{To: testContract, Data: transfer},
+			false,
+			nil,
+		},
+		{
+			"pass - any function of the contract",
+			&MsgCallEVM{To: testAddress1, Data: approve, Value: sdkmath.ZeroInt()},
+			false,
+			nil,
+		},
+		{
+			"pass - value within the spend limit",
+			&MsgCallEVM{To: testContract, Data: transfer, Value: sdkmath.NewInt(40)},
+			false,
+			NewContractCallAuthorization(auth.AllowedCalls, sdkmath.NewInt(60)),
+		},
+		{
+			"pass - whole spend limit",
+			&MsgCallEVM{To: testAddress1, Value: sdkmath.NewInt(100)},
+			false,
+			NewContractCallAuthorization(auth.AllowedCalls, sdkmath.ZeroInt()),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := auth.Accept(ctx, tc.msg)
+			if tc.expError {
+				require.Error(t, err)
+				require.False(t, res.Accept)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, res.Accept)
+			// the authorization is kept for the calls without value
+			require.False(t, res.Delete)
+			if tc.expUpdated == nil {
+				require.Nil(t, res.Updated)
+			} else {
+				require.Equal(t, tc.expUpdated, res.Updated)
+			}
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
 	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 )
 
 var (
@@ -25,7 +26,15 @@ var (
 
 const (
 	// Amino names
-	updateParamsName = "os/evm/MsgUpdateParams"
+	updateParamsName               = "os/evm/MsgUpdateParams"
+	registerPreinstallsName        = "os/evm/MsgRegisterPreinstalls"
+	addAccessControlEntriesName    = "os/evm/MsgAddAccessControlEntries"
+	removeAccessControlEntriesName = "os/evm/MsgRemoveAccessControlEntries"
+	freezeContractName             = "os/evm/MsgFreezeContract"
+	unfreezeContractName           = "os/evm/MsgUnfreezeContract"
+	callEVMName                    = "os/evm/MsgCallEVM"
+	deployContractName             = "os/evm/MsgDeployContract"
+	contractCallAuthorizationName  = "os/evm/ContractCallAuthorization"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -44,6 +53,17 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgEthereumTx{},
 		&MsgUpdateParams{},
+		&MsgRegisterPreinstalls{},
+		&MsgAddAccessControlEntries{},
+		&MsgRemoveAccessControlEntries{},
+		&MsgFreezeContract{},
+		&MsgUnfreezeContract{},
+		&MsgCallEVM{},
+		&MsgDeployContract{},
+	)
+	registry.RegisterImplementations(
+		(*authz.Authorization)(nil),
+		&ContractCallAuthorization{},
 	)
 	registry.RegisterInterface(
 		"os.vm.v1.TxData",
@@ -91,4 +111,12 @@ func UnpackTxData(anyTxData *codectypes.Any) (TxData, error) {
 // RegisterLegacyAminoCodec required for EIP-712
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParamsName, nil)
+	cdc.RegisterConcrete(&MsgRegisterPreinstalls{}, registerPreinstallsName, nil)
+	cdc.RegisterConcrete(&MsgAddAccessControlEntries{}, addAccessControlEntriesName, nil)
+	cdc.RegisterConcrete(&MsgRemoveAccessControlEntries{}, removeAccessControlEntriesName, nil)
+	cdc.RegisterConcrete(&MsgFreezeContract{}, freezeContractName, nil)
+	cdc.RegisterConcrete(&MsgUnfreezeContract{}, unfreezeContractName, nil)
+	cdc.RegisterConcrete(&MsgCallEVM{}, callEVMName, nil)
+	cdc.RegisterConcrete(&MsgDeployContract{}, deployContractName, nil)
+	cdc.RegisterConcrete(&ContractCallAuthorization{}, contractCallAuthorizationName, nil)
 }
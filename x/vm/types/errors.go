@@ -30,6 +30,14 @@ const (
 	codeErrInactivePrecompile
 	codeErrABIPack
 	codeErrABIUnpack
+	codeErrPostTxProcessing
+	codeErrInvalidPreinstall
+	codeErrAccessControlEntryNotFound
+	codeErrContractFrozen
+	codeErrContractNotFrozen
+	codeErrInvalidCallback
+	codeErrCallbackFailed
+	codeErrModuleAccountRecipient
 )
 
 var (
@@ -86,6 +94,30 @@ var (
 
 	// ErrABIUnpack returns an error if the contract ABI unpacking fails
 	ErrABIUnpack = errorsmod.Register(ModuleName, codeErrABIUnpack, "contract ABI unpack failed")
+
+	// ErrPostTxProcessing returns an error if the post-transaction processing hooks fail
+	ErrPostTxProcessing = errorsmod.Register(ModuleName, codeErrPostTxProcessing, "failed to execute post transaction processing")
+
+	// ErrInvalidPreinstall returns an error if a preinstall is invalid or collides with the existing state
+	ErrInvalidPreinstall = errorsmod.Register(ModuleName, codeErrInvalidPreinstall, "invalid preinstall")
+
+	// ErrAccessControlEntryNotFound returns an error if there is no access control entry for a contract function
+	ErrAccessControlEntryNotFound = errorsmod.Register(ModuleName, codeErrAccessControlEntryNotFound, "access control entry not found")
+
+	// ErrContractFrozen returns an error if a contract is frozen by governance
+	ErrContractFrozen = errorsmod.Register(ModuleName, codeErrContractFrozen, "contract is frozen")
+
+	// ErrContractNotFrozen returns an error if a contract is expected to be frozen but it's not
+	ErrContractNotFrozen = errorsmod.Register(ModuleName, codeErrContractNotFrozen, "contract is not frozen")
+
+	// ErrInvalidCallback returns an error if the IBC callback defined in a packet memo is invalid
+	ErrInvalidCallback = errorsmod.Register(ModuleName, codeErrInvalidCallback, "invalid IBC callback")
+
+	// ErrCallbackFailed returns an error if the execution of an IBC callback failed
+	ErrCallbackFailed = errorsmod.Register(ModuleName, codeErrCallbackFailed, "IBC callback failed")
+
+	// ErrModuleAccountRecipient returns an error if the EVM module account is called or receives funds from the EVM
+	ErrModuleAccountRecipient = errorsmod.Register(ModuleName, codeErrModuleAccountRecipient, "EVM module account cannot receive funds")
 )
 
 // NewExecErrorWithReason unpacks the revert return bytes and returns a wrapped error
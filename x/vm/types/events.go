@@ -7,6 +7,13 @@ const (
 	EventTypeTxLog      = "tx_log"
 	EventTypeFeeMarket  = "evm_fee_market"
 
+	EventTypeRegisterPreinstall       = "register_preinstall"
+	EventTypeAddAccessControlEntry    = "add_access_control_entry"
+	EventTypeRemoveAccessControlEntry = "remove_access_control_entry"
+	EventTypeFreezeContract           = "freeze_contract"
+	EventTypeUnfreezeContract         = "unfreeze_contract"
+	EventTypeIBCCallback              = "ibc_callback"
+
 	AttributeKeyBaseFee         = "base_fee"
 	AttributeKeyContractAddress = "contract"
 	AttributeKeyRecipient       = "recipient"
@@ -16,6 +23,18 @@ const (
 	AttributeKeyTxGasUsed       = "txGasUsed"
 	AttributeKeyTxType          = "txType"
 	AttributeKeyTxLog           = "txLog"
+	AttributeKeySyntheticTx     = "syntheticTx"
+	AttributeKeyPreinstallName  = "name"
+	AttributeKeyCodeHash        = "code_hash"
+	AttributeKeySelector        = "selector"
+	AttributeKeyAccessType      = "access_type"
+	AttributeKeyAddresses       = "addresses"
+	AttributeKeyCallbackType    = "callback_type"
+	AttributeKeyCallbackGas     = "callback_gas_limit"
+	AttributeKeyCallbackResult  = "callback_result"
+	AttributeKeyCallbackError   = "callback_error"
+	AttributeKeyPacketSequence  = "packet_sequence"
+	AttributeKeyPacketChannel   = "packet_channel"
 
 	// tx failed in eth vm execution
 	AttributeKeyEthereumTxFailed = "ethereumTxFailed"
@@ -6,6 +6,7 @@ package types
 import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -76,6 +77,10 @@ type Params struct {
 	// active_static_precompiles defines the slice of hex addresses of the
 	// precompiled contracts that are active
 	ActiveStaticPrecompiles []string `protobuf:"bytes,10,rep,name=active_static_precompiles,json=activeStaticPrecompiles,proto3" json:"active_static_precompiles,omitempty"`
+	// emergency_authority defines the bech32 address of the account that can
+	// freeze and unfreeze contracts without a governance proposal. It is
+	// disabled if empty.
+	EmergencyAuthority string `protobuf:"bytes,11,opt,name=emergency_authority,json=emergencyAuthority,proto3" json:"emergency_authority,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -160,6 +165,13 @@ func (m *Params) GetActiveStaticPrecompiles() []string {
 	return nil
 }
 
+func (m *Params) GetEmergencyAuthority() string {
+	if m != nil {
+		return m.EmergencyAuthority
+	}
+	return ""
+}
+
 // AccessControl defines the permission policy of the EVM
 // for creating and calling contracts
 type AccessControl struct {
@@ -277,6 +289,85 @@ func (m *AccessControlType) GetAccessControlList() []string {
 	return nil
 }
 
+// AccessControlEntry defines a call permission rule for a contract, or for one
+// of its functions. It applies on top of the call policy of the AccessControl
+// parameters.
+type AccessControlEntry struct {
+	// contract defines the hex address of the contract the rule applies to
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// selector defines the hex 4-byte function selector the rule applies to. The
+	// rule applies to all the calls to the contract if empty.
+	Selector string `protobuf:"bytes,2,opt,name=selector,proto3" json:"selector,omitempty"`
+	// access_type defines which type of permission is required to perform the
+	// call
+	AccessType AccessType `protobuf:"varint,3,opt,name=access_type,json=accessType,proto3,enum=cosmos.evm.vm.v1.AccessType" json:"access_type,omitempty" yaml:"access_type"`
+	// access_control_list defines the list of addresses that are blocked from
+	// (ACCESS_TYPE_PERMISSIONLESS) or allowed to (ACCESS_TYPE_PERMISSIONED)
+	// perform the call
+	AccessControlList []string `protobuf:"bytes,4,rep,name=access_control_list,json=accessControlList,proto3" json:"access_control_list,omitempty" yaml:"access_control_list"`
+}
+
+func (m *AccessControlEntry) Reset()         { *m = AccessControlEntry{} }
+func (m *AccessControlEntry) String() string { return proto.CompactTextString(m) }
+func (*AccessControlEntry) ProtoMessage()    {}
+func (*AccessControlEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1129b8db63d55c7, []int{3}
+}
+func (m *AccessControlEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccessControlEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccessControlEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccessControlEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccessControlEntry.Merge(m, src)
+}
+func (m *AccessControlEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccessControlEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccessControlEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccessControlEntry proto.InternalMessageInfo
+
+func (m *AccessControlEntry) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *AccessControlEntry) GetSelector() string {
+	if m != nil {
+		return m.Selector
+	}
+	return ""
+}
+
+func (m *AccessControlEntry) GetAccessType() AccessType {
+	if m != nil {
+		return m.AccessType
+	}
+	return AccessTypePermissionless
+}
+
+func (m *AccessControlEntry) GetAccessControlList() []string {
+	if m != nil {
+		return m.AccessControlList
+	}
+	return nil
+}
+
 // ChainConfig defines the Ethereum ChainConfig parameters using *sdk.Int values
 // instead of *big.Int.
 type ChainConfig struct {
@@ -340,7 +431,7 @@ func (m *ChainConfig) Reset()         { *m = ChainConfig{} }
 func (m *ChainConfig) String() string { return proto.CompactTextString(m) }
 func (*ChainConfig) ProtoMessage()    {}
 func (*ChainConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{3}
+	return fileDescriptor_d1129b8db63d55c7, []int{4}
 }
 func (m *ChainConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -416,7 +507,7 @@ func (m *State) Reset()         { *m = State{} }
 func (m *State) String() string { return proto.CompactTextString(m) }
 func (*State) ProtoMessage()    {}
 func (*State) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{4}
+	return fileDescriptor_d1129b8db63d55c7, []int{5}
 }
 func (m *State) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -473,7 +564,7 @@ func (m *TransactionLogs) Reset()         { *m = TransactionLogs{} }
 func (m *TransactionLogs) String() string { return proto.CompactTextString(m) }
 func (*TransactionLogs) ProtoMessage()    {}
 func (*TransactionLogs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{5}
+	return fileDescriptor_d1129b8db63d55c7, []int{6}
 }
 func (m *TransactionLogs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -549,7 +640,7 @@ func (m *Log) Reset()         { *m = Log{} }
 func (m *Log) String() string { return proto.CompactTextString(m) }
 func (*Log) ProtoMessage()    {}
 func (*Log) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{6}
+	return fileDescriptor_d1129b8db63d55c7, []int{7}
 }
 func (m *Log) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -664,7 +755,7 @@ func (m *TxResult) Reset()         { *m = TxResult{} }
 func (m *TxResult) String() string { return proto.CompactTextString(m) }
 func (*TxResult) ProtoMessage()    {}
 func (*TxResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{7}
+	return fileDescriptor_d1129b8db63d55c7, []int{8}
 }
 func (m *TxResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -705,7 +796,7 @@ func (m *AccessTuple) Reset()         { *m = AccessTuple{} }
 func (m *AccessTuple) String() string { return proto.CompactTextString(m) }
 func (*AccessTuple) ProtoMessage()    {}
 func (*AccessTuple) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{8}
+	return fileDescriptor_d1129b8db63d55c7, []int{9}
 }
 func (m *AccessTuple) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -765,7 +856,7 @@ func (m *TraceConfig) Reset()         { *m = TraceConfig{} }
 func (m *TraceConfig) String() string { return proto.CompactTextString(m) }
 func (*TraceConfig) ProtoMessage()    {}
 func (*TraceConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{9}
+	return fileDescriptor_d1129b8db63d55c7, []int{10}
 }
 func (m *TraceConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -871,11 +962,78 @@ func (m *TraceConfig) GetTracerJsonConfig() string {
 	return ""
 }
 
+// Preinstall defines a contract that is deployed at a fixed address, either at
+// genesis or through governance, without going through a deployment
+// transaction.
+type Preinstall struct {
+	// name of the preinstall contract
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// address in hex format of the preinstall contract
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// code in hex format for the preinstall contract
+	Code string `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (m *Preinstall) Reset()         { *m = Preinstall{} }
+func (m *Preinstall) String() string { return proto.CompactTextString(m) }
+func (*Preinstall) ProtoMessage()    {}
+func (*Preinstall) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1129b8db63d55c7, []int{11}
+}
+func (m *Preinstall) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Preinstall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Preinstall.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Preinstall) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Preinstall.Merge(m, src)
+}
+func (m *Preinstall) XXX_Size() int {
+	return m.Size()
+}
+func (m *Preinstall) XXX_DiscardUnknown() {
+	xxx_messageInfo_Preinstall.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Preinstall proto.InternalMessageInfo
+
+func (m *Preinstall) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Preinstall) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Preinstall) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.evm.vm.v1.AccessType", AccessType_name, AccessType_value)
 	proto.RegisterType((*Params)(nil), "cosmos.evm.vm.v1.Params")
 	proto.RegisterType((*AccessControl)(nil), "cosmos.evm.vm.v1.AccessControl")
 	proto.RegisterType((*AccessControlType)(nil), "cosmos.evm.vm.v1.AccessControlType")
+	proto.RegisterType((*AccessControlEntry)(nil), "cosmos.evm.vm.v1.AccessControlEntry")
 	proto.RegisterType((*ChainConfig)(nil), "cosmos.evm.vm.v1.ChainConfig")
 	proto.RegisterType((*State)(nil), "cosmos.evm.vm.v1.State")
 	proto.RegisterType((*TransactionLogs)(nil), "cosmos.evm.vm.v1.TransactionLogs")
@@ -883,135 +1041,144 @@ func init() {
 	proto.RegisterType((*TxResult)(nil), "cosmos.evm.vm.v1.TxResult")
 	proto.RegisterType((*AccessTuple)(nil), "cosmos.evm.vm.v1.AccessTuple")
 	proto.RegisterType((*TraceConfig)(nil), "cosmos.evm.vm.v1.TraceConfig")
+	proto.RegisterType((*Preinstall)(nil), "cosmos.evm.vm.v1.Preinstall")
 }
 
 func init() { proto.RegisterFile("cosmos/evm/vm/v1/evm.proto", fileDescriptor_d1129b8db63d55c7) }
 
 var fileDescriptor_d1129b8db63d55c7 = []byte{
-	// 1956 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x58, 0x4d, 0x6f, 0x1b, 0xc7,
-	0xf9, 0x17, 0xa5, 0x95, 0xb4, 0x1c, 0x52, 0xe4, 0x6a, 0x44, 0xc9, 0x34, 0x9d, 0xbf, 0x96, 0xff,
-	0x6d, 0x0f, 0xaa, 0x91, 0x4a, 0xb6, 0x1c, 0xb5, 0x86, 0xd3, 0x17, 0x88, 0x32, 0xd3, 0x4a, 0x95,
-	0x1d, 0x61, 0xa8, 0x34, 0x48, 0xd1, 0x62, 0x31, 0xdc, 0x9d, 0x90, 0x1b, 0xed, 0xee, 0x10, 0x3b,
-	0x43, 0x9a, 0xec, 0x27, 0x08, 0x7c, 0x4a, 0x81, 0x5e, 0x0d, 0x04, 0xe8, 0xa5, 0xc7, 0x7c, 0x84,
-	0x1e, 0x83, 0x9c, 0x72, 0x2c, 0x0a, 0x74, 0x51, 0xd0, 0x87, 0x00, 0x3a, 0xea, 0x13, 0x14, 0xf3,
-	0xc2, 0x57, 0x29, 0x8a, 0x0a, 0x10, 0xc2, 0x3c, 0x6f, 0xbf, 0xdf, 0xf3, 0xcc, 0x3c, 0xb3, 0x33,
-	0x23, 0x50, 0xf1, 0x28, 0x8b, 0x28, 0xdb, 0x23, 0xbd, 0x68, 0x4f, 0xfc, 0x1e, 0x8b, 0xd1, 0x6e,
-	0x27, 0xa1, 0x9c, 0x42, 0x4b, 0xd9, 0x76, 0x85, 0x46, 0xfc, 0x1e, 0x57, 0xd6, 0x71, 0x14, 0xc4,
-	0x74, 0x4f, 0xfe, 0x55, 0x4e, 0x95, 0x52, 0x8b, 0xb6, 0xa8, 0x1c, 0xee, 0x89, 0x91, 0xd2, 0x3a,
-	0x7f, 0x35, 0xc0, 0xca, 0x19, 0x4e, 0x70, 0xc4, 0xe0, 0x63, 0x90, 0x25, 0xbd, 0xc8, 0xf5, 0x49,
-	0x4c, 0xa3, 0x72, 0xa6, 0x9a, 0xd9, 0xc9, 0xd6, 0x4a, 0x57, 0xa9, 0x6d, 0x0d, 0x70, 0x14, 0x3e,
-	0x73, 0xc6, 0x26, 0x07, 0x99, 0xa4, 0x17, 0x3d, 0x17, 0x43, 0x78, 0x08, 0x00, 0xe9, 0xf3, 0x04,
-	0xbb, 0x24, 0xe8, 0xb0, 0xb2, 0x51, 0x5d, 0xda, 0x59, 0xaa, 0x39, 0xc3, 0xd4, 0xce, 0xd6, 0x85,
-	0xb6, 0x7e, 0x7c, 0xc6, 0xae, 0x52, 0x7b, 0x5d, 0x03, 0x8c, 0x1d, 0x1d, 0x94, 0x95, 0x42, 0x3d,
-	0xe8, 0x30, 0xd8, 0x04, 0x79, 0xaf, 0x8d, 0x83, 0xd8, 0xf5, 0x68, 0xfc, 0x69, 0xd0, 0x2a, 0x2f,
-	0x57, 0x33, 0x3b, 0xb9, 0xfd, 0xff, 0xdb, 0x9d, 0x2f, 0x69, 0xf7, 0x48, 0x78, 0x1d, 0x49, 0xa7,
-	0x5a, 0xf5, 0xeb, 0xd4, 0x5e, 0xb8, 0x4a, 0xed, 0x0d, 0x05, 0x3d, 0x0d, 0xe0, 0xfc, 0xfd, 0xbb,
-	0xaf, 0x1e, 0x66, 0x50, 0xce, 0x9b, 0xb8, 0xc3, 0x7d, 0xb0, 0x89, 0xc3, 0x90, 0xbe, 0x72, 0xbb,
-	0xb1, 0xa8, 0x9a, 0x78, 0x9c, 0xf8, 0x2e, 0xef, 0xb3, 0xf2, 0x4a, 0x35, 0xb3, 0x63, 0xa2, 0x0d,
-	0x69, 0xfc, 0x68, 0x62, 0x3b, 0xef, 0x33, 0xb8, 0x0f, 0xf2, 0xa2, 0x64, 0xaf, 0x8d, 0xe3, 0x98,
-	0x84, 0xac, 0x6c, 0x56, 0x97, 0x76, 0xb2, 0xb5, 0xe2, 0x30, 0xb5, 0x73, 0xf5, 0xdf, 0xbf, 0x38,
-	0xd2, 0x6a, 0x94, 0x23, 0xbd, 0x68, 0x24, 0xc0, 0x3f, 0x81, 0x02, 0xf6, 0x3c, 0xc2, 0x98, 0xc8,
-	0x85, 0x27, 0x34, 0x2c, 0x67, 0x65, 0x35, 0xf6, 0xf5, 0x6a, 0x0e, 0xa5, 0xdf, 0x91, 0x72, 0xab,
-	0x6d, 0x8a, 0x7a, 0x86, 0xa9, 0xbd, 0x36, 0xa3, 0x46, 0x6b, 0x78, 0x5a, 0x84, 0xcf, 0xc0, 0x7d,
-	0xec, 0xf1, 0xa0, 0x47, 0x5c, 0xc6, 0x31, 0x0f, 0x3c, 0xb7, 0x93, 0x10, 0x8f, 0x46, 0x9d, 0x20,
-	0x24, 0xac, 0x0c, 0x44, 0x7e, 0xe8, 0x9e, 0x72, 0x68, 0x48, 0xfb, 0xd9, 0xc4, 0xfc, 0xec, 0xc1,
-	0xeb, 0xef, 0xbe, 0x7a, 0xb8, 0x35, 0xd5, 0x43, 0x7d, 0xd1, 0x45, 0x6a, 0xe5, 0x4f, 0x0c, 0x73,
-	0xd1, 0x5a, 0x3a, 0x31, 0xcc, 0x25, 0xcb, 0x38, 0x31, 0xcc, 0x55, 0xcb, 0x74, 0xfe, 0x92, 0x01,
-	0xb3, 0xb9, 0xc0, 0x43, 0xb0, 0xe2, 0x25, 0x04, 0x73, 0x22, 0x5b, 0x23, 0xb7, 0xff, 0xa3, 0x1f,
-	0xa8, 0xe9, 0x7c, 0xd0, 0x21, 0x35, 0x43, 0xd4, 0x85, 0x74, 0x20, 0xfc, 0x25, 0x30, 0x3c, 0x1c,
-	0x86, 0xe5, 0xc5, 0xff, 0x15, 0x40, 0x86, 0x39, 0xff, 0xce, 0x80, 0xf5, 0x6b, 0x1e, 0xd0, 0x03,
-	0x39, 0x3d, 0xe7, 0x7c, 0xd0, 0x51, 0xc9, 0x15, 0xf6, 0xdf, 0xf9, 0x3e, 0x6c, 0x09, 0xfa, 0xe3,
-	0x61, 0x6a, 0x83, 0x89, 0x7c, 0x95, 0xda, 0x50, 0xf5, 0xd1, 0x14, 0x90, 0x83, 0x00, 0x1e, 0x7b,
-	0x40, 0x0f, 0x6c, 0xcc, 0x2e, 0xac, 0x1b, 0x06, 0x8c, 0x97, 0x17, 0x65, 0x4f, 0x3c, 0x19, 0xa6,
-	0xf6, 0x6c, 0x62, 0xa7, 0x01, 0xe3, 0x57, 0xa9, 0x5d, 0x99, 0x41, 0x9d, 0x8e, 0x74, 0xd0, 0x3a,
-	0x9e, 0x0f, 0x70, 0xbe, 0x29, 0x82, 0xdc, 0x54, 0x93, 0xc3, 0x3f, 0x82, 0x62, 0x9b, 0x46, 0x84,
-	0x71, 0x82, 0x7d, 0xb7, 0x19, 0x52, 0xef, 0x42, 0xef, 0xca, 0x27, 0xff, 0x4a, 0xed, 0x4d, 0x55,
-	0x20, 0xf3, 0x2f, 0x76, 0x03, 0xba, 0x17, 0x61, 0xde, 0xde, 0x3d, 0x8e, 0x05, 0xe9, 0x96, 0x22,
-	0x9d, 0x8b, 0x74, 0x50, 0x61, 0xac, 0xa9, 0x09, 0x05, 0x6c, 0x83, 0x82, 0x8f, 0xa9, 0xfb, 0x29,
-	0x4d, 0x2e, 0x34, 0xf8, 0xa2, 0x04, 0xaf, 0x7d, 0x2f, 0xf8, 0x30, 0xb5, 0xf3, 0xcf, 0x0f, 0x3f,
-	0xfc, 0x80, 0x26, 0x17, 0x12, 0xe2, 0x2a, 0xb5, 0x37, 0x15, 0xd9, 0x2c, 0x90, 0x83, 0xf2, 0x3e,
-	0xa6, 0x63, 0x37, 0xf8, 0x31, 0xb0, 0xc6, 0x0e, 0xac, 0xdb, 0xe9, 0xd0, 0x84, 0x97, 0x97, 0xc4,
-	0xc6, 0xab, 0xfd, 0x74, 0x98, 0xda, 0x05, 0x0d, 0xd9, 0x50, 0x96, 0xab, 0xd4, 0xbe, 0x37, 0x07,
-	0xaa, 0x63, 0x1c, 0x54, 0xd0, 0xb0, 0xda, 0x55, 0x7c, 0x3a, 0x48, 0xd0, 0x79, 0x7c, 0xf0, 0x48,
-	0x17, 0x60, 0xc8, 0x02, 0x7e, 0x7d, 0x5b, 0x01, 0xb9, 0xfa, 0xf1, 0xd9, 0xe3, 0x83, 0x47, 0xa3,
-	0xfc, 0xf5, 0xf7, 0x63, 0x1a, 0xc5, 0x41, 0x39, 0x25, 0xaa, 0xe4, 0x8f, 0x81, 0x16, 0xdd, 0x36,
-	0x66, 0x6d, 0xf9, 0x75, 0xca, 0xd6, 0x76, 0x44, 0x03, 0x29, 0xa4, 0xdf, 0x62, 0xd6, 0x9e, 0xcc,
-	0x7a, 0x73, 0xf0, 0x67, 0x1c, 0xf3, 0xa0, 0x1b, 0x8d, 0xb0, 0x80, 0x0a, 0x16, 0x5e, 0xe3, 0x74,
-	0x0f, 0x74, 0xba, 0x2b, 0x77, 0x4d, 0xf7, 0xe0, 0xa6, 0x74, 0x0f, 0x66, 0xd3, 0x55, 0x3e, 0x63,
-	0x8e, 0xa7, 0x9a, 0x63, 0xf5, 0xae, 0x1c, 0x4f, 0x6f, 0xe2, 0x78, 0x3a, 0xcb, 0xa1, 0x7c, 0x44,
-	0x5f, 0xce, 0xd5, 0x59, 0x36, 0xef, 0xdc, 0x97, 0xd7, 0x66, 0xa8, 0x30, 0xd6, 0x28, 0xf4, 0x0b,
-	0x50, 0xf2, 0x68, 0xcc, 0xb8, 0xd0, 0xc5, 0xb4, 0x13, 0x12, 0x4d, 0x91, 0x95, 0x14, 0x4f, 0x6f,
-	0xa3, 0x78, 0xa0, 0x4f, 0x83, 0x1b, 0xc2, 0x1d, 0xb4, 0x31, 0xab, 0x56, 0x64, 0x2e, 0xb0, 0x3a,
-	0x84, 0x93, 0x84, 0x35, 0xbb, 0x49, 0x4b, 0x13, 0x01, 0x49, 0xf4, 0xde, 0x6d, 0x44, 0xba, 0x43,
-	0xe7, 0x43, 0x1d, 0x54, 0x9c, 0xa8, 0x14, 0xc1, 0x27, 0xa0, 0x10, 0x08, 0xd6, 0x66, 0x37, 0xd4,
-	0xf0, 0x39, 0x09, 0xbf, 0x7f, 0x1b, 0xbc, 0xde, 0x55, 0xb3, 0x81, 0x0e, 0x5a, 0x1b, 0x29, 0x14,
-	0xb4, 0x0f, 0x60, 0xd4, 0x0d, 0x12, 0xb7, 0x15, 0x62, 0x2f, 0x20, 0x89, 0x86, 0xcf, 0x4b, 0xf8,
-	0x9f, 0xdd, 0x06, 0x7f, 0x5f, 0xc1, 0x5f, 0x0f, 0x76, 0x90, 0x25, 0x94, 0xbf, 0x51, 0x3a, 0xc5,
-	0xd2, 0x00, 0xf9, 0x26, 0x49, 0xc2, 0x20, 0xd6, 0xf8, 0x6b, 0x12, 0xff, 0xd1, 0x6d, 0xf8, 0xba,
-	0x83, 0xa6, 0xc3, 0x1c, 0x94, 0x53, 0xe2, 0x18, 0x34, 0xa4, 0xb1, 0x4f, 0x47, 0xa0, 0xeb, 0x77,
-	0x06, 0x9d, 0x0e, 0x73, 0x50, 0x4e, 0x89, 0x0a, 0xb4, 0x05, 0x36, 0x70, 0x92, 0xd0, 0x57, 0x73,
-	0x13, 0x02, 0x25, 0xf6, 0xcf, 0x6f, 0xc3, 0x1e, 0x7d, 0xa7, 0xaf, 0x47, 0x8b, 0xef, 0xb4, 0xd0,
-	0xce, 0x4c, 0x89, 0x0f, 0x60, 0x2b, 0xc1, 0x83, 0x39, 0x9e, 0xd2, 0x9d, 0x27, 0xfe, 0x7a, 0xb0,
-	0x83, 0x2c, 0xa1, 0x9c, 0x61, 0xf9, 0x0c, 0x94, 0x22, 0x92, 0xb4, 0x88, 0x1b, 0x13, 0xce, 0x3a,
-	0x61, 0xc0, 0x35, 0xcf, 0xe6, 0x9d, 0xf7, 0xc1, 0x4d, 0xe1, 0x0e, 0x82, 0x52, 0xfd, 0x52, 0x6b,
-	0xc7, 0x5d, 0xca, 0xda, 0x38, 0x6e, 0xb5, 0x71, 0xa0, 0x59, 0xb6, 0xee, 0xdc, 0xa5, 0xb3, 0x81,
-	0x0e, 0x5a, 0x1b, 0x29, 0xc6, 0x4b, 0xed, 0xe1, 0xd8, 0xeb, 0x8e, 0x96, 0xfa, 0xde, 0x9d, 0x97,
-	0x7a, 0x3a, 0xcc, 0x41, 0x39, 0x25, 0x2a, 0xd0, 0xfb, 0xc0, 0x54, 0x57, 0xbe, 0xc0, 0x2f, 0x97,
-	0xab, 0x99, 0x1d, 0x03, 0xad, 0x4a, 0xf9, 0xd8, 0x87, 0x25, 0xb0, 0xac, 0x2e, 0xb0, 0xf7, 0x05,
-	0x11, 0x52, 0x02, 0xac, 0x00, 0xd3, 0x27, 0x5e, 0x10, 0xe1, 0x90, 0x95, 0x2b, 0x32, 0x60, 0x2c,
-	0x9f, 0x18, 0x66, 0xc1, 0x2a, 0x9e, 0x18, 0x66, 0xd1, 0xb2, 0x4e, 0x0c, 0xd3, 0xb2, 0xd6, 0x4f,
-	0x0c, 0x73, 0xc3, 0x2a, 0xa1, 0xb5, 0x01, 0x0d, 0xa9, 0xdb, 0x7b, 0xa2, 0x32, 0x40, 0x39, 0xf2,
-	0x0a, 0x33, 0xfd, 0xd5, 0x42, 0x05, 0x0f, 0x73, 0x1c, 0x0e, 0x98, 0x9e, 0x55, 0x64, 0xa9, 0xb9,
-	0x9e, 0x3a, 0x03, 0xf7, 0xc0, 0xb2, 0xb8, 0x84, 0x11, 0x68, 0x81, 0xa5, 0x0b, 0x32, 0x50, 0x27,
-	0x37, 0x12, 0x43, 0x91, 0x62, 0x0f, 0x87, 0x5d, 0xa2, 0x0e, 0x5c, 0xa4, 0x04, 0xe7, 0x0c, 0x14,
-	0xcf, 0x13, 0x1c, 0x33, 0x71, 0x81, 0xa3, 0xf1, 0x29, 0x6d, 0x31, 0x08, 0x81, 0x21, 0x0f, 0x1d,
-	0x15, 0x2b, 0xc7, 0xf0, 0x27, 0xc0, 0x08, 0x69, 0x8b, 0xc9, 0xab, 0x47, 0x6e, 0x7f, 0xf3, 0xfa,
-	0x3d, 0xe7, 0x94, 0xb6, 0x90, 0x74, 0x71, 0xbe, 0x59, 0x04, 0x4b, 0xa7, 0xb4, 0x05, 0xcb, 0x60,
-	0x15, 0xfb, 0x7e, 0x42, 0x18, 0xd3, 0x48, 0x23, 0x11, 0x6e, 0x81, 0x15, 0x4e, 0x3b, 0x81, 0xa7,
-	0xe0, 0xb2, 0x48, 0x4b, 0x82, 0xd8, 0xc7, 0x1c, 0xcb, 0x53, 0x3a, 0x8f, 0xe4, 0x58, 0xdc, 0x87,
-	0x65, 0x65, 0x6e, 0xdc, 0x8d, 0x9a, 0x24, 0x91, 0x87, 0xad, 0x51, 0x2b, 0x5e, 0xa6, 0x76, 0x4e,
-	0xea, 0x5f, 0x4a, 0x35, 0x9a, 0x16, 0xe0, 0xbb, 0x60, 0x95, 0xf7, 0xa7, 0x0f, 0xce, 0x8d, 0xcb,
-	0xd4, 0x2e, 0xf2, 0x49, 0x99, 0xe2, 0x5c, 0x44, 0x2b, 0xbc, 0x2f, 0xcf, 0xc7, 0x3d, 0x60, 0xf2,
-	0xbe, 0x1b, 0xc4, 0x3e, 0xe9, 0xcb, 0xb3, 0xd1, 0xa8, 0x95, 0x2e, 0x53, 0xdb, 0x9a, 0x72, 0x3f,
-	0x16, 0x36, 0xb4, 0xca, 0xfb, 0x72, 0x00, 0xdf, 0x05, 0x40, 0xa5, 0x24, 0x19, 0xd4, 0x51, 0xb7,
-	0x76, 0x99, 0xda, 0x59, 0xa9, 0x95, 0xd8, 0x93, 0x21, 0x74, 0xc0, 0xb2, 0xc2, 0x36, 0x25, 0x76,
-	0xfe, 0x32, 0xb5, 0xcd, 0x90, 0xb6, 0x14, 0xa6, 0x32, 0x89, 0xa9, 0x4a, 0x48, 0x44, 0x7b, 0xc4,
-	0x97, 0xe7, 0x8d, 0x89, 0x46, 0xa2, 0xf3, 0xc5, 0x22, 0x30, 0xcf, 0xfb, 0x88, 0xb0, 0x6e, 0xc8,
-	0xe1, 0x07, 0xc0, 0x92, 0xb7, 0x39, 0xec, 0x71, 0x77, 0x66, 0x6a, 0x6b, 0x0f, 0x26, 0xa7, 0xc3,
-	0xbc, 0x87, 0x83, 0x8a, 0x23, 0xd5, 0xa1, 0x9e, 0xff, 0x12, 0x58, 0x6e, 0x86, 0x94, 0x46, 0xb2,
-	0x13, 0xf2, 0x48, 0x09, 0xf0, 0x63, 0x39, 0x6b, 0x72, 0x95, 0x97, 0xe4, 0x4d, 0xf9, 0xff, 0xaf,
-	0xaf, 0xf2, 0x5c, 0xab, 0xd4, 0x1e, 0xe8, 0x07, 0x51, 0x41, 0x71, 0xeb, 0x78, 0xfd, 0x16, 0x5a,
-	0xe1, 0x7d, 0xd9, 0x4f, 0x16, 0x58, 0x4a, 0x08, 0x97, 0x2b, 0x97, 0x47, 0x62, 0x28, 0xf6, 0x45,
-	0x42, 0x7a, 0x24, 0xe1, 0xc4, 0x97, 0x2b, 0x64, 0xa2, 0xb1, 0x2c, 0x36, 0x59, 0x0b, 0x33, 0xb7,
-	0xcb, 0x88, 0xaf, 0x96, 0x03, 0xad, 0xb6, 0x30, 0xfb, 0x88, 0x11, 0xff, 0x99, 0xf1, 0xf9, 0x97,
-	0xf6, 0x82, 0x83, 0x41, 0x4e, 0x5f, 0xa2, 0xbb, 0x9d, 0x90, 0xdc, 0xd2, 0x66, 0xfb, 0x20, 0xcf,
-	0x38, 0x4d, 0x70, 0x8b, 0xb8, 0x17, 0x64, 0xa0, 0x9b, 0x4d, 0xb5, 0x8e, 0xd6, 0xff, 0x8e, 0x0c,
-	0x18, 0x9a, 0x16, 0x34, 0xc5, 0x97, 0x06, 0xc8, 0x9d, 0x27, 0xd8, 0x23, 0xfa, 0x4a, 0x2c, 0x1a,
-	0x56, 0x88, 0x89, 0xa6, 0xd0, 0x92, 0xe0, 0xe6, 0x41, 0x44, 0x68, 0x97, 0xeb, 0x4d, 0x35, 0x12,
-	0x45, 0x44, 0x42, 0x48, 0x9f, 0x78, 0x72, 0x2e, 0x0d, 0xa4, 0x25, 0x78, 0x00, 0xd6, 0xfc, 0x80,
-	0xe1, 0x66, 0x28, 0x1f, 0x53, 0xde, 0x85, 0x2a, 0xbf, 0x66, 0x5d, 0xa6, 0x76, 0x5e, 0x1b, 0x1a,
-	0x42, 0x8f, 0x66, 0x24, 0xf8, 0x3e, 0x28, 0x4e, 0xc2, 0x64, 0xb6, 0xea, 0x0d, 0x59, 0x83, 0x97,
-	0xa9, 0x5d, 0x18, 0xbb, 0x4a, 0x0b, 0x9a, 0x93, 0xd5, 0xb7, 0xa9, 0xd9, 0x6d, 0xc9, 0x0e, 0x34,
-	0x91, 0x12, 0x84, 0x36, 0x0c, 0xa2, 0x80, 0xcb, 0x8e, 0x5b, 0x46, 0x4a, 0x80, 0xef, 0x83, 0x2c,
-	0xed, 0x91, 0x24, 0x09, 0x7c, 0xf9, 0xb6, 0xfb, 0xe1, 0x37, 0x31, 0x9a, 0xf8, 0x8b, 0xe2, 0x48,
-	0x2c, 0x93, 0x8c, 0x48, 0x44, 0x93, 0x81, 0xbc, 0x74, 0xe8, 0xe2, 0x94, 0xe1, 0x85, 0xd4, 0xa3,
-	0x19, 0x09, 0xd6, 0x00, 0xd4, 0x61, 0x09, 0xe1, 0xdd, 0x24, 0x76, 0xe5, 0x47, 0x20, 0x2f, 0x63,
-	0xe5, 0x56, 0x54, 0x56, 0x24, 0x8d, 0xcf, 0x31, 0xc7, 0xe8, 0x9a, 0x06, 0xfe, 0x0a, 0x40, 0xb5,
-	0x26, 0xee, 0x67, 0x8c, 0x8e, 0x1f, 0xf5, 0xea, 0xd6, 0x20, 0xf9, 0x95, 0x55, 0xe7, 0x6c, 0x29,
-	0xe9, 0x84, 0x51, 0x5d, 0xc5, 0x89, 0x61, 0x1a, 0xd6, 0xb2, 0x7a, 0x84, 0x8e, 0xe7, 0x4f, 0x57,
-	0x81, 0x36, 0x46, 0xf2, 0x54, 0x7a, 0x0f, 0xff, 0x91, 0x01, 0x53, 0x6f, 0x39, 0xf8, 0x0b, 0x50,
-	0x39, 0x3c, 0x3a, 0xaa, 0x37, 0x1a, 0xee, 0xf9, 0x27, 0x67, 0x75, 0xf7, 0xac, 0x8e, 0x5e, 0x1c,
-	0x37, 0x1a, 0xc7, 0x1f, 0xbe, 0x3c, 0xad, 0x37, 0x1a, 0xd6, 0x42, 0xe5, 0x9d, 0xd7, 0x6f, 0xaa,
-	0xe5, 0x89, 0xff, 0x19, 0x49, 0xa2, 0x80, 0xb1, 0x80, 0xc6, 0xa1, 0xe8, 0xd4, 0xf7, 0xc0, 0xd6,
-	0x74, 0x34, 0xaa, 0x37, 0xce, 0xd1, 0xf1, 0xd1, 0x79, 0xfd, 0xb9, 0x95, 0xa9, 0x94, 0x5f, 0xbf,
-	0xa9, 0x96, 0x26, 0x91, 0x88, 0x30, 0x9e, 0x04, 0x9e, 0xd8, 0x29, 0x4f, 0x41, 0xf9, 0x66, 0xce,
-	0xfa, 0x73, 0x6b, 0xb1, 0x52, 0x79, 0xfd, 0xa6, 0xba, 0x75, 0x13, 0x23, 0xf1, 0x2b, 0xc6, 0xe7,
-	0x7f, 0xdb, 0x5e, 0xa8, 0x3d, 0xfb, 0x7a, 0xb8, 0x9d, 0xf9, 0x76, 0xb8, 0x9d, 0xf9, 0xcf, 0x70,
-	0x3b, 0xf3, 0xc5, 0xdb, 0xed, 0x85, 0x6f, 0xdf, 0x6e, 0x2f, 0xfc, 0xf3, 0xed, 0xf6, 0xc2, 0x1f,
-	0xaa, 0xad, 0x80, 0xb7, 0xbb, 0xcd, 0x5d, 0x8f, 0x46, 0x7b, 0xf3, 0x6f, 0x77, 0xf1, 0x4a, 0x65,
-	0xcd, 0x15, 0xf9, 0x6f, 0x9c, 0x27, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x3d, 0xc6, 0xdf, 0xbc,
-	0x1f, 0x12, 0x00, 0x00,
+	// 2081 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcb, 0x6f, 0x1b, 0xc7,
+	0x19, 0x17, 0xa5, 0x95, 0xb4, 0x1c, 0x52, 0xe4, 0x6a, 0xf4, 0x30, 0x4d, 0xa7, 0x5a, 0x76, 0xdb,
+	0x83, 0x6a, 0x24, 0x92, 0x2d, 0x47, 0xad, 0xe1, 0xf4, 0x01, 0x51, 0x66, 0x5a, 0xa9, 0xb2, 0x23,
+	0x0c, 0x95, 0x06, 0x29, 0x5a, 0x2c, 0x86, 0xbb, 0x13, 0x72, 0xa3, 0xdd, 0x1d, 0x62, 0x67, 0x28,
+	0x93, 0xfd, 0x0b, 0x02, 0x9f, 0xd2, 0x4b, 0x6f, 0x06, 0x02, 0xf4, 0xd2, 0x63, 0x0e, 0xfd, 0x03,
+	0x7a, 0x0c, 0x72, 0x0a, 0x7a, 0x2a, 0x0a, 0x74, 0x5b, 0xc8, 0x87, 0x00, 0x3a, 0xea, 0x2f, 0x28,
+	0xe6, 0xc1, 0xe5, 0x43, 0x8a, 0xa2, 0x02, 0x45, 0x00, 0xc1, 0x9e, 0xdf, 0x37, 0xdf, 0xf7, 0xfb,
+	0x1e, 0xf3, 0xed, 0x3c, 0x08, 0xaa, 0x1e, 0x65, 0x11, 0x65, 0xdb, 0xe4, 0x2c, 0xda, 0x16, 0x7f,
+	0x0f, 0xc5, 0x68, 0xab, 0x9b, 0x50, 0x4e, 0xa1, 0xa5, 0xe6, 0xb6, 0x84, 0x44, 0xfc, 0x3d, 0xac,
+	0x2e, 0xe3, 0x28, 0x88, 0xe9, 0xb6, 0xfc, 0x57, 0x29, 0x55, 0xef, 0x2a, 0x25, 0x57, 0xa2, 0x6d,
+	0x6d, 0xa1, 0xa6, 0x56, 0xdb, 0xb4, 0x4d, 0x95, 0x5c, 0x8c, 0x94, 0xd4, 0xf9, 0xb7, 0x01, 0x16,
+	0x8e, 0x71, 0x82, 0x23, 0x06, 0x1f, 0x82, 0x3c, 0x39, 0x8b, 0x5c, 0x9f, 0xc4, 0x34, 0xaa, 0xe4,
+	0x6a, 0xb9, 0xcd, 0x7c, 0x7d, 0xf5, 0x32, 0xb5, 0xad, 0x01, 0x8e, 0xc2, 0x27, 0x4e, 0x36, 0xe5,
+	0x20, 0x93, 0x9c, 0x45, 0x4f, 0xc5, 0x10, 0xee, 0x01, 0x40, 0xfa, 0x3c, 0xc1, 0x2e, 0x09, 0xba,
+	0xac, 0x62, 0xd4, 0xe6, 0x36, 0xe7, 0xea, 0xce, 0x79, 0x6a, 0xe7, 0x1b, 0x42, 0xda, 0x38, 0x38,
+	0x66, 0x97, 0xa9, 0xbd, 0xac, 0x09, 0x32, 0x45, 0x07, 0xe5, 0x25, 0x68, 0x04, 0x5d, 0x06, 0x5b,
+	0xa0, 0xe8, 0x75, 0x70, 0x10, 0xbb, 0x1e, 0x8d, 0x3f, 0x0a, 0xda, 0x95, 0xf9, 0x5a, 0x6e, 0xb3,
+	0xb0, 0xf3, 0xbd, 0xad, 0xe9, 0x6c, 0xb7, 0xf6, 0x85, 0xd6, 0xbe, 0x54, 0xaa, 0xd7, 0xbe, 0x48,
+	0xed, 0x99, 0xcb, 0xd4, 0x5e, 0x51, 0xd4, 0xe3, 0x04, 0xce, 0x5f, 0xbe, 0xfe, 0xfc, 0x7e, 0x0e,
+	0x15, 0xbc, 0x91, 0x3a, 0xdc, 0x01, 0x6b, 0x38, 0x0c, 0xe9, 0x0b, 0xb7, 0x17, 0x8b, 0xac, 0x89,
+	0xc7, 0x89, 0xef, 0xf2, 0x3e, 0xab, 0x2c, 0xd4, 0x72, 0x9b, 0x26, 0x5a, 0x91, 0x93, 0xef, 0x8f,
+	0xe6, 0x4e, 0xfa, 0x0c, 0xee, 0x80, 0xa2, 0x48, 0xd9, 0xeb, 0xe0, 0x38, 0x26, 0x21, 0xab, 0x98,
+	0xb5, 0xb9, 0xcd, 0x7c, 0xbd, 0x7c, 0x9e, 0xda, 0x85, 0xc6, 0x6f, 0x9e, 0xed, 0x6b, 0x31, 0x2a,
+	0x90, 0xb3, 0x68, 0x08, 0xe0, 0xef, 0x41, 0x09, 0x7b, 0x1e, 0x61, 0x4c, 0xc4, 0xc2, 0x13, 0x1a,
+	0x56, 0xf2, 0x32, 0x1b, 0xfb, 0x6a, 0x36, 0x7b, 0x52, 0x6f, 0x5f, 0xa9, 0xd5, 0xd7, 0x44, 0x3e,
+	0xe7, 0xa9, 0xbd, 0x34, 0x21, 0x46, 0x4b, 0x78, 0x1c, 0xc2, 0x27, 0xe0, 0x2e, 0xf6, 0x78, 0x70,
+	0x46, 0x5c, 0xc6, 0x31, 0x0f, 0x3c, 0xb7, 0x9b, 0x10, 0x8f, 0x46, 0xdd, 0x20, 0x24, 0xac, 0x02,
+	0x44, 0x7c, 0xe8, 0x8e, 0x52, 0x68, 0xca, 0xf9, 0xe3, 0xd1, 0x34, 0x3c, 0x00, 0x2b, 0x24, 0x22,
+	0x49, 0x9b, 0xc4, 0xde, 0xc0, 0xc5, 0x3d, 0xde, 0xa1, 0x49, 0xc0, 0x07, 0x95, 0x82, 0x5c, 0xe6,
+	0xca, 0xdf, 0xff, 0xfa, 0xd6, 0xaa, 0x0e, 0x71, 0xcf, 0xf7, 0x13, 0xc2, 0x58, 0x93, 0x27, 0x41,
+	0xdc, 0x46, 0x30, 0x33, 0xda, 0x1b, 0xda, 0x3c, 0xb9, 0xf7, 0xf2, 0xeb, 0xcf, 0xef, 0xaf, 0x8f,
+	0x75, 0x6a, 0x5f, 0xf4, 0xaa, 0x6a, 0xa2, 0x43, 0xc3, 0x9c, 0xb5, 0xe6, 0x0e, 0x0d, 0x73, 0xce,
+	0x32, 0x0e, 0x0d, 0x73, 0xd1, 0x32, 0x9d, 0x3f, 0xe6, 0xc0, 0x64, 0x5a, 0x70, 0x0f, 0x2c, 0x78,
+	0x09, 0xc1, 0x9c, 0xc8, 0x2e, 0x2b, 0xec, 0xfc, 0xe0, 0x5b, 0xca, 0x73, 0x32, 0xe8, 0x92, 0xba,
+	0x21, 0x4a, 0x84, 0xb4, 0x21, 0xfc, 0x19, 0x30, 0x3c, 0x1c, 0x86, 0x95, 0xd9, 0xff, 0x95, 0x40,
+	0x9a, 0x39, 0xff, 0xca, 0x81, 0xe5, 0x2b, 0x1a, 0xd0, 0x03, 0x05, 0xbd, 0x7c, 0x7c, 0xd0, 0x55,
+	0xc1, 0x95, 0x76, 0xde, 0xf8, 0x26, 0x6e, 0x49, 0xfa, 0xc3, 0xf3, 0xd4, 0x06, 0x23, 0x7c, 0x99,
+	0xda, 0x50, 0xb5, 0xe4, 0x18, 0x91, 0x83, 0x00, 0xce, 0x34, 0xa0, 0x07, 0x56, 0x26, 0x7b, 0xc4,
+	0x0d, 0x03, 0xc6, 0x2b, 0xb3, 0xb2, 0xbd, 0x1e, 0x9d, 0xa7, 0xf6, 0x64, 0x60, 0x47, 0x01, 0xe3,
+	0x97, 0xa9, 0x5d, 0x9d, 0x60, 0x1d, 0xb7, 0x74, 0xd0, 0x32, 0x9e, 0x36, 0x70, 0xfe, 0x34, 0x0b,
+	0xe0, 0x04, 0x4d, 0x23, 0xe6, 0xc9, 0x00, 0x56, 0x81, 0x29, 0x4d, 0xb1, 0xc7, 0xd5, 0x07, 0x8e,
+	0x32, 0x2c, 0xe6, 0x18, 0x09, 0x89, 0xc7, 0x69, 0x22, 0xab, 0x9a, 0x47, 0x19, 0x9e, 0x2e, 0xcc,
+	0xdc, 0x77, 0x59, 0x18, 0xe3, 0xff, 0x5a, 0x98, 0x2f, 0xcb, 0xa0, 0x30, 0xb6, 0x91, 0xc0, 0xdf,
+	0x81, 0x72, 0x87, 0x46, 0x84, 0x71, 0x82, 0x7d, 0xb7, 0x15, 0x52, 0xef, 0x54, 0xef, 0x7c, 0x8f,
+	0xfe, 0x99, 0xda, 0x6b, 0x2a, 0x41, 0xe6, 0x9f, 0x6e, 0x05, 0x74, 0x3b, 0xc2, 0xbc, 0xb3, 0x75,
+	0x10, 0x0b, 0xa7, 0xeb, 0xca, 0xe9, 0x94, 0xa5, 0x83, 0x4a, 0x99, 0xa4, 0x2e, 0x04, 0xb0, 0x03,
+	0x4a, 0x3e, 0xa6, 0xee, 0x47, 0x34, 0x39, 0xd5, 0xe4, 0xb2, 0xb2, 0xf5, 0xfa, 0x37, 0x92, 0x9f,
+	0xa7, 0x76, 0xf1, 0xe9, 0xde, 0x7b, 0xef, 0xd2, 0xe4, 0x54, 0x52, 0x5c, 0xa6, 0xf6, 0x9a, 0x72,
+	0x36, 0x49, 0xe4, 0xa0, 0xa2, 0x8f, 0x69, 0xa6, 0x06, 0x3f, 0x00, 0x56, 0xa6, 0xc0, 0x7a, 0xdd,
+	0x2e, 0x4d, 0xb8, 0x5c, 0x26, 0xb3, 0xfe, 0xd6, 0x79, 0x6a, 0x97, 0x34, 0x65, 0x53, 0xcd, 0x5c,
+	0xa6, 0xf6, 0x9d, 0x29, 0x52, 0x6d, 0xe3, 0xa0, 0x92, 0xa6, 0xd5, 0xaa, 0x62, 0x7b, 0x26, 0x41,
+	0xf7, 0xe1, 0xee, 0x03, 0x9d, 0x80, 0x21, 0x13, 0xf8, 0xc5, 0x4d, 0x09, 0x14, 0x1a, 0x07, 0xc7,
+	0x0f, 0x77, 0x1f, 0x0c, 0xe3, 0xd7, 0x7b, 0xf4, 0x38, 0x8b, 0x83, 0x0a, 0x0a, 0xaa, 0xe0, 0x0f,
+	0x80, 0x86, 0x6e, 0x07, 0xb3, 0x8e, 0x3c, 0x01, 0xf2, 0xf5, 0x4d, 0xd1, 0x40, 0x8a, 0xe9, 0x57,
+	0x98, 0x75, 0x46, 0x55, 0x6f, 0x0d, 0xfe, 0x80, 0x63, 0x1e, 0xf4, 0xa2, 0x21, 0x17, 0x50, 0xc6,
+	0x42, 0x2b, 0x0b, 0x77, 0x57, 0x87, 0xbb, 0x70, 0xdb, 0x70, 0x77, 0xaf, 0x0b, 0x77, 0x77, 0x32,
+	0x5c, 0xa5, 0x93, 0xf9, 0x78, 0xac, 0x7d, 0x2c, 0xde, 0xd6, 0xc7, 0xe3, 0xeb, 0x7c, 0x3c, 0x9e,
+	0xf4, 0xa1, 0x74, 0x44, 0x5f, 0x4e, 0xe5, 0x59, 0x31, 0x6f, 0xdd, 0x97, 0x57, 0x2a, 0x54, 0xca,
+	0x24, 0x8a, 0xfd, 0x14, 0xac, 0x7a, 0x34, 0x66, 0x5c, 0xc8, 0x62, 0xda, 0x0d, 0x89, 0x76, 0x91,
+	0x97, 0x2e, 0x1e, 0xdf, 0xe4, 0xe2, 0x9e, 0x72, 0x71, 0x9d, 0xb9, 0x83, 0x56, 0x26, 0xc5, 0xca,
+	0x99, 0x0b, 0xac, 0x2e, 0xe1, 0x24, 0x61, 0xad, 0x5e, 0xd2, 0xd6, 0x8e, 0x80, 0x74, 0xf4, 0xf6,
+	0x4d, 0x8e, 0x74, 0x87, 0x4e, 0x9b, 0x3a, 0xa8, 0x3c, 0x12, 0x29, 0x07, 0x1f, 0x82, 0x52, 0x20,
+	0xbc, 0xb6, 0x7a, 0xa1, 0xa6, 0x57, 0xa7, 0xda, 0xce, 0x4d, 0xf4, 0xfa, 0xab, 0x9a, 0x34, 0x74,
+	0xd0, 0xd2, 0x50, 0xa0, 0xa8, 0x7d, 0x00, 0xa3, 0x5e, 0x90, 0xb8, 0xed, 0x10, 0x7b, 0x01, 0x49,
+	0x34, 0x7d, 0x51, 0xd2, 0xff, 0xf8, 0x26, 0xfa, 0xbb, 0x8a, 0xfe, 0xaa, 0xb1, 0x83, 0x2c, 0x21,
+	0xfc, 0xa5, 0x92, 0x29, 0x2f, 0x4d, 0x50, 0x6c, 0x91, 0x24, 0x0c, 0x62, 0xcd, 0xbf, 0x24, 0xf9,
+	0x1f, 0xdc, 0xc4, 0xaf, 0x3b, 0x68, 0xdc, 0xcc, 0x41, 0x05, 0x05, 0x33, 0xd2, 0x90, 0xc6, 0x3e,
+	0x1d, 0x92, 0x2e, 0xdf, 0x9a, 0x74, 0xdc, 0xcc, 0x41, 0x05, 0x05, 0x15, 0x69, 0x1b, 0xac, 0xe0,
+	0x24, 0xa1, 0x2f, 0xa6, 0x0a, 0x02, 0x25, 0xf7, 0x4f, 0x6e, 0xe2, 0x1e, 0xee, 0xd3, 0x57, 0xad,
+	0xc5, 0x3e, 0x2d, 0xa4, 0x13, 0x25, 0xf1, 0x01, 0x6c, 0x27, 0x78, 0x30, 0xe5, 0x67, 0xf5, 0xd6,
+	0x85, 0xbf, 0x6a, 0xec, 0x20, 0x4b, 0x08, 0x27, 0xbc, 0x7c, 0x0c, 0x56, 0xe5, 0xf5, 0xc6, 0x8d,
+	0x09, 0x67, 0xdd, 0x30, 0xe0, 0xda, 0xcf, 0xda, 0xad, 0xbf, 0x83, 0xeb, 0xcc, 0x1d, 0x04, 0xa5,
+	0xf8, 0xb9, 0x96, 0x66, 0x5d, 0xca, 0x3a, 0x38, 0x6e, 0x77, 0x70, 0xa0, 0xbd, 0xac, 0xdf, 0xba,
+	0x4b, 0x27, 0x0d, 0x1d, 0xb4, 0x34, 0x14, 0x64, 0x4b, 0xed, 0xe1, 0xd8, 0xeb, 0x0d, 0x97, 0xfa,
+	0xce, 0xad, 0x97, 0x7a, 0xdc, 0xcc, 0x41, 0x05, 0x05, 0x15, 0xe9, 0x5d, 0x60, 0xaa, 0x6b, 0x75,
+	0xe0, 0x57, 0x2a, 0xb5, 0xdc, 0xa6, 0x81, 0x16, 0x25, 0x3e, 0xf0, 0xe1, 0x2a, 0x98, 0x57, 0x8f,
+	0x84, 0xbb, 0xf2, 0x9e, 0xa0, 0x80, 0xb8, 0x40, 0xf8, 0xc4, 0x0b, 0x22, 0x1c, 0xb2, 0x4a, 0x55,
+	0x1a, 0x64, 0xf8, 0xd0, 0x30, 0x4b, 0x56, 0xf9, 0xd0, 0x30, 0xcb, 0x96, 0x75, 0x68, 0x98, 0x96,
+	0xb5, 0x7c, 0x68, 0x98, 0x2b, 0xd6, 0x2a, 0x5a, 0x1a, 0xd0, 0x90, 0xba, 0x67, 0x8f, 0x54, 0x04,
+	0xa8, 0x40, 0x5e, 0x60, 0xa6, 0x77, 0x2d, 0x54, 0xf2, 0x30, 0xc7, 0xe1, 0x80, 0xe9, 0xaa, 0x22,
+	0x4b, 0xd5, 0x7a, 0xec, 0x0c, 0xdc, 0x06, 0xf3, 0xe2, 0xa2, 0x4b, 0xa0, 0x05, 0xe6, 0x4e, 0xc9,
+	0x40, 0x5f, 0x69, 0xc4, 0x50, 0x84, 0x78, 0x86, 0xc3, 0x1e, 0xd1, 0x57, 0x19, 0x05, 0x9c, 0x63,
+	0x50, 0x3e, 0x49, 0x70, 0xcc, 0xc4, 0x25, 0x99, 0xc6, 0x47, 0xb4, 0xcd, 0x20, 0x04, 0x86, 0x3c,
+	0x74, 0x94, 0xad, 0x1c, 0xc3, 0x1f, 0x01, 0x23, 0xa4, 0x6d, 0x26, 0xef, 0x64, 0x85, 0x9d, 0xb5,
+	0xab, 0xf7, 0x9c, 0x23, 0xda, 0x46, 0x52, 0xc5, 0xf9, 0x72, 0x16, 0xcc, 0x1d, 0xd1, 0x36, 0xac,
+	0x80, 0x45, 0xac, 0x2e, 0xce, 0x9a, 0x69, 0x08, 0xe1, 0x3a, 0x58, 0xe0, 0xb4, 0x1b, 0x78, 0x8a,
+	0x2e, 0x8f, 0x34, 0x12, 0x8e, 0x7d, 0xcc, 0xb1, 0x3c, 0xa5, 0x8b, 0x48, 0x8e, 0xc5, 0x9b, 0x43,
+	0x66, 0xe6, 0xc6, 0xbd, 0xa8, 0x45, 0x12, 0x79, 0xd8, 0x1a, 0xf5, 0xf2, 0x45, 0x6a, 0x17, 0xa4,
+	0xfc, 0xb9, 0x14, 0xa3, 0x71, 0x00, 0xdf, 0x04, 0x8b, 0xbc, 0x3f, 0x7e, 0x70, 0xae, 0x5c, 0xa4,
+	0x76, 0x99, 0x8f, 0xd2, 0x14, 0xe7, 0x22, 0x5a, 0xe0, 0x7d, 0xf1, 0x3f, 0xdc, 0x06, 0x26, 0xef,
+	0xbb, 0x41, 0xec, 0x93, 0xbe, 0x3c, 0x1b, 0x8d, 0xfa, 0xea, 0x45, 0x6a, 0x5b, 0x63, 0xea, 0x07,
+	0x62, 0x0e, 0x2d, 0xf2, 0xbe, 0x1c, 0xc0, 0x37, 0x01, 0x50, 0x21, 0x49, 0x0f, 0xea, 0xa8, 0x5b,
+	0xba, 0x48, 0xed, 0xbc, 0x94, 0x4a, 0xee, 0xd1, 0x10, 0x3a, 0x60, 0x5e, 0x71, 0x9b, 0x92, 0xbb,
+	0x78, 0x91, 0xda, 0x66, 0x48, 0xdb, 0x8a, 0x53, 0x4d, 0x89, 0x52, 0x25, 0x24, 0xa2, 0x67, 0xc4,
+	0x97, 0xe7, 0x8d, 0x89, 0x86, 0xd0, 0xf9, 0x74, 0x16, 0x98, 0x27, 0x7d, 0x44, 0x58, 0x2f, 0xe4,
+	0xf0, 0x5d, 0x60, 0x0d, 0xef, 0xa6, 0xee, 0x44, 0x69, 0xeb, 0xf7, 0x46, 0xa7, 0xc3, 0xb4, 0x86,
+	0x83, 0xca, 0x43, 0x91, 0x7e, 0xc7, 0x88, 0x4e, 0x68, 0x85, 0x94, 0x46, 0xb2, 0x13, 0x8a, 0x48,
+	0x01, 0xf8, 0x81, 0xac, 0x9a, 0x5c, 0xe5, 0x39, 0xf9, 0x84, 0xf8, 0xfe, 0xd5, 0x55, 0x9e, 0x6a,
+	0x95, 0xfa, 0x3d, 0xfd, 0xe8, 0x2c, 0x29, 0xdf, 0xda, 0x5e, 0xbf, 0x37, 0x17, 0x78, 0x5f, 0xf6,
+	0x93, 0x05, 0xe6, 0x12, 0xc2, 0xe5, 0xca, 0x15, 0x91, 0x18, 0x8a, 0xef, 0x22, 0x21, 0x67, 0x24,
+	0xe1, 0xc4, 0x97, 0x2b, 0x64, 0xa2, 0x0c, 0x8b, 0x8f, 0xac, 0x8d, 0x99, 0xdb, 0x63, 0xc4, 0x57,
+	0xcb, 0x81, 0x16, 0xdb, 0x98, 0xbd, 0xcf, 0x88, 0xff, 0xc4, 0xf8, 0xe4, 0x33, 0x7b, 0xc6, 0xc1,
+	0xa0, 0xa0, 0x2f, 0xd1, 0xbd, 0x6e, 0x48, 0x6e, 0x68, 0xb3, 0x1d, 0x50, 0x64, 0x9c, 0x26, 0xb8,
+	0x4d, 0xdc, 0x53, 0x32, 0xd0, 0xcd, 0xa6, 0x5a, 0x47, 0xcb, 0x7f, 0x4d, 0x06, 0x0c, 0x8d, 0x03,
+	0xed, 0xe2, 0x33, 0x03, 0x14, 0x4e, 0x12, 0xec, 0x11, 0x7d, 0x25, 0x16, 0x0d, 0x2b, 0x60, 0xa2,
+	0x5d, 0x68, 0x24, 0x7c, 0xf3, 0x20, 0x22, 0xb4, 0xc7, 0xf5, 0x47, 0x35, 0x84, 0xc2, 0x22, 0x21,
+	0xa4, 0x4f, 0x3c, 0x59, 0x4b, 0x03, 0x69, 0x04, 0x77, 0xc1, 0x92, 0x1f, 0x30, 0xdc, 0x0a, 0xe5,
+	0x83, 0xd5, 0x3b, 0x55, 0xe9, 0xd7, 0xad, 0x8b, 0xd4, 0x2e, 0xea, 0x89, 0xa6, 0x90, 0xa3, 0x09,
+	0x04, 0xdf, 0x01, 0xe5, 0x91, 0x99, 0x8c, 0x56, 0xbd, 0xd3, 0xeb, 0xf0, 0x22, 0xb5, 0x4b, 0x99,
+	0xaa, 0x9c, 0x41, 0x53, 0x58, 0xed, 0x4d, 0xad, 0x5e, 0x5b, 0x76, 0xa0, 0x89, 0x14, 0x10, 0xd2,
+	0x30, 0x88, 0x02, 0x2e, 0x3b, 0x6e, 0x1e, 0x29, 0x00, 0xdf, 0x01, 0x79, 0x7a, 0x46, 0x92, 0x24,
+	0xf0, 0xe5, 0xfb, 0xf9, 0xdb, 0x7f, 0x77, 0x40, 0x23, 0x7d, 0x91, 0x1c, 0x89, 0x65, 0x90, 0x11,
+	0x89, 0x68, 0xa2, 0x9e, 0xd2, 0x3a, 0x39, 0x35, 0xf1, 0x4c, 0xca, 0xd1, 0x04, 0x82, 0x75, 0x00,
+	0xb5, 0x59, 0x42, 0x78, 0x2f, 0x89, 0x5d, 0xb9, 0x09, 0x14, 0xa5, 0xad, 0xfc, 0x14, 0xd5, 0x2c,
+	0x92, 0x93, 0x4f, 0x31, 0xc7, 0xe8, 0x8a, 0x04, 0xfe, 0x1c, 0x40, 0xb5, 0x26, 0xee, 0xc7, 0x8c,
+	0x66, 0x3f, 0x9c, 0xa8, 0x5b, 0x83, 0xf4, 0xaf, 0x66, 0x75, 0xcc, 0x96, 0x42, 0x87, 0x8c, 0xea,
+	0x2c, 0x0e, 0x0d, 0xd3, 0xb0, 0xe6, 0xd5, 0xeb, 0x3c, 0xab, 0x9f, 0xce, 0x02, 0xad, 0x0c, 0xf1,
+	0x58, 0x78, 0xce, 0x73, 0x00, 0x8e, 0x13, 0x12, 0x88, 0xbb, 0x5d, 0x18, 0x8a, 0x9d, 0x2b, 0xc6,
+	0x11, 0x19, 0x6e, 0x99, 0x62, 0x3c, 0xde, 0x98, 0xb3, 0x93, 0x8d, 0x09, 0x81, 0xe1, 0x51, 0x5f,
+	0x3d, 0x1a, 0xf3, 0x48, 0x8e, 0xef, 0xff, 0x2d, 0x07, 0xc6, 0xde, 0x86, 0xf0, 0xa7, 0xa0, 0xba,
+	0xb7, 0xbf, 0xdf, 0x68, 0x36, 0xdd, 0x93, 0x0f, 0x8f, 0x1b, 0xee, 0x71, 0x03, 0x3d, 0x3b, 0x68,
+	0x36, 0x0f, 0xde, 0x7b, 0x7e, 0xd4, 0x68, 0x36, 0xad, 0x99, 0xea, 0x1b, 0x2f, 0x5f, 0xd5, 0x2a,
+	0x23, 0xfd, 0x63, 0x92, 0x44, 0x01, 0x63, 0x01, 0x8d, 0x43, 0xe1, 0xe0, 0x6d, 0xb0, 0x3e, 0x6e,
+	0x8d, 0x1a, 0xcd, 0x13, 0x74, 0xb0, 0x7f, 0xd2, 0x78, 0x6a, 0xe5, 0xaa, 0x95, 0x97, 0xaf, 0x6a,
+	0xab, 0x23, 0x4b, 0x44, 0x18, 0x4f, 0x02, 0xf1, 0x0b, 0x0f, 0x7c, 0x0c, 0x2a, 0xd7, 0xfb, 0x6c,
+	0x3c, 0xb5, 0x66, 0xab, 0xd5, 0x97, 0xaf, 0x6a, 0xeb, 0xd7, 0x79, 0x24, 0x7e, 0xd5, 0xf8, 0xe4,
+	0xcf, 0x1b, 0x33, 0xf5, 0x27, 0x5f, 0x9c, 0x6f, 0xe4, 0xbe, 0x3a, 0xdf, 0xc8, 0xfd, 0xe7, 0x7c,
+	0x23, 0xf7, 0xe9, 0xeb, 0x8d, 0x99, 0xaf, 0x5e, 0x6f, 0xcc, 0xfc, 0xe3, 0xf5, 0xc6, 0xcc, 0x6f,
+	0x6b, 0xed, 0x80, 0x77, 0x7a, 0xad, 0x2d, 0x8f, 0x46, 0xdb, 0xd3, 0x3f, 0x92, 0x88, 0x57, 0x2f,
+	0x6b, 0x2d, 0xc8, 0x9f, 0xde, 0x1e, 0xfd, 0x77, 0x00, 0x8e, 0xb1, 0x3e, 0x0d, 0xee, 0x13, 0x00,
+	0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -1034,6 +1201,13 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.EmergencyAuthority) > 0 {
+		i -= len(m.EmergencyAuthority)
+		copy(dAtA[i:], m.EmergencyAuthority)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.EmergencyAuthority)))
+		i--
+		dAtA[i] = 0x5a
+	}
 	if len(m.ActiveStaticPrecompiles) > 0 {
 		for iNdEx := len(m.ActiveStaticPrecompiles) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.ActiveStaticPrecompiles[iNdEx])
@@ -1191,6 +1365,57 @@ func (m *AccessControlType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AccessControlEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccessControlEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccessControlEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AccessControlList) > 0 {
+		for iNdEx := len(m.AccessControlList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AccessControlList[iNdEx])
+			copy(dAtA[i:], m.AccessControlList[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.AccessControlList[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.AccessType != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.AccessType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Selector) > 0 {
+		i -= len(m.Selector)
+		copy(dAtA[i:], m.Selector)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Selector)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ChainConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1860,6 +2085,50 @@ func (m *TraceConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Preinstall) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Preinstall) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Preinstall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvm(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvm(v)
 	base := offset
@@ -1907,6 +2176,10 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovEvm(uint64(l))
 		}
 	}
+	l = len(m.EmergencyAuthority)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
 	return n
 }
 
@@ -1941,6 +2214,32 @@ func (m *AccessControlType) Size() (n int) {
 	return n
 }
 
+func (m *AccessControlEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.Selector)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.AccessType != 0 {
+		n += 1 + sovEvm(uint64(m.AccessType))
+	}
+	if len(m.AccessControlList) > 0 {
+		for _, s := range m.AccessControlList {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *ChainConfig) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2208,6 +2507,27 @@ func (m *TraceConfig) Size() (n int) {
 	return n
 }
 
+func (m *Preinstall) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	return n
+}
+
 func sovEvm(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2501,6 +2821,38 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.ActiveStaticPrecompiles = append(m.ActiveStaticPrecompiles, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmergencyAuthority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EmergencyAuthority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
@@ -2739,7 +3091,7 @@ func (m *AccessControlType) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChainConfig) Unmarshal(dAtA []byte) error {
+func (m *AccessControlEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2762,15 +3114,15 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChainConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: AccessControlEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChainConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AccessControlEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HomesteadBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2798,15 +3150,180 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.HomesteadBlock = &v
-			if err := m.HomesteadBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DAOForkBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Selector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessType", wireType)
+			}
+			m.AccessType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccessType |= AccessType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessControlList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccessControlList = append(m.AccessControlList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HomesteadBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.HomesteadBlock = &v
+			if err := m.HomesteadBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DAOForkBlock", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4698,6 +5215,152 @@ func (m *TraceConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Preinstall) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Preinstall: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Preinstall: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Code = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvm(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
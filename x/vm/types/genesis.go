@@ -1,8 +1,12 @@
 package types
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 
+	"github.com/ethereum/go-ethereum/common"
+
 	"github.com/cosmos/evm/types"
 )
 
@@ -18,8 +22,11 @@ func (ga GenesisAccount) Validate() error {
 // chain config values.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Accounts: []GenesisAccount{},
-		Params:   DefaultParams(),
+		Accounts:             []GenesisAccount{},
+		Params:               DefaultParams(),
+		Preinstalls:          []Preinstall{},
+		AccessControlEntries: []AccessControlEntry{},
+		FrozenContracts:      []string{},
 	}
 }
 
@@ -45,5 +52,51 @@ func (gs GenesisState) Validate() error {
 		seenAccounts[acc.Address] = true
 	}
 
+	if err := ValidatePreinstalls(gs.Preinstalls); err != nil {
+		return err
+	}
+
+	accountAddresses := make(map[common.Address]bool, len(gs.Accounts))
+	for _, acc := range gs.Accounts {
+		accountAddresses[common.HexToAddress(acc.Address)] = true
+	}
+	for _, preinstall := range gs.Preinstalls {
+		if accountAddresses[common.HexToAddress(preinstall.Address)] {
+			return fmt.Errorf("preinstall %s collides with a genesis account", preinstall.Address)
+		}
+	}
+
+	if err := ValidateAccessControlEntries(gs.AccessControlEntries); err != nil {
+		return err
+	}
+
+	seenFrozenContracts := make(map[common.Address]bool, len(gs.FrozenContracts))
+	for _, contract := range gs.FrozenContracts {
+		if err := types.ValidateNonZeroAddress(contract); err != nil {
+			return fmt.Errorf("invalid frozen contract %s: %w", contract, err)
+		}
+
+		address := common.HexToAddress(contract)
+		if seenFrozenContracts[address] {
+			return fmt.Errorf("duplicated frozen contract %s", contract)
+		}
+		seenFrozenContracts[address] = true
+	}
+
+	if gs.GenesisStream != nil {
+		if err := gs.GenesisStream.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return gs.Params.Validate()
 }
+
+// Validate performs a basic validation of the GenesisStream fields.
+func (gs GenesisStream) Validate() error {
+	checksum, err := hex.DecodeString(gs.Checksum)
+	if err != nil || len(checksum) != sha256.Size {
+		return fmt.Errorf("invalid genesis stream checksum %q", gs.Checksum)
+	}
+	return nil
+}
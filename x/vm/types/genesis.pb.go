@@ -30,6 +30,19 @@ type GenesisState struct {
 	Accounts []GenesisAccount `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts"`
 	// params defines all the parameters of the module.
 	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// preinstalls defines a set of contracts to be deployed at fixed addresses
+	// on genesis.
+	Preinstalls []Preinstall `protobuf:"bytes,3,rep,name=preinstalls,proto3" json:"preinstalls"`
+	// access_control_entries defines the per-contract and per-function call
+	// permission rules.
+	AccessControlEntries []AccessControlEntry `protobuf:"bytes,4,rep,name=access_control_entries,json=accessControlEntries,proto3" json:"access_control_entries"`
+	// frozen_contracts defines the ethereum hex addresses of the contracts frozen
+	// by governance.
+	FrozenContracts []string `protobuf:"bytes,5,rep,name=frozen_contracts,json=frozenContracts,proto3" json:"frozen_contracts,omitempty"`
+	// genesis_stream defines the commitment to the genesis stream file holding
+	// the ethereum genesis accounts, if they are streamed instead of being part
+	// of the accounts array.
+	GenesisStream *GenesisStream `protobuf:"bytes,6,opt,name=genesis_stream,json=genesisStream,proto3" json:"genesis_stream,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -79,6 +92,90 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetPreinstalls() []Preinstall {
+	if m != nil {
+		return m.Preinstalls
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAccessControlEntries() []AccessControlEntry {
+	if m != nil {
+		return m.AccessControlEntries
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFrozenContracts() []string {
+	if m != nil {
+		return m.FrozenContracts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGenesisStream() *GenesisStream {
+	if m != nil {
+		return m.GenesisStream
+	}
+	return nil
+}
+
+// GenesisStream defines the commitment to the JSON-lines genesis stream file
+// of the ethereum genesis accounts, which is verified on import.
+type GenesisStream struct {
+	// checksum defines the hex encoded SHA-256 checksum of the file.
+	Checksum string `protobuf:"bytes,1,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	// accounts defines the number of genesis accounts in the file.
+	Accounts uint64 `protobuf:"varint,2,opt,name=accounts,proto3" json:"accounts,omitempty"`
+}
+
+func (m *GenesisStream) Reset()         { *m = GenesisStream{} }
+func (m *GenesisStream) String() string { return proto.CompactTextString(m) }
+func (*GenesisStream) ProtoMessage()    {}
+func (*GenesisStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b6f3a3ceb84d18, []int{1}
+}
+func (m *GenesisStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisStream.Merge(m, src)
+}
+func (m *GenesisStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisStream proto.InternalMessageInfo
+
+func (m *GenesisStream) GetChecksum() string {
+	if m != nil {
+		return m.Checksum
+	}
+	return ""
+}
+
+func (m *GenesisStream) GetAccounts() uint64 {
+	if m != nil {
+		return m.Accounts
+	}
+	return 0
+}
+
 // GenesisAccount defines an account to be initialized in the genesis state.
 // Its main difference between with Geth's GenesisAccount is that it uses a
 // custom storage type and that it doesn't contain the private key field.
@@ -95,7 +192,7 @@ func (m *GenesisAccount) Reset()         { *m = GenesisAccount{} }
 func (m *GenesisAccount) String() string { return proto.CompactTextString(m) }
 func (*GenesisAccount) ProtoMessage()    {}
 func (*GenesisAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6b6f3a3ceb84d18, []int{1}
+	return fileDescriptor_e6b6f3a3ceb84d18, []int{2}
 }
 func (m *GenesisAccount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -147,33 +244,43 @@ func (m *GenesisAccount) GetStorage() Storage {
 
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.evm.vm.v1.GenesisState")
+	proto.RegisterType((*GenesisStream)(nil), "cosmos.evm.vm.v1.GenesisStream")
 	proto.RegisterType((*GenesisAccount)(nil), "cosmos.evm.vm.v1.GenesisAccount")
 }
 
 func init() { proto.RegisterFile("cosmos/evm/vm/v1/genesis.proto", fileDescriptor_e6b6f3a3ceb84d18) }
 
 var fileDescriptor_e6b6f3a3ceb84d18 = []byte{
-	// 313 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2d, 0xcb, 0xd5, 0x07, 0x21, 0x43, 0xfd, 0xf4, 0xd4, 0xbc, 0xd4, 0xe2,
-	0xcc, 0x62, 0xbd, 0x82, 0xa2, 0xfc, 0x92, 0x7c, 0x21, 0x01, 0x88, 0xbc, 0x5e, 0x6a, 0x59, 0xae,
-	0x1e, 0x08, 0x19, 0x4a, 0x09, 0x26, 0xe6, 0x66, 0xe6, 0xe5, 0xeb, 0x83, 0x49, 0x88, 0x22, 0x29,
-	0x91, 0xf4, 0xfc, 0xf4, 0x7c, 0x30, 0x53, 0x1f, 0xc4, 0x82, 0x8a, 0x4a, 0x61, 0x18, 0x0d, 0x32,
-	0x04, 0x2c, 0xa7, 0x34, 0x85, 0x91, 0x8b, 0xc7, 0x1d, 0x62, 0x51, 0x70, 0x49, 0x62, 0x49, 0xaa,
-	0x90, 0x3b, 0x17, 0x47, 0x62, 0x72, 0x72, 0x7e, 0x69, 0x5e, 0x49, 0xb1, 0x04, 0xa3, 0x02, 0xb3,
-	0x06, 0xb7, 0x91, 0x82, 0x1e, 0xba, 0xd5, 0x7a, 0x50, 0x1d, 0x8e, 0x10, 0x85, 0x4e, 0x9c, 0x27,
-	0xee, 0xc9, 0x33, 0xac, 0x78, 0xbe, 0x41, 0x8b, 0x31, 0x08, 0xae, 0x59, 0xc8, 0x9a, 0x8b, 0xad,
-	0x20, 0xb1, 0x28, 0x31, 0xb7, 0x58, 0x82, 0x49, 0x81, 0x51, 0x83, 0xdb, 0x48, 0x02, 0xd3, 0x98,
-	0x00, 0xb0, 0x3c, 0xb2, 0x76, 0xa8, 0x16, 0xa5, 0x76, 0x46, 0x2e, 0x3e, 0x54, 0x4b, 0x84, 0x24,
-	0xb8, 0xd8, 0x13, 0x53, 0x52, 0x8a, 0x52, 0x8b, 0x41, 0xee, 0x62, 0xd4, 0xe0, 0x0c, 0x82, 0x71,
-	0x85, 0x84, 0xb8, 0x58, 0x92, 0xf3, 0x53, 0x52, 0xc1, 0xf6, 0x70, 0x06, 0x81, 0xd9, 0x42, 0xee,
-	0x5c, 0xec, 0xc5, 0x25, 0xf9, 0x45, 0x89, 0xe9, 0xa9, 0x12, 0xcc, 0x60, 0x5f, 0x88, 0x63, 0x5a,
-	0x0f, 0xf6, 0xb0, 0x93, 0x08, 0xc8, 0xf6, 0x55, 0xf7, 0xe5, 0xd9, 0x83, 0x21, 0xea, 0x21, 0x0e,
-	0x81, 0xe9, 0x76, 0xb2, 0x3a, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4,
-	0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0x85,
-	0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0xa4, 0x10, 0xae, 0x00, 0x85,
-	0x71, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0x38, 0x8c, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0xb2, 0x4f, 0x90, 0xb3, 0xdc, 0x01, 0x00, 0x00,
+	// 463 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0x3f, 0x6f, 0xd3, 0x40,
+	0x14, 0xcf, 0x91, 0x90, 0x34, 0x17, 0x5a, 0xca, 0x29, 0x82, 0x53, 0x84, 0x1c, 0x2b, 0x62, 0x08,
+	0x0c, 0xb6, 0x5a, 0x36, 0x98, 0x9a, 0x0a, 0x22, 0x36, 0xe4, 0x6c, 0x2c, 0xd1, 0xf5, 0xf2, 0x70,
+	0x2d, 0x62, 0x5f, 0x74, 0xef, 0x52, 0x51, 0xbe, 0x00, 0x2b, 0x1f, 0x03, 0x31, 0xb1, 0xf2, 0x0d,
+	0x3a, 0x76, 0x64, 0x02, 0x94, 0x0c, 0x7c, 0x0d, 0xe4, 0x3b, 0x27, 0xb8, 0x31, 0x48, 0xa7, 0xe8,
+	0xee, 0xfd, 0xfe, 0xbc, 0xf8, 0xfd, 0x1e, 0xf5, 0xa4, 0xc2, 0x54, 0x61, 0x08, 0x17, 0x69, 0x98,
+	0x9f, 0xa3, 0x30, 0x86, 0x0c, 0x30, 0xc1, 0x60, 0xa1, 0x95, 0x51, 0xec, 0xd0, 0xe1, 0x01, 0x5c,
+	0xa4, 0x41, 0x7e, 0x8e, 0x7a, 0xf7, 0x44, 0x9a, 0x64, 0x2a, 0xb4, 0xbf, 0x8e, 0xd4, 0xeb, 0xc6,
+	0x2a, 0x56, 0xf6, 0x1a, 0xe6, 0xb7, 0xa2, 0xda, 0xab, 0x58, 0xe7, 0x26, 0x16, 0x1b, 0x7c, 0xab,
+	0xd3, 0x3b, 0x63, 0xd7, 0x68, 0x62, 0x84, 0x01, 0x36, 0xa6, 0x7b, 0x42, 0x4a, 0xb5, 0xcc, 0x0c,
+	0x72, 0xe2, 0xd7, 0x87, 0x9d, 0x63, 0x3f, 0xd8, 0x6d, 0x1d, 0x14, 0x8a, 0x13, 0x47, 0x1c, 0xb5,
+	0xaf, 0x7e, 0xf4, 0x6b, 0x9f, 0x7f, 0x7f, 0x7d, 0x42, 0xa2, 0xad, 0x98, 0x3d, 0xa7, 0xcd, 0x85,
+	0xd0, 0x22, 0x45, 0x7e, 0xcb, 0x27, 0xc3, 0xce, 0x31, 0xaf, 0xda, 0xbc, 0xb6, 0x78, 0x59, 0x5e,
+	0x48, 0xd8, 0x2b, 0xda, 0x59, 0x68, 0x48, 0x32, 0x34, 0x62, 0x3e, 0x47, 0x5e, 0xb7, 0x7f, 0xe4,
+	0xe1, 0x3f, 0x1c, 0xb6, 0xa4, 0xb2, 0x4b, 0x59, 0xcb, 0x80, 0xde, 0x17, 0x52, 0x02, 0xe2, 0x54,
+	0xaa, 0xcc, 0x68, 0x35, 0x9f, 0x42, 0x66, 0x74, 0x02, 0xc8, 0x1b, 0xd6, 0xf5, 0x51, 0xd5, 0xf5,
+	0xc4, 0xf2, 0x4f, 0x1d, 0xfd, 0x45, 0x66, 0xf4, 0x65, 0xd9, 0xbd, 0x2b, 0x76, 0xe1, 0x04, 0x90,
+	0x3d, 0xa6, 0x87, 0x6f, 0xb5, 0xfa, 0x00, 0x99, 0x6b, 0x23, 0xa4, 0x41, 0x7e, 0xdb, 0xaf, 0x0f,
+	0xdb, 0xd1, 0x5d, 0x57, 0x3f, 0xdd, 0x94, 0xd9, 0x4b, 0x7a, 0x50, 0x64, 0x3b, 0x45, 0xa3, 0x41,
+	0xa4, 0xbc, 0x69, 0x27, 0xd4, 0xff, 0xef, 0xa0, 0x27, 0x96, 0x16, 0xed, 0xc7, 0xe5, 0xe7, 0x60,
+	0x4c, 0xf7, 0x6f, 0xe0, 0xac, 0x47, 0xf7, 0xe4, 0x39, 0xc8, 0x77, 0xb8, 0x4c, 0x39, 0xf1, 0xc9,
+	0xb0, 0x1d, 0x6d, 0xdf, 0x39, 0xb6, 0xcd, 0x35, 0x0f, 0xa4, 0xf1, 0x37, 0xaa, 0xc1, 0x47, 0x42,
+	0x0f, 0x6e, 0x46, 0xca, 0x38, 0x6d, 0x89, 0xd9, 0x4c, 0x03, 0x62, 0xe1, 0xb4, 0x79, 0x32, 0x46,
+	0x1b, 0x52, 0xcd, 0xc0, 0x9a, 0xb4, 0x23, 0x7b, 0x67, 0x63, 0xda, 0x42, 0xa3, 0xb4, 0x88, 0xa1,
+	0x88, 0xea, 0x41, 0xf5, 0x53, 0xec, 0x7a, 0x8d, 0xba, 0xf9, 0x1c, 0xbf, 0xfc, 0xec, 0xb7, 0x26,
+	0x8e, 0xef, 0x46, 0xba, 0x51, 0x8f, 0x9e, 0x5d, 0xad, 0x3c, 0x72, 0xbd, 0xf2, 0xc8, 0xaf, 0x95,
+	0x47, 0x3e, 0xad, 0xbd, 0xda, 0xf5, 0xda, 0xab, 0x7d, 0x5f, 0x7b, 0xb5, 0x37, 0x7e, 0x9c, 0x98,
+	0xf3, 0xe5, 0x59, 0x20, 0x55, 0x1a, 0x96, 0xf6, 0xf9, 0x7d, 0xbe, 0xd1, 0xe6, 0x72, 0x01, 0x78,
+	0xd6, 0xb4, 0x1b, 0xfd, 0xf4, 0xcf, 0x00, 0xb4, 0x31, 0x1c, 0x94, 0x4a, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -196,6 +303,55 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.GenesisStream != nil {
+		{
+			size, err := m.GenesisStream.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.FrozenContracts) > 0 {
+		for iNdEx := len(m.FrozenContracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FrozenContracts[iNdEx])
+			copy(dAtA[i:], m.FrozenContracts[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.FrozenContracts[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.AccessControlEntries) > 0 {
+		for iNdEx := len(m.AccessControlEntries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccessControlEntries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Preinstalls) > 0 {
+		for iNdEx := len(m.Preinstalls) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Preinstalls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -223,6 +379,41 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GenesisStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Accounts != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Accounts))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Checksum) > 0 {
+		i -= len(m.Checksum)
+		copy(dAtA[i:], m.Checksum)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Checksum)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisAccount) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -299,6 +490,44 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Preinstalls) > 0 {
+		for _, e := range m.Preinstalls {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AccessControlEntries) > 0 {
+		for _, e := range m.AccessControlEntries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FrozenContracts) > 0 {
+		for _, s := range m.FrozenContracts {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.GenesisStream != nil {
+		l = m.GenesisStream.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *GenesisStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Accounts != 0 {
+		n += 1 + sovGenesis(uint64(m.Accounts))
+	}
 	return n
 }
 
@@ -427,6 +656,243 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Preinstalls", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Preinstalls = append(m.Preinstalls, Preinstall{})
+			if err := m.Preinstalls[len(m.Preinstalls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessControlEntries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccessControlEntries = append(m.AccessControlEntries, AccessControlEntry{})
+			if err := m.AccessControlEntries[len(m.AccessControlEntries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenContracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenContracts = append(m.FrozenContracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisStream", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GenesisStream == nil {
+				m.GenesisStream = &GenesisStream{}
+			}
+			if err := m.GenesisStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisStream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisStream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisStream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			m.Accounts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Accounts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
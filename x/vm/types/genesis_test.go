@@ -1,6 +1,8 @@
 package types
 
 import (
+	"crypto/sha256"
+	"strings"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -157,6 +159,101 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			expPass: false,
 		},
+		{
+			name: "valid genesis with preinstalls",
+			genState: &GenesisState{
+				Params:      DefaultParams(),
+				Preinstalls: DefaultPreinstalls,
+			},
+			expPass: true,
+		},
+		{
+			name: "duplicated preinstall",
+			genState: &GenesisState{
+				Params:      DefaultParams(),
+				Preinstalls: []Preinstall{DefaultPreinstalls[0], DefaultPreinstalls[0]},
+			},
+			expPass: false,
+		},
+		{
+			name: "preinstall colliding with genesis account",
+			genState: &GenesisState{
+				Accounts: []GenesisAccount{
+					{
+						Address: suite.address,
+						Code:    suite.code,
+					},
+				},
+				Params: DefaultParams(),
+				Preinstalls: []Preinstall{
+					{Name: "Test", Address: suite.address, Code: "0x" + suite.code},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid genesis with access control entries",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				AccessControlEntries: []AccessControlEntry{
+					{Contract: suite.address, AccessType: AccessTypeRestricted},
+					{Contract: suite.address, Selector: "0xa9059cbb", AccessType: AccessTypeRestricted},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "valid genesis with frozen contracts",
+			genState: &GenesisState{
+				Params:          DefaultParams(),
+				FrozenContracts: []string{suite.address},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid emergency authority",
+			genState: &GenesisState{
+				Params: Params{
+					EmergencyAuthority: "foobar",
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "duplicated frozen contract",
+			genState: &GenesisState{
+				Params:          DefaultParams(),
+				FrozenContracts: []string{suite.address, suite.address},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid genesis with a genesis stream",
+			genState: &GenesisState{
+				Params:        DefaultParams(),
+				GenesisStream: &GenesisStream{Checksum: strings.Repeat("ab", sha256.Size), Accounts: 2},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis stream checksum",
+			genState: &GenesisState{
+				Params:        DefaultParams(),
+				GenesisStream: &GenesisStream{Checksum: "abcd", Accounts: 2},
+			},
+			expPass: false,
+		},
+		{
+			name: "duplicated access control entry",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				AccessControlEntries: []AccessControlEntry{
+					{Contract: suite.address, AccessType: AccessTypeRestricted},
+					{Contract: suite.address, AccessType: AccessTypePermissioned},
+				},
+			},
+			expPass: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,105 @@
+package types
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	errorsmod "cosmossdk.io/errors"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+const (
+	// SourceCallbackKey is the memo key of the callback executed on the source
+	// chain when the packet is acknowledged or times out.
+	SourceCallbackKey = "src_callback"
+	// DestinationCallbackKey is the memo key of the callback executed on the
+	// destination chain when the packet is received.
+	DestinationCallbackKey = "dest_callback"
+
+	// DefaultMaxCallbackGas is the default maximum amount of gas that a packet
+	// callback can consume. It is also the gas limit of the callbacks that don't
+	// define one.
+	DefaultMaxCallbackGas uint64 = 1_000_000
+
+	// CallbackTypeReceivePacket is the type of the callback executed when a
+	// packet is received.
+	CallbackTypeReceivePacket = "receive_packet"
+	// CallbackTypeAcknowledgementPacket is the type of the callback executed
+	// when a packet is acknowledged.
+	CallbackTypeAcknowledgementPacket = "acknowledgement_packet"
+	// CallbackTypeTimeoutPacket is the type of the callback executed when a
+	// packet times out.
+	CallbackTypeTimeoutPacket = "timeout_packet"
+
+	// AttributeValueCallbackSuccess is the result of a successful callback.
+	AttributeValueCallbackSuccess = "success"
+	// AttributeValueCallbackFailure is the result of a failed callback.
+	AttributeValueCallbackFailure = "failure"
+)
+
+// CallbackSenderAddress is the address that calls the callback contracts, which
+// is the x/vm module account address.
+var CallbackSenderAddress = common.BytesToAddress(authtypes.NewModuleAddress(ModuleName))
+
+// CallbackData defines the contract to call on a packet lifecycle event and the
+// maximum amount of gas the call can consume.
+type CallbackData struct {
+	ContractAddress common.Address
+	GasLimit        uint64
+}
+
+// callbackMemo defines the JSON-encoded callback of a packet memo, with the
+// same format as the ADR-008 callbacks.
+type callbackMemo struct {
+	Address  string `json:"address"`
+	GasLimit string `json:"gas_limit,omitempty"`
+}
+
+// GetCallbackData returns the callback defined under the given key of the
+// packet memo, or false if the memo doesn't define one. The gas limit of the
+// callback is capped to the maximum callback gas.
+func GetCallbackData(memo, callbackKey string, maxCallbackGas uint64) (CallbackData, bool, error) {
+	if memo == "" {
+		return CallbackData{}, false, nil
+	}
+
+	var memoFields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &memoFields); err != nil {
+		// the memo is not a JSON object so it doesn't define callbacks
+		return CallbackData{}, false, nil
+	}
+
+	rawCallback, found := memoFields[callbackKey]
+	if !found {
+		return CallbackData{}, false, nil
+	}
+
+	var callback callbackMemo
+	if err := json.Unmarshal(rawCallback, &callback); err != nil {
+		return CallbackData{}, false, errorsmod.Wrapf(ErrInvalidCallback, "invalid %s: %s", callbackKey, err)
+	}
+
+	if !common.IsHexAddress(callback.Address) {
+		return CallbackData{}, false, errorsmod.Wrapf(ErrInvalidCallback, "invalid %s contract address %q", callbackKey, callback.Address)
+	}
+
+	gasLimit := maxCallbackGas
+	if callback.GasLimit != "" {
+		userGasLimit, err := strconv.ParseUint(callback.GasLimit, 10, 64)
+		if err != nil {
+			return CallbackData{}, false, errorsmod.Wrapf(ErrInvalidCallback, "invalid %s gas limit %q", callbackKey, callback.GasLimit)
+		}
+
+		if userGasLimit != 0 && userGasLimit < maxCallbackGas {
+			gasLimit = userGasLimit
+		}
+	}
+
+	return CallbackData{
+		ContractAddress: common.HexToAddress(callback.Address),
+		GasLimit:        gasLimit,
+	}, true, nil
+}
@@ -0,0 +1,65 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/x/vm/types"
+)
+
+func TestGetCallbackData(t *testing.T) {
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	maxGas := uint64(1_000_000)
+
+	testCases := []struct {
+		name        string
+		memo        string
+		expFound    bool
+		expCallback types.CallbackData
+		errContains string
+	}{
+		{"empty memo", "", false, types.CallbackData{}, ""},
+		{"non-JSON memo", "hello", false, types.CallbackData{}, ""},
+		{"no callback", `{"forward":{}}`, false, types.CallbackData{}, ""},
+		{"invalid callback", `{"dest_callback":"0x1"}`, false, types.CallbackData{}, "invalid dest_callback"},
+		{"invalid address", `{"dest_callback":{"address":"cosmos1"}}`, false, types.CallbackData{}, "contract address"},
+		{"invalid gas limit", `{"dest_callback":{"address":"` + contract.Hex() + `","gas_limit":"-1"}}`, false, types.CallbackData{}, "gas limit"},
+		{
+			"default gas limit",
+			`{"dest_callback":{"address":"` + contract.Hex() + `"}}`,
+			true,
+			types.CallbackData{ContractAddress: contract, GasLimit: maxGas},
+			"",
+		},
+		{
+			"custom gas limit",
+			`{"dest_callback":{"address":"` + contract.Hex() + `","gas_limit":"50000"}}`,
+			true,
+			types.CallbackData{ContractAddress: contract, GasLimit: 50_000},
+			"",
+		},
+		{
+			"gas limit capped to the maximum",
+			`{"dest_callback":{"address":"` + contract.Hex() + `","gas_limit":"5000000"}}`,
+			true,
+			types.CallbackData{ContractAddress: contract, GasLimit: maxGas},
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			callback, found, err := types.GetCallbackData(tc.memo, types.DestinationCallbackKey, maxGas)
+			if tc.errContains != "" {
+				require.ErrorContains(t, err, tc.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expFound, found)
+			require.Equal(t, tc.expCallback, callback)
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+// accessControlInterpreter wraps the EVM interpreter to check the per-function call
+// permissions before running the code of a contract. The opcode call hooks can't
+// perform this check since they don't have access to the call input.
+type accessControlInterpreter struct {
+	vm.Interpreter
+
+	policy PermissionPolicy
+	signer common.Address
+}
+
+// NewAccessControlInterpreter returns an interpreter that checks with the permission policy
+// if the call to the contract function is allowed before running it with the given interpreter.
+//
+// NOTE: the calls to precompiled contracts are not run by the interpreter, so the
+// per-function permissions don't apply to them.
+func NewAccessControlInterpreter(interpreter vm.Interpreter, policy PermissionPolicy, signer common.Address) vm.Interpreter {
+	return accessControlInterpreter{
+		Interpreter: interpreter,
+		policy:      policy,
+		signer:      signer,
+	}
+}
+
+// Run implements the vm.Interpreter interface.
+func (i accessControlInterpreter) Run(contract *vm.Contract, input []byte, static bool) ([]byte, error) {
+	// contract creations don't have a code address and don't call any function
+	if contract.CodeAddr != nil && len(input) >= SelectorLength {
+		selector := input[:SelectorLength]
+		if !i.policy.CanCallFunction(i.signer, contract.Caller(), *contract.CodeAddr, selector) {
+			return nil, fmt.Errorf(
+				"caller address %s does not have permission to call function %#x of contract %s",
+				contract.Caller(), selector, contract.CodeAddr,
+			)
+		}
+	}
+
+	return i.Interpreter.Run(contract, input, static)
+}
@@ -27,6 +27,9 @@ const (
 	prefixStorage
 	prefixParams
 	prefixCodeHash
+	prefixCodeRefCount
+	prefixAccessControlEntry
+	prefixFrozenContract
 )
 
 // prefix bytes for the EVM transient store
@@ -35,14 +38,19 @@ const (
 	prefixTransientTxIndex
 	prefixTransientLogSize
 	prefixTransientGasUsed
+	prefixTransientSignerNonce
 )
 
 // KVStore key prefixes
 var (
-	KeyPrefixCode     = []byte{prefixCode}
-	KeyPrefixStorage  = []byte{prefixStorage}
-	KeyPrefixParams   = []byte{prefixParams}
-	KeyPrefixCodeHash = []byte{prefixCodeHash}
+	KeyPrefixCode         = []byte{prefixCode}
+	KeyPrefixStorage      = []byte{prefixStorage}
+	KeyPrefixParams       = []byte{prefixParams}
+	KeyPrefixCodeHash     = []byte{prefixCodeHash}
+	KeyPrefixCodeRefCount = []byte{prefixCodeRefCount}
+
+	KeyPrefixAccessControlEntry = []byte{prefixAccessControlEntry}
+	KeyPrefixFrozenContract     = []byte{prefixFrozenContract}
 )
 
 // Transient Store key prefixes
@@ -51,6 +59,8 @@ var (
 	KeyPrefixTransientTxIndex = []byte{prefixTransientTxIndex}
 	KeyPrefixTransientLogSize = []byte{prefixTransientLogSize}
 	KeyPrefixTransientGasUsed = []byte{prefixTransientGasUsed}
+
+	KeyPrefixTransientSignerNonce = []byte{prefixTransientSignerNonce}
 )
 
 // AddressStoragePrefix returns a prefix to iterate over a given account storage.
@@ -62,3 +72,20 @@ func AddressStoragePrefix(address common.Address) []byte {
 func StateKey(address common.Address, key []byte) []byte {
 	return append(AddressStoragePrefix(address), key...)
 }
+
+// AccessControlEntriesPrefix returns a prefix to iterate over the call permission rules of a
+// given contract.
+func AccessControlEntriesPrefix(contract common.Address) []byte {
+	return append(KeyPrefixAccessControlEntry, contract.Bytes()...)
+}
+
+// AccessControlEntryKey defines the key under which the call permission rule of a contract
+// function is stored. The selector is nil for the contract-wide rule.
+func AccessControlEntryKey(contract common.Address, selector []byte) []byte {
+	return append(AccessControlEntriesPrefix(contract), selector...)
+}
+
+// FrozenContractKey defines the key under which a contract frozen by governance is stored.
+func FrozenContractKey(contract common.Address) []byte {
+	return append(KeyPrefixFrozenContract, contract.Bytes()...)
+}
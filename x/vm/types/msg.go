@@ -21,6 +21,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
@@ -33,6 +34,13 @@ var (
 	_ sdk.Tx     = &MsgEthereumTx{}
 	_ ante.GasTx = &MsgEthereumTx{}
 	_ sdk.Msg    = &MsgUpdateParams{}
+	_ sdk.Msg    = &MsgRegisterPreinstalls{}
+	_ sdk.Msg    = &MsgAddAccessControlEntries{}
+	_ sdk.Msg    = &MsgRemoveAccessControlEntries{}
+	_ sdk.Msg    = &MsgFreezeContract{}
+	_ sdk.Msg    = &MsgUnfreezeContract{}
+	_ sdk.Msg    = &MsgCallEVM{}
+	_ sdk.Msg    = &MsgDeployContract{}
 
 	_ codectypes.UnpackInterfacesMessage = MsgEthereumTx{}
 )
@@ -41,6 +49,10 @@ var (
 const (
 	// TypeMsgEthereumTx defines the type string of an Ethereum transaction
 	TypeMsgEthereumTx = "ethereum_tx"
+
+	// CosmosSenderAddressPrefix is the prefix used to derive the EVM address of
+	// the Cosmos senders that don't have a 20-byte address.
+	CosmosSenderAddressPrefix = "cosmos-evm-sender"
 )
 
 var MsgEthereumTxCustomGetSigner = txsigning.CustomGetSigner{
@@ -374,3 +386,170 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 func (m MsgUpdateParams) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
 }
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgRegisterPreinstalls) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if len(m.Preinstalls) == 0 {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "preinstalls cannot be empty")
+	}
+
+	return ValidatePreinstalls(m.Preinstalls)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgRegisterPreinstalls) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgAddAccessControlEntries) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if len(m.Entries) == 0 {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "access control entries cannot be empty")
+	}
+
+	return ValidateAccessControlEntries(m.Entries)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgAddAccessControlEntries) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check of the provided data. The access type of the entries
+// is ignored on removal, so it's not validated.
+func (m *MsgRemoveAccessControlEntries) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if len(m.Entries) == 0 {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "access control entries cannot be empty")
+	}
+
+	for _, entry := range m.Entries {
+		if err := entry.ValidateKey(); err != nil {
+			return err
+		}
+		if err := validateAllowlistAddresses(entry.AccessControlList); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgRemoveAccessControlEntries) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgFreezeContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if err := types.ValidateNonZeroAddress(m.Contract); err != nil {
+		return errorsmod.Wrap(err, "invalid contract address")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgFreezeContract) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUnfreezeContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if err := types.ValidateNonZeroAddress(m.Contract); err != nil {
+		return errorsmod.Wrap(err, "invalid contract address")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUnfreezeContract) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgCallEVM) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	if err := types.ValidateAddress(m.To); err != nil {
+		return errorsmod.Wrap(err, "invalid to address")
+	}
+
+	return validateCosmosEVMCall(m.Value, m.GasLimit)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgCallEVM) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgDeployContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	if len(m.Data) == 0 {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "contract creation code cannot be empty")
+	}
+
+	return validateCosmosEVMCall(m.Value, m.GasLimit)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgDeployContract) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// validateCosmosEVMCall validates the value and gas limit of an EVM execution
+// signed by a Cosmos account. A nil value is equivalent to zero.
+func validateCosmosEVMCall(value sdkmath.Int, gasLimit uint64) error {
+	if !value.IsNil() && value.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidAmount, "value cannot be negative %s", value)
+	}
+
+	if !types.IsValidInt256(value.BigInt()) {
+		return errorsmod.Wrap(ErrInvalidAmount, "out of bound")
+	}
+
+	if gasLimit == 0 {
+		return errorsmod.Wrap(ErrInvalidGasLimit, "gas limit must not be zero")
+	}
+
+	return nil
+}
+
+// CosmosSenderEVMAddress returns the EVM address that executes the EVM messages
+// signed by a Cosmos account (i.e. MsgCallEVM and MsgDeployContract). 20-byte
+// addresses are used as is, while longer ones, such as the 32-byte addresses of
+// the interchain accounts, are mapped to an address derived from the whole
+// sender address instead of being truncated, so that they can't act on behalf
+// of the account owning the truncated address.
+func CosmosSenderEVMAddress(sender sdk.AccAddress) common.Address {
+	if len(sender) == common.AddressLength {
+		return common.BytesToAddress(sender)
+	}
+	return common.BytesToAddress(address.Module(CosmosSenderAddressPrefix, sender)[:common.AddressLength])
+}
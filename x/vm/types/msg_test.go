@@ -929,3 +929,54 @@ func assertEqual(orig *ethtypes.Transaction, cpy *ethtypes.Transaction) error {
 	}
 	return nil
 }
+
+func (suite *MsgsTestSuite) TestMsgCallEVM_ValidateBasic() {
+	sender := sdk.AccAddress(suite.from.Bytes()).String()
+
+	testCases := []struct {
+		msg        string
+		callMsg    types.MsgCallEVM
+		expectPass bool
+	}{
+		{"pass", types.MsgCallEVM{Sender: sender, To: suite.to.Hex(), Value: sdkmath.NewInt(1), GasLimit: 21000}, true},
+		{"pass - nil value", types.MsgCallEVM{Sender: sender, To: suite.to.Hex(), GasLimit: 21000}, true},
+		{"invalid sender", types.MsgCallEVM{Sender: "foobar", To: suite.to.Hex(), GasLimit: 21000}, false},
+		{"invalid to", types.MsgCallEVM{Sender: sender, To: invalidAddress, GasLimit: 21000}, false},
+		{"negative value", types.MsgCallEVM{Sender: sender, To: suite.to.Hex(), Value: sdkmath.NewInt(-1), GasLimit: 21000}, false},
+		{"zero gas limit", types.MsgCallEVM{Sender: sender, To: suite.to.Hex()}, false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.callMsg.ValidateBasic()
+		if tc.expectPass {
+			suite.Require().NoError(err, tc.msg)
+		} else {
+			suite.Require().Error(err, tc.msg)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgDeployContract_ValidateBasic() {
+	sender := sdk.AccAddress(suite.from.Bytes()).String()
+
+	testCases := []struct {
+		msg        string
+		deployMsg  types.MsgDeployContract
+		expectPass bool
+	}{
+		{"pass", types.MsgDeployContract{Sender: sender, Data: []byte{0x00}, GasLimit: 100000}, true},
+		{"invalid sender", types.MsgDeployContract{Sender: "foobar", Data: []byte{0x00}, GasLimit: 100000}, false},
+		{"empty code", types.MsgDeployContract{Sender: sender, GasLimit: 100000}, false},
+		{"negative value", types.MsgDeployContract{Sender: sender, Data: []byte{0x00}, Value: sdkmath.NewInt(-1), GasLimit: 100000}, false},
+		{"zero gas limit", types.MsgDeployContract{Sender: sender, Data: []byte{0x00}}, false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.deployMsg.ValidateBasic()
+		if tc.expectPass {
+			suite.Require().NoError(err, tc.msg)
+		} else {
+			suite.Require().Error(err, tc.msg)
+		}
+	}
+}
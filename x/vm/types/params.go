@@ -14,6 +14,8 @@ import (
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 
 	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 var (
@@ -106,6 +108,10 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateEmergencyAuthority(p.EmergencyAuthority); err != nil {
+		return err
+	}
+
 	return validateChannels(p.EVMChannels)
 }
 
@@ -176,6 +182,19 @@ func validateAllowlistAddresses(i interface{}) error {
 	return nil
 }
 
+// validateEmergencyAuthority checks that the emergency authority is either empty
+// or a valid bech32 address.
+func validateEmergencyAuthority(authority string) error {
+	if authority == "" {
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		return fmt.Errorf("invalid emergency authority %s: %w", authority, err)
+	}
+	return nil
+}
+
 func validateBool(i interface{}) error {
 	_, ok := i.(bool)
 	if !ok {
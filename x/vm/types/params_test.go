@@ -3,8 +3,11 @@ package types
 import (
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
 	ethparams "github.com/ethereum/go-ethereum/params"
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestParamsValidate(t *testing.T) {
@@ -49,6 +52,20 @@ func TestParamsValidate(t *testing.T) {
 			},
 			errContains: "precompiles need to be sorted",
 		},
+		{
+			name: "valid emergency authority",
+			params: Params{
+				EmergencyAuthority: sdk.AccAddress(common.HexToAddress("0x1").Bytes()).String(),
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid emergency authority",
+			params: Params{
+				EmergencyAuthority: "foobar",
+			},
+			errContains: "invalid emergency authority",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -17,6 +17,9 @@ type PermissionPolicy interface {
 	// CanCall checks if the any type of CALL opcode execution is allowed. This includes
 	// contract calls and transfers.
 	CanCall(signer, caller, recipient common.Address) bool
+	// CanCallFunction checks if the execution of the contract function with the given
+	// selector is allowed.
+	CanCallFunction(signer, caller, contract common.Address, selector []byte) bool
 
 	// GetCallHook returns a CallHook that checks if the caller is allowed to perform a call.
 	// This is used by the EVM opcode hooks to enforce access control policies.
@@ -27,6 +30,8 @@ type PermissionPolicy interface {
 }
 
 // RestrictedPermissionPolicy is a permission policy that restricts contract creation and calls based on a set of accessControl.
+// On top of it, the calls to specific contracts or contract functions can be restricted by the
+// access control entries returned by getEntry.
 // Note that all the properties are private, this enforces the permissions not to be modified
 // anywhere else within the code.
 // For users that require a custom permission policy, they can implement the PermissionPolicy interface.
@@ -34,9 +39,12 @@ type RestrictedPermissionPolicy struct {
 	accessControl *AccessControl
 	canCreate     callerFn
 	canCall       callerFn
+	getEntry      AccessControlEntryGetter
 }
 
-func NewRestrictedPermissionPolicy(accessControl *AccessControl, signer common.Address) PermissionPolicy {
+// NewRestrictedPermissionPolicy returns a new RestrictedPermissionPolicy for the given signer. The
+// getEntry function can be nil if there are no per-contract or per-function call rules.
+func NewRestrictedPermissionPolicy(accessControl *AccessControl, signer common.Address, getEntry AccessControlEntryGetter) PermissionPolicy {
 	// generate create function at instantiation for signer address to be check only once
 	// since it remains constant
 	canCreate := getCanCreateFn(accessControl, signer)
@@ -45,6 +53,7 @@ func NewRestrictedPermissionPolicy(accessControl *AccessControl, signer common.A
 		accessControl: accessControl,
 		canCreate:     canCreate,
 		canCall:       canCall,
+		getEntry:      getEntry,
 	}
 }
 
@@ -95,13 +104,33 @@ func getCanCreateFn(accessControl *AccessControl, signer common.Address) callerF
 	return func(_ common.Address) bool { return false }
 }
 
-// CanCreate implements the PermissionPolicy interface.
+// CanCall implements the PermissionPolicy interface.
 // It allows calls if access type is set to everybody.
 // Otherwise, it checks if:
 // - The signer is allowed to do so.
 // - If the signer is not allowed, then we check if the caller is allowed to do so.
-func (p RestrictedPermissionPolicy) CanCall(_, caller, _ common.Address) bool {
-	return p.canCall(caller)
+// The call must also be allowed by the contract-wide entry of the recipient, if any.
+func (p RestrictedPermissionPolicy) CanCall(signer, caller, recipient common.Address) bool {
+	return p.canCall(caller) && p.isAllowedByEntry(signer, caller, recipient, nil)
+}
+
+// CanCallFunction implements the PermissionPolicy interface.
+// It allows the call unless there is an entry for the contract function that doesn't allow
+// the signer or the caller to perform it.
+func (p RestrictedPermissionPolicy) CanCallFunction(signer, caller, contract common.Address, selector []byte) bool {
+	return p.isAllowedByEntry(signer, caller, contract, selector)
+}
+
+// isAllowedByEntry checks the access control entry of the contract function with the given
+// selector, or the contract-wide one if the selector is nil. The call is allowed if there is
+// no such entry.
+func (p RestrictedPermissionPolicy) isAllowedByEntry(signer, caller, contract common.Address, selector []byte) bool {
+	if p.getEntry == nil {
+		return true
+	}
+
+	entry, found := p.getEntry(contract, selector)
+	return !found || entry.IsAllowed(signer, caller)
 }
 
 func getCanCallFn(accessControl *AccessControl, signer common.Address) callerFn {
@@ -219,6 +219,7 @@ func (suite *UnitTestSuite) TestAccessControl() {
 			permissionPolicy := types.NewRestrictedPermissionPolicy(
 				&accessControl,
 				tc.signer,
+				nil,
 			)
 
 			canCreate := permissionPolicy.CanCreate(tc.signer, tc.caller)
@@ -229,3 +230,40 @@ func (suite *UnitTestSuite) TestAccessControl() {
 		})
 	}
 }
+
+func (suite *UnitTestSuite) TestAccessControlEntries() {
+	keyring := testkeyring.New(3)
+	contract := keyring.GetAddr(2)
+	selector := []byte{0xa9, 0x05, 0x9c, 0xbb}
+
+	entries := map[string]types.AccessControlEntry{
+		string(types.AccessControlEntryKey(contract, nil)): types.NewAccessControlEntry(
+			contract, nil, types.AccessTypePermissionless, []string{keyring.GetAddr(1).Hex()},
+		),
+		string(types.AccessControlEntryKey(contract, selector)): types.NewAccessControlEntry(
+			contract, selector, types.AccessTypePermissioned, []string{keyring.GetAddr(0).Hex()},
+		),
+	}
+	getEntry := func(contract common.Address, selector []byte) (types.AccessControlEntry, bool) {
+		entry, found := entries[string(types.AccessControlEntryKey(contract, selector))]
+		return entry, found
+	}
+
+	accessControl := types.DefaultParams().AccessControl
+	policy := types.NewRestrictedPermissionPolicy(&accessControl, keyring.GetAddr(0), getEntry)
+
+	// contract-wide entry
+	suite.Require().True(policy.CanCall(keyring.GetAddr(0), keyring.GetAddr(0), contract))
+	suite.Require().False(policy.CanCall(keyring.GetAddr(1), keyring.GetAddr(1), contract))
+	suite.Require().True(policy.CanCall(keyring.GetAddr(1), keyring.GetAddr(1), keyring.GetAddr(0)), "expected no entry for recipient")
+
+	// function entry
+	suite.Require().True(policy.CanCallFunction(keyring.GetAddr(0), keyring.GetAddr(1), contract, selector))
+	suite.Require().False(policy.CanCallFunction(keyring.GetAddr(1), keyring.GetAddr(1), contract, selector))
+	suite.Require().True(policy.CanCallFunction(keyring.GetAddr(1), keyring.GetAddr(1), contract, []byte{0x01, 0x02, 0x03, 0x04}), "expected no entry for selector")
+
+	// the params policy is still applied on top of the entries
+	accessControl.Call.AccessType = types.AccessTypeRestricted
+	policy = types.NewRestrictedPermissionPolicy(&accessControl, keyring.GetAddr(0), getEntry)
+	suite.Require().False(policy.CanCall(keyring.GetAddr(0), keyring.GetAddr(0), contract))
+}
@@ -1,5 +1,12 @@
 package types
 
+import (
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
 const (
 	P256PrecompileAddress   = "0x0000000000000000000000000000000000000100"
 	Bech32PrecompileAddress = "0x0000000000000000000000000000000000000400"
@@ -14,6 +21,8 @@ const (
 	GovPrecompileAddress          = "0x0000000000000000000000000000000000000805"
 	SlashingPrecompileAddress     = "0x0000000000000000000000000000000000000806"
 	EvidencePrecompileAddress     = "0x0000000000000000000000000000000000000807"
+	AuthzPrecompileAddress        = "0x0000000000000000000000000000000000000808"
+	FeegrantPrecompileAddress     = "0x0000000000000000000000000000000000000809"
 )
 
 // AvailableStaticPrecompiles defines the full list of all available EVM extension addresses.
@@ -31,4 +40,12 @@ var AvailableStaticPrecompiles = []string{
 	GovPrecompileAddress,
 	SlashingPrecompileAddress,
 	EvidencePrecompileAddress,
+	AuthzPrecompileAddress,
+	FeegrantPrecompileAddress,
+}
+
+// IsPrecompileAddress returns true if the address is the one of an Ethereum
+// precompile or of an available static precompile, active or not.
+func IsPrecompileAddress(address common.Address) bool {
+	return slices.Contains(AvailableStaticPrecompiles, address.Hex()) || slices.Contains(vm.PrecompiledAddressesBerlin, address)
 }
@@ -0,0 +1,88 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPreinstallValidate(t *testing.T) {
+	testCases := []struct {
+		name       string
+		preinstall Preinstall
+		expError   bool
+	}{
+		{
+			"valid preinstall",
+			Preinstall{Name: "Test", Address: "0x4e59b44847b379578588920ca78fbf26c0b4956c", Code: "0x6080"},
+			false,
+		},
+		{
+			"empty name",
+			Preinstall{Name: "", Address: "0x4e59b44847b379578588920ca78fbf26c0b4956c", Code: "0x6080"},
+			true,
+		},
+		{
+			"invalid address",
+			Preinstall{Name: "Test", Address: "0x123", Code: "0x6080"},
+			true,
+		},
+		{
+			"zero address",
+			Preinstall{Name: "Test", Address: "0x0000000000000000000000000000000000000000", Code: "0x6080"},
+			true,
+		},
+		{
+			"static precompile address",
+			Preinstall{Name: "Test", Address: StakingPrecompileAddress, Code: "0x6080"},
+			true,
+		},
+		{
+			"ethereum precompile address",
+			Preinstall{Name: "Test", Address: "0x0000000000000000000000000000000000000001", Code: "0x6080"},
+			true,
+		},
+		{
+			"code without 0x prefix",
+			Preinstall{Name: "Test", Address: "0x4e59b44847b379578588920ca78fbf26c0b4956c", Code: "6080"},
+			true,
+		},
+		{
+			"empty code",
+			Preinstall{Name: "Test", Address: "0x4e59b44847b379578588920ca78fbf26c0b4956c", Code: "0x"},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.preinstall.Validate()
+			if tc.expError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDefaultPreinstalls(t *testing.T) {
+	require.NoError(t, ValidatePreinstalls(DefaultPreinstalls))
+
+	// the canonical address and code hash of each default preinstall
+	expCodeHashes := map[common.Address]common.Hash{
+		common.HexToAddress("0x4e59b44847b379578588920ca78fbf26c0b4956c"): common.HexToHash("0x2fa86add0aed31f33a762c9d88e807c475bd51d0f52bd0955754b2608f7e4989"),
+		common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11"): common.HexToHash("0xd5c15df687b16f2ff992fc8d767b4216323184a2bbc6ee2f9c398c318e770891"),
+		common.HexToAddress("0x000000000022D473030F116dDEE9F6B43aC78BA3"): common.HexToHash("0xc67d1657868aa5146eaf24fb879fb1fdec3d2d493b3683a61c9c2f4fb2851131"),
+		common.HexToAddress("0x914d7Fec6aaC8cd542e72Bca78B30650d45643d7"): common.HexToHash("0x2fa86add0aed31f33a762c9d88e807c475bd51d0f52bd0955754b2608f7e4989"),
+	}
+	require.Len(t, DefaultPreinstalls, len(expCodeHashes))
+
+	for _, preinstall := range DefaultPreinstalls {
+		expCodeHash, found := expCodeHashes[common.HexToAddress(preinstall.Address)]
+		require.True(t, found, "unexpected preinstall %s at %s", preinstall.Name, preinstall.Address)
+		require.Equal(t, expCodeHash, crypto.Keccak256Hash(common.FromHex(preinstall.Code)), preinstall.Name)
+	}
+}
@@ -1483,6 +1483,327 @@ func (m *QueryGlobalMinGasPriceResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryGlobalMinGasPriceResponse proto.InternalMessageInfo
 
+// QueryAccessControlEntriesRequest is the request type for the
+// Query/AccessControlEntries RPC method.
+type QueryAccessControlEntriesRequest struct {
+	// contract is the optional ethereum hex address of the contract to query the
+	// rules for. All the rules are returned if empty.
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAccessControlEntriesRequest) Reset()         { *m = QueryAccessControlEntriesRequest{} }
+func (m *QueryAccessControlEntriesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccessControlEntriesRequest) ProtoMessage()    {}
+func (*QueryAccessControlEntriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{28}
+}
+func (m *QueryAccessControlEntriesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccessControlEntriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccessControlEntriesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccessControlEntriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccessControlEntriesRequest.Merge(m, src)
+}
+func (m *QueryAccessControlEntriesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccessControlEntriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccessControlEntriesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccessControlEntriesRequest proto.InternalMessageInfo
+
+func (m *QueryAccessControlEntriesRequest) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *QueryAccessControlEntriesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAccessControlEntriesResponse is the response type for the
+// Query/AccessControlEntries RPC method.
+type QueryAccessControlEntriesResponse struct {
+	// entries are the call permission rules
+	Entries []AccessControlEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAccessControlEntriesResponse) Reset()         { *m = QueryAccessControlEntriesResponse{} }
+func (m *QueryAccessControlEntriesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccessControlEntriesResponse) ProtoMessage()    {}
+func (*QueryAccessControlEntriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{29}
+}
+func (m *QueryAccessControlEntriesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccessControlEntriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccessControlEntriesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccessControlEntriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccessControlEntriesResponse.Merge(m, src)
+}
+func (m *QueryAccessControlEntriesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccessControlEntriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccessControlEntriesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccessControlEntriesResponse proto.InternalMessageInfo
+
+func (m *QueryAccessControlEntriesResponse) GetEntries() []AccessControlEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *QueryAccessControlEntriesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAccessControlEntryRequest is the request type for the
+// Query/AccessControlEntry RPC method.
+type QueryAccessControlEntryRequest struct {
+	// contract is the ethereum hex address of the contract to query the rule
+	// for.
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// selector is the hex 4-byte function selector to query the rule for. The
+	// contract-wide rule is returned if empty.
+	Selector string `protobuf:"bytes,2,opt,name=selector,proto3" json:"selector,omitempty"`
+}
+
+func (m *QueryAccessControlEntryRequest) Reset()         { *m = QueryAccessControlEntryRequest{} }
+func (m *QueryAccessControlEntryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccessControlEntryRequest) ProtoMessage()    {}
+func (*QueryAccessControlEntryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{30}
+}
+func (m *QueryAccessControlEntryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccessControlEntryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccessControlEntryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccessControlEntryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccessControlEntryRequest.Merge(m, src)
+}
+func (m *QueryAccessControlEntryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccessControlEntryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccessControlEntryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccessControlEntryRequest proto.InternalMessageInfo
+
+func (m *QueryAccessControlEntryRequest) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *QueryAccessControlEntryRequest) GetSelector() string {
+	if m != nil {
+		return m.Selector
+	}
+	return ""
+}
+
+// QueryAccessControlEntryResponse is the response type for the
+// Query/AccessControlEntry RPC method.
+type QueryAccessControlEntryResponse struct {
+	// entry is the call permission rule
+	Entry AccessControlEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry"`
+}
+
+func (m *QueryAccessControlEntryResponse) Reset()         { *m = QueryAccessControlEntryResponse{} }
+func (m *QueryAccessControlEntryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccessControlEntryResponse) ProtoMessage()    {}
+func (*QueryAccessControlEntryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{31}
+}
+func (m *QueryAccessControlEntryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccessControlEntryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccessControlEntryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccessControlEntryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccessControlEntryResponse.Merge(m, src)
+}
+func (m *QueryAccessControlEntryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccessControlEntryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccessControlEntryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccessControlEntryResponse proto.InternalMessageInfo
+
+func (m *QueryAccessControlEntryResponse) GetEntry() AccessControlEntry {
+	if m != nil {
+		return m.Entry
+	}
+	return AccessControlEntry{}
+}
+
+// QueryFrozenContractsRequest is the request type for the Query/FrozenContracts
+// RPC method.
+type QueryFrozenContractsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFrozenContractsRequest) Reset()         { *m = QueryFrozenContractsRequest{} }
+func (m *QueryFrozenContractsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFrozenContractsRequest) ProtoMessage()    {}
+func (*QueryFrozenContractsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{32}
+}
+func (m *QueryFrozenContractsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFrozenContractsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFrozenContractsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFrozenContractsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFrozenContractsRequest.Merge(m, src)
+}
+func (m *QueryFrozenContractsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFrozenContractsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFrozenContractsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFrozenContractsRequest proto.InternalMessageInfo
+
+func (m *QueryFrozenContractsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryFrozenContractsResponse is the response type for the
+// Query/FrozenContracts RPC method.
+type QueryFrozenContractsResponse struct {
+	// contracts are the ethereum hex addresses of the frozen contracts
+	Contracts []string `protobuf:"bytes,1,rep,name=contracts,proto3" json:"contracts,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFrozenContractsResponse) Reset()         { *m = QueryFrozenContractsResponse{} }
+func (m *QueryFrozenContractsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFrozenContractsResponse) ProtoMessage()    {}
+func (*QueryFrozenContractsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{33}
+}
+func (m *QueryFrozenContractsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFrozenContractsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFrozenContractsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFrozenContractsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFrozenContractsResponse.Merge(m, src)
+}
+func (m *QueryFrozenContractsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFrozenContractsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFrozenContractsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFrozenContractsResponse proto.InternalMessageInfo
+
+func (m *QueryFrozenContractsResponse) GetContracts() []string {
+	if m != nil {
+		return m.Contracts
+	}
+	return nil
+}
+
+func (m *QueryFrozenContractsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryConfigRequest)(nil), "cosmos.evm.vm.v1.QueryConfigRequest")
 	proto.RegisterType((*QueryConfigResponse)(nil), "cosmos.evm.vm.v1.QueryConfigResponse")
@@ -1512,114 +1833,136 @@ func init() {
 	proto.RegisterType((*QueryBaseFeeResponse)(nil), "cosmos.evm.vm.v1.QueryBaseFeeResponse")
 	proto.RegisterType((*QueryGlobalMinGasPriceRequest)(nil), "cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest")
 	proto.RegisterType((*QueryGlobalMinGasPriceResponse)(nil), "cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse")
+	proto.RegisterType((*QueryAccessControlEntriesRequest)(nil), "cosmos.evm.vm.v1.QueryAccessControlEntriesRequest")
+	proto.RegisterType((*QueryAccessControlEntriesResponse)(nil), "cosmos.evm.vm.v1.QueryAccessControlEntriesResponse")
+	proto.RegisterType((*QueryAccessControlEntryRequest)(nil), "cosmos.evm.vm.v1.QueryAccessControlEntryRequest")
+	proto.RegisterType((*QueryAccessControlEntryResponse)(nil), "cosmos.evm.vm.v1.QueryAccessControlEntryResponse")
+	proto.RegisterType((*QueryFrozenContractsRequest)(nil), "cosmos.evm.vm.v1.QueryFrozenContractsRequest")
+	proto.RegisterType((*QueryFrozenContractsResponse)(nil), "cosmos.evm.vm.v1.QueryFrozenContractsResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/evm/vm/v1/query.proto", fileDescriptor_0e8f08e175b3ef0c) }
 
 var fileDescriptor_0e8f08e175b3ef0c = []byte{
-	// 1623 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcf, 0x6f, 0x13, 0xc7,
-	0x17, 0xcf, 0xc6, 0x4e, 0xec, 0x8c, 0x13, 0x08, 0x43, 0xf8, 0xe2, 0xec, 0x37, 0xb1, 0xc3, 0x42,
-	0x7e, 0x12, 0x76, 0x49, 0x4a, 0x2b, 0x95, 0x1e, 0xda, 0x24, 0x0a, 0x81, 0x02, 0x15, 0xdd, 0x46,
-	0x3d, 0x54, 0xaa, 0xac, 0xf1, 0x7a, 0x58, 0xaf, 0xe2, 0xdd, 0x31, 0x3b, 0xeb, 0xd4, 0x81, 0xd2,
-	0x43, 0xa5, 0x22, 0x28, 0x17, 0xa4, 0xde, 0x5b, 0x8e, 0xbd, 0xb5, 0x37, 0xfe, 0x05, 0x8e, 0x48,
-	0xbd, 0x54, 0x3d, 0xd0, 0x0a, 0x2a, 0xb5, 0x7f, 0x43, 0x4f, 0xd5, 0xfc, 0x58, 0xdb, 0xeb, 0xf5,
-	0xda, 0xa1, 0xa2, 0xb7, 0x4a, 0x11, 0xec, 0xcc, 0xbc, 0x1f, 0x9f, 0xf7, 0xe6, 0xcd, 0x7b, 0x9f,
-	0x04, 0xcc, 0x58, 0x84, 0xba, 0x84, 0x1a, 0x78, 0xdf, 0x35, 0xd8, 0xcf, 0x9a, 0x71, 0xab, 0x81,
-	0xfd, 0x03, 0xbd, 0xee, 0x93, 0x80, 0xc0, 0x49, 0x71, 0xaa, 0xe3, 0x7d, 0x57, 0x67, 0x3f, 0x6b,
-	0xea, 0x31, 0xe4, 0x3a, 0x1e, 0x31, 0xf8, 0xbf, 0x42, 0x48, 0x5d, 0x91, 0x26, 0xca, 0x88, 0x62,
-	0xa1, 0x6d, 0xec, 0xaf, 0x95, 0x71, 0x80, 0xd6, 0x8c, 0x3a, 0xb2, 0x1d, 0x0f, 0x05, 0x0e, 0xf1,
-	0xa4, 0xec, 0x94, 0x4d, 0x6c, 0xc2, 0x3f, 0x0d, 0xf6, 0x25, 0x77, 0x67, 0x6c, 0x42, 0xec, 0x1a,
-	0x36, 0x50, 0xdd, 0x31, 0x90, 0xe7, 0x91, 0x80, 0xab, 0x50, 0x79, 0x5a, 0x94, 0xa7, 0x7c, 0x55,
-	0x6e, 0xdc, 0x34, 0x02, 0xc7, 0xc5, 0x34, 0x40, 0x6e, 0x5d, 0x0a, 0xa8, 0xb1, 0x18, 0x18, 0x5e,
-	0x71, 0x36, 0x1d, 0x3b, 0x0b, 0x9a, 0xe2, 0x48, 0x9b, 0x02, 0xf0, 0x43, 0x86, 0x76, 0x8b, 0x78,
-	0x37, 0x1d, 0xdb, 0xc4, 0xb7, 0x1a, 0x98, 0x06, 0xda, 0x35, 0x70, 0x3c, 0xb2, 0x4b, 0xeb, 0xc4,
-	0xa3, 0x18, 0xbe, 0x09, 0x46, 0x2d, 0xbe, 0x93, 0x57, 0xe6, 0x94, 0xa5, 0xdc, 0xfa, 0xac, 0xde,
-	0x9d, 0x1a, 0x7d, 0xab, 0x8a, 0x1c, 0x4f, 0xaa, 0x49, 0x61, 0xed, 0x6d, 0x69, 0x6d, 0xc3, 0xb2,
-	0x48, 0xc3, 0x0b, 0xa4, 0x13, 0x98, 0x07, 0x19, 0x54, 0xa9, 0xf8, 0x98, 0x52, 0x6e, 0x6e, 0xcc,
-	0x0c, 0x97, 0x17, 0xb3, 0xf7, 0x1f, 0x17, 0x87, 0xfe, 0x7c, 0x5c, 0x1c, 0xd2, 0x2c, 0x30, 0x15,
-	0x55, 0x95, 0x48, 0xf2, 0x20, 0x53, 0x46, 0x35, 0xe4, 0x59, 0x38, 0xd4, 0x95, 0x4b, 0xf8, 0x7f,
-	0x30, 0x66, 0x91, 0x0a, 0x2e, 0x55, 0x11, 0xad, 0xe6, 0x87, 0xf9, 0x59, 0x96, 0x6d, 0x5c, 0x46,
-	0xb4, 0x0a, 0xa7, 0xc0, 0x88, 0x47, 0x98, 0x52, 0x6a, 0x4e, 0x59, 0x4a, 0x9b, 0x62, 0xa1, 0xbd,
-	0x0b, 0xa6, 0x65, 0xb4, 0x2c, 0x98, 0x7f, 0x80, 0xf2, 0x9e, 0x02, 0xd4, 0x5e, 0x16, 0x24, 0xd8,
-	0x79, 0x70, 0x44, 0xe4, 0xa9, 0x14, 0xb5, 0x34, 0x21, 0x76, 0x37, 0xc4, 0x26, 0x54, 0x41, 0x96,
-	0x32, 0xa7, 0x0c, 0xdf, 0x30, 0xc7, 0xd7, 0x5a, 0x33, 0x13, 0x48, 0x58, 0x2d, 0x79, 0x0d, 0xb7,
-	0x8c, 0x7d, 0x19, 0xc1, 0x84, 0xdc, 0xfd, 0x80, 0x6f, 0x6a, 0x57, 0xc1, 0x0c, 0xc7, 0xf1, 0x31,
-	0xaa, 0x39, 0x15, 0x14, 0x10, 0xbf, 0x2b, 0x98, 0x53, 0x60, 0xdc, 0x22, 0x5e, 0x37, 0x8e, 0x1c,
-	0xdb, 0xdb, 0x88, 0x45, 0xf5, 0x50, 0x01, 0xb3, 0x09, 0xd6, 0x64, 0x60, 0x8b, 0xe0, 0x68, 0x88,
-	0x2a, 0x6a, 0x31, 0x04, 0xfb, 0x1a, 0x43, 0x0b, 0x8b, 0x68, 0x53, 0xdc, 0xf3, 0xab, 0x5c, 0xcf,
-	0x79, 0x59, 0x44, 0x2d, 0xd5, 0x41, 0x45, 0xa4, 0x5d, 0x95, 0xce, 0x3e, 0x0a, 0x88, 0x8f, 0xec,
-	0xc1, 0xce, 0xe0, 0x24, 0x48, 0xed, 0xe1, 0x03, 0x59, 0x6f, 0xec, 0xb3, 0xc3, 0xfd, 0xaa, 0x74,
-	0xdf, 0x32, 0x26, 0xdd, 0x4f, 0x81, 0x91, 0x7d, 0x54, 0x6b, 0x84, 0xce, 0xc5, 0x42, 0x7b, 0x0b,
-	0x4c, 0xca, 0x52, 0xaa, 0xbc, 0x52, 0x90, 0x8b, 0xe0, 0x58, 0x87, 0x9e, 0x74, 0x01, 0x41, 0x9a,
-	0xd5, 0x3e, 0xd7, 0x1a, 0x37, 0xf9, 0xb7, 0x76, 0x5b, 0xbe, 0xf8, 0xdd, 0xe6, 0x35, 0x62, 0xd3,
-	0xd0, 0x05, 0x04, 0x69, 0xfe, 0x62, 0x84, 0x7d, 0xfe, 0x0d, 0x2f, 0x01, 0xd0, 0xee, 0x5d, 0x3c,
-	0xb6, 0xdc, 0xfa, 0x42, 0xf8, 0xe4, 0x59, 0xa3, 0xd3, 0x45, 0x9b, 0x94, 0x8d, 0x4e, 0xbf, 0xd1,
-	0x4e, 0x95, 0xd9, 0xa1, 0xd9, 0x01, 0xf2, 0x81, 0x22, 0x13, 0x1b, 0x3a, 0x97, 0x38, 0x97, 0x41,
-	0xba, 0x46, 0x6c, 0x16, 0x5d, 0x6a, 0x29, 0xb7, 0x7e, 0x22, 0xde, 0x56, 0xae, 0x11, 0xdb, 0xe4,
-	0x22, 0x70, 0xa7, 0x07, 0xa8, 0xc5, 0x81, 0xa0, 0x84, 0x9f, 0x4e, 0x54, 0xad, 0xce, 0x77, 0x03,
-	0xf9, 0xc8, 0x0d, 0xf3, 0xa0, 0x99, 0x12, 0x60, 0xb8, 0x2b, 0x01, 0xbe, 0x03, 0x46, 0xeb, 0x7c,
-	0x47, 0x76, 0xbe, 0x7c, 0x1c, 0xa2, 0xd0, 0xd8, 0x1c, 0x7b, 0xfa, 0xbc, 0x38, 0xf4, 0xfd, 0x1f,
-	0x3f, 0xae, 0x28, 0xa6, 0x54, 0xd1, 0x9e, 0x28, 0xe0, 0xc8, 0x76, 0x50, 0xdd, 0x42, 0xb5, 0x5a,
-	0x47, 0xba, 0x91, 0x6f, 0xd3, 0xf0, 0x62, 0xd8, 0x37, 0x3c, 0x09, 0x32, 0x36, 0xa2, 0x25, 0x0b,
-	0xd5, 0xe5, 0x1b, 0x19, 0xb5, 0x11, 0xdd, 0x42, 0x75, 0xf8, 0x29, 0x98, 0xac, 0xfb, 0xa4, 0x4e,
-	0x28, 0xf6, 0x5b, 0xef, 0x8c, 0xbd, 0x91, 0xf1, 0xcd, 0xf5, 0xbf, 0x9e, 0x17, 0x75, 0xdb, 0x09,
-	0xaa, 0x8d, 0xb2, 0x6e, 0x11, 0xd7, 0x90, 0x7d, 0x5e, 0xfc, 0x77, 0x8e, 0x56, 0xf6, 0x8c, 0xe0,
-	0xa0, 0x8e, 0xa9, 0xbe, 0xd5, 0x7e, 0xe0, 0xe6, 0xd1, 0xd0, 0x56, 0xf8, 0x38, 0xa7, 0x41, 0xd6,
-	0x62, 0x5d, 0xbb, 0xe4, 0x54, 0xf2, 0xe9, 0x39, 0x65, 0x29, 0x65, 0x66, 0xf8, 0xfa, 0x4a, 0x45,
-	0xdb, 0x05, 0xc7, 0xb7, 0x69, 0xe0, 0xb8, 0x28, 0xc0, 0x3b, 0xa8, 0x9d, 0x8d, 0x49, 0x90, 0xb2,
-	0x91, 0x00, 0x9f, 0x36, 0xd9, 0x27, 0xdb, 0xf1, 0x71, 0xc0, 0x71, 0x8f, 0x9b, 0xec, 0x93, 0x59,
-	0xdd, 0x77, 0x4b, 0xd8, 0xf7, 0x89, 0x78, 0xd0, 0x63, 0x66, 0x66, 0xdf, 0xdd, 0x66, 0x4b, 0xed,
-	0x41, 0x3a, 0xac, 0x02, 0x1f, 0x59, 0x78, 0xb7, 0x19, 0x26, 0x65, 0x0d, 0xa4, 0x5c, 0x1a, 0xce,
-	0x96, 0x62, 0x3c, 0xc3, 0xd7, 0xa9, 0xbd, 0x1d, 0x54, 0xb1, 0x8f, 0x1b, 0xee, 0x6e, 0xd3, 0x64,
-	0xb2, 0xf0, 0x3d, 0x30, 0x1e, 0x30, 0x23, 0x25, 0x39, 0x97, 0x52, 0x49, 0x73, 0x89, 0xbb, 0x92,
-	0x73, 0x29, 0x17, 0xb4, 0x17, 0x70, 0x0b, 0x8c, 0xd7, 0x7d, 0x5c, 0xc1, 0x16, 0xa6, 0x94, 0xf8,
-	0x34, 0x9f, 0xe6, 0x25, 0x38, 0xd0, 0x7b, 0x44, 0x89, 0xf5, 0xd5, 0x72, 0x8d, 0x58, 0x7b, 0x61,
-	0x07, 0x1b, 0xe1, 0x69, 0xcc, 0xf1, 0x3d, 0xd1, 0xbf, 0xe0, 0x2c, 0x00, 0x42, 0x84, 0x3f, 0xb3,
-	0x51, 0x9e, 0x91, 0x31, 0xbe, 0xc3, 0x27, 0xd3, 0xe5, 0xf0, 0x98, 0xcd, 0xf5, 0x7c, 0x86, 0x87,
-	0xa1, 0xea, 0x62, 0xe8, 0xeb, 0xe1, 0xd0, 0xd7, 0x77, 0xc3, 0xa1, 0xbf, 0x39, 0xc1, 0xca, 0xec,
-	0xd1, 0xaf, 0x45, 0x45, 0x94, 0x9a, 0xb0, 0xc4, 0x8e, 0x7b, 0x56, 0x4b, 0xf6, 0xdf, 0xa9, 0x96,
-	0xb1, 0x48, 0xb5, 0x40, 0x0d, 0x4c, 0x88, 0x18, 0x5c, 0xd4, 0x2c, 0xb1, 0x02, 0x01, 0x1d, 0x69,
-	0xb8, 0x8e, 0x9a, 0x3b, 0x88, 0xbe, 0x9f, 0xce, 0x0e, 0x4f, 0xa6, 0xcc, 0x6c, 0xd0, 0x2c, 0x39,
-	0x5e, 0x05, 0x37, 0xb5, 0x15, 0xd9, 0x1c, 0x5b, 0xa5, 0xd0, 0xee, 0x5c, 0x15, 0x14, 0xa0, 0xf0,
-	0x81, 0xb0, 0x6f, 0xed, 0x49, 0x0a, 0xfc, 0xaf, 0x2d, 0xbc, 0xc9, 0xac, 0x76, 0x94, 0x4e, 0xd0,
-	0x0c, 0xfb, 0xc7, 0xe0, 0xd2, 0x09, 0x9a, 0xf4, 0x35, 0x94, 0xce, 0x7f, 0xb7, 0x7e, 0xc8, 0x5b,
-	0xd7, 0xce, 0x81, 0x93, 0xb1, 0x8b, 0xeb, 0x73, 0xd1, 0x27, 0x5a, 0xb3, 0x9e, 0xe2, 0x4b, 0x18,
-	0xb7, 0x59, 0xe9, 0x54, 0x74, 0x5b, 0x9a, 0xb8, 0x00, 0xb2, 0xac, 0xf1, 0x97, 0x6e, 0x62, 0x39,
-	0x4b, 0x37, 0xa7, 0x7f, 0x79, 0x5e, 0x3c, 0x21, 0x22, 0xa4, 0x95, 0x3d, 0xdd, 0x21, 0x86, 0x8b,
-	0x82, 0xaa, 0x7e, 0xc5, 0x0b, 0xd8, 0x8c, 0xe7, 0xda, 0x5a, 0x51, 0xb2, 0x9b, 0x9d, 0x1a, 0x29,
-	0xa3, 0xda, 0x75, 0xc7, 0xdb, 0x41, 0xf4, 0x86, 0xef, 0xb4, 0xa8, 0x85, 0x66, 0x81, 0x42, 0x92,
-	0x80, 0x74, 0xbc, 0x01, 0x26, 0x5c, 0xc7, 0x63, 0x41, 0x97, 0xea, 0xec, 0x40, 0x7a, 0x9f, 0x65,
-	0xb7, 0x94, 0x8c, 0x20, 0xe7, 0xb6, 0x4d, 0xad, 0x7f, 0x7d, 0x14, 0x8c, 0x70, 0x2f, 0xf0, 0x2b,
-	0x05, 0x64, 0x24, 0xc1, 0x82, 0xf3, 0xf1, 0x2a, 0xec, 0xc1, 0xa0, 0xd5, 0x85, 0x41, 0x62, 0x02,
-	0xa7, 0x76, 0xf6, 0xcb, 0x9f, 0x7e, 0xff, 0x66, 0x78, 0x1e, 0x9e, 0x36, 0x62, 0xbf, 0x08, 0x48,
-	0x92, 0x65, 0xdc, 0x91, 0x45, 0x73, 0x17, 0x7e, 0xab, 0x80, 0x89, 0x08, 0x8f, 0x85, 0x67, 0x13,
-	0xdc, 0xf4, 0xe2, 0xcb, 0xea, 0xea, 0xe1, 0x84, 0x25, 0xb2, 0x75, 0x8e, 0x6c, 0x15, 0xae, 0xc4,
-	0x91, 0x85, 0x94, 0x39, 0x06, 0xf0, 0x07, 0x05, 0x4c, 0x76, 0x53, 0x52, 0xa8, 0x27, 0xb8, 0x4d,
-	0x60, 0xc2, 0xaa, 0x71, 0x68, 0x79, 0x89, 0xf4, 0x22, 0x47, 0x7a, 0x01, 0xae, 0xc7, 0x91, 0xee,
-	0x87, 0x3a, 0x6d, 0xb0, 0x9d, 0x2c, 0xfb, 0x2e, 0xbc, 0xa7, 0x80, 0x8c, 0x24, 0x9f, 0x89, 0x57,
-	0x1b, 0xe5, 0xb5, 0x89, 0x57, 0xdb, 0xc5, 0x61, 0xb5, 0x55, 0x0e, 0x6b, 0x01, 0x9e, 0x89, 0xc3,
-	0x92, 0x64, 0x96, 0x76, 0xa4, 0xee, 0xa1, 0x02, 0x32, 0x92, 0x86, 0x26, 0x02, 0x89, 0x72, 0xde,
-	0x44, 0x20, 0x5d, 0x6c, 0x56, 0x5b, 0xe3, 0x40, 0xce, 0xc2, 0xe5, 0x38, 0x10, 0x2a, 0x44, 0xdb,
-	0x38, 0x8c, 0x3b, 0x7b, 0xf8, 0xe0, 0x2e, 0xbc, 0x0d, 0xd2, 0x8c, 0xad, 0x42, 0x2d, 0xb1, 0x64,
-	0x5a, 0x14, 0x58, 0x3d, 0xdd, 0x57, 0x46, 0x62, 0x58, 0xe6, 0x18, 0x4e, 0xc3, 0x53, 0xbd, 0xaa,
-	0xa9, 0x12, 0xc9, 0xc4, 0x67, 0x60, 0x54, 0x10, 0x36, 0x78, 0x26, 0xc1, 0x72, 0x84, 0x17, 0xaa,
-	0xf3, 0x03, 0xa4, 0x24, 0x82, 0x39, 0x8e, 0x40, 0x85, 0xf9, 0x38, 0x02, 0x41, 0x06, 0x61, 0x13,
-	0x64, 0x24, 0x17, 0x84, 0x73, 0x71, 0x9b, 0x51, 0x9a, 0xa8, 0x2e, 0x0e, 0x9a, 0x64, 0xa1, 0x5f,
-	0x8d, 0xfb, 0x9d, 0x81, 0x6a, 0xdc, 0x2f, 0x0e, 0xaa, 0x25, 0x8b, 0xb9, 0xfb, 0x02, 0xe4, 0x3a,
-	0xc8, 0xdc, 0x21, 0xbc, 0xf7, 0x88, 0xb9, 0x07, 0x1b, 0xd4, 0x16, 0xb8, 0xef, 0x39, 0x58, 0xe8,
-	0xe1, 0x5b, 0x8a, 0xb3, 0x16, 0x09, 0x3f, 0x07, 0x19, 0x39, 0xe5, 0x13, 0x6b, 0x2f, 0x4a, 0x08,
-	0x13, 0x6b, 0xaf, 0x8b, 0x2c, 0xf4, 0x8b, 0x5e, 0x8c, 0xf8, 0xa0, 0x09, 0xef, 0x2b, 0x00, 0xb4,
-	0xc7, 0x0f, 0x5c, 0xea, 0x67, 0xba, 0x93, 0x5a, 0xa8, 0xcb, 0x87, 0x90, 0x94, 0x38, 0xe6, 0x39,
-	0x8e, 0x22, 0x9c, 0x4d, 0xc2, 0xc1, 0x67, 0x22, 0x4b, 0x84, 0x1c, 0x61, 0x7d, 0xba, 0x41, 0xe7,
-	0xe4, 0xeb, 0xd3, 0x0d, 0x22, 0x93, 0xb0, 0x5f, 0x22, 0xc2, 0x09, 0xc9, 0x2a, 0x5f, 0xf2, 0x97,
-	0x33, 0x89, 0x6f, 0xaa, 0xe3, 0x6f, 0x41, 0x89, 0x95, 0x1f, 0xfd, 0xdb, 0x50, 0xbf, 0xca, 0x17,
-	0x04, 0x0b, 0x7e, 0xa7, 0x80, 0x63, 0xb1, 0x59, 0x0a, 0x93, 0x1a, 0x71, 0xd2, 0x58, 0x56, 0xcf,
-	0x1f, 0x5e, 0x41, 0x42, 0x5b, 0xe4, 0xd0, 0x4e, 0xc1, 0x62, 0x1c, 0x5a, 0x64, 0x7c, 0x6f, 0x5e,
-	0x7c, 0xfa, 0xa2, 0xa0, 0x3c, 0x7b, 0x51, 0x50, 0x7e, 0x7b, 0x51, 0x50, 0x1e, 0xbd, 0x2c, 0x0c,
-	0x3d, 0x7b, 0x59, 0x18, 0xfa, 0xf9, 0x65, 0x61, 0xe8, 0x93, 0xb9, 0x38, 0x81, 0x62, 0x46, 0x9a,
-	0xcc, 0x0c, 0xa7, 0x4f, 0xe5, 0x51, 0x4e, 0xd7, 0xde, 0xf8, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x36,
-	0xbb, 0x12, 0xbc, 0x4c, 0x14, 0x00, 0x00,
+	// 1873 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcf, 0x6f, 0x1b, 0xc7,
+	0xf5, 0xd7, 0x9a, 0x94, 0x48, 0x3d, 0x49, 0xb1, 0x3c, 0xa2, 0xbf, 0xa1, 0x37, 0x92, 0x48, 0xaf,
+	0xf5, 0xcb, 0xb2, 0xcd, 0xb5, 0xe4, 0xe4, 0x0b, 0xd4, 0x39, 0xb4, 0x96, 0x20, 0x2b, 0x6e, 0xec,
+	0xc2, 0x65, 0x85, 0xa2, 0x28, 0x50, 0x10, 0xc3, 0xe5, 0x98, 0x5c, 0x88, 0xbb, 0xc3, 0xec, 0xac,
+	0x54, 0xca, 0xa9, 0x7b, 0x28, 0x90, 0x20, 0x41, 0x2e, 0x01, 0x0a, 0xf4, 0x54, 0xb4, 0x39, 0xf6,
+	0xd6, 0xf6, 0xd2, 0xfc, 0x0b, 0x39, 0x06, 0xe8, 0xa5, 0xe8, 0xc1, 0x2d, 0xec, 0x02, 0xed, 0xdf,
+	0xd0, 0x53, 0x31, 0x33, 0x6f, 0x49, 0xae, 0x96, 0x2b, 0x52, 0x81, 0x7a, 0x2b, 0x40, 0xd8, 0x3b,
+	0x33, 0xef, 0xc7, 0xe7, 0xbd, 0x79, 0xf3, 0x7e, 0x08, 0x16, 0x1d, 0x2e, 0x3c, 0x2e, 0x6c, 0x76,
+	0xec, 0xd9, 0xf2, 0xb7, 0x65, 0x7f, 0x70, 0xc4, 0x82, 0x93, 0x4a, 0x27, 0xe0, 0x21, 0x27, 0xf3,
+	0xfa, 0xb4, 0xc2, 0x8e, 0xbd, 0x8a, 0xfc, 0x6d, 0x99, 0x57, 0xa8, 0xe7, 0xfa, 0xdc, 0x56, 0xff,
+	0x6a, 0x22, 0x73, 0x13, 0x45, 0xd4, 0xa9, 0x60, 0x9a, 0xdb, 0x3e, 0xde, 0xaa, 0xb3, 0x90, 0x6e,
+	0xd9, 0x1d, 0xda, 0x74, 0x7d, 0x1a, 0xba, 0xdc, 0x47, 0xda, 0x42, 0x93, 0x37, 0xb9, 0xfa, 0xb4,
+	0xe5, 0x17, 0xee, 0x2e, 0x36, 0x39, 0x6f, 0xb6, 0x99, 0x4d, 0x3b, 0xae, 0x4d, 0x7d, 0x9f, 0x87,
+	0x8a, 0x45, 0xe0, 0x69, 0x09, 0x4f, 0xd5, 0xaa, 0x7e, 0xf4, 0xcc, 0x0e, 0x5d, 0x8f, 0x89, 0x90,
+	0x7a, 0x1d, 0x24, 0x30, 0x13, 0x36, 0x48, 0xbc, 0xfa, 0xec, 0x5a, 0xe2, 0x2c, 0xec, 0xea, 0x23,
+	0xab, 0x00, 0xe4, 0xfb, 0x12, 0xed, 0x2e, 0xf7, 0x9f, 0xb9, 0xcd, 0x2a, 0xfb, 0xe0, 0x88, 0x89,
+	0xd0, 0x7a, 0x0c, 0x0b, 0xb1, 0x5d, 0xd1, 0xe1, 0xbe, 0x60, 0xe4, 0x1d, 0x98, 0x72, 0xd4, 0x4e,
+	0xd1, 0x28, 0x1b, 0x1b, 0x33, 0xdb, 0x4b, 0x95, 0xd3, 0xae, 0xa9, 0xec, 0xb6, 0xa8, 0xeb, 0x23,
+	0x1b, 0x12, 0x5b, 0xdf, 0x42, 0x69, 0x0f, 0x1c, 0x87, 0x1f, 0xf9, 0x21, 0x2a, 0x21, 0x45, 0xc8,
+	0xd1, 0x46, 0x23, 0x60, 0x42, 0x28, 0x71, 0xd3, 0xd5, 0x68, 0x79, 0x3f, 0xff, 0xc9, 0x17, 0xa5,
+	0x89, 0x7f, 0x7d, 0x51, 0x9a, 0xb0, 0x1c, 0x28, 0xc4, 0x59, 0x11, 0x49, 0x11, 0x72, 0x75, 0xda,
+	0xa6, 0xbe, 0xc3, 0x22, 0x5e, 0x5c, 0x92, 0xb7, 0x60, 0xda, 0xe1, 0x0d, 0x56, 0x6b, 0x51, 0xd1,
+	0x2a, 0x5e, 0x52, 0x67, 0x79, 0xb9, 0xf1, 0x1e, 0x15, 0x2d, 0x52, 0x80, 0x49, 0x9f, 0x4b, 0xa6,
+	0x4c, 0xd9, 0xd8, 0xc8, 0x56, 0xf5, 0xc2, 0xfa, 0x36, 0x5c, 0x43, 0x6b, 0xa5, 0x31, 0xdf, 0x00,
+	0xe5, 0xc7, 0x06, 0x98, 0xc3, 0x24, 0x20, 0xd8, 0x55, 0x78, 0x43, 0xfb, 0xa9, 0x16, 0x97, 0x34,
+	0xa7, 0x77, 0x1f, 0xe8, 0x4d, 0x62, 0x42, 0x5e, 0x48, 0xa5, 0x12, 0xdf, 0x25, 0x85, 0xaf, 0xb7,
+	0x96, 0x22, 0xa8, 0x96, 0x5a, 0xf3, 0x8f, 0xbc, 0x3a, 0x0b, 0xd0, 0x82, 0x39, 0xdc, 0xfd, 0x9e,
+	0xda, 0xb4, 0xde, 0x87, 0x45, 0x85, 0xe3, 0x87, 0xb4, 0xed, 0x36, 0x68, 0xc8, 0x83, 0x53, 0xc6,
+	0x5c, 0x87, 0x59, 0x87, 0xfb, 0xa7, 0x71, 0xcc, 0xc8, 0xbd, 0x07, 0x09, 0xab, 0x3e, 0x33, 0x60,
+	0x29, 0x45, 0x1a, 0x1a, 0xb6, 0x0e, 0x97, 0x23, 0x54, 0x71, 0x89, 0x11, 0xd8, 0x0b, 0x34, 0x2d,
+	0x0a, 0xa2, 0x1d, 0x7d, 0xcf, 0xe7, 0xb9, 0x9e, 0xbb, 0x50, 0x88, 0xb3, 0x8e, 0x0a, 0x22, 0xeb,
+	0x7d, 0x54, 0xf6, 0x83, 0x90, 0x07, 0xb4, 0x39, 0x5a, 0x19, 0x99, 0x87, 0xcc, 0x21, 0x3b, 0xc1,
+	0x78, 0x93, 0x9f, 0x03, 0xea, 0x6f, 0x43, 0x21, 0x2e, 0x0c, 0xd5, 0x17, 0x60, 0xf2, 0x98, 0xb6,
+	0x8f, 0x22, 0xe5, 0x7a, 0x61, 0xfd, 0x3f, 0xcc, 0x63, 0x28, 0x35, 0xce, 0x65, 0xe4, 0x3a, 0x5c,
+	0x19, 0xe0, 0x43, 0x15, 0x04, 0xb2, 0x32, 0xf6, 0x15, 0xd7, 0x6c, 0x55, 0x7d, 0x5b, 0xcf, 0xf1,
+	0xc5, 0x1f, 0x74, 0x1f, 0xf3, 0xa6, 0x88, 0x54, 0x10, 0xc8, 0xaa, 0x17, 0xa3, 0xe5, 0xab, 0x6f,
+	0xf2, 0x10, 0xa0, 0x9f, 0xbb, 0x94, 0x6d, 0x33, 0xdb, 0x6b, 0xd1, 0x93, 0x97, 0x89, 0xae, 0xa2,
+	0xd3, 0x24, 0x26, 0xba, 0xca, 0xd3, 0xbe, 0xab, 0xaa, 0x03, 0x9c, 0x03, 0x20, 0x3f, 0x35, 0x60,
+	0x21, 0xa6, 0x1c, 0x71, 0xde, 0x84, 0x6c, 0x9b, 0x37, 0xa5, 0x75, 0x99, 0x8d, 0x99, 0xed, 0xab,
+	0xc9, 0xb4, 0xf2, 0x98, 0x37, 0xab, 0x8a, 0x84, 0xec, 0x0f, 0x01, 0xb5, 0x3e, 0x12, 0x94, 0xd6,
+	0x33, 0x88, 0xaa, 0x97, 0xf9, 0x9e, 0xd2, 0x80, 0x7a, 0x91, 0x1f, 0xac, 0x2a, 0x2c, 0xc4, 0x76,
+	0x11, 0xe0, 0xbb, 0x30, 0xd5, 0x51, 0x3b, 0x98, 0xf9, 0x8a, 0x49, 0x88, 0x9a, 0x63, 0x67, 0xfa,
+	0xab, 0x97, 0xa5, 0x89, 0xdf, 0xfd, 0xf3, 0x0f, 0x9b, 0x46, 0x15, 0x59, 0xac, 0x2f, 0x0d, 0x78,
+	0x63, 0x2f, 0x6c, 0xed, 0xd2, 0x76, 0x7b, 0xc0, 0xdd, 0x34, 0x68, 0x8a, 0xe8, 0x62, 0xe4, 0x37,
+	0x79, 0x13, 0x72, 0x4d, 0x2a, 0x6a, 0x0e, 0xed, 0xe0, 0x1b, 0x99, 0x6a, 0x52, 0xb1, 0x4b, 0x3b,
+	0xe4, 0x27, 0x30, 0xdf, 0x09, 0x78, 0x87, 0x0b, 0x16, 0xf4, 0xde, 0x99, 0x7c, 0x23, 0xb3, 0x3b,
+	0xdb, 0xff, 0x7e, 0x59, 0xaa, 0x34, 0xdd, 0xb0, 0x75, 0x54, 0xaf, 0x38, 0xdc, 0xb3, 0x31, 0xcf,
+	0xeb, 0xff, 0xee, 0x88, 0xc6, 0xa1, 0x1d, 0x9e, 0x74, 0x98, 0xa8, 0xec, 0xf6, 0x1f, 0x78, 0xf5,
+	0x72, 0x24, 0x2b, 0x7a, 0x9c, 0xd7, 0x20, 0xef, 0xc8, 0xac, 0x5d, 0x73, 0x1b, 0xc5, 0x6c, 0xd9,
+	0xd8, 0xc8, 0x54, 0x73, 0x6a, 0xfd, 0xa8, 0x61, 0x1d, 0xc0, 0xc2, 0x9e, 0x08, 0x5d, 0x8f, 0x86,
+	0x6c, 0x9f, 0xf6, 0xbd, 0x31, 0x0f, 0x99, 0x26, 0xd5, 0xe0, 0xb3, 0x55, 0xf9, 0x29, 0x77, 0x02,
+	0x16, 0x2a, 0xdc, 0xb3, 0x55, 0xf9, 0x29, 0xa5, 0x1e, 0x7b, 0x35, 0x16, 0x04, 0x5c, 0x3f, 0xe8,
+	0xe9, 0x6a, 0xee, 0xd8, 0xdb, 0x93, 0x4b, 0xeb, 0xd3, 0x6c, 0x14, 0x05, 0x01, 0x75, 0xd8, 0x41,
+	0x37, 0x72, 0xca, 0x16, 0x64, 0x3c, 0x11, 0xd5, 0x96, 0x52, 0xd2, 0xc3, 0x4f, 0x44, 0x73, 0x2f,
+	0x6c, 0xb1, 0x80, 0x1d, 0x79, 0x07, 0xdd, 0xaa, 0xa4, 0x25, 0xdf, 0x81, 0xd9, 0x50, 0x0a, 0xa9,
+	0x61, 0x5d, 0xca, 0xa4, 0xd5, 0x25, 0xa5, 0x0a, 0xeb, 0xd2, 0x4c, 0xd8, 0x5f, 0x90, 0x5d, 0x98,
+	0xed, 0x04, 0xac, 0xc1, 0x1c, 0x26, 0x04, 0x0f, 0x44, 0x31, 0x5b, 0xce, 0x8c, 0xa3, 0x3d, 0xc6,
+	0x24, 0xf3, 0x6a, 0xbd, 0xcd, 0x9d, 0xc3, 0x28, 0x83, 0x4d, 0x2a, 0x37, 0xce, 0xa8, 0x3d, 0x9d,
+	0xbf, 0xc8, 0x12, 0x80, 0x26, 0x51, 0xcf, 0x6c, 0x4a, 0x79, 0x64, 0x5a, 0xed, 0xa8, 0xca, 0xf4,
+	0x5e, 0x74, 0x2c, 0xeb, 0x7a, 0x31, 0xa7, 0xcc, 0x30, 0x2b, 0xba, 0xe8, 0x57, 0xa2, 0xa2, 0x5f,
+	0x39, 0x88, 0x8a, 0xfe, 0xce, 0x9c, 0x0c, 0xb3, 0xcf, 0xff, 0x56, 0x32, 0x74, 0xa8, 0x69, 0x49,
+	0xf2, 0x78, 0x68, 0xb4, 0xe4, 0xff, 0x3b, 0xd1, 0x32, 0x1d, 0x8b, 0x16, 0x62, 0xc1, 0x9c, 0xb6,
+	0xc1, 0xa3, 0xdd, 0x9a, 0x0c, 0x10, 0x18, 0x70, 0xc3, 0x13, 0xda, 0xdd, 0xa7, 0xe2, 0xbb, 0xd9,
+	0xfc, 0xa5, 0xf9, 0x4c, 0x35, 0x1f, 0x76, 0x6b, 0xae, 0xdf, 0x60, 0x5d, 0x6b, 0x13, 0x93, 0x63,
+	0x2f, 0x14, 0xfa, 0x99, 0xab, 0x41, 0x43, 0x1a, 0x3d, 0x10, 0xf9, 0x6d, 0x7d, 0x99, 0x81, 0xff,
+	0xeb, 0x13, 0xef, 0x48, 0xa9, 0x03, 0xa1, 0x13, 0x76, 0xa3, 0xfc, 0x31, 0x3a, 0x74, 0xc2, 0xae,
+	0xb8, 0x80, 0xd0, 0xf9, 0xdf, 0xad, 0x8f, 0x79, 0xeb, 0xd6, 0x1d, 0x78, 0x33, 0x71, 0x71, 0x67,
+	0x5c, 0xf4, 0xd5, 0x5e, 0xad, 0x17, 0xec, 0x21, 0x63, 0xfd, 0xae, 0xb4, 0x10, 0xdf, 0x46, 0x11,
+	0x6f, 0x43, 0x5e, 0x26, 0xfe, 0xda, 0x33, 0x86, 0xb5, 0x74, 0xe7, 0xda, 0x5f, 0x5f, 0x96, 0xae,
+	0x6a, 0x0b, 0x45, 0xe3, 0xb0, 0xe2, 0x72, 0xdb, 0xa3, 0x61, 0xab, 0xf2, 0xc8, 0x0f, 0x65, 0x8d,
+	0x57, 0xdc, 0x56, 0x09, 0xbb, 0x9b, 0xfd, 0x36, 0xaf, 0xd3, 0xf6, 0x13, 0xd7, 0xdf, 0xa7, 0xe2,
+	0x69, 0xe0, 0xf6, 0x5a, 0x0b, 0xcb, 0x81, 0xe5, 0x34, 0x02, 0x54, 0xfc, 0x00, 0xe6, 0x3c, 0xd7,
+	0x97, 0x46, 0xd7, 0x3a, 0xf2, 0x00, 0xb5, 0x2f, 0xc9, 0x5b, 0x4a, 0x47, 0x30, 0xe3, 0xf5, 0x45,
+	0xc9, 0xd6, 0xb1, 0x1c, 0x75, 0xb8, 0x4c, 0x88, 0x5d, 0xee, 0x87, 0x01, 0x6f, 0xef, 0xf9, 0x61,
+	0xe0, 0xb2, 0x5e, 0x71, 0x36, 0x21, 0xef, 0xc8, 0x03, 0xea, 0x84, 0x58, 0xa0, 0x7b, 0xeb, 0x8b,
+	0x2a, 0xd2, 0xb2, 0x48, 0x5d, 0x3f, 0x03, 0x08, 0x5a, 0xfc, 0x08, 0x72, 0x4c, 0x6f, 0xe1, 0x5b,
+	0x5b, 0x49, 0xbe, 0x97, 0x84, 0x80, 0x93, 0xc1, 0xa2, 0x18, 0xf1, 0x5f, 0x5c, 0x21, 0xff, 0x11,
+	0xde, 0x53, 0x52, 0xef, 0x38, 0xfe, 0x53, 0xad, 0x69, 0x9b, 0x39, 0x21, 0x0f, 0xa2, 0x71, 0x21,
+	0x5a, 0x5b, 0x2d, 0x28, 0xa5, 0x4a, 0x46, 0x87, 0xec, 0xc1, 0xa4, 0x34, 0xe8, 0x04, 0xab, 0xd6,
+	0xb9, 0xdd, 0xa1, 0xb9, 0x2d, 0x06, 0x6f, 0x29, 0x4d, 0x0f, 0x03, 0xfe, 0x9c, 0xf9, 0xbb, 0x08,
+	0xae, 0x17, 0x00, 0xf1, 0x4b, 0x36, 0xbe, 0xf1, 0x25, 0x7f, 0x64, 0xc0, 0xe2, 0x70, 0x3d, 0x68,
+	0xce, 0xa2, 0x9c, 0x9e, 0x70, 0x53, 0xdd, 0xf0, 0x74, 0xb5, 0xbf, 0x71, 0x61, 0x57, 0xb6, 0xfd,
+	0xab, 0x05, 0x98, 0x54, 0x38, 0xc8, 0x47, 0x06, 0xe4, 0x70, 0xac, 0x20, 0xab, 0x49, 0xe7, 0x0d,
+	0x99, 0x1b, 0xcd, 0xb5, 0x51, 0x64, 0x5a, 0xa1, 0x75, 0xeb, 0x17, 0x7f, 0xfe, 0xc7, 0x2f, 0x2f,
+	0xad, 0x92, 0x1b, 0x76, 0x62, 0xfc, 0xc5, 0xd1, 0xc2, 0xfe, 0x10, 0x53, 0xe5, 0x0b, 0xf2, 0x1b,
+	0x03, 0xe6, 0x62, 0xd3, 0x1b, 0xb9, 0x95, 0xa2, 0x66, 0xd8, 0x94, 0x68, 0xde, 0x1e, 0x8f, 0x18,
+	0x91, 0x6d, 0x2b, 0x64, 0xb7, 0xc9, 0x66, 0x12, 0x59, 0x34, 0x28, 0x26, 0x00, 0xfe, 0xde, 0x80,
+	0xf9, 0xd3, 0x83, 0x18, 0xa9, 0xa4, 0xa8, 0x4d, 0x99, 0xff, 0x4c, 0x7b, 0x6c, 0x7a, 0x44, 0x7a,
+	0x5f, 0x21, 0x7d, 0x9b, 0x6c, 0x27, 0x91, 0x1e, 0x47, 0x3c, 0x7d, 0xb0, 0x83, 0xb3, 0xe5, 0x0b,
+	0xf2, 0xb1, 0x01, 0x39, 0x1c, 0xb9, 0x52, 0xaf, 0x36, 0x3e, 0xcd, 0x99, 0x6b, 0xa3, 0xc8, 0x10,
+	0xd6, 0x6d, 0x05, 0x6b, 0x8d, 0xac, 0x24, 0x61, 0xe1, 0x08, 0x27, 0x06, 0x5c, 0xf7, 0x99, 0x01,
+	0x39, 0x1c, 0xbe, 0x52, 0x81, 0xc4, 0x27, 0x3d, 0x73, 0x6d, 0x14, 0x19, 0x02, 0xd9, 0x52, 0x40,
+	0x6e, 0x91, 0x9b, 0x49, 0x20, 0x42, 0x93, 0xf6, 0x71, 0xd8, 0x1f, 0x1e, 0xb2, 0x93, 0x17, 0xe4,
+	0x39, 0x64, 0xe5, 0x8c, 0x46, 0xac, 0xd4, 0x90, 0xe9, 0x0d, 0x7e, 0xe6, 0x8d, 0x33, 0x69, 0x10,
+	0xc3, 0x4d, 0x85, 0xe1, 0x06, 0xb9, 0x3e, 0x2c, 0x9a, 0x1a, 0x31, 0x4f, 0xfc, 0x14, 0xa6, 0xf4,
+	0x98, 0x42, 0x56, 0x52, 0x24, 0xc7, 0xa6, 0x21, 0x73, 0x75, 0x04, 0x15, 0x22, 0x28, 0x2b, 0x04,
+	0x26, 0x29, 0x26, 0x11, 0xe8, 0x11, 0x88, 0x74, 0x21, 0x87, 0x13, 0x10, 0x29, 0x27, 0x65, 0xc6,
+	0x87, 0x23, 0x73, 0x7d, 0x54, 0xff, 0x16, 0xe9, 0xb5, 0x94, 0xde, 0x45, 0x62, 0x26, 0xf5, 0xb2,
+	0xb0, 0x55, 0x73, 0xa4, 0xba, 0x9f, 0xc3, 0xcc, 0xc0, 0x08, 0x33, 0x86, 0xf6, 0x21, 0x36, 0x0f,
+	0x99, 0x81, 0xac, 0x35, 0xa5, 0xbb, 0x4c, 0x96, 0x87, 0xe8, 0x46, 0x72, 0xd9, 0x18, 0x90, 0x9f,
+	0x41, 0x0e, 0x7b, 0xdb, 0xd4, 0xd8, 0x8b, 0x8f, 0x41, 0xe6, 0xda, 0x28, 0xb2, 0xd1, 0xd6, 0xeb,
+	0xc6, 0x36, 0xec, 0x92, 0x4f, 0x0c, 0x80, 0x7e, 0xd3, 0x45, 0x36, 0xce, 0x12, 0x3d, 0xd8, 0x50,
+	0x9b, 0x37, 0xc7, 0xa0, 0x44, 0x1c, 0xab, 0x0a, 0x47, 0x89, 0x2c, 0xa5, 0xe1, 0x50, 0x9d, 0xa0,
+	0x74, 0x04, 0x36, 0x6e, 0x67, 0x64, 0x83, 0xc1, 0x7e, 0xcf, 0x5c, 0x1b, 0x45, 0x36, 0xda, 0x11,
+	0x51, 0x5f, 0x28, 0x23, 0x1f, 0xbb, 0xf6, 0x95, 0xd4, 0x37, 0x35, 0xf0, 0x17, 0x50, 0x73, 0x75,
+	0x04, 0xd5, 0xe8, 0xc8, 0xd7, 0x63, 0x05, 0xf9, 0xad, 0x01, 0x57, 0x12, 0x1d, 0x24, 0x49, 0x4b,
+	0xc4, 0x69, 0xcd, 0xa8, 0x79, 0x77, 0x7c, 0x06, 0x84, 0xb6, 0xae, 0xa0, 0x5d, 0x27, 0xa5, 0x24,
+	0xb4, 0x58, 0xd3, 0x4a, 0xfe, 0x68, 0x40, 0x61, 0x58, 0xd3, 0x47, 0xb6, 0xd3, 0x0b, 0x6d, 0x5a,
+	0xab, 0x6a, 0xde, 0x3b, 0x17, 0x0f, 0x42, 0xbd, 0xab, 0xa0, 0x6e, 0x92, 0x8d, 0xa1, 0x95, 0x9a,
+	0x09, 0x51, 0x73, 0x34, 0x63, 0x2d, 0x6a, 0x1e, 0xff, 0x64, 0x00, 0x49, 0x36, 0x56, 0xe4, 0xee,
+	0xb8, 0xda, 0xa3, 0xd6, 0xd0, 0xdc, 0x3a, 0x07, 0x07, 0xa2, 0x7d, 0x57, 0xa1, 0x7d, 0x87, 0xdc,
+	0x1b, 0x17, 0xad, 0x2a, 0x8c, 0xaa, 0x85, 0x7a, 0x41, 0x7e, 0x6d, 0xc0, 0xe5, 0x53, 0xcd, 0x17,
+	0xb9, 0x93, 0x82, 0x61, 0x78, 0x33, 0x68, 0x56, 0xc6, 0x25, 0x47, 0xbc, 0x9b, 0x0a, 0xef, 0x0a,
+	0xb1, 0x92, 0x78, 0x9f, 0x29, 0x96, 0x5a, 0x04, 0x4f, 0xec, 0xdc, 0xff, 0xea, 0xd5, 0xb2, 0xf1,
+	0xf5, 0xab, 0x65, 0xe3, 0xef, 0xaf, 0x96, 0x8d, 0xcf, 0x5f, 0x2f, 0x4f, 0x7c, 0xfd, 0x7a, 0x79,
+	0xe2, 0x2f, 0xaf, 0x97, 0x27, 0x7e, 0x5c, 0x4e, 0x8e, 0x90, 0x52, 0x4e, 0x57, 0x4a, 0x52, 0x03,
+	0x64, 0x7d, 0x4a, 0x0d, 0xac, 0xf7, 0xfe, 0x33, 0x00, 0x2f, 0x3a, 0xb2, 0xda, 0x4e, 0x19, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1670,6 +2013,14 @@ type QueryClient interface {
 	// but makes the conversion to 18 decimals
 	// when the evm denom is represented with a different precision.
 	GlobalMinGasPrice(ctx context.Context, in *QueryGlobalMinGasPriceRequest, opts ...grpc.CallOption) (*QueryGlobalMinGasPriceResponse, error)
+	// AccessControlEntries queries the per-contract and per-function call
+	// permission rules, optionally filtered by contract.
+	AccessControlEntries(ctx context.Context, in *QueryAccessControlEntriesRequest, opts ...grpc.CallOption) (*QueryAccessControlEntriesResponse, error)
+	// AccessControlEntry queries the call permission rule of a contract, or of
+	// one of its functions.
+	AccessControlEntry(ctx context.Context, in *QueryAccessControlEntryRequest, opts ...grpc.CallOption) (*QueryAccessControlEntryResponse, error)
+	// FrozenContracts queries the contracts frozen by governance.
+	FrozenContracts(ctx context.Context, in *QueryFrozenContractsRequest, opts ...grpc.CallOption) (*QueryFrozenContractsResponse, error)
 }
 
 type queryClient struct {
@@ -1806,6 +2157,33 @@ func (c *queryClient) GlobalMinGasPrice(ctx context.Context, in *QueryGlobalMinG
 	return out, nil
 }
 
+func (c *queryClient) AccessControlEntries(ctx context.Context, in *QueryAccessControlEntriesRequest, opts ...grpc.CallOption) (*QueryAccessControlEntriesResponse, error) {
+	out := new(QueryAccessControlEntriesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Query/AccessControlEntries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccessControlEntry(ctx context.Context, in *QueryAccessControlEntryRequest, opts ...grpc.CallOption) (*QueryAccessControlEntryResponse, error) {
+	out := new(QueryAccessControlEntryResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Query/AccessControlEntry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FrozenContracts(ctx context.Context, in *QueryFrozenContractsRequest, opts ...grpc.CallOption) (*QueryFrozenContractsResponse, error) {
+	out := new(QueryFrozenContractsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Query/FrozenContracts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Account queries an Ethereum account.
@@ -1844,6 +2222,14 @@ type QueryServer interface {
 	// but makes the conversion to 18 decimals
 	// when the evm denom is represented with a different precision.
 	GlobalMinGasPrice(context.Context, *QueryGlobalMinGasPriceRequest) (*QueryGlobalMinGasPriceResponse, error)
+	// AccessControlEntries queries the per-contract and per-function call
+	// permission rules, optionally filtered by contract.
+	AccessControlEntries(context.Context, *QueryAccessControlEntriesRequest) (*QueryAccessControlEntriesResponse, error)
+	// AccessControlEntry queries the call permission rule of a contract, or of
+	// one of its functions.
+	AccessControlEntry(context.Context, *QueryAccessControlEntryRequest) (*QueryAccessControlEntryResponse, error)
+	// FrozenContracts queries the contracts frozen by governance.
+	FrozenContracts(context.Context, *QueryFrozenContractsRequest) (*QueryFrozenContractsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1892,6 +2278,15 @@ func (*UnimplementedQueryServer) Config(ctx context.Context, req *QueryConfigReq
 func (*UnimplementedQueryServer) GlobalMinGasPrice(ctx context.Context, req *QueryGlobalMinGasPriceRequest) (*QueryGlobalMinGasPriceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GlobalMinGasPrice not implemented")
 }
+func (*UnimplementedQueryServer) AccessControlEntries(ctx context.Context, req *QueryAccessControlEntriesRequest) (*QueryAccessControlEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccessControlEntries not implemented")
+}
+func (*UnimplementedQueryServer) AccessControlEntry(ctx context.Context, req *QueryAccessControlEntryRequest) (*QueryAccessControlEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccessControlEntry not implemented")
+}
+func (*UnimplementedQueryServer) FrozenContracts(ctx context.Context, req *QueryFrozenContractsRequest) (*QueryFrozenContractsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FrozenContracts not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2149,6 +2544,61 @@ func _Query_GlobalMinGasPrice_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AccessControlEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccessControlEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccessControlEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.vm.v1.Query/AccessControlEntries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccessControlEntries(ctx, req.(*QueryAccessControlEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccessControlEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccessControlEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccessControlEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.vm.v1.Query/AccessControlEntry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccessControlEntry(ctx, req.(*QueryAccessControlEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FrozenContracts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFrozenContractsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FrozenContracts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.vm.v1.Query/FrozenContracts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FrozenContracts(ctx, req.(*QueryFrozenContractsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.evm.vm.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2209,6 +2659,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GlobalMinGasPrice",
 			Handler:    _Query_GlobalMinGasPrice_Handler,
 		},
+		{
+			MethodName: "AccessControlEntries",
+			Handler:    _Query_AccessControlEntries_Handler,
+		},
+		{
+			MethodName: "AccessControlEntry",
+			Handler:    _Query_AccessControlEntry_Handler,
+		},
+		{
+			MethodName: "FrozenContracts",
+			Handler:    _Query_FrozenContracts_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evm/vm/v1/query.proto",
@@ -3265,9 +3727,249 @@ func (m *QueryGlobalMinGasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
+func (m *QueryAccessControlEntriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccessControlEntriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccessControlEntriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccessControlEntriesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccessControlEntriesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccessControlEntriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccessControlEntryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccessControlEntryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccessControlEntryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Selector) > 0 {
+		i -= len(m.Selector)
+		copy(dAtA[i:], m.Selector)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Selector)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccessControlEntryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccessControlEntryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccessControlEntryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Entry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFrozenContractsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFrozenContractsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFrozenContractsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFrozenContractsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFrozenContractsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFrozenContractsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contracts) > 0 {
+		for iNdEx := len(m.Contracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Contracts[iNdEx])
+			copy(dAtA[i:], m.Contracts[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Contracts[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
 		v >>= 7
@@ -3724,39 +4426,135 @@ func (m *QueryGlobalMinGasPriceResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryAccessControlEntriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func (m *QueryConfigRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConfigRequest: wiretype end group for non-group")
+
+func (m *QueryAccessControlEntriesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
 		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccessControlEntryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Selector)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccessControlEntryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Entry.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFrozenContractsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFrozenContractsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Contracts) > 0 {
+		for _, s := range m.Contracts {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryConfigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6699,6 +7497,645 @@ func (m *QueryGlobalMinGasPriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryAccessControlEntriesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccessControlEntriesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccessControlEntriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccessControlEntriesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccessControlEntriesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccessControlEntriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, AccessControlEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccessControlEntryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccessControlEntryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccessControlEntryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Selector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccessControlEntryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccessControlEntryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccessControlEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Entry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFrozenContractsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFrozenContractsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFrozenContractsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFrozenContractsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFrozenContractsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFrozenContractsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contracts = append(m.Contracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,42 @@
+package types
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// NewSyntheticTx returns the unsigned legacy transaction that represents an EVM
+// message executed on behalf of a Cosmos account (e.g. through MsgCallEVM), so
+// that the execution can be exposed through the JSON-RPC API like any other
+// Ethereum transaction.
+//
+// As the message isn't signed with an Ethereum key, V is left empty, while R
+// and S are set to the block height and to the index of the transaction in
+// the block, which makes the hash of every synthetic transaction unique.
+func NewSyntheticTx(msg core.Message, height int64, txIndex uint64) *ethtypes.Transaction {
+	return ethtypes.NewTx(&ethtypes.LegacyTx{
+		Nonce:    msg.Nonce(),
+		GasPrice: msg.GasPrice(),
+		Gas:      msg.Gas(),
+		To:       msg.To(),
+		Value:    msg.Value(),
+		Data:     msg.Data(),
+		V:        new(big.Int),
+		R:        big.NewInt(height),
+		S:        new(big.Int).SetUint64(txIndex),
+	})
+}
+
+// IsSyntheticTx returns true if the transaction has been created by
+// NewSyntheticTx, i.e. it's an unsigned legacy transaction with the block
+// height set as its R value.
+func IsSyntheticTx(tx *ethtypes.Transaction) bool {
+	if tx.Type() != ethtypes.LegacyTxType {
+		return false
+	}
+
+	v, r, _ := tx.RawSignatureValues()
+	return (v == nil || v.Sign() == 0) && r != nil && r.Sign() > 0
+}
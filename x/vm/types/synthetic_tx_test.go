@@ -0,0 +1,55 @@
+package types_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	"github.com/cosmos/evm/x/vm/types"
+)
+
+func TestSyntheticTx(t *testing.T) {
+	from, key := utiltx.NewAddrKey()
+	to := utiltx.GenerateAddress()
+
+	msg := ethtypes.NewMessage(
+		from, &to, 1, big.NewInt(100), 50000, big.NewInt(0), big.NewInt(0), big.NewInt(0),
+		[]byte{0x01}, ethtypes.AccessList{}, false,
+	)
+
+	tx := types.NewSyntheticTx(msg, 10, 2)
+	require.True(t, types.IsSyntheticTx(tx))
+	require.Equal(t, msg.Nonce(), tx.Nonce())
+	require.Equal(t, msg.Gas(), tx.Gas())
+	require.Equal(t, &to, tx.To())
+	require.Equal(t, msg.Value(), tx.Value())
+	require.Equal(t, msg.Data(), tx.Data())
+
+	// the hash depends on the position of the tx in the chain
+	require.NotEqual(t, tx.Hash(), types.NewSyntheticTx(msg, 10, 3).Hash())
+	require.NotEqual(t, tx.Hash(), types.NewSyntheticTx(msg, 11, 2).Hash())
+
+	// the tx is preserved when wrapped in a MsgEthereumTx
+	ethMsg := &types.MsgEthereumTx{}
+	require.NoError(t, ethMsg.FromEthereumTx(tx))
+	require.Equal(t, tx.Hash().Hex(), ethMsg.Hash)
+	require.Equal(t, tx.Hash(), ethMsg.AsTransaction().Hash())
+	require.True(t, types.IsSyntheticTx(ethMsg.AsTransaction()))
+
+	// signed txs aren't synthetic txs
+	signedTx := types.NewTx(&types.EvmTxArgs{
+		ChainID:  big.NewInt(9001),
+		Nonce:    1,
+		To:       &to,
+		GasLimit: 50000,
+		GasPrice: big.NewInt(1),
+	})
+	signedTx.From = from.Hex()
+	require.NoError(t, signedTx.Sign(ethtypes.LatestSignerForChainID(big.NewInt(9001)), utiltx.NewSigner(key)))
+	require.False(t, types.IsSyntheticTx(signedTx.AsTransaction()))
+	require.False(t, types.IsSyntheticTx(ethtypes.NewTx(&ethtypes.DynamicFeeTx{To: &common.Address{}})))
+}